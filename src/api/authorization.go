@@ -0,0 +1,91 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/inout"
+	"hive/models"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+func authorizationToProto(authorization *models.Authorization) *inout.Authorization {
+	decisions := make([]*inout.AuthorizationDecision, len(authorization.Decisions))
+	for i, decision := range authorization.Decisions {
+		decisions[i] = &inout.AuthorizationDecision{
+			Allowed: decision.Allowed,
+			Reason:  decision.Reason,
+		}
+	}
+
+	return &inout.Authorization{
+		UserID:    authorization.UserID.Bytes(),
+		Version:   authorization.Version,
+		Decisions: decisions,
+	}
+}
+
+func (api *API) renderAuthorizeValidationError(w http.ResponseWriter, r *http.Request, validationError *inout.AuthorizeResponseV1_ValidationError) {
+	api.Renderer.Render(w, r, http.StatusBadRequest, &inout.AuthorizeResponseV1{
+		Data: &inout.AuthorizeResponseV1_ValidationError_{ValidationError: validationError}})
+}
+
+// AuthorizeV1 checks permissions of user identified by id or access token on behalf of other services
+func (api *API) AuthorizeV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.AuthorizeResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	ctx := r.Context()
+	userID := uuid.FromBytesOrNil(body.UserID)
+
+	if body.Token != "" {
+		status, user := api.authenticationController.GetTokenUser(ctx, body.Token)
+		if status != enums.Ok || user == nil {
+			api.renderAuthorizeValidationError(w, r, &inout.AuthorizeResponseV1_ValidationError{
+				Token: []string{"Некорректный токен доступа"}})
+			return
+		}
+
+		userID = user.GetUserID()
+	}
+
+	if uuid.Equal(userID, uuid.Nil) {
+		api.renderAuthorizeValidationError(w, r, &inout.AuthorizeResponseV1_ValidationError{
+			UserID: []string{"Необходимо указать пользователя или токен доступа"}})
+		return
+	}
+
+	if len(body.Checks) == 0 || len(body.Checks) > api.environment.AuthorizationMaxChecks {
+		api.renderAuthorizeValidationError(w, r, &inout.AuthorizeResponseV1_ValidationError{
+			Checks: []string{"Некорректное количество проверок"}})
+		return
+	}
+
+	checks := make([]*models.AuthorizationCheck, len(body.Checks))
+	for i, check := range body.Checks {
+		checks[i] = &models.AuthorizationCheck{
+			Action:         check.Action,
+			Resource:       check.Resource,
+			OrganizationID: uuid.FromBytesOrNil(check.OrganizationID),
+		}
+	}
+
+	status, authorization := api.Controller.Authorize(ctx, userID, checks)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.AuthorizeResponseV1{
+			Data: &inout.AuthorizeResponseV1_Ok{Ok: authorizationToProto(authorization)}})
+	case enums.UserNotFound:
+		api.renderAuthorizeValidationError(w, r, &inout.AuthorizeResponseV1_ValidationError{
+			UserID: []string{"Такого пользователя не существует"}})
+	case enums.IncorrectPermission:
+		api.renderAuthorizeValidationError(w, r, &inout.AuthorizeResponseV1_ValidationError{
+			Checks: []string{"Некорректная проверка, ожидаются действие и ресурс из латинских букв"}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
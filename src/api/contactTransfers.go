@@ -0,0 +1,172 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+func contactTransferToProto(transfer *models.ContactTransfer) *inout.ContactTransfer {
+	return &inout.ContactTransfer{
+		Id:         transfer.Id.Bytes(),
+		Created:    transfer.Created,
+		Kind:       transfer.Kind,
+		Value:      transfer.Value,
+		FromUserID: transfer.FromUserId.Bytes(),
+		ToUserID:   transfer.ToUserId.Bytes(),
+		Status:     transfer.Status,
+		Resolved:   transfer.Resolved,
+	}
+}
+
+// requestedContactTransferToProto hides previous owner from requesting user, so contacts can't be used to find accounts
+func requestedContactTransferToProto(transfer *models.ContactTransfer) *inout.ContactTransfer {
+	data := contactTransferToProto(transfer)
+	data.FromUserID = nil
+	return data
+}
+
+// GetUserContactTransfersV1 lists ownership history of contacts user gave away or received,
+// previous owners of received contacts are hidden as in GetContactTransferV1
+func (api *API) GetUserContactTransfersV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	user := repositories.GetUserFromContext(r.Context())
+	if !canAccessProfile(user, id, enums.UsersRead) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.ContactTransferResource, OwnerID: id}); !ok {
+		return
+	}
+
+	transfers := api.Controller.GetUserContactTransfers(r.Context(), id)
+	data := make([]*inout.ContactTransfer, 0, len(transfers))
+	for _, transfer := range transfers {
+		if canAccessProfile(user, transfer.FromUserId, enums.UsersRead) {
+			data = append(data, contactTransferToProto(transfer))
+		} else {
+			data = append(data, requestedContactTransferToProto(transfer))
+		}
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListContactTransferResponseV1{Data: data})
+}
+
+// GetContactTransferV1 shows transfer to its previous owner, to requesting user and to users with read permission
+func (api *API) GetContactTransferV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, transfer := api.Controller.GetContactTransfer(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+	case enums.ContactTransferNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.GetContactTransferResponseV1{})
+		return
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+		return
+	}
+
+	user := repositories.GetUserFromContext(r.Context())
+	if !canAccessProfile(user, transfer.FromUserId, enums.UsersRead) && !canAccessProfile(user, transfer.ToUserId, enums.UsersRead) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	ownerID := transfer.FromUserId
+	if uuid.Equal(user.GetUserID(), transfer.ToUserId) {
+		ownerID = transfer.ToUserId
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.ContactTransferResource, OwnerID: ownerID}); !ok {
+		return
+	}
+
+	data := contactTransferToProto(transfer)
+	if !canAccessProfile(user, transfer.FromUserId, enums.UsersRead) {
+		data = requestedContactTransferToProto(transfer)
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.GetContactTransferResponseV1{Data: data})
+}
+
+// ReviewContactTransferV1 approves or rejects pending transfer, only previous owner of contact could review it
+func (api *API) ReviewContactTransferV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.ReviewContactTransferResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	id, _ := extractors.GetUUID(r)
+	status, transfer := api.Controller.GetContactTransfer(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+	case enums.ContactTransferNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.ReviewContactTransferResponseV1{})
+		return
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+		return
+	}
+
+	if !canAccessProfile(repositories.GetUserFromContext(r.Context()), transfer.FromUserId, enums.UsersUpdate) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.ContactTransferResource, OwnerID: transfer.FromUserId}); !ok {
+		return
+	}
+
+	status, transfer = api.Controller.ReviewContactTransfer(r.Context(), id, body.Status)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.ReviewContactTransferResponseV1{
+			Data: &inout.ReviewContactTransferResponseV1_Ok{Ok: contactTransferToProto(transfer)},
+		})
+	case enums.ContactTransferNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewContactTransferResponseV1{
+			Data: &inout.ReviewContactTransferResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewContactTransferResponseV1_ValidationError{
+					Errors: []string{"Передача уже рассмотрена"},
+				}}})
+	case enums.IncorrectContactTransferStatus:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewContactTransferResponseV1{
+			Data: &inout.ReviewContactTransferResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewContactTransferResponseV1_ValidationError{
+					Status: []string{"Передачу можно только подтвердить или отклонить"},
+				}}})
+	case enums.LastLoginIdentifier:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewContactTransferResponseV1{
+			Data: &inout.ReviewContactTransferResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewContactTransferResponseV1_ValidationError{
+					Errors: []string{"Нельзя передать последний email или телефон, по которому пользователь входит"},
+				}}})
+	case enums.ContactTransferOutdated:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewContactTransferResponseV1{
+			Data: &inout.ReviewContactTransferResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewContactTransferResponseV1_ValidationError{
+					Errors: []string{"Контакт больше не принадлежит пользователю"},
+				}}})
+	case enums.UserNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewContactTransferResponseV1{
+			Data: &inout.ReviewContactTransferResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewContactTransferResponseV1_ValidationError{
+					Errors: []string{"Такого пользователя не существует"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"hive/auth"
+	"hive/auth/backends"
+	"hive/config"
+	"hive/controllers"
+	"hive/enums"
+	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUserContactTransfersV1HidesPreviousOwnerFromReceiver(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := controllers.NewMockIController(ctrl)
+	policyEngine := policies.NewMockIPolicyEngine(ctrl)
+	api := InitAPI(controller, auth.NewMockIAuthenticationController(ctrl), policyEngine, config.InitEnvironment())
+	id := uuid.NewV4()
+	previousOwnerID := uuid.NewV4()
+	receiverID := uuid.NewV4()
+
+	request := httptest.NewRequest(http.MethodGet, "/", bytes.NewReader([]byte{}))
+	request.Header.Add("Content-Type", "application/octet-stream")
+	request = mux.SetURLVars(request, map[string]string{"id": id.String()})
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), &backends.BasicAuthenticationBackendUser{UserID: id}))
+	ctx := request.Context()
+
+	policyEngine.
+		EXPECT().
+		Evaluate(ctx, gomock.Any()).
+		Return(&policies.Decision{Allowed: true}).
+		Times(1)
+
+	controller.
+		EXPECT().
+		GetUserContactTransfers(ctx, id).
+		Return([]*models.ContactTransfer{
+			{Id: uuid.NewV4(), Kind: enums.EmailContact, Value: "received@mail.com", FromUserId: previousOwnerID, ToUserId: id},
+			{Id: uuid.NewV4(), Kind: enums.EmailContact, Value: "given@mail.com", FromUserId: id, ToUserId: receiverID},
+		}).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.GetUserContactTransfersV1(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+	responseBody, _ := ioutil.ReadAll(recorder.Result().Body)
+	var message inout.ListContactTransferResponseV1
+	require.NoError(t, proto.Unmarshal(responseBody, &message))
+	require.Len(t, message.GetData(), 2)
+	require.Empty(t, message.GetData()[0].FromUserID)
+	require.Equal(t, id.Bytes(), message.GetData()[1].FromUserID)
+	require.Equal(t, receiverID.Bytes(), message.GetData()[1].ToUserID)
+}
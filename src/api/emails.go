@@ -2,11 +2,26 @@ package api
 
 import (
 	"hive/enums"
+	"hive/extractors"
 	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
 	uuid "github.com/satori/go.uuid"
 	"net/http"
 )
 
+func emailToProto(email *models.Email) *inout.Email {
+	return &inout.Email{
+		Id:         email.Id.Bytes(),
+		Created:    email.Created,
+		UserID:     email.UserId.Bytes(),
+		Email:      email.Value,
+		Primary:    email.Primary,
+		VerifiedAt: email.VerifiedAt,
+	}
+}
+
 func (api *API) CreateEmailV1(w http.ResponseWriter, r *http.Request) {
 	body := &inout.CreateEmailResponseV1_Request{}
 	err := api.Parser.Parse(r, w, body)
@@ -14,17 +29,26 @@ func (api *API) CreateEmailV1(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	status, email := api.Controller.CreateEmail(r.Context(), body.Email, body.Code, uuid.FromBytesOrNil(body.UserID))
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.EmailResource, OwnerID: uuid.FromBytesOrNil(body.UserID)}); !ok {
+		return
+	}
+
+	status, email, transfer := api.Controller.CreateEmail(r.Context(), body.Email, body.Code, uuid.FromBytesOrNil(body.UserID))
 
 	switch status {
 	case enums.Ok:
 		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateEmailResponseV1{
 			Data: &inout.CreateEmailResponseV1_Ok{
-				Ok: &inout.Email{
-					Id:      email.Id.Bytes(),
-					Created: email.Created,
-					UserID:  email.UserId.Bytes(),
-					Email:   email.Value,
+				Ok: emailToProto(email)}})
+	case enums.ContactTransferRequested:
+		api.Renderer.Render(w, r, http.StatusAccepted, &inout.CreateEmailResponseV1{
+			Data: &inout.CreateEmailResponseV1_Transfer{
+				Transfer: requestedContactTransferToProto(transfer)}})
+	case enums.LastLoginIdentifier:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateEmailResponseV1{
+			Data: &inout.CreateEmailResponseV1_ValidationError_{
+				ValidationError: &inout.CreateEmailResponseV1_ValidationError{
+					Email: []string{"Email является единственным способом входа другого пользователя"},
 				}}})
 	case enums.IncorrectEmailCode:
 		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateEmailResponseV1{
@@ -83,3 +107,99 @@ func (api *API) CreateEmailConfirmationV1(w http.ResponseWriter, r *http.Request
 		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
 	}
 }
+
+// getOwnedEmail finds email which could be changed by authenticated user, renders error otherwise
+func (api *API) getOwnedEmail(w http.ResponseWriter, r *http.Request) (*models.Email, bool) {
+
+	id, _ := extractors.GetUUID(r)
+	status, email := api.Controller.GetEmailByID(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+	case enums.EmailNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+		return nil, false
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+		return nil, false
+	}
+
+	if !canAccessProfile(repositories.GetUserFromContext(r.Context()), email.UserId, enums.UsersUpdate) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return nil, false
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.EmailResource, OwnerID: email.UserId}); !ok {
+		return nil, false
+	}
+
+	return email, true
+}
+
+// GetUserEmailsV1 lists emails of user, primary email goes first
+func (api *API) GetUserEmailsV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	if !canAccessProfile(repositories.GetUserFromContext(r.Context()), id, enums.UsersRead) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.EmailResource, OwnerID: id}); !ok {
+		return
+	}
+
+	emails := api.Controller.GetUserEmails(r.Context(), id)
+	data := make([]*inout.Email, 0, len(emails))
+	for _, email := range emails {
+		data = append(data, emailToProto(email))
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListEmailResponseV1{Data: data})
+}
+
+func (api *API) DeleteEmailV1(w http.ResponseWriter, r *http.Request) {
+
+	email, ok := api.getOwnedEmail(w, r)
+	if !ok {
+		return
+	}
+
+	status, email := api.Controller.DeleteEmail(r.Context(), email.Id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.DeleteEmailResponseV1{
+			Data: &inout.DeleteEmailResponseV1_Ok{Ok: emailToProto(email)},
+		})
+	case enums.EmailNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	case enums.LastLoginIdentifier:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.DeleteEmailResponseV1{
+			Data: &inout.DeleteEmailResponseV1_ValidationError_{
+				ValidationError: &inout.DeleteEmailResponseV1_ValidationError{
+					Errors: []string{"Нельзя удалить последний email или телефон, по которому пользователь входит"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) SetPrimaryEmailV1(w http.ResponseWriter, r *http.Request) {
+
+	email, ok := api.getOwnedEmail(w, r)
+	if !ok {
+		return
+	}
+
+	status, email := api.Controller.SetPrimaryEmail(r.Context(), email.Id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.SetPrimaryEmailResponseV1{Data: emailToProto(email)})
+	case enums.EmailNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
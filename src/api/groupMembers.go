@@ -0,0 +1,99 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/functools"
+	"hive/inout"
+	"hive/repositories"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+func (api *API) GetGroupMembersV1Query(r *http.Request) repositories.GetGroupMembersQuery {
+
+	query := r.URL.Query()
+	return repositories.GetGroupMembersQuery{
+		Pagination: functools.GetPagination(query, api.environment),
+		GroupId:    functools.StringsSliceToUUIDSlice(query["groups"]),
+		UserId:     functools.StringsSliceToUUIDSlice(query["users"]),
+	}
+}
+
+func (api *API) GetGroupMembersV1(w http.ResponseWriter, r *http.Request) {
+
+	query := api.GetGroupMembersV1Query(r)
+	members, pagination := api.Controller.GetGroupMembers(r.Context(), query)
+	membersData := make([]*inout.GroupMember, len(members))
+
+	for i, member := range members {
+		membersData[i] = &inout.GroupMember{
+			Id:      member.Id.Bytes(),
+			Created: member.Created,
+			GroupID: member.GroupId.Bytes(),
+			UserID:  member.UserId.Bytes(),
+		}
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListGroupMembersResponseV1{Data: membersData, Pagination: &inout.Pagination{
+		HasPrevious: pagination.HasPrevious,
+		HasNext:     pagination.HasNext,
+		Count:       pagination.Count,
+	}})
+}
+
+func (api *API) CreateGroupMemberV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.CreateGroupMemberResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	status, member := api.Controller.CreateGroupMember(r.Context(), uuid.FromBytesOrNil(body.GroupID), uuid.FromBytesOrNil(body.UserID))
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateGroupMemberResponseV1{
+			Data: &inout.CreateGroupMemberResponseV1_Ok{Ok: &inout.GroupMember{
+				Id:      member.Id.Bytes(),
+				Created: member.Created,
+				GroupID: member.GroupId.Bytes(),
+				UserID:  member.UserId.Bytes(),
+			}},
+		})
+	case enums.GroupNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateGroupMemberResponseV1{
+			Data: &inout.CreateGroupMemberResponseV1_ValidationError_{
+				ValidationError: &inout.CreateGroupMemberResponseV1_ValidationError{
+					GroupID: []string{"Такой группы не существует"},
+				}}})
+	case enums.UserNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateGroupMemberResponseV1{
+			Data: &inout.CreateGroupMemberResponseV1_ValidationError_{
+				ValidationError: &inout.CreateGroupMemberResponseV1_ValidationError{
+					UserID: []string{"Такого пользователя не существует"},
+				}}})
+	case enums.GroupMemberAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateGroupMemberResponseV1{
+			Data: &inout.CreateGroupMemberResponseV1_ValidationError_{
+				ValidationError: &inout.CreateGroupMemberResponseV1_ValidationError{
+					Errors: []string{"Пользователь уже состоит в группе"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) DeleteGroupMemberV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, _ := api.Controller.DeleteGroupMember(r.Context(), id)
+
+	switch status {
+	case enums.Ok, enums.GroupMemberNotFound:
+		api.Renderer.Render(w, r, http.StatusNoContent, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
@@ -0,0 +1,99 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/functools"
+	"hive/inout"
+	"hive/repositories"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+func (api *API) GetGroupRolesV1Query(r *http.Request) repositories.GetGroupRolesQuery {
+
+	query := r.URL.Query()
+	return repositories.GetGroupRolesQuery{
+		Pagination: functools.GetPagination(query, api.environment),
+		GroupId:    functools.StringsSliceToUUIDSlice(query["groups"]),
+		RoleId:     functools.StringsSliceToUUIDSlice(query["roles"]),
+	}
+}
+
+func (api *API) GetGroupRolesV1(w http.ResponseWriter, r *http.Request) {
+
+	query := api.GetGroupRolesV1Query(r)
+	groupRoles, pagination := api.Controller.GetGroupRoles(r.Context(), query)
+	groupRolesData := make([]*inout.GroupRole, len(groupRoles))
+
+	for i, groupRole := range groupRoles {
+		groupRolesData[i] = &inout.GroupRole{
+			Id:      groupRole.Id.Bytes(),
+			Created: groupRole.Created,
+			GroupID: groupRole.GroupId.Bytes(),
+			RoleID:  groupRole.RoleId.Bytes(),
+		}
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListGroupRolesResponseV1{Data: groupRolesData, Pagination: &inout.Pagination{
+		HasPrevious: pagination.HasPrevious,
+		HasNext:     pagination.HasNext,
+		Count:       pagination.Count,
+	}})
+}
+
+func (api *API) CreateGroupRoleV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.CreateGroupRoleResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	status, groupRole := api.Controller.CreateGroupRole(r.Context(), uuid.FromBytesOrNil(body.GroupID), uuid.FromBytesOrNil(body.RoleID))
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateGroupRoleResponseV1{
+			Data: &inout.CreateGroupRoleResponseV1_Ok{Ok: &inout.GroupRole{
+				Id:      groupRole.Id.Bytes(),
+				Created: groupRole.Created,
+				GroupID: groupRole.GroupId.Bytes(),
+				RoleID:  groupRole.RoleId.Bytes(),
+			}},
+		})
+	case enums.GroupNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateGroupRoleResponseV1{
+			Data: &inout.CreateGroupRoleResponseV1_ValidationError_{
+				ValidationError: &inout.CreateGroupRoleResponseV1_ValidationError{
+					GroupID: []string{"Такой группы не существует"},
+				}}})
+	case enums.RoleNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateGroupRoleResponseV1{
+			Data: &inout.CreateGroupRoleResponseV1_ValidationError_{
+				ValidationError: &inout.CreateGroupRoleResponseV1_ValidationError{
+					RoleID: []string{"Такой роли не существует"},
+				}}})
+	case enums.GroupRoleAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateGroupRoleResponseV1{
+			Data: &inout.CreateGroupRoleResponseV1_ValidationError_{
+				ValidationError: &inout.CreateGroupRoleResponseV1_ValidationError{
+					Errors: []string{"Роль уже выдана группе"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) DeleteGroupRoleV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, _ := api.Controller.DeleteGroupRole(r.Context(), id)
+
+	switch status {
+	case enums.Ok, enums.GroupRoleNotFound:
+		api.Renderer.Render(w, r, http.StatusNoContent, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
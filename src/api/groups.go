@@ -0,0 +1,128 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/functools"
+	"hive/inout"
+	"hive/models"
+	"hive/repositories"
+	"net/http"
+)
+
+func groupToProto(group *models.Group) *inout.Group {
+	return &inout.Group{
+		Id:          group.Id.Bytes(),
+		Created:     group.Created,
+		Title:       group.Title,
+		Description: group.Description,
+	}
+}
+
+func (api *API) GetGroupsV1Query(r *http.Request) repositories.GetGroupsQuery {
+	query := r.URL.Query()
+	return repositories.GetGroupsQuery{
+		Pagination:  functools.GetPagination(query, api.environment),
+		Identifiers: functools.StringsSliceToUUIDSlice(query["id"]),
+		Titles:      query["titles"],
+	}
+}
+
+func (api *API) GetGroupV1(w http.ResponseWriter, r *http.Request) {
+	id, _ := extractors.GetUUID(r)
+	status, group := api.Controller.GetGroup(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.GetGroupResponseV1{Data: groupToProto(group)})
+	case enums.GroupNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.GetGroupResponseV1{})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) GetGroupsV1(w http.ResponseWriter, r *http.Request) {
+
+	query := api.GetGroupsV1Query(r)
+	groups, pagination := api.Controller.GetGroups(r.Context(), query)
+	groupsData := make([]*inout.Group, len(groups))
+
+	for i, group := range groups {
+		groupsData[i] = groupToProto(group)
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListGroupResponseV1{Data: groupsData, Pagination: &inout.Pagination{
+		HasPrevious: pagination.HasPrevious,
+		HasNext:     pagination.HasNext,
+		Count:       pagination.Count,
+	}})
+}
+
+func (api *API) CreateGroupV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.CreateGroupResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	status, group := api.Controller.CreateGroup(r.Context(), body.Title, body.Description)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateGroupResponseV1{
+			Data: &inout.CreateGroupResponseV1_Ok{Ok: groupToProto(group)}})
+	case enums.GroupAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateGroupResponseV1{
+			Data: &inout.CreateGroupResponseV1_ValidationError_{
+				ValidationError: &inout.CreateGroupResponseV1_ValidationError{
+					Title: []string{"Группа с таким названием уже существует"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) UpdateGroupV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.UpdateGroupResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	id, _ := extractors.GetUUID(r)
+	status, group := api.Controller.UpdateGroup(r.Context(), id, body.Title, body.Description)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.UpdateGroupResponseV1{
+			Data: &inout.UpdateGroupResponseV1_Ok{Ok: groupToProto(group)}})
+	case enums.GroupNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.UpdateGroupResponseV1{})
+	case enums.GroupAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateGroupResponseV1{
+			Data: &inout.UpdateGroupResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateGroupResponseV1_ValidationError{
+					Title: []string{"Группа с таким названием уже существует"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) DeleteGroupV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, _ := api.Controller.DeleteGroup(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusNoContent, nil)
+	case enums.GroupNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
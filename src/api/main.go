@@ -4,6 +4,7 @@ import (
 	"hive/auth"
 	"hive/config"
 	"hive/controllers"
+	"hive/policies"
 	"hive/presenters"
 	"github.com/getsentry/sentry-go"
 	"github.com/golang/mock/gomock"
@@ -15,10 +16,17 @@ type IAPI interface {
 	GetAuthenticationController() auth.IAuthenticationController
 	GetController() controllers.IController
 
+	// Authorization
+
+	AuthorizeV1(w http.ResponseWriter, r *http.Request)
+
 	// Emails
 
 	CreateEmailV1(w http.ResponseWriter, r *http.Request)
 	CreateEmailConfirmationV1(w http.ResponseWriter, r *http.Request)
+	GetUserEmailsV1(w http.ResponseWriter, r *http.Request)
+	DeleteEmailV1(w http.ResponseWriter, r *http.Request)
+	SetPrimaryEmailV1(w http.ResponseWriter, r *http.Request)
 
 	// Passwords
 
@@ -28,16 +36,73 @@ type IAPI interface {
 
 	CreatePhoneV1(w http.ResponseWriter, r *http.Request)
 	CreatePhoneConfirmationV1(w http.ResponseWriter, r *http.Request)
+	GetUserPhonesV1(w http.ResponseWriter, r *http.Request)
+	DeletePhoneV1(w http.ResponseWriter, r *http.Request)
+	SetPrimaryPhoneV1(w http.ResponseWriter, r *http.Request)
+
+	// Contact Transfers
+
+	GetUserContactTransfersV1(w http.ResponseWriter, r *http.Request)
+	GetContactTransferV1(w http.ResponseWriter, r *http.Request)
+	ReviewContactTransferV1(w http.ResponseWriter, r *http.Request)
 
 	// Roles
 
 	CreateRoleV1(w http.ResponseWriter, r *http.Request)
 	GetRolesV1(w http.ResponseWriter, r *http.Request)
 	GetRoleV1(w http.ResponseWriter, r *http.Request)
+	UpdateRoleParentsV1(w http.ResponseWriter, r *http.Request)
+	UpdateRoleV1(w http.ResponseWriter, r *http.Request)
+	DeleteRoleV1(w http.ResponseWriter, r *http.Request)
+	UpdateRoleManagedRolesV1(w http.ResponseWriter, r *http.Request)
+	GetManageableRolesV1(w http.ResponseWriter, r *http.Request)
+
+	// Role Requests
+
+	CreateRoleRequestV1(w http.ResponseWriter, r *http.Request)
+	GetRoleRequestsV1(w http.ResponseWriter, r *http.Request)
+	GetRoleRequestV1(w http.ResponseWriter, r *http.Request)
+	ReviewRoleRequestV1(w http.ResponseWriter, r *http.Request)
+	CancelRoleRequestV1(w http.ResponseWriter, r *http.Request)
+
+	// Organizations
+
+	CreateOrganizationV1(w http.ResponseWriter, r *http.Request)
+	GetOrganizationsV1(w http.ResponseWriter, r *http.Request)
+	GetOrganizationV1(w http.ResponseWriter, r *http.Request)
+
+	// Organization Members
+
+	CreateOrganizationMemberV1(w http.ResponseWriter, r *http.Request)
+	GetOrganizationMembersV1(w http.ResponseWriter, r *http.Request)
+	DeleteOrganizationMemberV1(w http.ResponseWriter, r *http.Request)
+
+	// Groups
+
+	CreateGroupV1(w http.ResponseWriter, r *http.Request)
+	GetGroupsV1(w http.ResponseWriter, r *http.Request)
+	GetGroupV1(w http.ResponseWriter, r *http.Request)
+	UpdateGroupV1(w http.ResponseWriter, r *http.Request)
+	DeleteGroupV1(w http.ResponseWriter, r *http.Request)
+
+	// Group Members
+
+	CreateGroupMemberV1(w http.ResponseWriter, r *http.Request)
+	GetGroupMembersV1(w http.ResponseWriter, r *http.Request)
+	DeleteGroupMemberV1(w http.ResponseWriter, r *http.Request)
+
+	// Group Roles
+
+	CreateGroupRoleV1(w http.ResponseWriter, r *http.Request)
+	GetGroupRolesV1(w http.ResponseWriter, r *http.Request)
+	DeleteGroupRoleV1(w http.ResponseWriter, r *http.Request)
 
 	// Secrets
 
 	GetSecretV1(w http.ResponseWriter, r *http.Request)
+	GetSecretsV1(w http.ResponseWriter, r *http.Request)
+	CreateSecretV1(w http.ResponseWriter, r *http.Request)
+	DeleteSecretV1(w http.ResponseWriter, r *http.Request)
 
 	// Sessions
 
@@ -48,12 +113,24 @@ type IAPI interface {
 	GetUserV1(w http.ResponseWriter, r *http.Request)
 	DeleteUserV1(w http.ResponseWriter, r *http.Request)
 	GetUsersV1(w http.ResponseWriter, r *http.Request)
+	GetUserProfileV1(w http.ResponseWriter, r *http.Request)
+	UpdateUserProfileV1(w http.ResponseWriter, r *http.Request)
+	UpdateUserStatusV1(w http.ResponseWriter, r *http.Request)
+	RequestUserDeletionV1(w http.ResponseWriter, r *http.Request)
+	CancelUserDeletionV1(w http.ResponseWriter, r *http.Request)
 	CreateUserV1(w http.ResponseWriter, r *http.Request)
 
+	// User Exports
+
+	CreateUserExportV1(w http.ResponseWriter, r *http.Request)
+	GetUserExportV1(w http.ResponseWriter, r *http.Request)
+	GetUserExportArchiveV1(w http.ResponseWriter, r *http.Request)
+
 	// User Views
 
 	GetUserViewV1(w http.ResponseWriter, r *http.Request)
 	GetUsersViewV1(w http.ResponseWriter, r *http.Request)
+	SearchUsersViewV1(w http.ResponseWriter, r *http.Request)
 
 	// User Roles
 
@@ -67,6 +144,7 @@ type API struct {
 	authenticationController auth.IAuthenticationController
 	Parser                   *presenters.Parser
 	Renderer                 *presenters.Renderer
+	policyEngine             policies.IPolicyEngine
 	environment              *config.Environment
 }
 
@@ -74,12 +152,13 @@ func (api *API) GetLoginController() auth.IAuthenticationController {
 	return api.authenticationController
 }
 
-func InitAPI(controller controllers.IController, authenticationController auth.IAuthenticationController, environment *config.Environment) *API {
+func InitAPI(controller controllers.IController, authenticationController auth.IAuthenticationController, policyEngine policies.IPolicyEngine, environment *config.Environment) *API {
 	return &API{
 		Controller:               controller,
 		authenticationController: authenticationController,
 		Parser:                   presenters.InitParser(),
 		Renderer:                 presenters.InitRenderer(),
+		policyEngine:             policyEngine,
 		environment:              environment,
 	}
 }
@@ -93,8 +172,9 @@ type APIWithMockedInternals struct {
 func InitAPIWithMockedInternals(ctrl *gomock.Controller) *APIWithMockedInternals {
 	controller := controllers.NewMockIController(ctrl)
 	authenticationController := auth.NewMockIAuthenticationController(ctrl)
+	environment := config.InitEnvironment()
 	return &APIWithMockedInternals{
-		API:                      InitAPI(controller, authenticationController, config.InitEnvironment()),
+		API:                      InitAPI(controller, authenticationController, policies.InitPolicyEngine(environment), environment),
 		Controller:               controller,
 		AuthenticationController: authenticationController,
 	}
@@ -63,6 +63,18 @@ func (mr *MockIAPIMockRecorder) GetController() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetController", reflect.TypeOf((*MockIAPI)(nil).GetController))
 }
 
+// AuthorizeV1 mocks base method
+func (m *MockIAPI) AuthorizeV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "AuthorizeV1", w, r)
+}
+
+// AuthorizeV1 indicates an expected call of AuthorizeV1
+func (mr *MockIAPIMockRecorder) AuthorizeV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeV1", reflect.TypeOf((*MockIAPI)(nil).AuthorizeV1), w, r)
+}
+
 // CreateEmailV1 mocks base method
 func (m *MockIAPI) CreateEmailV1(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -87,6 +99,42 @@ func (mr *MockIAPIMockRecorder) CreateEmailConfirmationV1(w, r interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailConfirmationV1", reflect.TypeOf((*MockIAPI)(nil).CreateEmailConfirmationV1), w, r)
 }
 
+// GetUserEmailsV1 mocks base method
+func (m *MockIAPI) GetUserEmailsV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserEmailsV1", w, r)
+}
+
+// GetUserEmailsV1 indicates an expected call of GetUserEmailsV1
+func (mr *MockIAPIMockRecorder) GetUserEmailsV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEmailsV1", reflect.TypeOf((*MockIAPI)(nil).GetUserEmailsV1), w, r)
+}
+
+// DeleteEmailV1 mocks base method
+func (m *MockIAPI) DeleteEmailV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteEmailV1", w, r)
+}
+
+// DeleteEmailV1 indicates an expected call of DeleteEmailV1
+func (mr *MockIAPIMockRecorder) DeleteEmailV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailV1", reflect.TypeOf((*MockIAPI)(nil).DeleteEmailV1), w, r)
+}
+
+// SetPrimaryEmailV1 mocks base method
+func (m *MockIAPI) SetPrimaryEmailV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPrimaryEmailV1", w, r)
+}
+
+// SetPrimaryEmailV1 indicates an expected call of SetPrimaryEmailV1
+func (mr *MockIAPIMockRecorder) SetPrimaryEmailV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryEmailV1", reflect.TypeOf((*MockIAPI)(nil).SetPrimaryEmailV1), w, r)
+}
+
 // CreatePasswordV1 mocks base method
 func (m *MockIAPI) CreatePasswordV1(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -123,6 +171,78 @@ func (mr *MockIAPIMockRecorder) CreatePhoneConfirmationV1(w, r interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhoneConfirmationV1", reflect.TypeOf((*MockIAPI)(nil).CreatePhoneConfirmationV1), w, r)
 }
 
+// GetUserPhonesV1 mocks base method
+func (m *MockIAPI) GetUserPhonesV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserPhonesV1", w, r)
+}
+
+// GetUserPhonesV1 indicates an expected call of GetUserPhonesV1
+func (mr *MockIAPIMockRecorder) GetUserPhonesV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPhonesV1", reflect.TypeOf((*MockIAPI)(nil).GetUserPhonesV1), w, r)
+}
+
+// DeletePhoneV1 mocks base method
+func (m *MockIAPI) DeletePhoneV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeletePhoneV1", w, r)
+}
+
+// DeletePhoneV1 indicates an expected call of DeletePhoneV1
+func (mr *MockIAPIMockRecorder) DeletePhoneV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhoneV1", reflect.TypeOf((*MockIAPI)(nil).DeletePhoneV1), w, r)
+}
+
+// SetPrimaryPhoneV1 mocks base method
+func (m *MockIAPI) SetPrimaryPhoneV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPrimaryPhoneV1", w, r)
+}
+
+// SetPrimaryPhoneV1 indicates an expected call of SetPrimaryPhoneV1
+func (mr *MockIAPIMockRecorder) SetPrimaryPhoneV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryPhoneV1", reflect.TypeOf((*MockIAPI)(nil).SetPrimaryPhoneV1), w, r)
+}
+
+// GetUserContactTransfersV1 mocks base method
+func (m *MockIAPI) GetUserContactTransfersV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserContactTransfersV1", w, r)
+}
+
+// GetUserContactTransfersV1 indicates an expected call of GetUserContactTransfersV1
+func (mr *MockIAPIMockRecorder) GetUserContactTransfersV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserContactTransfersV1", reflect.TypeOf((*MockIAPI)(nil).GetUserContactTransfersV1), w, r)
+}
+
+// GetContactTransferV1 mocks base method
+func (m *MockIAPI) GetContactTransferV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetContactTransferV1", w, r)
+}
+
+// GetContactTransferV1 indicates an expected call of GetContactTransferV1
+func (mr *MockIAPIMockRecorder) GetContactTransferV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactTransferV1", reflect.TypeOf((*MockIAPI)(nil).GetContactTransferV1), w, r)
+}
+
+// ReviewContactTransferV1 mocks base method
+func (m *MockIAPI) ReviewContactTransferV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReviewContactTransferV1", w, r)
+}
+
+// ReviewContactTransferV1 indicates an expected call of ReviewContactTransferV1
+func (mr *MockIAPIMockRecorder) ReviewContactTransferV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewContactTransferV1", reflect.TypeOf((*MockIAPI)(nil).ReviewContactTransferV1), w, r)
+}
+
 // CreateRoleV1 mocks base method
 func (m *MockIAPI) CreateRoleV1(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -159,6 +279,330 @@ func (mr *MockIAPIMockRecorder) GetRoleV1(w, r interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleV1", reflect.TypeOf((*MockIAPI)(nil).GetRoleV1), w, r)
 }
 
+// UpdateRoleParentsV1 mocks base method
+func (m *MockIAPI) UpdateRoleParentsV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateRoleParentsV1", w, r)
+}
+
+// UpdateRoleParentsV1 indicates an expected call of UpdateRoleParentsV1
+func (mr *MockIAPIMockRecorder) UpdateRoleParentsV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleParentsV1", reflect.TypeOf((*MockIAPI)(nil).UpdateRoleParentsV1), w, r)
+}
+
+// UpdateRoleV1 mocks base method
+func (m *MockIAPI) UpdateRoleV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateRoleV1", w, r)
+}
+
+// UpdateRoleV1 indicates an expected call of UpdateRoleV1
+func (mr *MockIAPIMockRecorder) UpdateRoleV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleV1", reflect.TypeOf((*MockIAPI)(nil).UpdateRoleV1), w, r)
+}
+
+// DeleteRoleV1 mocks base method
+func (m *MockIAPI) DeleteRoleV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteRoleV1", w, r)
+}
+
+// DeleteRoleV1 indicates an expected call of DeleteRoleV1
+func (mr *MockIAPIMockRecorder) DeleteRoleV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleV1", reflect.TypeOf((*MockIAPI)(nil).DeleteRoleV1), w, r)
+}
+
+// UpdateRoleManagedRolesV1 mocks base method
+func (m *MockIAPI) UpdateRoleManagedRolesV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateRoleManagedRolesV1", w, r)
+}
+
+// UpdateRoleManagedRolesV1 indicates an expected call of UpdateRoleManagedRolesV1
+func (mr *MockIAPIMockRecorder) UpdateRoleManagedRolesV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleManagedRolesV1", reflect.TypeOf((*MockIAPI)(nil).UpdateRoleManagedRolesV1), w, r)
+}
+
+// GetManageableRolesV1 mocks base method
+func (m *MockIAPI) GetManageableRolesV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetManageableRolesV1", w, r)
+}
+
+// GetManageableRolesV1 indicates an expected call of GetManageableRolesV1
+func (mr *MockIAPIMockRecorder) GetManageableRolesV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManageableRolesV1", reflect.TypeOf((*MockIAPI)(nil).GetManageableRolesV1), w, r)
+}
+
+// CreateRoleRequestV1 mocks base method
+func (m *MockIAPI) CreateRoleRequestV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateRoleRequestV1", w, r)
+}
+
+// CreateRoleRequestV1 indicates an expected call of CreateRoleRequestV1
+func (mr *MockIAPIMockRecorder) CreateRoleRequestV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoleRequestV1", reflect.TypeOf((*MockIAPI)(nil).CreateRoleRequestV1), w, r)
+}
+
+// GetRoleRequestsV1 mocks base method
+func (m *MockIAPI) GetRoleRequestsV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetRoleRequestsV1", w, r)
+}
+
+// GetRoleRequestsV1 indicates an expected call of GetRoleRequestsV1
+func (mr *MockIAPIMockRecorder) GetRoleRequestsV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleRequestsV1", reflect.TypeOf((*MockIAPI)(nil).GetRoleRequestsV1), w, r)
+}
+
+// GetRoleRequestV1 mocks base method
+func (m *MockIAPI) GetRoleRequestV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetRoleRequestV1", w, r)
+}
+
+// GetRoleRequestV1 indicates an expected call of GetRoleRequestV1
+func (mr *MockIAPIMockRecorder) GetRoleRequestV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleRequestV1", reflect.TypeOf((*MockIAPI)(nil).GetRoleRequestV1), w, r)
+}
+
+// ReviewRoleRequestV1 mocks base method
+func (m *MockIAPI) ReviewRoleRequestV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReviewRoleRequestV1", w, r)
+}
+
+// ReviewRoleRequestV1 indicates an expected call of ReviewRoleRequestV1
+func (mr *MockIAPIMockRecorder) ReviewRoleRequestV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewRoleRequestV1", reflect.TypeOf((*MockIAPI)(nil).ReviewRoleRequestV1), w, r)
+}
+
+// CancelRoleRequestV1 mocks base method
+func (m *MockIAPI) CancelRoleRequestV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CancelRoleRequestV1", w, r)
+}
+
+// CancelRoleRequestV1 indicates an expected call of CancelRoleRequestV1
+func (mr *MockIAPIMockRecorder) CancelRoleRequestV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRoleRequestV1", reflect.TypeOf((*MockIAPI)(nil).CancelRoleRequestV1), w, r)
+}
+
+// CreateOrganizationV1 mocks base method
+func (m *MockIAPI) CreateOrganizationV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateOrganizationV1", w, r)
+}
+
+// CreateOrganizationV1 indicates an expected call of CreateOrganizationV1
+func (mr *MockIAPIMockRecorder) CreateOrganizationV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationV1", reflect.TypeOf((*MockIAPI)(nil).CreateOrganizationV1), w, r)
+}
+
+// GetOrganizationsV1 mocks base method
+func (m *MockIAPI) GetOrganizationsV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetOrganizationsV1", w, r)
+}
+
+// GetOrganizationsV1 indicates an expected call of GetOrganizationsV1
+func (mr *MockIAPIMockRecorder) GetOrganizationsV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationsV1", reflect.TypeOf((*MockIAPI)(nil).GetOrganizationsV1), w, r)
+}
+
+// GetOrganizationV1 mocks base method
+func (m *MockIAPI) GetOrganizationV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetOrganizationV1", w, r)
+}
+
+// GetOrganizationV1 indicates an expected call of GetOrganizationV1
+func (mr *MockIAPIMockRecorder) GetOrganizationV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationV1", reflect.TypeOf((*MockIAPI)(nil).GetOrganizationV1), w, r)
+}
+
+// CreateOrganizationMemberV1 mocks base method
+func (m *MockIAPI) CreateOrganizationMemberV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateOrganizationMemberV1", w, r)
+}
+
+// CreateOrganizationMemberV1 indicates an expected call of CreateOrganizationMemberV1
+func (mr *MockIAPIMockRecorder) CreateOrganizationMemberV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMemberV1", reflect.TypeOf((*MockIAPI)(nil).CreateOrganizationMemberV1), w, r)
+}
+
+// GetOrganizationMembersV1 mocks base method
+func (m *MockIAPI) GetOrganizationMembersV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetOrganizationMembersV1", w, r)
+}
+
+// GetOrganizationMembersV1 indicates an expected call of GetOrganizationMembersV1
+func (mr *MockIAPIMockRecorder) GetOrganizationMembersV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembersV1", reflect.TypeOf((*MockIAPI)(nil).GetOrganizationMembersV1), w, r)
+}
+
+// DeleteOrganizationMemberV1 mocks base method
+func (m *MockIAPI) DeleteOrganizationMemberV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteOrganizationMemberV1", w, r)
+}
+
+// DeleteOrganizationMemberV1 indicates an expected call of DeleteOrganizationMemberV1
+func (mr *MockIAPIMockRecorder) DeleteOrganizationMemberV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationMemberV1", reflect.TypeOf((*MockIAPI)(nil).DeleteOrganizationMemberV1), w, r)
+}
+
+// CreateGroupV1 mocks base method
+func (m *MockIAPI) CreateGroupV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateGroupV1", w, r)
+}
+
+// CreateGroupV1 indicates an expected call of CreateGroupV1
+func (mr *MockIAPIMockRecorder) CreateGroupV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupV1", reflect.TypeOf((*MockIAPI)(nil).CreateGroupV1), w, r)
+}
+
+// GetGroupsV1 mocks base method
+func (m *MockIAPI) GetGroupsV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetGroupsV1", w, r)
+}
+
+// GetGroupsV1 indicates an expected call of GetGroupsV1
+func (mr *MockIAPIMockRecorder) GetGroupsV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupsV1", reflect.TypeOf((*MockIAPI)(nil).GetGroupsV1), w, r)
+}
+
+// GetGroupV1 mocks base method
+func (m *MockIAPI) GetGroupV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetGroupV1", w, r)
+}
+
+// GetGroupV1 indicates an expected call of GetGroupV1
+func (mr *MockIAPIMockRecorder) GetGroupV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupV1", reflect.TypeOf((*MockIAPI)(nil).GetGroupV1), w, r)
+}
+
+// UpdateGroupV1 mocks base method
+func (m *MockIAPI) UpdateGroupV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateGroupV1", w, r)
+}
+
+// UpdateGroupV1 indicates an expected call of UpdateGroupV1
+func (mr *MockIAPIMockRecorder) UpdateGroupV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroupV1", reflect.TypeOf((*MockIAPI)(nil).UpdateGroupV1), w, r)
+}
+
+// DeleteGroupV1 mocks base method
+func (m *MockIAPI) DeleteGroupV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteGroupV1", w, r)
+}
+
+// DeleteGroupV1 indicates an expected call of DeleteGroupV1
+func (mr *MockIAPIMockRecorder) DeleteGroupV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupV1", reflect.TypeOf((*MockIAPI)(nil).DeleteGroupV1), w, r)
+}
+
+// CreateGroupMemberV1 mocks base method
+func (m *MockIAPI) CreateGroupMemberV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateGroupMemberV1", w, r)
+}
+
+// CreateGroupMemberV1 indicates an expected call of CreateGroupMemberV1
+func (mr *MockIAPIMockRecorder) CreateGroupMemberV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupMemberV1", reflect.TypeOf((*MockIAPI)(nil).CreateGroupMemberV1), w, r)
+}
+
+// GetGroupMembersV1 mocks base method
+func (m *MockIAPI) GetGroupMembersV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetGroupMembersV1", w, r)
+}
+
+// GetGroupMembersV1 indicates an expected call of GetGroupMembersV1
+func (mr *MockIAPIMockRecorder) GetGroupMembersV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembersV1", reflect.TypeOf((*MockIAPI)(nil).GetGroupMembersV1), w, r)
+}
+
+// DeleteGroupMemberV1 mocks base method
+func (m *MockIAPI) DeleteGroupMemberV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteGroupMemberV1", w, r)
+}
+
+// DeleteGroupMemberV1 indicates an expected call of DeleteGroupMemberV1
+func (mr *MockIAPIMockRecorder) DeleteGroupMemberV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupMemberV1", reflect.TypeOf((*MockIAPI)(nil).DeleteGroupMemberV1), w, r)
+}
+
+// CreateGroupRoleV1 mocks base method
+func (m *MockIAPI) CreateGroupRoleV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateGroupRoleV1", w, r)
+}
+
+// CreateGroupRoleV1 indicates an expected call of CreateGroupRoleV1
+func (mr *MockIAPIMockRecorder) CreateGroupRoleV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupRoleV1", reflect.TypeOf((*MockIAPI)(nil).CreateGroupRoleV1), w, r)
+}
+
+// GetGroupRolesV1 mocks base method
+func (m *MockIAPI) GetGroupRolesV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetGroupRolesV1", w, r)
+}
+
+// GetGroupRolesV1 indicates an expected call of GetGroupRolesV1
+func (mr *MockIAPIMockRecorder) GetGroupRolesV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRolesV1", reflect.TypeOf((*MockIAPI)(nil).GetGroupRolesV1), w, r)
+}
+
+// DeleteGroupRoleV1 mocks base method
+func (m *MockIAPI) DeleteGroupRoleV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteGroupRoleV1", w, r)
+}
+
+// DeleteGroupRoleV1 indicates an expected call of DeleteGroupRoleV1
+func (mr *MockIAPIMockRecorder) DeleteGroupRoleV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupRoleV1", reflect.TypeOf((*MockIAPI)(nil).DeleteGroupRoleV1), w, r)
+}
+
 // GetSecretV1 mocks base method
 func (m *MockIAPI) GetSecretV1(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -171,6 +615,42 @@ func (mr *MockIAPIMockRecorder) GetSecretV1(w, r interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretV1", reflect.TypeOf((*MockIAPI)(nil).GetSecretV1), w, r)
 }
 
+// GetSecretsV1 mocks base method
+func (m *MockIAPI) GetSecretsV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetSecretsV1", w, r)
+}
+
+// GetSecretsV1 indicates an expected call of GetSecretsV1
+func (mr *MockIAPIMockRecorder) GetSecretsV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretsV1", reflect.TypeOf((*MockIAPI)(nil).GetSecretsV1), w, r)
+}
+
+// CreateSecretV1 mocks base method
+func (m *MockIAPI) CreateSecretV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateSecretV1", w, r)
+}
+
+// CreateSecretV1 indicates an expected call of CreateSecretV1
+func (mr *MockIAPIMockRecorder) CreateSecretV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecretV1", reflect.TypeOf((*MockIAPI)(nil).CreateSecretV1), w, r)
+}
+
+// DeleteSecretV1 mocks base method
+func (m *MockIAPI) DeleteSecretV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteSecretV1", w, r)
+}
+
+// DeleteSecretV1 indicates an expected call of DeleteSecretV1
+func (mr *MockIAPIMockRecorder) DeleteSecretV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecretV1", reflect.TypeOf((*MockIAPI)(nil).DeleteSecretV1), w, r)
+}
+
 // CreateSessionV1 mocks base method
 func (m *MockIAPI) CreateSessionV1(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -219,6 +699,66 @@ func (mr *MockIAPIMockRecorder) GetUsersV1(w, r interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersV1", reflect.TypeOf((*MockIAPI)(nil).GetUsersV1), w, r)
 }
 
+// GetUserProfileV1 mocks base method
+func (m *MockIAPI) GetUserProfileV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserProfileV1", w, r)
+}
+
+// GetUserProfileV1 indicates an expected call of GetUserProfileV1
+func (mr *MockIAPIMockRecorder) GetUserProfileV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfileV1", reflect.TypeOf((*MockIAPI)(nil).GetUserProfileV1), w, r)
+}
+
+// UpdateUserProfileV1 mocks base method
+func (m *MockIAPI) UpdateUserProfileV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateUserProfileV1", w, r)
+}
+
+// UpdateUserProfileV1 indicates an expected call of UpdateUserProfileV1
+func (mr *MockIAPIMockRecorder) UpdateUserProfileV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfileV1", reflect.TypeOf((*MockIAPI)(nil).UpdateUserProfileV1), w, r)
+}
+
+// UpdateUserStatusV1 mocks base method
+func (m *MockIAPI) UpdateUserStatusV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "UpdateUserStatusV1", w, r)
+}
+
+// UpdateUserStatusV1 indicates an expected call of UpdateUserStatusV1
+func (mr *MockIAPIMockRecorder) UpdateUserStatusV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatusV1", reflect.TypeOf((*MockIAPI)(nil).UpdateUserStatusV1), w, r)
+}
+
+// RequestUserDeletionV1 mocks base method
+func (m *MockIAPI) RequestUserDeletionV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RequestUserDeletionV1", w, r)
+}
+
+// RequestUserDeletionV1 indicates an expected call of RequestUserDeletionV1
+func (mr *MockIAPIMockRecorder) RequestUserDeletionV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestUserDeletionV1", reflect.TypeOf((*MockIAPI)(nil).RequestUserDeletionV1), w, r)
+}
+
+// CancelUserDeletionV1 mocks base method
+func (m *MockIAPI) CancelUserDeletionV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CancelUserDeletionV1", w, r)
+}
+
+// CancelUserDeletionV1 indicates an expected call of CancelUserDeletionV1
+func (mr *MockIAPIMockRecorder) CancelUserDeletionV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserDeletionV1", reflect.TypeOf((*MockIAPI)(nil).CancelUserDeletionV1), w, r)
+}
+
 // CreateUserV1 mocks base method
 func (m *MockIAPI) CreateUserV1(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -231,6 +771,42 @@ func (mr *MockIAPIMockRecorder) CreateUserV1(w, r interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserV1", reflect.TypeOf((*MockIAPI)(nil).CreateUserV1), w, r)
 }
 
+// CreateUserExportV1 mocks base method
+func (m *MockIAPI) CreateUserExportV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CreateUserExportV1", w, r)
+}
+
+// CreateUserExportV1 indicates an expected call of CreateUserExportV1
+func (mr *MockIAPIMockRecorder) CreateUserExportV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserExportV1", reflect.TypeOf((*MockIAPI)(nil).CreateUserExportV1), w, r)
+}
+
+// GetUserExportV1 mocks base method
+func (m *MockIAPI) GetUserExportV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserExportV1", w, r)
+}
+
+// GetUserExportV1 indicates an expected call of GetUserExportV1
+func (mr *MockIAPIMockRecorder) GetUserExportV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExportV1", reflect.TypeOf((*MockIAPI)(nil).GetUserExportV1), w, r)
+}
+
+// GetUserExportArchiveV1 mocks base method
+func (m *MockIAPI) GetUserExportArchiveV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "GetUserExportArchiveV1", w, r)
+}
+
+// GetUserExportArchiveV1 indicates an expected call of GetUserExportArchiveV1
+func (mr *MockIAPIMockRecorder) GetUserExportArchiveV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExportArchiveV1", reflect.TypeOf((*MockIAPI)(nil).GetUserExportArchiveV1), w, r)
+}
+
 // GetUserViewV1 mocks base method
 func (m *MockIAPI) GetUserViewV1(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
@@ -255,6 +831,18 @@ func (mr *MockIAPIMockRecorder) GetUsersViewV1(w, r interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersViewV1", reflect.TypeOf((*MockIAPI)(nil).GetUsersViewV1), w, r)
 }
 
+// SearchUsersViewV1 mocks base method
+func (m *MockIAPI) SearchUsersViewV1(w http.ResponseWriter, r *http.Request) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SearchUsersViewV1", w, r)
+}
+
+// SearchUsersViewV1 indicates an expected call of SearchUsersViewV1
+func (mr *MockIAPIMockRecorder) SearchUsersViewV1(w, r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersViewV1", reflect.TypeOf((*MockIAPI)(nil).SearchUsersViewV1), w, r)
+}
+
 // DeleteUserRoleV1 mocks base method
 func (m *MockIAPI) DeleteUserRoleV1(w http.ResponseWriter, r *http.Request) {
 	m.ctrl.T.Helper()
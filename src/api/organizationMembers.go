@@ -0,0 +1,99 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/functools"
+	"hive/inout"
+	"hive/repositories"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+func (api *API) GetOrganizationMembersV1Query(r *http.Request) repositories.GetOrganizationMembersQuery {
+
+	query := r.URL.Query()
+	return repositories.GetOrganizationMembersQuery{
+		Pagination:     functools.GetPagination(query, api.environment),
+		OrganizationId: functools.StringsSliceToUUIDSlice(query["organizations"]),
+		UserId:         functools.StringsSliceToUUIDSlice(query["users"]),
+	}
+}
+
+func (api *API) GetOrganizationMembersV1(w http.ResponseWriter, r *http.Request) {
+
+	query := api.GetOrganizationMembersV1Query(r)
+	members, pagination := api.Controller.GetOrganizationMembers(r.Context(), query)
+	membersData := make([]*inout.OrganizationMember, len(members))
+
+	for i, member := range members {
+		membersData[i] = &inout.OrganizationMember{
+			Id:             member.Id.Bytes(),
+			Created:        member.Created,
+			OrganizationID: member.OrganizationId.Bytes(),
+			UserID:         member.UserId.Bytes(),
+		}
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListOrganizationMembersResponseV1{Data: membersData, Pagination: &inout.Pagination{
+		HasPrevious: pagination.HasPrevious,
+		HasNext:     pagination.HasNext,
+		Count:       pagination.Count,
+	}})
+}
+
+func (api *API) CreateOrganizationMemberV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.CreateOrganizationMemberResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	status, member := api.Controller.CreateOrganizationMember(r.Context(), uuid.FromBytesOrNil(body.OrganizationID), uuid.FromBytesOrNil(body.UserID))
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateOrganizationMemberResponseV1{
+			Data: &inout.CreateOrganizationMemberResponseV1_Ok{Ok: &inout.OrganizationMember{
+				Id:             member.Id.Bytes(),
+				Created:        member.Created,
+				OrganizationID: member.OrganizationId.Bytes(),
+				UserID:         member.UserId.Bytes(),
+			}},
+		})
+	case enums.OrganizationNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateOrganizationMemberResponseV1{
+			Data: &inout.CreateOrganizationMemberResponseV1_ValidationError_{
+				ValidationError: &inout.CreateOrganizationMemberResponseV1_ValidationError{
+					OrganizationID: []string{"Такой организации не существует"},
+				}}})
+	case enums.UserNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateOrganizationMemberResponseV1{
+			Data: &inout.CreateOrganizationMemberResponseV1_ValidationError_{
+				ValidationError: &inout.CreateOrganizationMemberResponseV1_ValidationError{
+					UserID: []string{"Такого пользователя не существует"},
+				}}})
+	case enums.OrganizationMemberAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateOrganizationMemberResponseV1{
+			Data: &inout.CreateOrganizationMemberResponseV1_ValidationError_{
+				ValidationError: &inout.CreateOrganizationMemberResponseV1_ValidationError{
+					Errors: []string{"Пользователь уже состоит в организации"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) DeleteOrganizationMemberV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, _ := api.Controller.DeleteOrganizationMember(r.Context(), id)
+
+	switch status {
+	case enums.Ok, enums.OrganizationMemberNotFound:
+		api.Renderer.Render(w, r, http.StatusNoContent, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
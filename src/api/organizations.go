@@ -0,0 +1,89 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/functools"
+	"hive/inout"
+	"hive/repositories"
+	"net/http"
+)
+
+func (api *API) GetOrganizationsV1Query(r *http.Request) repositories.GetOrganizationsQuery {
+	query := r.URL.Query()
+	return repositories.GetOrganizationsQuery{
+		Pagination:  functools.GetPagination(query, api.environment),
+		Identifiers: functools.StringsSliceToUUIDSlice(query["id"]),
+		Titles:      query["titles"],
+	}
+}
+
+func (api *API) GetOrganizationV1(w http.ResponseWriter, r *http.Request) {
+	id, _ := extractors.GetUUID(r)
+	status, organization := api.Controller.GetOrganization(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.GetOrganizationResponseV1{
+			Data: &inout.Organization{
+				Id:      organization.Id.Bytes(),
+				Created: organization.Created,
+				Title:   organization.Title,
+			}})
+	case enums.OrganizationNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.GetOrganizationResponseV1{})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) GetOrganizationsV1(w http.ResponseWriter, r *http.Request) {
+
+	query := api.GetOrganizationsV1Query(r)
+	organizations, pagination := api.Controller.GetOrganizations(r.Context(), query)
+	organizationsData := make([]*inout.Organization, len(organizations))
+
+	for i, organization := range organizations {
+		organizationsData[i] = &inout.Organization{
+			Id:      organization.Id.Bytes(),
+			Created: organization.Created,
+			Title:   organization.Title,
+		}
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListOrganizationResponseV1{Data: organizationsData, Pagination: &inout.Pagination{
+		HasPrevious: pagination.HasPrevious,
+		HasNext:     pagination.HasNext,
+		Count:       pagination.Count,
+	}})
+}
+
+func (api *API) CreateOrganizationV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.CreateOrganizationResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	status, organization := api.Controller.CreateOrganization(r.Context(), body.Title)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateOrganizationResponseV1{
+			Data: &inout.CreateOrganizationResponseV1_Ok{
+				Ok: &inout.Organization{
+					Id:      organization.Id.Bytes(),
+					Created: organization.Created,
+					Title:   organization.Title,
+				}}})
+	case enums.OrganizationAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateOrganizationResponseV1{
+			Data: &inout.CreateOrganizationResponseV1_ValidationError_{
+				ValidationError: &inout.CreateOrganizationResponseV1_ValidationError{
+					Title: []string{"Организация с таким названием уже существует"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
@@ -3,6 +3,7 @@ package api
 import (
 	"hive/enums"
 	"hive/inout"
+	"hive/policies"
 	uuid "github.com/satori/go.uuid"
 	"net/http"
 )
@@ -15,6 +16,10 @@ func (api *API) CreatePasswordV1(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.PasswordResource, OwnerID: uuid.FromBytesOrNil(body.UserID)}); !ok {
+		return
+	}
+
 	status, password := api.Controller.CreatePassword(r.Context(), uuid.FromBytesOrNil(body.UserID), body.Value)
 
 	switch status {
@@ -2,11 +2,26 @@ package api
 
 import (
 	"hive/enums"
+	"hive/extractors"
 	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
 	uuid "github.com/satori/go.uuid"
 	"net/http"
 )
 
+func phoneToProto(phone *models.Phone) *inout.Phone {
+	return &inout.Phone{
+		Id:         phone.Id.Bytes(),
+		Created:    phone.Created,
+		UserID:     phone.UserId.Bytes(),
+		Phone:      phone.Value,
+		Primary:    phone.Primary,
+		VerifiedAt: phone.VerifiedAt,
+	}
+}
+
 func (api *API) CreatePhoneV1(w http.ResponseWriter, r *http.Request) {
 	body := &inout.CreatePhoneResponseV1_Request{}
 	err := api.Parser.Parse(r, w, body)
@@ -14,17 +29,26 @@ func (api *API) CreatePhoneV1(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	status, phone := api.Controller.CreatePhone(r.Context(), body.Phone, body.Code, uuid.FromBytesOrNil(body.UserID))
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.PhoneResource, OwnerID: uuid.FromBytesOrNil(body.UserID)}); !ok {
+		return
+	}
+
+	status, phone, transfer := api.Controller.CreatePhone(r.Context(), body.Phone, body.Code, uuid.FromBytesOrNil(body.UserID))
 
 	switch status {
 	case enums.Ok:
 		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreatePhoneResponseV1{
 			Data: &inout.CreatePhoneResponseV1_Ok{
-				Ok: &inout.Phone{
-					Id:      phone.Id.Bytes(),
-					Created: phone.Created,
-					UserID:  phone.UserId.Bytes(),
-					Phone:   phone.Value,
+				Ok: phoneToProto(phone)}})
+	case enums.ContactTransferRequested:
+		api.Renderer.Render(w, r, http.StatusAccepted, &inout.CreatePhoneResponseV1{
+			Data: &inout.CreatePhoneResponseV1_Transfer{
+				Transfer: requestedContactTransferToProto(transfer)}})
+	case enums.LastLoginIdentifier:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreatePhoneResponseV1{
+			Data: &inout.CreatePhoneResponseV1_ValidationError_{
+				ValidationError: &inout.CreatePhoneResponseV1_ValidationError{
+					Phone: []string{"Телефон является единственным способом входа другого пользователя"},
 				}}})
 	case enums.IncorrectPhoneCode:
 		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreatePhoneResponseV1{
@@ -84,3 +108,99 @@ func (api *API) CreatePhoneConfirmationV1(w http.ResponseWriter, r *http.Request
 		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
 	}
 }
+
+// getOwnedPhone finds phone which could be changed by authenticated user, renders error otherwise
+func (api *API) getOwnedPhone(w http.ResponseWriter, r *http.Request) (*models.Phone, bool) {
+
+	id, _ := extractors.GetUUID(r)
+	status, phone := api.Controller.GetPhoneByID(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+	case enums.PhoneNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+		return nil, false
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+		return nil, false
+	}
+
+	if !canAccessProfile(repositories.GetUserFromContext(r.Context()), phone.UserId, enums.UsersUpdate) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return nil, false
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.PhoneResource, OwnerID: phone.UserId}); !ok {
+		return nil, false
+	}
+
+	return phone, true
+}
+
+// GetUserPhonesV1 lists phones of user, primary phone goes first
+func (api *API) GetUserPhonesV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	if !canAccessProfile(repositories.GetUserFromContext(r.Context()), id, enums.UsersRead) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.PhoneResource, OwnerID: id}); !ok {
+		return
+	}
+
+	phones := api.Controller.GetUserPhones(r.Context(), id)
+	data := make([]*inout.Phone, 0, len(phones))
+	for _, phone := range phones {
+		data = append(data, phoneToProto(phone))
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListPhoneResponseV1{Data: data})
+}
+
+func (api *API) DeletePhoneV1(w http.ResponseWriter, r *http.Request) {
+
+	phone, ok := api.getOwnedPhone(w, r)
+	if !ok {
+		return
+	}
+
+	status, phone := api.Controller.DeletePhone(r.Context(), phone.Id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.DeletePhoneResponseV1{
+			Data: &inout.DeletePhoneResponseV1_Ok{Ok: phoneToProto(phone)},
+		})
+	case enums.PhoneNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	case enums.LastLoginIdentifier:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.DeletePhoneResponseV1{
+			Data: &inout.DeletePhoneResponseV1_ValidationError_{
+				ValidationError: &inout.DeletePhoneResponseV1_ValidationError{
+					Errors: []string{"Нельзя удалить последний email или телефон, по которому пользователь входит"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) SetPrimaryPhoneV1(w http.ResponseWriter, r *http.Request) {
+
+	phone, ok := api.getOwnedPhone(w, r)
+	if !ok {
+		return
+	}
+
+	status, phone := api.Controller.SetPrimaryPhone(r.Context(), phone.Id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.SetPrimaryPhoneResponseV1{Data: phoneToProto(phone)})
+	case enums.PhoneNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"hive/extractors"
+	"hive/policies"
+	"hive/repositories"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+func (api *API) evaluatePolicy(r *http.Request, resource policies.Resource) *policies.Decision {
+	return api.policyEngine.Evaluate(r.Context(), &policies.Request{
+		User:     repositories.GetUserFromContext(r.Context()),
+		Route:    extractors.GetRouteName(r),
+		Resource: resource,
+	})
+}
+
+// authorize renders forbidden response if access policies deny access to resource on route of request
+func (api *API) authorize(w http.ResponseWriter, r *http.Request, resource policies.Resource) (*policies.Decision, bool) {
+	decision := api.evaluatePolicy(r, resource)
+	if !decision.Allowed {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+	}
+
+	return decision, decision.Allowed
+}
+
+// getPolicyOwner tells whose resources access policies allow to list on route of request: nil owner means resources
+// of anyone, otherwise only resources of authenticated user are allowed, false if none are. Policies depend on owner
+// only through owner condition, so decisions for a foreign and for an own resource are enough. Lists are filtered
+// by owner in queries, so pages and counts never include denied records
+func (api *API) getPolicyOwner(r *http.Request, resourceType string) (uuid.UUID, bool) {
+	if api.evaluatePolicy(r, policies.Resource{Type: resourceType, OwnerID: uuid.NewV4()}).Allowed {
+		return uuid.Nil, true
+	}
+
+	user := repositories.GetUserFromContext(r.Context())
+	if user != nil && api.evaluatePolicy(r, policies.Resource{Type: resourceType, OwnerID: user.GetUserID()}).Allowed {
+		return user.GetUserID(), true
+	}
+
+	return uuid.Nil, false
+}
+
+// restrictToPolicyOwner narrows filter by owners to owner allowed by policies, false if nothing is left
+func restrictToPolicyOwner(ownersID []uuid.UUID, ownerID uuid.UUID) ([]uuid.UUID, bool) {
+	if ownerID == uuid.Nil {
+		return ownersID, true
+	}
+
+	if len(ownersID) == 0 {
+		return []uuid.UUID{ownerID}, true
+	}
+
+	for _, id := range ownersID {
+		if uuid.Equal(id, ownerID) {
+			return []uuid.UUID{ownerID}, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,105 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/functools"
+	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	"github.com/getsentry/sentry-go"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// getProfileFilter collects filters by profile attributes passed as "profile.<attribute>" parameters,
+// only attributes allowed by configuration are taken into account
+func (api *API) getProfileFilter(query url.Values) map[string]string {
+	filter := map[string]string{}
+
+	for parameter, values := range query {
+		attribute := strings.TrimPrefix(parameter, "profile.")
+		if attribute == parameter || len(values) == 0 || !functools.Contains(attribute, api.environment.ProfileFilterAttributes) {
+			continue
+		}
+
+		filter[attribute] = values[0]
+	}
+
+	return filter
+}
+
+// canAccessProfile allows users to access own profile and users with permission to access profile of anyone
+func canAccessProfile(user models.IAuthenticationBackendUser, id uuid.UUID, permission string) bool {
+	return uuid.Equal(user.GetUserID(), id) || functools.HasPermission(user.GetPermissions(), permission)
+}
+
+func (api *API) GetUserProfileV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	if !canAccessProfile(repositories.GetUserFromContext(r.Context()), id, enums.UsersRead) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserResource, OwnerID: id}); !ok {
+		return
+	}
+
+	user := api.Controller.GetUser(r.Context(), id)
+
+	if user == nil {
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+		return
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.GetUserProfileResponseV1{Data: functools.MapToStruct(user.Profile)})
+}
+
+func (api *API) UpdateUserProfileV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.UpdateUserProfileResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	id, _ := extractors.GetUUID(r)
+	if !canAccessProfile(repositories.GetUserFromContext(r.Context()), id, enums.UsersUpdate) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserResource, OwnerID: id}); !ok {
+		return
+	}
+
+	attributes, err := functools.StructToMap(body.Profile)
+	if err != nil {
+		sentry.CaptureException(err)
+		api.Renderer.Render(w, r, http.StatusBadRequest, nil)
+		return
+	}
+
+	status, user := api.Controller.UpdateUserProfile(r.Context(), id, attributes)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.UpdateUserProfileResponseV1{
+			Data: &inout.UpdateUserProfileResponseV1_Ok{Ok: functools.MapToStruct(user.Profile)},
+		})
+	case enums.UserNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	case enums.IncorrectProfile:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateUserProfileResponseV1{
+			Data: &inout.UpdateUserProfileResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateUserProfileResponseV1_ValidationError{
+					Profile: []string{"Профиль не соответствует схеме"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
@@ -0,0 +1,257 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/functools"
+	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	uuid "github.com/satori/go.uuid"
+	"net/http"
+)
+
+func roleRequestToProto(request *models.RoleRequest) *inout.RoleRequest {
+	return &inout.RoleRequest{
+		Id:         request.Id.Bytes(),
+		Created:    request.Created,
+		UserID:     request.UserId.Bytes(),
+		RoleID:     request.RoleId.Bytes(),
+		Reason:     request.Reason,
+		Status:     request.Status,
+		ExpiresAt:  request.ExpiresAt,
+		ReviewerID: nullUUIDToBytes(request.ReviewerId),
+		Reviewed:   request.Reviewed,
+		Comment:    request.Comment,
+		UserRoleID: nullUUIDToBytes(request.UserRoleId),
+	}
+}
+
+// canReadRoleRequest allows author of request and users who could review it
+func (api *API) canReadRoleRequest(r *http.Request, user models.IAuthenticationBackendUser, request *models.RoleRequest) bool {
+	if uuid.Equal(user.GetUserID(), request.UserId) {
+		return true
+	}
+
+	return api.Controller.CanManageUserRole(r.Context(), user, request.RoleId, enums.RoleRequestsRead)
+}
+
+func (api *API) GetRoleRequestsV1Query(r *http.Request) repositories.GetRoleRequestsQuery {
+
+	query := r.URL.Query()
+	return repositories.GetRoleRequestsQuery{
+		Pagination: functools.GetPagination(query, api.environment),
+		UserId:     functools.StringsSliceToUUIDSlice(query["users"]),
+		RoleId:     functools.StringsSliceToUUIDSlice(query["roles"]),
+		Status:     query["statuses"],
+	}
+}
+
+func (api *API) GetRoleRequestsV1(w http.ResponseWriter, r *http.Request) {
+
+	query := api.GetRoleRequestsV1Query(r)
+
+	ownerID, ok := api.getPolicyOwner(r, enums.RoleRequestResource)
+	if ok {
+		query.UserId, ok = restrictToPolicyOwner(query.UserId, ownerID)
+	}
+
+	if !ok {
+		api.Renderer.Render(w, r, http.StatusOK, &inout.ListRoleRequestsResponseV1{Data: []*inout.RoleRequest{}, Pagination: &inout.Pagination{}})
+		return
+	}
+
+	user := repositories.GetUserFromContext(r.Context())
+	requests, pagination := api.Controller.GetRoleRequests(r.Context(), user, query)
+	requestsData := make([]*inout.RoleRequest, 0, len(requests))
+
+	for _, request := range requests {
+		requestsData = append(requestsData, roleRequestToProto(request))
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListRoleRequestsResponseV1{Data: requestsData, Pagination: &inout.Pagination{
+		HasPrevious: pagination.HasPrevious,
+		HasNext:     pagination.HasNext,
+		Count:       pagination.Count,
+	}})
+}
+
+func (api *API) GetRoleRequestV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, request := api.Controller.GetRoleRequest(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		if !api.canReadRoleRequest(r, repositories.GetUserFromContext(r.Context()), request) {
+			api.Renderer.Render(w, r, http.StatusForbidden, nil)
+			return
+		}
+
+		if _, ok := api.authorize(w, r, policies.Resource{Type: enums.RoleRequestResource, OwnerID: request.UserId}); !ok {
+			return
+		}
+
+		api.Renderer.Render(w, r, http.StatusOK, &inout.GetRoleRequestResponseV1{Data: roleRequestToProto(request)})
+	case enums.RoleRequestNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.GetRoleRequestResponseV1{})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) CreateRoleRequestV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.CreateRoleRequestResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	user := repositories.GetUserFromContext(r.Context())
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.RoleRequestResource, OwnerID: user.GetUserID()}); !ok {
+		return
+	}
+
+	status, request := api.Controller.CreateRoleRequest(r.Context(), user.GetUserID(), uuid.FromBytesOrNil(body.RoleID), body.Reason, body.ExpiresAt)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateRoleRequestResponseV1{
+			Data: &inout.CreateRoleRequestResponseV1_Ok{Ok: roleRequestToProto(request)},
+		})
+	case enums.RoleNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateRoleRequestResponseV1{
+			Data: &inout.CreateRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.CreateRoleRequestResponseV1_ValidationError{
+					RoleID: []string{"Такой роли не существует"},
+				}}})
+	case enums.IncorrectRoleRequestReason:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateRoleRequestResponseV1{
+			Data: &inout.CreateRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.CreateRoleRequestResponseV1_ValidationError{
+					Reason: []string{"Необходимо указать причину запроса"},
+				}}})
+	case enums.IncorrectUserRolePeriod:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateRoleRequestResponseV1{
+			Data: &inout.CreateRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.CreateRoleRequestResponseV1_ValidationError{
+					ExpiresAt: []string{"Срок действия роли должен заканчиваться в будущем"},
+				}}})
+	case enums.RoleRequestAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateRoleRequestResponseV1{
+			Data: &inout.CreateRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.CreateRoleRequestResponseV1_ValidationError{
+					Errors: []string{"Запрос на эту роль уже ожидает рассмотрения"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+// ReviewRoleRequestV1 approves or denies request, reviewer must be able to grant requested role
+// and could not review own request
+func (api *API) ReviewRoleRequestV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.ReviewRoleRequestResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	id, _ := extractors.GetUUID(r)
+	status, request := api.Controller.GetRoleRequest(r.Context(), id)
+	if status == enums.RoleRequestNotFound {
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.ReviewRoleRequestResponseV1{})
+		return
+	}
+
+	user := repositories.GetUserFromContext(r.Context())
+	if status != enums.Ok || uuid.Equal(user.GetUserID(), request.UserId) ||
+		!api.Controller.CanManageUserRole(r.Context(), user, request.RoleId, enums.UserRolesCreate) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.RoleRequestResource, OwnerID: request.UserId}); !ok {
+		return
+	}
+
+	status, request = api.Controller.ReviewRoleRequest(r.Context(), id, user.GetUserID(), body.Status, body.Comment, body.ExpiresAt)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.ReviewRoleRequestResponseV1{
+			Data: &inout.ReviewRoleRequestResponseV1_Ok{Ok: roleRequestToProto(request)},
+		})
+	case enums.RoleRequestNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.ReviewRoleRequestResponseV1{})
+	case enums.IncorrectRoleRequestStatus:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewRoleRequestResponseV1{
+			Data: &inout.ReviewRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewRoleRequestResponseV1_ValidationError{
+					Status: []string{"Запрос можно только одобрить или отклонить"},
+				}}})
+	case enums.IncorrectUserRolePeriod:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewRoleRequestResponseV1{
+			Data: &inout.ReviewRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewRoleRequestResponseV1_ValidationError{
+					ExpiresAt: []string{"Срок действия роли должен заканчиваться в будущем"},
+				}}})
+	case enums.RoleRequestReviewed:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewRoleRequestResponseV1{
+			Data: &inout.ReviewRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewRoleRequestResponseV1_ValidationError{
+					Errors: []string{"Запрос уже рассмотрен"},
+				}}})
+	case enums.UserRoleAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.ReviewRoleRequestResponseV1{
+			Data: &inout.ReviewRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.ReviewRoleRequestResponseV1_ValidationError{
+					Errors: []string{"Данная роль уже есть у пользователя"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+// CancelRoleRequestV1 withdraws pending request, only author could cancel request
+func (api *API) CancelRoleRequestV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, request := api.Controller.GetRoleRequest(r.Context(), id)
+	if status == enums.RoleRequestNotFound {
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.CancelRoleRequestResponseV1{})
+		return
+	}
+
+	user := repositories.GetUserFromContext(r.Context())
+	if status != enums.Ok || !uuid.Equal(user.GetUserID(), request.UserId) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.RoleRequestResource, OwnerID: request.UserId}); !ok {
+		return
+	}
+
+	status, request = api.Controller.CancelRoleRequest(r.Context(), id, user.GetUserID())
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.CancelRoleRequestResponseV1{
+			Data: &inout.CancelRoleRequestResponseV1_Ok{Ok: roleRequestToProto(request)},
+		})
+	case enums.RoleRequestNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.CancelRoleRequestResponseV1{})
+	case enums.RoleRequestReviewed:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CancelRoleRequestResponseV1{
+			Data: &inout.CancelRoleRequestResponseV1_ValidationError_{
+				ValidationError: &inout.CancelRoleRequestResponseV1_ValidationError{
+					Errors: []string{"Запрос уже рассмотрен"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
@@ -0,0 +1,64 @@
+package api
+
+import (
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"hive/auth"
+	"hive/auth/backends"
+	"hive/config"
+	"hive/controllers"
+	"hive/enums"
+	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetRoleRequestsV1WithOwnerPolicy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := controllers.NewMockIController(ctrl)
+	policyEngine := policies.NewMockIPolicyEngine(ctrl)
+	api := InitAPI(controller, auth.NewMockIAuthenticationController(ctrl), policyEngine, config.InitEnvironment())
+	userID := uuid.NewV4()
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Add("Content-Type", "application/octet-stream")
+	user := &backends.BasicAuthenticationBackendUser{UserID: userID, Permissions: []string{enums.RoleRequestsRead}}
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), user))
+
+	policyEngine.
+		EXPECT().
+		Evaluate(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, policyRequest *policies.Request) *policies.Decision {
+			require.Equal(t, enums.RoleRequestResource, policyRequest.Resource.Type)
+			return &policies.Decision{Allowed: uuid.Equal(policyRequest.Resource.OwnerID, userID)}
+		}).
+		AnyTimes()
+
+	controller.
+		EXPECT().
+		GetRoleRequests(gomock.Any(), user, gomock.Any()).
+		DoAndReturn(func(_ interface{}, _ interface{}, query repositories.GetRoleRequestsQuery) ([]*models.RoleRequest, *models.PaginationResponse) {
+			require.Equal(t, []uuid.UUID{userID}, query.UserId)
+			return []*models.RoleRequest{{Id: uuid.NewV4(), UserId: userID}}, &models.PaginationResponse{Count: 1}
+		}).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.GetRoleRequestsV1(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+	responseBody, _ := ioutil.ReadAll(recorder.Result().Body)
+	var message inout.ListRoleRequestsResponseV1
+	require.NoError(t, proto.Unmarshal(responseBody, &message))
+	require.Len(t, message.Data, 1)
+	require.Equal(t, int64(1), message.Pagination.Count)
+}
@@ -5,10 +5,24 @@ import (
 	"hive/extractors"
 	"hive/functools"
 	"hive/inout"
+	"hive/models"
+	"hive/policies"
 	"hive/repositories"
 	"net/http"
 )
 
+func roleToProto(role *models.Role) *inout.Role {
+	return &inout.Role{
+		Id:             role.Id.Bytes(),
+		Created:        role.Created,
+		Title:          role.Title,
+		Description:    role.Description,
+		Permissions:    role.Permissions,
+		ParentsID:      functools.UUIDSliceToByteArraySlice(role.ParentsID),
+		ManagedRolesID: functools.UUIDSliceToByteArraySlice(role.ManagedRolesID),
+	}
+}
+
 func (api *API) GetRolesV1Query(r *http.Request) repositories.GetRolesQuery {
 	query := r.URL.Query()
 	return repositories.GetRolesQuery{
@@ -19,16 +33,16 @@ func (api *API) GetRolesV1Query(r *http.Request) repositories.GetRolesQuery {
 
 func (api *API) GetRoleV1(w http.ResponseWriter, r *http.Request) {
 	id, _ := extractors.GetUUID(r)
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.RoleResource}); !ok {
+		return
+	}
+
 	status, role := api.Controller.GetRole(r.Context(), id)
 
 	switch status {
 	case enums.Ok:
 		api.Renderer.Render(w, r, http.StatusOK, &inout.GetRoleResponseV1{
-			Data: &inout.Role{
-				Id:      role.Id.Bytes(),
-				Created: role.Created,
-				Title:   role.Title,
-			}})
+			Data: roleToProto(role)})
 	case enums.RoleNotFound:
 		api.Renderer.Render(w, r, http.StatusNotFound, &inout.GetRoleResponseV1{})
 	default:
@@ -38,16 +52,34 @@ func (api *API) GetRoleV1(w http.ResponseWriter, r *http.Request) {
 
 func (api *API) GetRolesV1(w http.ResponseWriter, r *http.Request) {
 
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.RoleResource}); !ok {
+		return
+	}
+
 	query := api.GetRolesV1Query(r)
 	roles, pagination := api.Controller.GetRoles(r.Context(), query)
 	rolesData := make([]*inout.Role, len(roles))
 
 	for i, role := range roles {
-		rolesData[i] = &inout.Role{
-			Id:      role.Id.Bytes(),
-			Created: role.Created,
-			Title:   role.Title,
-		}
+		rolesData[i] = roleToProto(role)
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListRoleResponseV1{Data: rolesData, Pagination: &inout.Pagination{
+		HasPrevious: pagination.HasPrevious,
+		HasNext:     pagination.HasNext,
+		Count:       pagination.Count,
+	}})
+}
+
+// GetManageableRolesV1 lists roles which authenticated user could grant and revoke
+func (api *API) GetManageableRolesV1(w http.ResponseWriter, r *http.Request) {
+
+	query := api.GetRolesV1Query(r)
+	roles, pagination := api.Controller.GetManageableRoles(r.Context(), repositories.GetUserFromContext(r.Context()), query)
+	rolesData := make([]*inout.Role, len(roles))
+
+	for i, role := range roles {
+		rolesData[i] = roleToProto(role)
 	}
 
 	api.Renderer.Render(w, r, http.StatusOK, &inout.ListRoleResponseV1{Data: rolesData, Pagination: &inout.Pagination{
@@ -67,23 +99,150 @@ func (api *API) CreateRoleV1(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	status, role := api.Controller.CreateRole(ctx, body.Title)
+	status, role := api.Controller.CreateRole(ctx, body.Title, body.Permissions, functools.ByteArraySliceToUUIDSlice(body.ParentsID))
 
 	switch status {
 	case enums.Ok:
 		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateRoleResponseV1{
 			Data: &inout.CreateRoleResponseV1_Ok{
-				Ok: &inout.Role{
-					Id:      role.Id.Bytes(),
-					Created: role.Created,
-					Title:   role.Title,
-				}}})
+				Ok: roleToProto(role)}})
 	case enums.RoleAlreadyExist:
 		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateRoleResponseV1{
 			Data: &inout.CreateRoleResponseV1_ValidationError_{
 				ValidationError: &inout.CreateRoleResponseV1_ValidationError{
 					Title: []string{"Роль с таким названием уже существует"},
 				}}})
+	case enums.IncorrectPermission:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateRoleResponseV1{
+			Data: &inout.CreateRoleResponseV1_ValidationError_{
+				ValidationError: &inout.CreateRoleResponseV1_ValidationError{
+					Permissions: []string{"Некорректное право доступа, ожидается формат resource:action"},
+				}}})
+	case enums.ParentRoleNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateRoleResponseV1{
+			Data: &inout.CreateRoleResponseV1_ValidationError_{
+				ValidationError: &inout.CreateRoleResponseV1_ValidationError{
+					ParentsID: []string{"Родительской роли не существует"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) UpdateRoleParentsV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.UpdateRoleParentsResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	id, _ := extractors.GetUUID(r)
+	status, role := api.Controller.UpdateRoleParents(r.Context(), id, functools.ByteArraySliceToUUIDSlice(body.ParentsID))
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.UpdateRoleParentsResponseV1{
+			Data: &inout.UpdateRoleParentsResponseV1_Ok{
+				Ok: roleToProto(role)}})
+	case enums.RoleNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.UpdateRoleParentsResponseV1{})
+	case enums.ParentRoleNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateRoleParentsResponseV1{
+			Data: &inout.UpdateRoleParentsResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateRoleParentsResponseV1_ValidationError{
+					ParentsID: []string{"Родительской роли не существует"},
+				}}})
+	case enums.RoleHierarchyCycle:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateRoleParentsResponseV1{
+			Data: &inout.UpdateRoleParentsResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateRoleParentsResponseV1_ValidationError{
+					ParentsID: []string{"Роль не может наследовать саму себя"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) UpdateRoleManagedRolesV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.UpdateRoleManagedRolesResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	id, _ := extractors.GetUUID(r)
+	status, role := api.Controller.UpdateRoleManagedRoles(r.Context(), id, functools.ByteArraySliceToUUIDSlice(body.ManagedRolesID))
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.UpdateRoleManagedRolesResponseV1{
+			Data: &inout.UpdateRoleManagedRolesResponseV1_Ok{
+				Ok: roleToProto(role)}})
+	case enums.RoleNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.UpdateRoleManagedRolesResponseV1{})
+	case enums.ManagedRoleNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateRoleManagedRolesResponseV1{
+			Data: &inout.UpdateRoleManagedRolesResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateRoleManagedRolesResponseV1_ValidationError{
+					ManagedRolesID: []string{"Управляемой роли не существует"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) UpdateRoleV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.UpdateRoleResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	id, _ := extractors.GetUUID(r)
+	status, role := api.Controller.UpdateRole(r.Context(), id, body.Title, body.Description)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.UpdateRoleResponseV1{
+			Data: &inout.UpdateRoleResponseV1_Ok{Ok: roleToProto(role)}})
+	case enums.RoleNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, &inout.UpdateRoleResponseV1{})
+	case enums.RoleAlreadyExist:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateRoleResponseV1{
+			Data: &inout.UpdateRoleResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateRoleResponseV1_ValidationError{
+					Title: []string{"Роль с таким названием уже существует"},
+				}}})
+	case enums.RoleProtected:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateRoleResponseV1{
+			Data: &inout.UpdateRoleResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateRoleResponseV1_ValidationError{
+					Title: []string{"Роль администратора не может быть переименована"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) DeleteRoleV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, _ := api.Controller.DeleteRole(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusNoContent, nil)
+	case enums.RoleNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	case enums.RoleProtected:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.DeleteRoleResponseV1{
+			Data: &inout.DeleteRoleResponseV1_ValidationError_{
+				ValidationError: &inout.DeleteRoleResponseV1_ValidationError{
+					Errors: []string{"Роль администратора не может быть удалена"},
+				}}})
 	default:
 		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
 	}
@@ -1,8 +1,11 @@
 package api
 
 import (
+	"hive/enums"
 	"hive/extractors"
+	"hive/functools"
 	"hive/inout"
+	"hive/repositories/postgresRepository"
 	"net/http"
 )
 
@@ -19,6 +22,72 @@ func (api *API) GetSecretV1(w http.ResponseWriter, r *http.Request) {
 				Id:      secret.Id.Bytes(),
 				Created: secret.Created,
 				Value:   secret.Value.Bytes(),
+				Expires: secret.Expires,
+				Revoked: secret.Revoked,
 			}})
 	}
 }
+
+func (api *API) GetSecretsV1Query(r *http.Request) postgresRepository.GetSecretsQuery {
+	query := r.URL.Query()
+	return postgresRepository.GetSecretsQuery{
+		Pagination:  functools.GetPagination(query, api.environment),
+		Identifiers: functools.StringsSliceToUUIDSlice(query["id"]),
+	}
+}
+
+func (api *API) GetSecretsV1(w http.ResponseWriter, r *http.Request) {
+
+	query := api.GetSecretsV1Query(r)
+	secrets, pagination := api.Controller.GetSecrets(r.Context(), query)
+	secretsData := make([]*inout.Secret, len(secrets))
+
+	for i, secret := range secrets {
+		secretsData[i] = &inout.Secret{
+			Id:      secret.Id.Bytes(),
+			Created: secret.Created,
+			Expires: secret.Expires,
+			Revoked: secret.Revoked,
+		}
+	}
+
+	api.Renderer.Render(w, r, http.StatusOK, &inout.ListSecretResponseV1{Data: secretsData, Pagination: &inout.Pagination{
+		HasPrevious: pagination.HasPrevious,
+		HasNext:     pagination.HasNext,
+		Count:       pagination.Count,
+	}})
+}
+
+func (api *API) CreateSecretV1(w http.ResponseWriter, r *http.Request) {
+
+	secret := api.Controller.RotateSecret(r.Context())
+
+	api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateSecretResponseV1{
+		Data: &inout.Secret{
+			Id:      secret.Id.Bytes(),
+			Created: secret.Created,
+			Expires: secret.Expires,
+			Revoked: secret.Revoked,
+		}})
+}
+
+func (api *API) DeleteSecretV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, secret := api.Controller.RevokeSecret(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.GetSecretResponseV1{
+			Data: &inout.Secret{
+				Id:      secret.Id.Bytes(),
+				Created: secret.Created,
+				Expires: secret.Expires,
+				Revoked: secret.Revoked,
+			}})
+	case enums.SecretNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
@@ -1,10 +1,13 @@
 package api
 
 import (
+	"hive/auth"
 	"hive/enums"
+	"hive/functools"
 	"hive/inout"
 	"hive/models"
 	"hive/repositories"
+	uuid "github.com/satori/go.uuid"
 	"net/http"
 	"time"
 )
@@ -23,12 +26,41 @@ func (api *API) CreateSessionV1(w http.ResponseWriter, r *http.Request) {
 
 	var status int
 	var session *models.Session
+	var keyThumbprint string
+
+	if r.Header.Get(auth.DPoPHeader) != "" {
+		status, keyThumbprint = api.authenticationController.ValidateDPoPProof(ctx, r, "")
+
+		switch status {
+		case enums.Ok:
+		case enums.DPoPNonceRequired:
+			w.Header().Set(auth.DPoPNonceHeader, api.authenticationController.CreateDPoPNonce(ctx))
+			api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateSessionResponseV1{
+				Data: &inout.CreateSessionResponseV1_ValidationError_{
+					ValidationError: &inout.CreateSessionResponseV1_ValidationError{
+						Dpop: []string{"Требуется nonce в DPoP proof"},
+					}}})
+			return
+		default:
+			api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateSessionResponseV1{
+				Data: &inout.CreateSessionResponseV1_ValidationError_{
+					ValidationError: &inout.CreateSessionResponseV1_ValidationError{
+						Dpop: []string{"Некорректный DPoP proof"},
+					}}})
+			return
+		}
+	}
 
 	if user != nil {
-		session = api.Controller.CreateSession(ctx, user.GetUserID(), body.Fingerprint, body.UserAgent)
-		status = enums.Ok
+		authentication := models.InitAuthenticationContext(nil, 0)
+		if authenticationContextUser, ok := user.(models.IAuthenticationContextUser); ok {
+			authentication = authenticationContextUser.GetAuthenticationContext()
+		}
+
+		status, session = api.Controller.CreateSession(ctx, user.GetUserID(), body.Fingerprint, body.UserAgent, body.ClientID, keyThumbprint,
+			uuid.FromBytesOrNil(body.OrganizationID), authentication)
 	} else if refreshToken != nil {
-		status, session = api.Controller.UpdateSession(ctx, *refreshToken, body.Fingerprint, body.UserAgent)
+		status, session = api.Controller.UpdateSession(ctx, *refreshToken, body.Fingerprint, body.UserAgent, body.ClientID, keyThumbprint)
 	} else {
 		api.Renderer.Render(w, r, http.StatusUnauthorized, nil)
 		return
@@ -37,14 +69,19 @@ func (api *API) CreateSessionV1(w http.ResponseWriter, r *http.Request) {
 	switch status {
 	case enums.Ok:
 
+		tokenType := "Bearer"
+		if session.KeyThumbprint != "" {
+			tokenType = auth.DPoPAuthorization
+		}
+
 		http.SetCookie(w, &http.Cookie{
 			Name:     enums.RefreshToken,
 			Value:    session.RefreshToken.String(),
 			Domain:   r.Referer(),
-			Expires:  time.Now().Add(time.Hour * 24 * time.Duration(api.environment.RefreshTokenLifetime)),
+			Expires:  time.Now().Add(time.Hour * 24 * time.Duration(repositories.GetRealmFromContext(ctx).GetRefreshTokenLifetime(api.environment))),
 			Secure:   true,
 			HttpOnly: true,
-			Path:     "/api/v1/sessions",
+			Path:     functools.GetOriginalPath(r),
 		})
 
 		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateSessionResponseV1{
@@ -54,14 +91,18 @@ func (api *API) CreateSessionV1(w http.ResponseWriter, r *http.Request) {
 					AccessToken:  session.AccessToken,
 					Created:      session.Created,
 					Expired:      session.Expires,
+					TokenType:    tokenType,
 				}}})
 	case
 		enums.SessionNotFound,
 		enums.UserNotFound,
 		enums.IncorrectToken,
 		enums.InvalidToken,
-		enums.SecretNotFound:
+		enums.SecretNotFound,
+		enums.SecretRevoked:
 		api.Renderer.Render(w, r, http.StatusUnauthorized, nil)
+	case enums.UserInactive:
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
 	case enums.IncorrectPassword:
 		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateSessionResponseV1{
 			Data: &inout.CreateSessionResponseV1_ValidationError_{
@@ -116,6 +157,12 @@ func (api *API) CreateSessionV1(w http.ResponseWriter, r *http.Request) {
 				ValidationError: &inout.CreateSessionResponseV1_ValidationError{
 					PhoneCode: []string{"Некорректный код подтверждения"},
 				}}})
+	case enums.OrganizationMemberNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateSessionResponseV1{
+			Data: &inout.CreateSessionResponseV1_ValidationError_{
+				ValidationError: &inout.CreateSessionResponseV1_ValidationError{
+					OrganizationID: []string{"Пользователь не состоит в организации"},
+				}}})
 	case enums.PhoneConfirmationCodeNotFound:
 		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateSessionResponseV1{
 			Data: &inout.CreateSessionResponseV1_ValidationError_{
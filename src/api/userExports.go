@@ -0,0 +1,107 @@
+package api
+
+import (
+	"hive/enums"
+	"hive/extractors"
+	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	"github.com/getsentry/sentry-go"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/protobuf/proto"
+	"net/http"
+)
+
+func userExportToProto(export *models.UserExport) *inout.UserExport {
+	return &inout.UserExport{
+		Id:          export.Id.Bytes(),
+		Created:     export.Created,
+		UserID:      export.UserId.Bytes(),
+		RequesterID: export.RequesterId.Bytes(),
+		Status:      export.Status,
+		Completed:   export.Completed,
+		Expires:     export.Expires,
+		Token:       export.Token,
+	}
+}
+
+// CreateUserExportV1 requests export of user data, the archive is built in background and
+// downloaded with token returned only in this response
+func (api *API) CreateUserExportV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	user := repositories.GetUserFromContext(r.Context())
+	if !canAccessProfile(user, id, enums.UsersExport) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserResource, OwnerID: id}); !ok {
+		return
+	}
+
+	status, export := api.Controller.CreateUserExport(r.Context(), id, user.GetUserID())
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusAccepted, &inout.CreateUserExportResponseV1{Data: userExportToProto(export)})
+	case enums.UserNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+// GetUserExportV1 returns state of export to exported user, requester and users allowed to export anyone
+func (api *API) GetUserExportV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, export := api.Controller.GetUserExport(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		user := repositories.GetUserFromContext(r.Context())
+		if !uuid.Equal(user.GetUserID(), export.RequesterId) && !canAccessProfile(user, export.UserId, enums.UsersExport) {
+			api.Renderer.Render(w, r, http.StatusForbidden, nil)
+			return
+		}
+
+		if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserResource, OwnerID: export.UserId}); !ok {
+			return
+		}
+
+		api.Renderer.Render(w, r, http.StatusOK, &inout.GetUserExportResponseV1{Data: userExportToProto(export)})
+	case enums.UserExportNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+// GetUserExportArchiveV1 downloads archive by token passed in X-Export-Token header, so it could be downloaded
+// without authentication. Archive is rendered in content type of request
+func (api *API) GetUserExportArchiveV1(w http.ResponseWriter, r *http.Request) {
+
+	id, _ := extractors.GetUUID(r)
+	status, export := api.Controller.GetUserExportArchive(r.Context(), id, repositories.GetExportTokenHeader(r))
+
+	switch status {
+	case enums.Ok:
+		data := &inout.UserExportData{}
+		err := proto.Unmarshal(export.Archive, data)
+		if err != nil {
+			sentry.CaptureException(err)
+			api.Renderer.Render(w, r, http.StatusInternalServerError, nil)
+			return
+		}
+
+		api.Renderer.Render(w, r, http.StatusOK, &inout.GetUserExportArchiveResponseV1{Data: data})
+	case enums.UserExportNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	case enums.UserExportNotReady:
+		api.Renderer.Render(w, r, http.StatusConflict, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
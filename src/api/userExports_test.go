@@ -0,0 +1,80 @@
+package api
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"hive/auth"
+	"hive/auth/backends"
+	"hive/config"
+	"hive/controllers"
+	"hive/enums"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUserExportArchiveV1ReadsTokenFromHeader(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := controllers.NewMockIController(ctrl)
+	api := InitAPI(controller, auth.NewMockIAuthenticationController(ctrl), policies.NewMockIPolicyEngine(ctrl), config.InitEnvironment())
+	id := uuid.NewV4()
+
+	request := httptest.NewRequest(http.MethodGet, "/?token=query", bytes.NewReader([]byte{}))
+	request.Header.Add("Content-Type", "application/octet-stream")
+	request.Header.Add("X-Export-Token", "header")
+	request = mux.SetURLVars(request, map[string]string{"id": id.String()})
+
+	controller.
+		EXPECT().
+		GetUserExportArchive(request.Context(), id, "header").
+		Return(enums.UserExportNotFound, nil).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.GetUserExportArchiveV1(recorder, request)
+	require.Equal(t, http.StatusNotFound, recorder.Result().StatusCode)
+}
+
+func TestGetUserExportV1WithDeniedPolicy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := controllers.NewMockIController(ctrl)
+	policyEngine := policies.NewMockIPolicyEngine(ctrl)
+	api := InitAPI(controller, auth.NewMockIAuthenticationController(ctrl), policyEngine, config.InitEnvironment())
+	export := &models.UserExport{Id: uuid.NewV4(), UserId: uuid.NewV4()}
+	export.RequesterId = export.UserId
+
+	request := httptest.NewRequest(http.MethodGet, "/", bytes.NewReader([]byte{}))
+	request.Header.Add("Content-Type", "application/octet-stream")
+	request = mux.SetURLVars(request, map[string]string{"id": export.Id.String()})
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), &backends.BasicAuthenticationBackendUser{UserID: export.UserId}))
+	ctx := request.Context()
+
+	controller.
+		EXPECT().
+		GetUserExport(ctx, export.Id).
+		Return(enums.Ok, export).
+		Times(1)
+
+	policyEngine.
+		EXPECT().
+		Evaluate(ctx, &policies.Request{
+			User:     repositories.GetUserFromContext(ctx),
+			Resource: policies.Resource{Type: enums.UserResource, OwnerID: export.UserId},
+		}).
+		Return(&policies.Decision{}).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.GetUserExportV1(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
@@ -5,34 +5,68 @@ import (
 	"hive/extractors"
 	"hive/functools"
 	"hive/inout"
+	"hive/models"
+	"hive/policies"
 	"hive/repositories"
 	uuid "github.com/satori/go.uuid"
 	"net/http"
 )
 
+// nullUUIDToBytes returns nil for missing identifiers, e.g. organization of role granted globally
+func nullUUIDToBytes(id uuid.UUID) []byte {
+	if id == uuid.Nil {
+		return nil
+	}
+
+	return id.Bytes()
+}
+
+func userRoleToProto(userRole *models.UserRole) *inout.UserRole {
+	return &inout.UserRole{
+		Id:             userRole.Id.Bytes(),
+		Created:        userRole.Created,
+		UserID:         userRole.UserId.Bytes(),
+		RoleID:         userRole.RoleId.Bytes(),
+		OrganizationID: nullUUIDToBytes(userRole.OrganizationId),
+		StartsAt:       userRole.StartsAt,
+		ExpiresAt:      userRole.ExpiresAt,
+	}
+}
+
 func (api *API) GetUserRolesV1Query(r *http.Request) repositories.GetUserRoleQuery {
 
 	query := r.URL.Query()
 	return repositories.GetUserRoleQuery{
-		Pagination: functools.GetPagination(query, api.environment),
-		UserId:     functools.StringsSliceToUUIDSlice(query["users"]),
-		RoleId:     functools.StringsSliceToUUIDSlice(query["roles"]),
+		Pagination:     functools.GetPagination(query, api.environment),
+		UserId:         functools.StringsSliceToUUIDSlice(query["users"]),
+		RoleId:         functools.StringsSliceToUUIDSlice(query["roles"]),
+		OrganizationId: functools.StringsSliceToUUIDSlice(query["organizations"]),
 	}
 }
 
 func (api *API) GetUserRolesV1(w http.ResponseWriter, r *http.Request) {
 
 	query := api.GetUserRolesV1Query(r)
+
+	ownerID, ok := api.getPolicyOwner(r, enums.UserRoleResource)
+	if ok {
+		query.UserId, ok = restrictToPolicyOwner(query.UserId, ownerID)
+	}
+
+	if !ok {
+		api.Renderer.Render(w, r, http.StatusOK, &inout.ListUserRolesResponseV1{Data: []*inout.UserRole{}, Pagination: &inout.Pagination{}})
+		return
+	}
+
 	userRoles, pagination := api.Controller.GetUserRoles(r.Context(), query)
-	usersData := make([]*inout.UserRole, len(userRoles))
-
-	for i, userRole := range userRoles {
-		usersData[i] = &inout.UserRole{
-			Id:      userRole.Id.Bytes(),
-			Created: userRole.Created,
-			UserID:  userRole.UserId.Bytes(),
-			RoleID:  userRole.RoleId.Bytes(),
+	usersData := make([]*inout.UserRole, 0, len(userRoles))
+
+	for _, userRole := range userRoles {
+		if !api.evaluatePolicy(r, policies.Resource{Type: enums.UserRoleResource, OwnerID: userRole.UserId}).Allowed {
+			continue
 		}
+
+		usersData = append(usersData, userRoleToProto(userRole))
 	}
 
 	api.Renderer.Render(w, r, http.StatusOK, &inout.ListUserRolesResponseV1{Data: usersData, Pagination: &inout.Pagination{
@@ -50,17 +84,22 @@ func (api *API) CreateUserRoleV1(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	status, userRole := api.Controller.CreateUserRole(r.Context(), uuid.FromBytesOrNil(body.UserID), uuid.FromBytesOrNil(body.RoleID))
+	user := repositories.GetUserFromContext(r.Context())
+	if !api.Controller.CanManageUserRole(r.Context(), user, uuid.FromBytesOrNil(body.RoleID), enums.UserRolesCreate) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserRoleResource, OwnerID: uuid.FromBytesOrNil(body.UserID)}); !ok {
+		return
+	}
+
+	status, userRole := api.Controller.CreateUserRole(r.Context(), uuid.FromBytesOrNil(body.UserID), uuid.FromBytesOrNil(body.RoleID), uuid.FromBytesOrNil(body.OrganizationID), body.StartsAt, body.ExpiresAt)
 
 	switch status {
 	case enums.Ok:
 		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateUserRoleResponseV1{
-			Data: &inout.CreateUserRoleResponseV1_Ok{Ok: &inout.UserRole{
-				Id:      userRole.Id.Bytes(),
-				Created: userRole.Created,
-				UserID:  userRole.UserId.Bytes(),
-				RoleID:  userRole.RoleId.Bytes(),
-			}},
+			Data: &inout.CreateUserRoleResponseV1_Ok{Ok: userRoleToProto(userRole)},
 		})
 	case enums.RoleNotFound:
 		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateUserRoleResponseV1{
@@ -75,12 +114,24 @@ func (api *API) CreateUserRoleV1(w http.ResponseWriter, r *http.Request) {
 				ValidationError: &inout.CreateUserRoleResponseV1_ValidationError{
 					UserID: []string{"Такого пользователя не существует"},
 				}}})
+	case enums.OrganizationMemberNotFound:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateUserRoleResponseV1{
+			Data: &inout.CreateUserRoleResponseV1_ValidationError_{
+				ValidationError: &inout.CreateUserRoleResponseV1_ValidationError{
+					OrganizationID: []string{"Пользователь не состоит в организации"},
+				}}})
 	case enums.UserRoleAlreadyExist:
 		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateUserRoleResponseV1{
 			Data: &inout.CreateUserRoleResponseV1_ValidationError_{
 				ValidationError: &inout.CreateUserRoleResponseV1_ValidationError{
 					Errors: []string{"Данная роль уже есть у пользователя"},
 				}}})
+	case enums.IncorrectUserRolePeriod:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateUserRoleResponseV1{
+			Data: &inout.CreateUserRoleResponseV1_ValidationError_{
+				ValidationError: &inout.CreateUserRoleResponseV1_ValidationError{
+					ExpiresAt: []string{"Срок действия роли должен заканчиваться в будущем и после его начала"},
+				}}})
 	default:
 		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
 	}
@@ -89,7 +140,23 @@ func (api *API) CreateUserRoleV1(w http.ResponseWriter, r *http.Request) {
 func (api *API) DeleteUserRoleV1(w http.ResponseWriter, r *http.Request) {
 
 	id, _ := extractors.GetUUID(r)
-	status, _ := api.Controller.DeleteUserRole(r.Context(), id)
+	status, userRole := api.Controller.GetUserRole(r.Context(), id)
+	if status == enums.UserRoleNotFound {
+		api.Renderer.Render(w, r, http.StatusNoContent, nil)
+		return
+	}
+
+	user := repositories.GetUserFromContext(r.Context())
+	if status != enums.Ok || !api.Controller.CanManageUserRole(r.Context(), user, userRole.RoleId, enums.UserRolesDelete) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserRoleResource, OwnerID: userRole.UserId}); !ok {
+		return
+	}
+
+	status, _ = api.Controller.DeleteUserRole(r.Context(), id)
 
 	switch status {
 	case enums.Ok:
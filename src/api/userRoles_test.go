@@ -0,0 +1,59 @@
+package api
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"hive/auth"
+	"hive/auth/backends"
+	"hive/config"
+	"hive/controllers"
+	"hive/enums"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDeleteUserRoleV1WithDeniedPolicy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := controllers.NewMockIController(ctrl)
+	policyEngine := policies.NewMockIPolicyEngine(ctrl)
+	api := InitAPI(controller, auth.NewMockIAuthenticationController(ctrl), policyEngine, config.InitEnvironment())
+	userRole := &models.UserRole{Id: uuid.NewV4(), UserId: uuid.NewV4(), RoleId: uuid.NewV4()}
+	user := &backends.BasicAuthenticationBackendUser{UserID: uuid.NewV4()}
+
+	request := httptest.NewRequest(http.MethodDelete, "/", bytes.NewReader([]byte{}))
+	request.Header.Add("Content-Type", "application/octet-stream")
+	request = mux.SetURLVars(request, map[string]string{"id": userRole.Id.String()})
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), user))
+	ctx := request.Context()
+
+	controller.
+		EXPECT().
+		GetUserRole(ctx, userRole.Id).
+		Return(enums.Ok, userRole).
+		Times(1)
+
+	controller.
+		EXPECT().
+		CanManageUserRole(ctx, user, userRole.RoleId, enums.UserRolesDelete).
+		Return(true).
+		Times(1)
+
+	policyEngine.
+		EXPECT().
+		Evaluate(ctx, &policies.Request{User: user, Resource: policies.Resource{Type: enums.UserRoleResource, OwnerID: userRole.UserId}}).
+		Return(&policies.Decision{}).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.DeleteUserRoleV1(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
@@ -1,39 +1,240 @@
 package api
 
 import (
+	"hive/enums"
 	"hive/extractors"
 	"hive/functools"
 	"hive/inout"
+	"hive/models"
+	"hive/policies"
 	"hive/repositories"
+	"fmt"
+	uuid "github.com/satori/go.uuid"
 	"net/http"
+	"sort"
+	"strings"
+	"unicode/utf8"
 )
 
-func (api *API) getUsersViewV1Query(r *http.Request) repositories.GetUsersViewStoreQuery {
+// hasFullUserViewsAccess checks whether user could see complete views of other users
+func hasFullUserViewsAccess(user models.IAuthenticationBackendUser) bool {
+	return functools.HasPermission(user.GetPermissions(), enums.UserViewsRead)
+}
+
+// isUserViewRedacted checks whether view is shown to user without contacts, roles, permissions and profile
+func isUserViewRedacted(user models.IAuthenticationBackendUser, id uuid.UUID) bool {
+	return !hasFullUserViewsAccess(user) && !uuid.Equal(user.GetUserID(), id)
+}
+
+// getUsersViewV1Query restricts users without full access to their own view, unless views of others are redacted.
+// Filters by redacted fields are allowed only within own view, so they can't reveal data of others
+func (api *API) getUsersViewV1Query(r *http.Request, user models.IAuthenticationBackendUser) repositories.GetUsersViewStoreQuery {
 	query := r.URL.Query()
 	pagination := functools.GetPagination(query, api.environment)
-	return repositories.GetUsersViewStoreQuery{
-		Limit:  pagination.Limit,
-		Page:   pagination.Page,
-		Id:     functools.StringsSliceToUUIDSlice(query["id"]),
-		Roles:  functools.StringsSliceToUUIDSlice(query["roles"]),
-		Phones: query["phones"],
-		Emails: query["emails"],
+	viewQuery := repositories.GetUsersViewStoreQuery{
+		Limit:   pagination.Limit,
+		Page:    pagination.Page,
+		Id:      functools.StringsSliceToUUIDSlice(query["id"]),
+		Roles:   functools.StringsSliceToUUIDSlice(query["roles"]),
+		Phones:  query["phones"],
+		Emails:  query["emails"],
+		Profile: api.getProfileFilter(query),
+	}
+
+	if hasFullUserViewsAccess(user) {
+		return viewQuery
+	}
+
+	filtersRedacted := len(viewQuery.Roles) > 0 || len(viewQuery.Phones) > 0 || len(viewQuery.Emails) > 0 || len(viewQuery.Profile) > 0
+	if !api.environment.UsersViewRedacted || filtersRedacted {
+		viewQuery.Id = []uuid.UUID{user.GetUserID()}
+	}
+
+	return viewQuery
+}
+
+func userViewOrganizationsToProto(organizations []*models.UserViewOrganization) []*inout.UserViewOrganization {
+	data := make([]*inout.UserViewOrganization, len(organizations))
+
+	for i, organization := range organizations {
+		data[i] = &inout.UserViewOrganization{
+			Id:          organization.Id.Bytes(),
+			Roles:       organization.Roles,
+			Permissions: organization.Permissions,
+		}
+	}
+
+	return data
+}
+
+// userViewToProto renders only fields of user view allowed by policy decision, redacted view keeps identity only
+func userViewToProto(userView *models.UserView, decision *policies.Decision, redacted bool) *inout.UserView {
+	data := &inout.UserView{
+		Id:      userView.Id.Bytes(),
+		Created: userView.Created,
+		Version: userView.Version,
+	}
+
+	if redacted {
+		return data
+	}
+
+	if decision.IsFieldAllowed("roles") {
+		data.Roles = userView.Roles
+	}
+
+	if decision.IsFieldAllowed("phones") {
+		data.Phones = userView.Phones
+		data.PrimaryPhone = userView.PrimaryPhone
+	}
+
+	if decision.IsFieldAllowed("emails") {
+		data.Emails = userView.Emails
+		data.PrimaryEmail = userView.PrimaryEmail
+	}
+
+	if decision.IsFieldAllowed("permissions") {
+		data.Permissions = userView.Permissions
+	}
+
+	if decision.IsFieldAllowed("organizations") {
+		data.Organizations = userViewOrganizationsToProto(userView.Organizations)
+	}
+
+	if decision.IsFieldAllowed("profile") {
+		data.Profile = functools.MapToStruct(userView.Profile)
+	}
+
+	if decision.IsFieldAllowed("status") {
+		data.Status = userView.Status
+		data.SuspendedUntil = userView.SuspendedUntil
+		data.DeletionScheduled = userView.DeletionScheduled
+	}
+
+	return data
+}
+
+// highlightUserView points to occurrences of every term of search query in fields of view allowed by policy decision
+func highlightUserView(userView *models.UserView, decision *policies.Decision, query string) []*inout.UserViewHighlight {
+	terms := strings.Fields(strings.ToLower(query))
+	highlights := make([]*inout.UserViewHighlight, 0)
+
+	highlight := func(field string, value string) {
+		lowered := strings.ToLower(value)
+		for _, term := range terms {
+			i := strings.Index(lowered, term)
+			if i < 0 {
+				continue
+			}
+
+			highlights = append(highlights, &inout.UserViewHighlight{
+				Field:  field,
+				Value:  value,
+				Start:  int32(utf8.RuneCountInString(lowered[:i])),
+				Length: int32(utf8.RuneCountInString(term)),
+			})
+		}
+	}
+
+	if decision.IsFieldAllowed("emails") {
+		for _, email := range userView.Emails {
+			highlight("emails", email)
+		}
+	}
+
+	if decision.IsFieldAllowed("phones") {
+		for _, phone := range userView.Phones {
+			highlight("phones", phone)
+		}
+	}
+
+	if decision.IsFieldAllowed("profile") {
+		attributes := make([]string, 0, len(userView.Profile))
+		for attribute := range userView.Profile {
+			attributes = append(attributes, attribute)
+		}
+
+		sort.Strings(attributes)
+
+		for _, attribute := range attributes {
+			switch value := userView.Profile[attribute].(type) {
+			case string, float64, bool:
+				highlight("profile."+attribute, fmt.Sprint(value))
+			}
+		}
+	}
+
+	return highlights
+}
+
+// SearchUsersViewV1 finds users by fragments of emails, phones and profile attributes, it is intended for
+// support tools so only users with full access to views could search
+func (api *API) SearchUsersViewV1(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+	ownerID, ok := api.getPolicyOwner(r, enums.UserViewResource)
+	if !ok {
+		api.Renderer.Render(w, r, http.StatusOK, &inout.SearchUsersViewResponseV1{Data: []*inout.FoundUserView{}, Pagination: &inout.Pagination{}})
+		return
+	}
+
+	id, _ := restrictToPolicyOwner(nil, ownerID)
+	status, users, pagination := api.Controller.SearchUsersView(r.Context(), repositories.SearchUsersViewQuery{
+		Query:      query.Get("q"),
+		Id:         id,
+		Pagination: functools.GetPagination(query, api.environment),
+	})
+
+	switch status {
+	case enums.Ok:
+		found := make([]*inout.FoundUserView, 0, len(users))
+
+		for _, u := range users {
+			decision := api.evaluatePolicy(r, policies.Resource{Type: enums.UserViewResource, OwnerID: u.Id})
+			if decision.Allowed {
+				found = append(found, &inout.FoundUserView{
+					Data:       userViewToProto(u, decision, false),
+					Highlights: highlightUserView(u, decision, query.Get("q")),
+				})
+			}
+		}
+
+		api.Renderer.Render(w, r, http.StatusOK, &inout.SearchUsersViewResponseV1{Data: found, Pagination: &inout.Pagination{
+			HasPrevious: pagination.HasPrevious,
+			HasNext:     pagination.HasNext,
+			Count:       pagination.Count,
+		}})
+	case enums.IncorrectSearchQuery:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.SearchUsersViewResponseV1{
+			ValidationError: &inout.SearchUsersViewResponseV1_ValidationError{
+				Query: []string{"Поисковый запрос должен содержать не менее трёх символов"},
+			}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
 	}
 }
 
 func (api *API) GetUsersViewV1(w http.ResponseWriter, r *http.Request) {
-	query := api.getUsersViewV1Query(r)
+	user := repositories.GetUserFromContext(r.Context())
+	query := api.getUsersViewV1Query(r, user)
+
+	ownerID, ok := api.getPolicyOwner(r, enums.UserViewResource)
+	if ok {
+		query.Id, ok = restrictToPolicyOwner(query.Id, ownerID)
+	}
+
+	if !ok {
+		api.Renderer.Render(w, r, http.StatusOK, &inout.ListUserViewResponseV1{Data: []*inout.UserView{}, Pagination: &inout.Pagination{}})
+		return
+	}
+
 	users, pagination := api.Controller.GetUserViews(r.Context(), query)
 
-	userViews := make([]*inout.UserView, len(users))
+	userViews := make([]*inout.UserView, 0, len(users))
 
-	for i, u := range users {
-		userViews[i] = &inout.UserView{
-			Id:      u.Id.Bytes(),
-			Created: u.Created,
-			Roles:   u.Roles,
-			Phones:  u.Phones,
-			Emails:  u.Emails,
+	for _, u := range users {
+		decision := api.evaluatePolicy(r, policies.Resource{Type: enums.UserViewResource, OwnerID: u.Id})
+		if decision.Allowed {
+			userViews = append(userViews, userViewToProto(u, decision, isUserViewRedacted(user, u.Id)))
 		}
 	}
 
@@ -47,18 +248,25 @@ func (api *API) GetUsersViewV1(w http.ResponseWriter, r *http.Request) {
 func (api *API) GetUserViewV1(w http.ResponseWriter, r *http.Request) {
 
 	id, _ := extractors.GetUUID(r)
+	user := repositories.GetUserFromContext(r.Context())
+	redacted := isUserViewRedacted(user, id)
+
+	if redacted && !api.environment.UsersViewRedacted {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	decision, ok := api.authorize(w, r, policies.Resource{Type: enums.UserViewResource, OwnerID: id})
+	if !ok {
+		return
+	}
+
 	userView := api.Controller.GetUserView(r.Context(), id)
 
 	if userView == nil {
 		api.Renderer.Render(w, r, http.StatusNotFound, nil)
 	} else {
 		api.Renderer.Render(w, r, http.StatusOK, &inout.GetUserViewResponseV1{
-			Data: &inout.UserView{
-				Id:      userView.Id.Bytes(),
-				Created: userView.Created,
-				Roles:   userView.Roles,
-				Phones:  userView.Phones,
-				Emails:  userView.Emails,
-			}})
+			Data: userViewToProto(userView, decision, redacted)})
 	}
 }
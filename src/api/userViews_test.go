@@ -0,0 +1,277 @@
+package api
+
+import (
+	"bytes"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"hive/auth"
+	"hive/auth/backends"
+	"hive/config"
+	"hive/controllers"
+	"hive/enums"
+	"hive/inout"
+	"hive/models"
+	"hive/policies"
+	"hive/repositories"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetUserViewV1WithDeniedFields(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := controllers.NewMockIController(ctrl)
+	policyEngine := policies.NewMockIPolicyEngine(ctrl)
+	api := InitAPI(controller, auth.NewMockIAuthenticationController(ctrl), policyEngine, config.InitEnvironment())
+	id := uuid.NewV4()
+
+	request := httptest.NewRequest(http.MethodGet, "/", bytes.NewReader([]byte{}))
+	request.Header.Add("Content-Type", "application/octet-stream")
+	request = mux.SetURLVars(request, map[string]string{"id": id.String()})
+	user := &backends.BasicAuthenticationBackendUser{UserID: id}
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), user))
+	ctx := request.Context()
+
+	policyEngine.
+		EXPECT().
+		Evaluate(ctx, &policies.Request{User: user, Resource: policies.Resource{Type: enums.UserViewResource, OwnerID: id}}).
+		Return(&policies.Decision{Allowed: true, DeniedFields: []string{"phones"}}).
+		Times(1)
+
+	controller.
+		EXPECT().
+		GetUserView(ctx, id).
+		Return(&models.UserView{Id: id, Phones: []string{"+79999999999"}, Emails: []string{"mail@mail.com"}}).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.GetUserViewV1(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+	responseBody, _ := ioutil.ReadAll(recorder.Result().Body)
+	var message inout.GetUserViewResponseV1
+	_ = proto.Unmarshal(responseBody, &message)
+	userView := message.GetData()
+	require.NotNil(t, userView)
+	require.Empty(t, userView.Phones)
+	require.Equal(t, []string{"mail@mail.com"}, userView.Emails)
+}
+
+func TestGetUserViewV1WithDeniedAccess(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	policyEngine := policies.NewMockIPolicyEngine(ctrl)
+	api := InitAPI(controllers.NewMockIController(ctrl), auth.NewMockIAuthenticationController(ctrl), policyEngine, config.InitEnvironment())
+
+	request := httptest.NewRequest(http.MethodGet, "/", bytes.NewReader([]byte{}))
+	request.Header.Add("Content-Type", "application/octet-stream")
+	request = mux.SetURLVars(request, map[string]string{"id": uuid.NewV4().String()})
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), &backends.BasicAuthenticationBackendUser{
+		UserID:      uuid.NewV4(),
+		Permissions: []string{enums.UserViewsRead},
+	}))
+
+	policyEngine.
+		EXPECT().
+		Evaluate(gomock.Any(), gomock.Any()).
+		Return(&policies.Decision{Allowed: false}).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.GetUserViewV1(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
+
+func TestGetUserViewV1OfAnotherUser(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	api := InitAPI(controllers.NewMockIController(ctrl), auth.NewMockIAuthenticationController(ctrl), policies.NewMockIPolicyEngine(ctrl), config.InitEnvironment())
+
+	request := httptest.NewRequest(http.MethodGet, "/", bytes.NewReader([]byte{}))
+	request.Header.Add("Content-Type", "application/octet-stream")
+	request = mux.SetURLVars(request, map[string]string{"id": uuid.NewV4().String()})
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), &backends.BasicAuthenticationBackendUser{
+		UserID:      uuid.NewV4(),
+		Permissions: []string{enums.UsersRead},
+	}))
+
+	recorder := httptest.NewRecorder()
+	api.GetUserViewV1(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
+
+func TestGetUserViewV1Redacted(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := controllers.NewMockIController(ctrl)
+	policyEngine := policies.NewMockIPolicyEngine(ctrl)
+	environment := config.InitEnvironment()
+	environment.UsersViewRedacted = true
+	api := InitAPI(controller, auth.NewMockIAuthenticationController(ctrl), policyEngine, environment)
+	id := uuid.NewV4()
+
+	request := httptest.NewRequest(http.MethodGet, "/", bytes.NewReader([]byte{}))
+	request.Header.Add("Content-Type", "application/octet-stream")
+	request = mux.SetURLVars(request, map[string]string{"id": id.String()})
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), &backends.BasicAuthenticationBackendUser{UserID: uuid.NewV4()}))
+
+	policyEngine.
+		EXPECT().
+		Evaluate(gomock.Any(), gomock.Any()).
+		Return(&policies.Decision{Allowed: true}).
+		Times(1)
+
+	controller.
+		EXPECT().
+		GetUserView(gomock.Any(), id).
+		Return(&models.UserView{Id: id, Roles: []string{"admin"}, Emails: []string{"mail@mail.com"}}).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.GetUserViewV1(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+	responseBody, _ := ioutil.ReadAll(recorder.Result().Body)
+	var message inout.GetUserViewResponseV1
+	_ = proto.Unmarshal(responseBody, &message)
+	userView := message.GetData()
+	require.NotNil(t, userView)
+	require.Equal(t, id.Bytes(), userView.Id)
+	require.Empty(t, userView.Roles)
+	require.Empty(t, userView.Emails)
+}
+
+func TestGetUsersViewV1Query(t *testing.T) {
+	t.Parallel()
+	environment := config.InitEnvironment()
+	api := InitAPI(nil, nil, nil, environment)
+	userID := uuid.NewV4()
+	requestedID := uuid.NewV4()
+	user := &backends.BasicAuthenticationBackendUser{UserID: userID}
+
+	request := httptest.NewRequest(http.MethodGet, "/?id="+requestedID.String(), nil)
+	require.Equal(t, []uuid.UUID{userID}, api.getUsersViewV1Query(request, user).Id)
+
+	environment.UsersViewRedacted = true
+	require.Equal(t, []uuid.UUID{requestedID}, api.getUsersViewV1Query(request, user).Id)
+
+	request = httptest.NewRequest(http.MethodGet, "/?emails=mail@mail.com", nil)
+	require.Equal(t, []uuid.UUID{userID}, api.getUsersViewV1Query(request, user).Id)
+
+	user.Permissions = []string{enums.UserViewsRead}
+	require.Empty(t, api.getUsersViewV1Query(request, user).Id)
+}
+
+func TestGetUsersViewV1QueryWithProfileFilter(t *testing.T) {
+	t.Parallel()
+	environment := config.InitEnvironment()
+	environment.UsersViewRedacted = true
+	environment.ProfileFilterAttributes = []string{"locale"}
+	api := InitAPI(nil, nil, nil, environment)
+	userID := uuid.NewV4()
+	user := &backends.BasicAuthenticationBackendUser{UserID: userID}
+
+	request := httptest.NewRequest(http.MethodGet, "/?profile.locale=ru&profile.name=Ivan", nil)
+	query := api.getUsersViewV1Query(request, user)
+	require.Equal(t, map[string]string{"locale": "ru"}, query.Profile)
+	require.Equal(t, []uuid.UUID{userID}, query.Id)
+
+	user.Permissions = []string{enums.UserViewsRead}
+	require.Empty(t, api.getUsersViewV1Query(request, user).Id)
+}
+
+func TestHighlightUserView(t *testing.T) {
+	t.Parallel()
+	userView := &models.UserView{
+		Id:      uuid.NewV4(),
+		Emails:  []string{"ivan.petrov@mail.com", "support@mail.com"},
+		Phones:  []string{"+71234567890"},
+		Profile: map[string]interface{}{"name": "Ivan Petrov", "age": float64(30)},
+	}
+
+	highlights := highlightUserView(userView, &policies.Decision{Allowed: true}, "Ivan")
+	require.Len(t, highlights, 2)
+	require.Equal(t, "emails", highlights[0].Field)
+	require.Equal(t, int32(0), highlights[0].Start)
+	require.Equal(t, int32(4), highlights[0].Length)
+	require.Equal(t, "profile.name", highlights[1].Field)
+
+	highlights = highlightUserView(userView, &policies.Decision{Allowed: true, DeniedFields: []string{"profile"}}, "petrov 4567")
+	require.Len(t, highlights, 2)
+	require.Equal(t, "emails", highlights[0].Field)
+	require.Equal(t, int32(5), highlights[0].Start)
+	require.Equal(t, "phones", highlights[1].Field)
+	require.Equal(t, int32(5), highlights[1].Start)
+}
+
+func TestGetUsersViewV1WithOwnerPolicy(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := controllers.NewMockIController(ctrl)
+	policyEngine := policies.NewMockIPolicyEngine(ctrl)
+	api := InitAPI(controller, auth.NewMockIAuthenticationController(ctrl), policyEngine, config.InitEnvironment())
+	userID := uuid.NewV4()
+
+	request := httptest.NewRequest(http.MethodGet, "/", nil)
+	request.Header.Add("Content-Type", "application/octet-stream")
+	user := &backends.BasicAuthenticationBackendUser{UserID: userID, Permissions: []string{enums.UserViewsRead}}
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), user))
+
+	policyEngine.
+		EXPECT().
+		Evaluate(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, policyRequest *policies.Request) *policies.Decision {
+			return &policies.Decision{Allowed: uuid.Equal(policyRequest.Resource.OwnerID, userID)}
+		}).
+		AnyTimes()
+
+	controller.
+		EXPECT().
+		GetUserViews(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ interface{}, query repositories.GetUsersViewStoreQuery) ([]*models.UserView, *models.PaginationResponse) {
+			require.Equal(t, []uuid.UUID{userID}, query.Id)
+			return []*models.UserView{{Id: userID}}, &models.PaginationResponse{Count: 1}
+		}).
+		Times(1)
+
+	recorder := httptest.NewRecorder()
+	api.GetUsersViewV1(recorder, request)
+	require.Equal(t, http.StatusOK, recorder.Result().StatusCode)
+
+	responseBody, _ := ioutil.ReadAll(recorder.Result().Body)
+	var message inout.ListUserViewResponseV1
+	require.NoError(t, proto.Unmarshal(responseBody, &message))
+	require.Len(t, message.Data, 1)
+	require.Equal(t, int64(1), message.Pagination.Count)
+}
+
+func TestRestrictToPolicyOwner(t *testing.T) {
+	t.Parallel()
+	ownerID, anotherID := uuid.NewV4(), uuid.NewV4()
+
+	for _, testCase := range []struct {
+		ownersID []uuid.UUID
+		ownerID  uuid.UUID
+		result   []uuid.UUID
+		ok       bool
+	}{
+		{[]uuid.UUID{anotherID}, uuid.Nil, []uuid.UUID{anotherID}, true},
+		{nil, ownerID, []uuid.UUID{ownerID}, true},
+		{[]uuid.UUID{anotherID, ownerID}, ownerID, []uuid.UUID{ownerID}, true},
+		{[]uuid.UUID{anotherID}, ownerID, nil, false},
+	} {
+		result, ok := restrictToPolicyOwner(testCase.ownersID, testCase.ownerID)
+		require.Equal(t, testCase.result, result)
+		require.Equal(t, testCase.ok, ok)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"hive/functools"
 	"hive/inout"
 	"hive/models"
+	"hive/policies"
 	"hive/repositories"
 	"github.com/getsentry/sentry-go"
 	uuid "github.com/satori/go.uuid"
@@ -13,6 +14,19 @@ import (
 	"net/url"
 )
 
+func userToProto(user *models.User) *inout.User {
+	return &inout.User{
+		Id:                user.Id.Bytes(),
+		Created:           user.Created,
+		Status:            user.Status,
+		SuspendedUntil:    user.SuspendedUntil,
+		StatusReason:      user.StatusReason,
+		StatusActorID:     user.StatusActorId.Bytes(),
+		StatusChanged:     user.StatusChanged,
+		DeletionScheduled: user.DeletionScheduled,
+	}
+}
+
 func (api *API) CreateUserV1(w http.ResponseWriter, r *http.Request) {
 
 	body := &inout.CreateUserResponseV1_Request{}
@@ -27,10 +41,8 @@ func (api *API) CreateUserV1(w http.ResponseWriter, r *http.Request) {
 	case enums.Ok:
 		api.Renderer.Render(w, r, http.StatusCreated, &inout.CreateUserResponseV1{
 			Data: &inout.CreateUserResponseV1_Ok{
-				Ok: &inout.User{
-					Id:      user.Id.Bytes(),
-					Created: user.Created,
-				}}})
+				Ok: userToProto(user),
+			}})
 	case enums.MinimumOneFieldRequired:
 		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateUserResponseV1{
 			Data: &inout.CreateUserResponseV1_ValidationError_{
@@ -94,6 +106,21 @@ func (api *API) CreateUserV1(w http.ResponseWriter, r *http.Request) {
 				ValidationError: &inout.CreateUserResponseV1_ValidationError{
 					Email: []string{"Некорректный email"},
 				}}})
+
+	// Contact transfers
+
+	case enums.LastLoginIdentifier:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateUserResponseV1{
+			Data: &inout.CreateUserResponseV1_ValidationError_{
+				ValidationError: &inout.CreateUserResponseV1_ValidationError{
+					Errors: []string{"Email или телефон является единственным способом входа другого пользователя"},
+				}}})
+	case enums.ContactOwnedByAnotherUser:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.CreateUserResponseV1{
+			Data: &inout.CreateUserResponseV1_ValidationError_{
+				ValidationError: &inout.CreateUserResponseV1_ValidationError{
+					Errors: []string{"Email или телефон принадлежит другому пользователю, добавьте его после регистрации"},
+				}}})
 	default:
 		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
 	}
@@ -103,7 +130,7 @@ func (api *API) GetUsersV1Query(query url.Values, user models.IAuthenticationBac
 	pagination := functools.GetPagination(query, api.environment)
 
 	var requestedUserIdentifiers []uuid.UUID
-	if user.GetIsAdmin() {
+	if functools.HasPermission(user.GetPermissions(), enums.UsersRead) {
 		requestedUserIdentifiers = functools.StringsSliceToUUIDSlice(query["id"])
 	} else {
 		requestedUserIdentifiers = []uuid.UUID{user.GetUserID()}
@@ -121,13 +148,14 @@ func (api *API) GetUsersV1(w http.ResponseWriter, r *http.Request) {
 	user := repositories.GetUserFromContext(r.Context())
 	query := api.GetUsersV1Query(r.URL.Query(), user)
 	users := api.Controller.GetUsers(r.Context(), query)
-	usersData := make([]*inout.User, len(users))
+	usersData := make([]*inout.User, 0, len(users))
 
-	for i, user := range users {
-		usersData[i] = &inout.User{
-			Id:      user.Id.Bytes(),
-			Created: user.Created,
+	for _, user := range users {
+		if !api.evaluatePolicy(r, policies.Resource{Type: enums.UserResource, OwnerID: user.Id}).Allowed {
+			continue
 		}
+
+		usersData = append(usersData, userToProto(user))
 	}
 
 	api.Renderer.Render(w, r, http.StatusOK, &inout.ListUserResponseV1{Data: usersData})
@@ -142,6 +170,10 @@ func (api *API) GetUserV1(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserResource, OwnerID: id}); !ok {
+		return
+	}
+
 	user := api.Controller.GetUser(r.Context(), id)
 
 	if user == nil {
@@ -149,12 +181,7 @@ func (api *API) GetUserV1(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	api.Renderer.Render(w, r, http.StatusOK, &inout.GetUserResponseV1{
-		Data: &inout.User{
-			Id:      user.Id.Bytes(),
-			Created: user.Created,
-		}},
-	)
+	api.Renderer.Render(w, r, http.StatusOK, &inout.GetUserResponseV1{Data: userToProto(user)})
 }
 
 func (api *API) DeleteUserV1(w http.ResponseWriter, r *http.Request) {
@@ -170,14 +197,124 @@ func (api *API) DeleteUserV1(w http.ResponseWriter, r *http.Request) {
 
 	switch status {
 	case enums.Ok:
-		api.Renderer.Render(w, r, http.StatusOK, &inout.GetUserResponseV1{
-			Data: &inout.User{
-				Id:      deletedUser.Id.Bytes(),
-				Created: deletedUser.Created,
-			},
+		api.Renderer.Render(w, r, http.StatusOK, &inout.GetUserResponseV1{Data: userToProto(deletedUser)})
+	case enums.UserNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+func (api *API) UpdateUserStatusV1(w http.ResponseWriter, r *http.Request) {
+
+	body := &inout.UpdateUserStatusResponseV1_Request{}
+	err := api.Parser.Parse(r, w, body)
+	if err != nil {
+		return
+	}
+
+	id, err := extractors.GetUUID(r)
+	if err != nil {
+		sentry.CaptureException(err)
+		api.Renderer.Render(w, r, http.StatusBadRequest, nil)
+		return
+	}
+
+	// Users can't lock themselves out
+	actor := repositories.GetUserFromContext(r.Context())
+	if uuid.Equal(actor.GetUserID(), id) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserResource, OwnerID: id}); !ok {
+		return
+	}
+
+	status, user := api.Controller.UpdateUserStatus(r.Context(), id, actor.GetUserID(), body.Status, body.SuspendedUntil, body.Reason)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.UpdateUserStatusResponseV1{
+			Data: &inout.UpdateUserStatusResponseV1_Ok{Ok: userToProto(user)},
 		})
 	case enums.UserNotFound:
 		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	case enums.IncorrectUserStatus:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateUserStatusResponseV1{
+			Data: &inout.UpdateUserStatusResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateUserStatusResponseV1_ValidationError{
+					Status: []string{"Некорректный статус пользователя"},
+				}}})
+	case enums.IncorrectSuspendedUntil:
+		api.Renderer.Render(w, r, http.StatusBadRequest, &inout.UpdateUserStatusResponseV1{
+			Data: &inout.UpdateUserStatusResponseV1_ValidationError_{
+				ValidationError: &inout.UpdateUserStatusResponseV1_ValidationError{
+					SuspendedUntil: []string{"Окончание блокировки должно быть в будущем"},
+				}}})
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+// RequestUserDeletionV1 schedules deletion of own account, the user is anonymized after grace period
+// unless deletion is cancelled or the user logs in again
+func (api *API) RequestUserDeletionV1(w http.ResponseWriter, r *http.Request) {
+
+	id, err := extractors.GetUUID(r)
+	if err != nil {
+		sentry.CaptureException(err)
+		api.Renderer.Render(w, r, http.StatusBadRequest, nil)
+		return
+	}
+
+	if !uuid.Equal(repositories.GetUserFromContext(r.Context()).GetUserID(), id) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserResource, OwnerID: id}); !ok {
+		return
+	}
+
+	status, user := api.Controller.RequestUserDeletion(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusAccepted, &inout.RequestUserDeletionResponseV1{Data: userToProto(user)})
+	case enums.UserNotFound:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
+	default:
+		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
+	}
+}
+
+// CancelUserDeletionV1 cancels deletion requested by user, users allowed to delete anyone could cancel it too
+func (api *API) CancelUserDeletionV1(w http.ResponseWriter, r *http.Request) {
+
+	id, err := extractors.GetUUID(r)
+	if err != nil {
+		sentry.CaptureException(err)
+		api.Renderer.Render(w, r, http.StatusBadRequest, nil)
+		return
+	}
+
+	if !canAccessProfile(repositories.GetUserFromContext(r.Context()), id, enums.UsersDelete) {
+		api.Renderer.Render(w, r, http.StatusForbidden, nil)
+		return
+	}
+
+	if _, ok := api.authorize(w, r, policies.Resource{Type: enums.UserResource, OwnerID: id}); !ok {
+		return
+	}
+
+	status, user := api.Controller.CancelUserDeletion(r.Context(), id)
+
+	switch status {
+	case enums.Ok:
+		api.Renderer.Render(w, r, http.StatusOK, &inout.CancelUserDeletionResponseV1{Data: userToProto(user)})
+	case enums.UserDeletionNotScheduled:
+		api.Renderer.Render(w, r, http.StatusNotFound, nil)
 	default:
 		api.Renderer.Render(w, r, unhandledStatus(r, status), nil)
 	}
@@ -169,8 +169,8 @@ func TestGetUsersV1QueryForAdminUser(t *testing.T) {
 	}, api.API.GetUsersV1Query(map[string][]string{
 		"id": requestedIdentifiers,
 	}, &backends.BasicAuthenticationBackendUser{
-		IsAdmin: true,
-		UserID:  adminUserID,
+		Permissions: []string{enums.UsersRead},
+		UserID:      adminUserID,
 	}))
 	ctrl.Finish()
 }
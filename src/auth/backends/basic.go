@@ -13,12 +13,16 @@ import (
 	"hive/passwordProcessors"
 	"hive/stores"
 	"strings"
+	"time"
 )
 
 type BasicAuthenticationBackendUser struct {
-	IsAdmin bool
-	Roles   []string
-	UserID  uuid.UUID
+	IsAdmin     bool
+	Roles       []string
+	Permissions []string
+	UserID      uuid.UUID
+	Methods     []string
+	AuthTime    int64
 }
 
 func (user *BasicAuthenticationBackendUser) GetIsAdmin() bool {
@@ -29,10 +33,18 @@ func (user *BasicAuthenticationBackendUser) GetRoles() []string {
 	return user.Roles
 }
 
+func (user *BasicAuthenticationBackendUser) GetPermissions() []string {
+	return user.Permissions
+}
+
 func (user *BasicAuthenticationBackendUser) GetUserID() uuid.UUID {
 	return user.UserID
 }
 
+func (user *BasicAuthenticationBackendUser) GetAuthenticationContext() *models.AuthenticationContext {
+	return models.InitAuthenticationContext(user.Methods, user.AuthTime)
+}
+
 type BasicAuthenticationBackend struct {
 	store             stores.IStore
 	passwordProcessor passwordProcessors.IPasswordProcessor
@@ -173,28 +185,56 @@ func (backend *BasicAuthenticationBackend) getUserFromPhoneAndCode(ctx context.C
 	return enums.Ok, &phone.UserId
 }
 
-func (backend *BasicAuthenticationBackend) GetUserID(ctx context.Context, first, second string) (int, *uuid.UUID) {
+// GetUserID returns user and authentication method which was used
+func (backend *BasicAuthenticationBackend) GetUserID(ctx context.Context, first, second string) (int, *uuid.UUID, string) {
 	if functools.NormalizeEmail(first) != "" {
 		if len(second) == 6 {
 			status, user := backend.getUserFromEmailAndCode(ctx, first, second)
 			if status == enums.Ok && user != nil {
-				return status, user
+				return status, user, enums.OTPAuthenticationMethod
 			}
 		}
 
-		return backend.getUserFromEmailAndPassword(ctx, first, second)
+		status, user := backend.getUserFromEmailAndPassword(ctx, first, second)
+		return status, user, enums.PasswordAuthenticationMethod
 	} else {
 		if len(second) == 6 {
 			status, user := backend.getUserFromPhoneAndCode(ctx, first, second)
 			if status == enums.Ok && user != nil {
-				return status, user
+				return status, user, enums.SMSAuthenticationMethod
 			}
 		}
 
-		return backend.getUserFromPhoneAndPassword(ctx, first, second)
+		status, user := backend.getUserFromPhoneAndPassword(ctx, first, second)
+		return status, user, enums.PasswordAuthenticationMethod
 	}
 }
 
+// verifySecondFactor checks confirmation code sent to email or phone of the same user
+func (backend *BasicAuthenticationBackend) verifySecondFactor(ctx context.Context, userID uuid.UUID, first, code string) (int, string) {
+	var status int
+	var secondFactorUserID *uuid.UUID
+	var method string
+
+	if functools.NormalizeEmail(first) != "" {
+		status, secondFactorUserID = backend.getUserFromEmailAndCode(ctx, first, code)
+		method = enums.OTPAuthenticationMethod
+	} else {
+		status, secondFactorUserID = backend.getUserFromPhoneAndCode(ctx, first, code)
+		method = enums.SMSAuthenticationMethod
+	}
+
+	if status != enums.Ok {
+		return status, ""
+	}
+
+	if secondFactorUserID == nil || *secondFactorUserID != userID {
+		return enums.UserNotFound, ""
+	}
+
+	return enums.Ok, method
+}
+
 func (backend *BasicAuthenticationBackend) GetUser(ctx context.Context, token string) (int, models.IAuthenticationBackendUser) {
 	decodedTokenInBytes, err := base64.StdEncoding.DecodeString(token)
 	if err != nil {
@@ -208,19 +248,38 @@ func (backend *BasicAuthenticationBackend) GetUser(ctx context.Context, token st
 		return enums.IncorrectToken, nil
 	}
 
-	status, userID := backend.GetUserID(ctx, parts[0], parts[1])
+	status, userID, method := backend.GetUserID(ctx, parts[0], parts[1])
 	if status != enums.Ok {
 		return status, nil
 	}
 
+	methods := []string{method}
+
+	// Confirmation code can be passed as third part of credentials to authenticate with second factor
+	if len(parts) > 2 && parts[2] != "" && method == enums.PasswordAuthenticationMethod {
+		status, method = backend.verifySecondFactor(ctx, *userID, parts[0], parts[2])
+		if status != enums.Ok {
+			return status, nil
+		}
+
+		methods = append(methods, method)
+	}
+
 	user := backend.store.GetUserView(ctx, *userID)
 	if user == nil {
 		return enums.UserNotFound, nil
 	}
 
+	if !user.IsActive(time.Now().UnixNano() / int64(time.Millisecond)) {
+		return enums.UserInactive, nil
+	}
+
 	return enums.Ok, &BasicAuthenticationBackendUser{
-		IsAdmin: functools.Contains(config.AdminRole, user.Roles),
-		Roles:   user.Roles,
-		UserID:  user.Id,
+		IsAdmin:     functools.Contains(config.AdminRole, user.Roles),
+		Roles:       user.Roles,
+		Permissions: user.Permissions,
+		UserID:      user.Id,
+		Methods:     methods,
+		AuthTime:    time.Now().Unix(),
 	}
 }
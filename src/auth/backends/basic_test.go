@@ -642,3 +642,118 @@ func TestBasicAuthenticationBackend_GetUser(t *testing.T) {
 	require.False(t, loggedUser.GetIsAdmin())
 	require.Contains(t, loggedUser.GetRoles(), "hello")
 }
+
+func TestBasicAuthenticationBackend_GetBlockedUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := InitBasicAuthenticationWithMockedInternals(ctrl)
+
+	code := "123456"
+	phone := "+79234567890"
+	formattedPhone := functools.NormalizePhone(phone)
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s", phone, code)))
+
+	userID := uuid.NewV4()
+
+	backend.
+		Store.
+		EXPECT().
+		GetPhoneConfirmationCode(ctx, formattedPhone).
+		Times(1).
+		Return(code)
+
+	backend.
+		Store.
+		EXPECT().
+		GetPhone(ctx, formattedPhone).
+		Times(1).
+		Return(enums.Ok, &models.Phone{
+			Id:     uuid.NewV4(),
+			UserId: userID,
+			Value:  formattedPhone,
+		})
+
+	backend.
+		Store.
+		EXPECT().
+		GetUserView(ctx, userID).
+		Times(1).
+		Return(&models.UserView{Id: userID, Status: enums.UserBlocked})
+
+	status, loggedUser := backend.Backend.GetUser(ctx, token)
+	require.Equal(t, enums.UserInactive, status)
+	require.Nil(t, loggedUser)
+}
+
+// Second factor
+
+func TestCreateSessionFromEmailPasswordAndCode(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := InitBasicAuthenticationWithMockedInternals(ctrl)
+
+	password := "password"
+	encodedPassword := "321"
+	code := "123456"
+	email := "mail@mail.com"
+	userID := uuid.NewV4()
+
+	backend.
+		Store.
+		EXPECT().
+		GetEmail(ctx, email).
+		Times(2).
+		Return(enums.Ok, &models.Email{
+			Id:      uuid.NewV4(),
+			Created: 1,
+			UserId:  userID,
+			Value:   email,
+		})
+
+	backend.
+		Store.
+		EXPECT().
+		GetLatestPassword(ctx, userID).
+		Times(1).
+		Return(enums.Ok, &models.Password{
+			Id:      uuid.NewV4(),
+			Created: 1,
+			UserId:  userID,
+			Value:   encodedPassword,
+		})
+
+	backend.
+		PasswordProcessor.
+		EXPECT().
+		VerifyPassword(ctx, password, encodedPassword).
+		Times(1).
+		Return(true)
+
+	backend.
+		Store.
+		EXPECT().
+		GetEmailConfirmationCode(ctx, email).
+		Times(1).
+		Return(code)
+
+	backend.
+		Store.
+		EXPECT().
+		GetUserView(ctx, userID).
+		Times(1).
+		Return(&models.UserView{Id: userID})
+
+	token := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf("%s:%s:%s", email, password, code)))
+	status, user := backend.Backend.GetUser(ctx, token)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, userID, user.GetUserID())
+
+	authentication := user.(models.IAuthenticationContextUser).GetAuthenticationContext()
+	require.Equal(t, []string{enums.PasswordAuthenticationMethod, enums.OTPAuthenticationMethod}, authentication.Methods)
+	require.Equal(t, enums.MultiFactorAuthenticationLevel, authentication.Level)
+}
@@ -7,7 +7,11 @@ import (
 	"hive/models"
 	"hive/stores"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/dgrijalva/jwt-go"
 	"github.com/getsentry/sentry-go"
 	"github.com/golang/mock/gomock"
@@ -17,16 +21,69 @@ import (
 )
 
 type JWTAuthenticationBackend struct {
-	store       stores.IStore
-	environment *config.Environment
+	store         stores.IStore
+	environment   *config.Environment
+	claimsMapping *config.ClaimsMapping
+}
+
+var reservedClaims = []string{"userID", "roles", "permissions", "isAdmin", "secretID", "sid", "fgp", "cnf", "acr", "amr", "auth_time", "org", "exp", "nbf", "iat", "iss", "aud", "sub", "jti"}
+
+// userViewClaims returns values of user view fields which can be mapped to access token claims
+func userViewClaims(user *models.UserView) map[string]interface{} {
+	return map[string]interface{}{
+		"id":      user.Id,
+		"created": user.Created,
+		"roles":   user.Roles,
+		"rolesID": user.RolesID,
+		"emails":  user.Emails,
+		"phones":  user.Phones,
+		"profile": user.Profile,
+	}
+}
+
+func validateClaimsMappingRule(rule config.ClaimsMappingRule) {
+	fields := userViewClaims(&models.UserView{})
+
+	for claim, field := range rule.Claims {
+		if functools.Contains(claim, reservedClaims) {
+			panic(fmt.Sprintf("claim %s is reserved and can not be mapped", claim))
+		}
+
+		if _, ok := fields[field]; !ok && field != "" {
+			panic(fmt.Sprintf("user view field %s can not be mapped to claim %s", field, claim))
+		}
+	}
 }
 
 type JWTAuthenticationBackendUser struct {
 	jwt.StandardClaims
-	IsAdmin  bool      `json:"isAdmin"`
-	Roles    []string  `json:"roles"`
-	UserID   uuid.UUID `json:"userID"`
-	SecretID uuid.UUID `json:"secretID"`
+	IsAdmin bool     `json:"isAdmin"`
+	Roles   []string `json:"roles"`
+	// Permissions granted by roles, see enums/permissions.go
+	Permissions []string  `json:"permissions"`
+	UserID      uuid.UUID `json:"userID"`
+	SecretID    uuid.UUID `json:"secretID"`
+	// Public id of session, zero for tokens issued without session binding
+	SessionID       uuid.UUID `json:"sid"`
+	FingerprintHash string    `json:"fgp,omitempty"`
+	// Confirmation of DPoP key, see RFC 9449
+	Confirmation *JWTConfirmation `json:"cnf,omitempty"`
+	// Authentication context of session, see OpenID Connect Core
+	AuthenticationLevel   string   `json:"acr,omitempty"`
+	AuthenticationMethods []string `json:"amr,omitempty"`
+	AuthenticationTime    int64    `json:"auth_time,omitempty"`
+	// Active organization of session
+	Organization *JWTOrganization `json:"org,omitempty"`
+}
+
+type JWTConfirmation struct {
+	KeyThumbprint string `json:"jkt"`
+}
+
+type JWTOrganization struct {
+	Id          uuid.UUID `json:"id"`
+	Roles       []string  `json:"roles"`
+	Permissions []string  `json:"permissions"`
 }
 
 func (user JWTAuthenticationBackendUser) GetIsAdmin() bool {
@@ -37,31 +94,144 @@ func (user JWTAuthenticationBackendUser) GetRoles() []string {
 	return user.Roles
 }
 
+func (user JWTAuthenticationBackendUser) GetPermissions() []string {
+	return user.Permissions
+}
+
 func (user JWTAuthenticationBackendUser) GetUserID() uuid.UUID {
 	return user.UserID
 }
 
-func (backend JWTAuthenticationBackend) EncodeAccessToken(_ context.Context, userID uuid.UUID, roles []string, secret *models.Secret, expires int64) string {
+func (user JWTAuthenticationBackendUser) GetSessionID() uuid.UUID {
+	return user.SessionID
+}
+
+func (user JWTAuthenticationBackendUser) GetFingerprintHash() string {
+	return user.FingerprintHash
+}
+
+func (user JWTAuthenticationBackendUser) GetAuthenticationContext() *models.AuthenticationContext {
+	return models.InitAuthenticationContext(user.AuthenticationMethods, user.AuthenticationTime)
+}
+
+func (user JWTAuthenticationBackendUser) GetOrganizationID() uuid.UUID {
+	if user.Organization == nil {
+		return uuid.Nil
+	}
+
+	return user.Organization.Id
+}
+
+func (user JWTAuthenticationBackendUser) GetOrganizationPermissions() []string {
+	if user.Organization == nil {
+		return nil
+	}
+
+	return user.Organization.Permissions
+}
+
+func (user JWTAuthenticationBackendUser) GetKeyThumbprint() string {
+	if user.Confirmation == nil {
+		return ""
+	}
+
+	return user.Confirmation.KeyThumbprint
+}
+
+// HashFingerprint is used to put fingerprint into access token without disclosing it
+func HashFingerprint(fingerprint string) string {
+	if fingerprint == "" {
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(fingerprint))
+	return hex.EncodeToString(hash[:])
+}
+
+func (backend JWTAuthenticationBackend) signAccessToken(claims jwt.Claims, secret *models.Secret) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	ss, err := token.SignedString(secret.Value.Bytes())
+	if err != nil {
+		sentry.CaptureException(err)
+		return ""
+	}
+
+	return ss
+}
+
+func (backend JWTAuthenticationBackend) EncodeAccessToken(_ context.Context, user *models.UserView, session *models.Session, clientID string, secret *models.Secret) string {
+	rule := backend.claimsMapping.GetRule(clientID)
+
+	// Token must not outlive temporary roles it carries
+	expiresAt := session.Expires
+	if rolesExpires := user.RolesExpires / 1000; rolesExpires > 0 && rolesExpires < expiresAt {
+		expiresAt = rolesExpires
+	}
 
 	claims := JWTAuthenticationBackendUser{
-		UserID:   userID,
-		Roles:    roles,
-		IsAdmin:  functools.Contains(config.AdminRole, roles),
-		SecretID: secret.Id,
+		UserID:          user.Id,
+		Roles:           user.Roles,
+		Permissions:     user.Permissions,
+		IsAdmin:         functools.Contains(config.AdminRole, user.Roles),
+		SecretID:        secret.Id,
+		SessionID:       session.PublicID,
+		FingerprintHash: HashFingerprint(session.Fingerprint),
 		StandardClaims: jwt.StandardClaims{
-			ExpiresAt: expires,
+			ExpiresAt: expiresAt,
 			NotBefore: time.Now().Unix(),
+			Issuer:    rule.Issuer,
+			Audience:  rule.Audience,
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	ss, err := token.SignedString(secret.Value.Bytes())
+	if session.AuthTime > 0 {
+		authentication := models.InitAuthenticationContext(session.AuthMethods, session.AuthTime)
+		claims.AuthenticationLevel = authentication.Level
+		claims.AuthenticationMethods = authentication.Methods
+		claims.AuthenticationTime = authentication.Time
+	}
+
+	if session.KeyThumbprint != "" {
+		claims.Confirmation = &JWTConfirmation{KeyThumbprint: session.KeyThumbprint}
+	}
+
+	if organization := user.GetOrganization(session.OrganizationID); session.OrganizationID != uuid.Nil && organization != nil {
+		claims.Organization = &JWTOrganization{
+			Id:          organization.Id,
+			Roles:       organization.Roles,
+			Permissions: organization.Permissions,
+		}
+	}
+
+	if len(rule.Claims) == 0 {
+		return backend.signAccessToken(claims, secret)
+	}
+
+	payload, err := json.Marshal(claims)
 	if err != nil {
 		sentry.CaptureException(err)
 		return ""
 	}
 
-	return ss
+	customClaims := jwt.MapClaims{}
+	err = json.Unmarshal(payload, &customClaims)
+	if err != nil {
+		sentry.CaptureException(err)
+		return ""
+	}
+
+	fields := userViewClaims(user)
+	for claim, field := range rule.Claims {
+		customClaims[claim] = fields[field]
+	}
+
+	ss := backend.signAccessToken(customClaims, secret)
+	if len(ss) <= backend.environment.AccessTokenMaxSize {
+		return ss
+	}
+
+	sentry.CaptureException(errors.New(fmt.Sprintf("access token of user %s exceeds %d bytes, custom claims are dropped", user.Id, backend.environment.AccessTokenMaxSize)))
+	return backend.signAccessToken(claims, secret)
 }
 
 func (backend JWTAuthenticationBackend) DecodeAccessTokenWithoutValidation(_ context.Context, tokenValue string) (int, *JWTAuthenticationBackendUser) {
@@ -121,16 +291,40 @@ func (backend JWTAuthenticationBackend) GetUser(ctx context.Context, token strin
 		return enums.SecretNotFound, nil
 	}
 
+	if secret.Revoked > 0 {
+		return enums.SecretRevoked, nil
+	}
+
 	status, payload := backend.DecodeAccessToken(ctx, token, secret.Value)
 	if status != enums.Ok {
 		return status, nil
 	}
 
+	if payload.SessionID != uuid.Nil && !backend.store.GetSessionExistence(ctx, payload.SessionID) {
+		return enums.SessionNotFound, nil
+	}
+
+	// Access tokens of suspended and blocked users are rejected before they expire, the view is cached
+	// and refreshed on every change of status
+	user := backend.store.GetUserView(ctx, payload.UserID)
+	if user == nil {
+		return enums.UserNotFound, nil
+	}
+
+	if !user.IsActive(time.Now().UnixNano() / int64(time.Millisecond)) {
+		return enums.UserInactive, nil
+	}
+
 	return enums.Ok, payload
 }
 
-func InitJWTAuthenticationBackend(store stores.IStore, environment *config.Environment) *JWTAuthenticationBackend {
-	return &JWTAuthenticationBackend{store: store, environment: environment}
+func InitJWTAuthenticationBackend(store stores.IStore, environment *config.Environment, claimsMapping *config.ClaimsMapping) *JWTAuthenticationBackend {
+	validateClaimsMappingRule(claimsMapping.ClaimsMappingRule)
+	for _, rule := range claimsMapping.Clients {
+		validateClaimsMappingRule(rule)
+	}
+
+	return &JWTAuthenticationBackend{store: store, environment: environment, claimsMapping: claimsMapping}
 }
 
 type JWTAuthenticationBackendWithMockedInternals struct {
@@ -140,8 +334,9 @@ type JWTAuthenticationBackendWithMockedInternals struct {
 
 func InitJWTAuthenticationBackendWithMockedInternals(ctrl *gomock.Controller) *JWTAuthenticationBackendWithMockedInternals {
 	store := stores.NewMockIStore(ctrl)
+	environment := config.InitEnvironment()
 	return &JWTAuthenticationBackendWithMockedInternals{
-		Backend: InitJWTAuthenticationBackend(store, config.InitEnvironment()),
+		Backend: InitJWTAuthenticationBackend(store, environment, config.InitClaimsMapping(environment)),
 		Store:   store,
 	}
 }
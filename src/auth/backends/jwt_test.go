@@ -1,9 +1,12 @@
 package backends
 
 import (
+	"hive/config"
 	"hive/enums"
 	"hive/models"
+	"hive/stores"
 	"context"
+	"github.com/dgrijalva/jwt-go"
 	"github.com/golang/mock/gomock"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/require"
@@ -26,7 +29,7 @@ func TestCreateSessionFromTokens(t *testing.T) {
 		Value:   uuid.NewV4(),
 	}
 
-	accessToken := backend.Backend.EncodeAccessToken(ctx, userID, []string{}, secret, time.Now().Add(time.Microsecond).Unix())
+	accessToken := backend.Backend.EncodeAccessToken(ctx, &models.UserView{Id: userID}, &models.Session{Expires: time.Now().Add(time.Microsecond).Unix()}, "", secret)
 
 	backend.
 		Store.
@@ -35,6 +38,13 @@ func TestCreateSessionFromTokens(t *testing.T) {
 		Times(1).
 		Return(secret)
 
+	backend.
+		Store.
+		EXPECT().
+		GetUserView(ctx, userID).
+		Times(1).
+		Return(&models.UserView{Id: userID})
+
 	status, loggedUser := backend.Backend.GetUser(ctx, accessToken)
 	require.Equal(t, enums.Ok, status)
 	require.NotNil(t, loggedUser.GetUserID())
@@ -56,7 +66,7 @@ func TestCreateSessionFromTokensWithoutSecret(t *testing.T) {
 		Value:   uuid.NewV4(),
 	}
 
-	accessToken := backend.Backend.EncodeAccessToken(ctx, userID, []string{}, secret, time.Now().Add(time.Microsecond).Unix())
+	accessToken := backend.Backend.EncodeAccessToken(ctx, &models.UserView{Id: userID}, &models.Session{Expires: time.Now().Add(time.Microsecond).Unix()}, "", secret)
 
 	backend.
 		Store.
@@ -69,3 +79,324 @@ func TestCreateSessionFromTokensWithoutSecret(t *testing.T) {
 	require.Equal(t, enums.SecretNotFound, status)
 	require.Nil(t, loggedUserID)
 }
+
+func TestCreateSessionFromTokensWithRevokedSecret(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := InitJWTAuthenticationBackendWithMockedInternals(ctrl)
+
+	userID := uuid.NewV4()
+
+	secret := &models.Secret{
+		Id:      uuid.NewV4(),
+		Created: 1,
+		Value:   uuid.NewV4(),
+		Revoked: 2,
+	}
+
+	accessToken := backend.Backend.EncodeAccessToken(ctx, &models.UserView{Id: userID}, &models.Session{Expires: time.Now().Add(time.Minute).Unix()}, "", secret)
+
+	backend.
+		Store.
+		EXPECT().
+		GetSecret(ctx, secret.Id).
+		Return(secret).
+		Times(1)
+
+	status, loggedUser := backend.Backend.GetUser(ctx, accessToken)
+	require.Equal(t, enums.SecretRevoked, status)
+	require.Nil(t, loggedUser)
+}
+
+func TestEncodeAccessTokenWithCustomClaims(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := stores.NewMockIStore(ctrl)
+	environment := config.InitEnvironment()
+	backend := InitJWTAuthenticationBackend(store, environment, &config.ClaimsMapping{
+		ClaimsMappingRule: config.ClaimsMappingRule{
+			Issuer:   "hive",
+			Audience: "services",
+			Claims:   map[string]string{"emails": "emails", "phones": "phones"},
+		},
+		Clients: map[string]config.ClaimsMappingRule{
+			"mobile": {
+				Claims: map[string]string{"phones": ""},
+			},
+		},
+	})
+
+	user := &models.UserView{
+		Id:     uuid.NewV4(),
+		Emails: []string{"test@example.com"},
+		Phones: []string{"79999999999"},
+	}
+
+	secret := &models.Secret{
+		Id:      uuid.NewV4(),
+		Created: 1,
+		Value:   uuid.NewV4(),
+	}
+
+	parser := jwt.Parser{}
+
+	claims := jwt.MapClaims{}
+	_, _, err := parser.ParseUnverified(backend.EncodeAccessToken(ctx, user, &models.Session{Expires: time.Now().Add(time.Minute).Unix()}, "", secret), claims)
+	require.Nil(t, err)
+	require.Equal(t, "hive", claims["iss"])
+	require.Equal(t, []interface{}{"test@example.com"}, claims["emails"])
+	require.Equal(t, []interface{}{"79999999999"}, claims["phones"])
+	require.Equal(t, user.Id.String(), claims["userID"])
+
+	claims = jwt.MapClaims{}
+	_, _, err = parser.ParseUnverified(backend.EncodeAccessToken(ctx, user, &models.Session{Expires: time.Now().Add(time.Minute).Unix()}, "mobile", secret), claims)
+	require.Nil(t, err)
+	require.Equal(t, "hive", claims["iss"])
+	require.Equal(t, "services", claims["aud"])
+	require.Equal(t, []interface{}{"test@example.com"}, claims["emails"])
+	require.NotContains(t, claims, "phones")
+}
+
+func TestEncodeAccessTokenExceedingMaxSize(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := stores.NewMockIStore(ctrl)
+	environment := config.InitEnvironment()
+	environment.AccessTokenMaxSize = 512
+	backend := InitJWTAuthenticationBackend(store, environment, &config.ClaimsMapping{
+		ClaimsMappingRule: config.ClaimsMappingRule{
+			Claims: map[string]string{"emails": "emails"},
+		},
+	})
+
+	user := &models.UserView{Id: uuid.NewV4()}
+	for i := 0; i < 50; i++ {
+		user.Emails = append(user.Emails, uuid.NewV4().String()+"@example.com")
+	}
+
+	secret := &models.Secret{
+		Id:      uuid.NewV4(),
+		Created: 1,
+		Value:   uuid.NewV4(),
+	}
+
+	accessToken := backend.EncodeAccessToken(ctx, user, &models.Session{Expires: time.Now().Add(time.Minute).Unix()}, "", secret)
+	require.LessOrEqual(t, len(accessToken), environment.AccessTokenMaxSize)
+
+	claims := jwt.MapClaims{}
+	_, _, err := (&jwt.Parser{}).ParseUnverified(accessToken, claims)
+	require.Nil(t, err)
+	require.NotContains(t, claims, "emails")
+	require.Equal(t, user.Id.String(), claims["userID"])
+}
+
+func TestInitJWTAuthenticationBackendWithReservedClaim(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	environment := config.InitEnvironment()
+
+	require.Panics(t, func() {
+		InitJWTAuthenticationBackend(stores.NewMockIStore(ctrl), environment, &config.ClaimsMapping{
+			ClaimsMappingRule: config.ClaimsMappingRule{
+				Claims: map[string]string{"roles": "emails"},
+			},
+		})
+	})
+}
+
+func TestCreateSessionFromTokensWithDeletedSession(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := InitJWTAuthenticationBackendWithMockedInternals(ctrl)
+
+	session := &models.Session{
+		Id:          uuid.NewV4(),
+		PublicID:    uuid.NewV4(),
+		Fingerprint: "fingerprint",
+		Expires:     time.Now().Add(time.Minute).Unix(),
+	}
+
+	secret := &models.Secret{
+		Id:      uuid.NewV4(),
+		Created: 1,
+		Value:   uuid.NewV4(),
+	}
+
+	userView := &models.UserView{Id: uuid.NewV4()}
+	accessToken := backend.Backend.EncodeAccessToken(ctx, userView, session, "", secret)
+
+	backend.
+		Store.
+		EXPECT().
+		GetSecret(ctx, secret.Id).
+		Return(secret).
+		Times(2)
+
+	backend.
+		Store.
+		EXPECT().
+		GetSessionExistence(ctx, session.PublicID).
+		Return(true).
+		Times(1)
+
+	backend.
+		Store.
+		EXPECT().
+		GetUserView(ctx, userView.Id).
+		Return(userView).
+		Times(1)
+
+	status, loggedUser := backend.Backend.GetUser(ctx, accessToken)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, session.PublicID, loggedUser.(*JWTAuthenticationBackendUser).GetSessionID())
+	require.Equal(t, HashFingerprint(session.Fingerprint), loggedUser.(*JWTAuthenticationBackendUser).GetFingerprintHash())
+
+	backend.
+		Store.
+		EXPECT().
+		GetSessionExistence(ctx, session.PublicID).
+		Return(false).
+		Times(1)
+
+	status, loggedUser = backend.Backend.GetUser(ctx, accessToken)
+	require.Equal(t, enums.SessionNotFound, status)
+	require.Nil(t, loggedUser)
+}
+
+func TestCreateSessionFromTokensOfSuspendedUser(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := InitJWTAuthenticationBackendWithMockedInternals(ctrl)
+
+	secret := &models.Secret{
+		Id:      uuid.NewV4(),
+		Created: 1,
+		Value:   uuid.NewV4(),
+	}
+
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	userView := &models.UserView{Id: uuid.NewV4(), Status: enums.UserSuspended, SuspendedUntil: now + 60000}
+	accessToken := backend.Backend.EncodeAccessToken(ctx, userView, &models.Session{Expires: time.Now().Add(time.Minute).Unix()}, "", secret)
+
+	backend.
+		Store.
+		EXPECT().
+		GetSecret(ctx, secret.Id).
+		Return(secret).
+		Times(2)
+
+	backend.
+		Store.
+		EXPECT().
+		GetUserView(ctx, userView.Id).
+		Return(userView).
+		Times(1)
+
+	status, loggedUser := backend.Backend.GetUser(ctx, accessToken)
+	require.Equal(t, enums.UserInactive, status)
+	require.Nil(t, loggedUser)
+
+	// Suspension ends by itself
+	backend.
+		Store.
+		EXPECT().
+		GetUserView(ctx, userView.Id).
+		Return(&models.UserView{Id: userView.Id, Status: enums.UserSuspended, SuspendedUntil: now - 1}).
+		Times(1)
+
+	status, loggedUser = backend.Backend.GetUser(ctx, accessToken)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, userView.Id, loggedUser.GetUserID())
+}
+
+func TestEncodeAccessTokenWithOrganization(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := InitJWTAuthenticationBackendWithMockedInternals(ctrl)
+
+	secret := &models.Secret{
+		Id:      uuid.NewV4(),
+		Created: 0,
+		Value:   uuid.NewV4(),
+	}
+
+	organizationID := uuid.NewV4()
+	user := &models.UserView{
+		Id:          uuid.NewV4(),
+		Permissions: []string{enums.UsersRead},
+		Organizations: []*models.UserViewOrganization{{
+			Id:          organizationID,
+			Roles:       []string{"manager"},
+			Permissions: []string{enums.UsersDelete},
+		}},
+	}
+
+	accessToken := backend.Backend.EncodeAccessToken(ctx, user, &models.Session{
+		Expires:        time.Now().Add(time.Minute).Unix(),
+		OrganizationID: organizationID,
+	}, "", secret)
+
+	backend.
+		Store.
+		EXPECT().
+		GetSecret(ctx, secret.Id).
+		Times(1).
+		Return(secret)
+
+	backend.
+		Store.
+		EXPECT().
+		GetUserView(ctx, user.Id).
+		Times(1).
+		Return(user)
+
+	status, loggedUser := backend.Backend.GetUser(ctx, accessToken)
+	require.Equal(t, enums.Ok, status)
+	organizationUser, ok := loggedUser.(models.IOrganizationAuthenticationBackendUser)
+	require.True(t, ok)
+	require.Equal(t, organizationID, organizationUser.GetOrganizationID())
+	require.Equal(t, []string{enums.UsersDelete}, organizationUser.GetOrganizationPermissions())
+	require.Equal(t, []string{enums.UsersRead}, loggedUser.GetPermissions())
+}
+
+func TestEncodeAccessTokenWithExpiringRoles(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	backend := InitJWTAuthenticationBackendWithMockedInternals(ctrl)
+
+	secret := &models.Secret{
+		Id:    uuid.NewV4(),
+		Value: uuid.NewV4(),
+	}
+
+	rolesExpires := time.Now().Add(time.Second * 30)
+	user := &models.UserView{
+		Id:           uuid.NewV4(),
+		Roles:        []string{"oncall"},
+		RolesExpires: rolesExpires.UnixNano() / int64(time.Millisecond),
+	}
+
+	accessToken := backend.Backend.EncodeAccessToken(ctx, user, &models.Session{
+		Expires: time.Now().Add(time.Minute).Unix(),
+	}, "", secret)
+
+	claims := &JWTAuthenticationBackendUser{}
+	_, _, err := new(jwt.Parser).ParseUnverified(accessToken, claims)
+	require.Nil(t, err)
+	require.Equal(t, rolesExpires.Unix(), claims.ExpiresAt)
+}
@@ -0,0 +1,237 @@
+package auth
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/getsentry/sentry-go"
+	"math/big"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	DPoPHeader        = "dpop"
+	DPoPNonceHeader   = "DPoP-Nonce"
+	DPoPProofType     = "dpop+jwt"
+	DPoPAuthorization = "DPoP"
+)
+
+// JWK contains public key fields used by DPoP proofs, see RFC 7517
+type JWK struct {
+	Kty string `json:"kty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	D   string `json:"d,omitempty"`
+}
+
+type DPoPProofClaims struct {
+	jwt.StandardClaims
+	HTTPMethod      string `json:"htm"`
+	HTTPURI         string `json:"htu"`
+	Nonce           string `json:"nonce,omitempty"`
+	AccessTokenHash string `json:"ath,omitempty"`
+}
+
+func decodeBigInt(value string) (*big.Int, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, errors.New("empty jwk parameter")
+	}
+
+	return new(big.Int).SetBytes(data), nil
+}
+
+// PublicKey returns ecdsa or rsa public key, private keys are never accepted
+func (key *JWK) PublicKey() (interface{}, error) {
+	if key.D != "" {
+		return nil, errors.New("jwk contains private key")
+	}
+
+	switch key.Kty {
+	case "EC":
+		var curve elliptic.Curve
+		switch key.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, errors.New(fmt.Sprintf("unsupported jwk curve %s", key.Crv))
+		}
+
+		x, err := decodeBigInt(key.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := decodeBigInt(key.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		if !curve.IsOnCurve(x, y) {
+			return nil, errors.New("jwk point is not on curve")
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+	case "RSA":
+		n, err := decodeBigInt(key.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := decodeBigInt(key.E)
+		if err != nil {
+			return nil, err
+		}
+
+		if !e.IsInt64() || n.BitLen() < 2048 {
+			return nil, errors.New("unsupported rsa jwk")
+		}
+
+		return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+	default:
+		return nil, errors.New(fmt.Sprintf("unsupported jwk type %s", key.Kty))
+	}
+}
+
+// Thumbprint returns base64url encoded SHA-256 JWK thumbprint, see RFC 7638
+func (key *JWK) Thumbprint() string {
+	var canonical string
+
+	switch key.Kty {
+	case "EC":
+		canonical = fmt.Sprintf(`{"crv":"%s","kty":"EC","x":"%s","y":"%s"}`, key.Crv, key.X, key.Y)
+	case "RSA":
+		canonical = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, key.E, key.N)
+	default:
+		return ""
+	}
+
+	hash := sha256.Sum256([]byte(canonical))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func HashAccessToken(accessToken string) string {
+	hash := sha256.Sum256([]byte(accessToken))
+	return base64.RawURLEncoding.EncodeToString(hash[:])
+}
+
+func getRequestURI(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil || r.Header.Get("X-Forwarded-Proto") == "https" {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://%s%s", scheme, r.Host, functools.GetOriginalPath(r))
+}
+
+func trimURI(uri string) string {
+	if i := strings.IndexAny(uri, "?#"); i >= 0 {
+		return uri[:i]
+	}
+
+	return uri
+}
+
+func parseDPoPProof(proof string) (*jwt.Token, string, error) {
+	var keyThumbprint string
+
+	token, err := jwt.ParseWithClaims(proof, &DPoPProofClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if token.Header["typ"] != DPoPProofType {
+			return nil, errors.New("incorrect dpop proof type")
+		}
+
+		switch token.Method.(type) {
+		case *jwt.SigningMethodECDSA, *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS:
+		default:
+			return nil, errors.New("dpop proof must be signed with asymmetric algorithm")
+		}
+
+		data, err := json.Marshal(token.Header["jwk"])
+		if err != nil {
+			return nil, err
+		}
+
+		key := &JWK{}
+		err = json.Unmarshal(data, key)
+		if err != nil {
+			return nil, err
+		}
+
+		keyThumbprint = key.Thumbprint()
+		return key.PublicKey()
+	})
+
+	return token, keyThumbprint, err
+}
+
+// ValidateDPoPProof validates proof from DPoP header of request and returns thumbprint of its key,
+// access token should be passed if proof is presented together with access token
+func (controller *AuthenticationController) ValidateDPoPProof(ctx context.Context, r *http.Request, accessToken string) (int, string) {
+
+	proofs := r.Header.Values(DPoPHeader)
+	if len(proofs) != 1 {
+		return enums.IncorrectDPoPProof, ""
+	}
+
+	token, keyThumbprint, err := parseDPoPProof(proofs[0])
+	if err != nil || !token.Valid || keyThumbprint == "" {
+		return enums.IncorrectDPoPProof, ""
+	}
+
+	claims, ok := token.Claims.(*DPoPProofClaims)
+	if !ok || claims.Id == "" || claims.IssuedAt == 0 {
+		return enums.IncorrectDPoPProof, ""
+	}
+
+	if claims.HTTPMethod != r.Method || trimURI(claims.HTTPURI) != getRequestURI(r) {
+		return enums.IncorrectDPoPProof, ""
+	}
+
+	lifetime := controller.environment.DPoPProofLifetime
+	now := time.Now().Unix()
+	if claims.IssuedAt < now-lifetime || claims.IssuedAt > now+lifetime {
+		return enums.IncorrectDPoPProof, ""
+	}
+
+	if accessToken != "" && claims.AccessTokenHash != HashAccessToken(accessToken) {
+		return enums.IncorrectDPoPProof, ""
+	}
+
+	if controller.environment.DPoPNonceRequired &&
+		(claims.Nonce == "" || !controller.store.GetDPoPNonceExistence(ctx, claims.Nonce)) {
+		return enums.DPoPNonceRequired, ""
+	}
+
+	if !controller.store.RegisterDPoPProof(ctx, keyThumbprint, claims.Id) {
+		sentry.CaptureException(errors.New(fmt.Sprintf("dpop proof %s replayed", claims.Id)))
+		return enums.IncorrectDPoPProof, ""
+	}
+
+	return enums.Ok, keyThumbprint
+}
+
+func (controller *AuthenticationController) CreateDPoPNonce(ctx context.Context) string {
+	return controller.store.CreateDPoPNonce(ctx)
+}
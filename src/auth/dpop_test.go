@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"hive/auth/backends"
+	"hive/config"
+	"hive/enums"
+	"hive/models"
+	"hive/stores"
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func createDPoPKey(t *testing.T) (*ecdsa.PrivateKey, *JWK) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	return key, &JWK{
+		Kty: "EC",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+func createDPoPProof(t *testing.T, key *ecdsa.PrivateKey, jwk *JWK, claims *DPoPProofClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["typ"] = DPoPProofType
+	token.Header["jwk"] = jwk
+
+	proof, err := token.SignedString(key)
+	require.Nil(t, err)
+	return proof
+}
+
+func createDPoPProofClaims(method, uri string) *DPoPProofClaims {
+	return &DPoPProofClaims{
+		StandardClaims: jwt.StandardClaims{
+			Id:       uuid.NewV4().String(),
+			IssuedAt: time.Now().Unix(),
+		},
+		HTTPMethod: method,
+		HTTPURI:    uri,
+	}
+}
+
+func initAuthControllerWithMockedStore(ctrl *gomock.Controller) (*AuthenticationController, *stores.MockIStore) {
+	store := stores.NewMockIStore(ctrl)
+	environment := config.InitEnvironment()
+	jwtBackend := backends.InitJWTAuthenticationBackend(store, environment, config.InitClaimsMapping(environment))
+	controller := InitAuthController(map[string]backends.IAuthenticationBackend{
+		"Bearer":          jwtBackend,
+		DPoPAuthorization: jwtBackend,
+	}, store, environment)
+	return controller, store
+}
+
+func TestValidateDPoPProof(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller, store := initAuthControllerWithMockedStore(ctrl)
+	key, jwk := createDPoPKey(t)
+
+	request := httptest.NewRequest(http.MethodPost, "http://hive.local/api/v1/sessions", bytes.NewReader([]byte{}))
+	claims := createDPoPProofClaims(http.MethodPost, "http://hive.local/api/v1/sessions?query=1")
+	request.Header.Set(DPoPHeader, createDPoPProof(t, key, jwk, claims))
+
+	store.
+		EXPECT().
+		RegisterDPoPProof(ctx, jwk.Thumbprint(), claims.Id).
+		Return(true).
+		Times(1)
+
+	status, keyThumbprint := controller.ValidateDPoPProof(ctx, request, "")
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, jwk.Thumbprint(), keyThumbprint)
+
+	store.
+		EXPECT().
+		RegisterDPoPProof(ctx, jwk.Thumbprint(), claims.Id).
+		Return(false).
+		Times(1)
+
+	status, keyThumbprint = controller.ValidateDPoPProof(ctx, request, "")
+	require.Equal(t, enums.IncorrectDPoPProof, status)
+	require.Empty(t, keyThumbprint)
+}
+
+func TestValidateDPoPProofWithIncorrectClaims(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller, _ := initAuthControllerWithMockedStore(ctrl)
+	key, jwk := createDPoPKey(t)
+
+	for _, claims := range []*DPoPProofClaims{
+		createDPoPProofClaims(http.MethodGet, "http://hive.local/api/v1/sessions"),
+		createDPoPProofClaims(http.MethodPost, "http://hive.local/api/v1/users"),
+		{
+			StandardClaims: jwt.StandardClaims{Id: uuid.NewV4().String(), IssuedAt: time.Now().Add(-time.Hour).Unix()},
+			HTTPMethod:     http.MethodPost,
+			HTTPURI:        "http://hive.local/api/v1/sessions",
+		},
+	} {
+		request := httptest.NewRequest(http.MethodPost, "http://hive.local/api/v1/sessions", bytes.NewReader([]byte{}))
+		request.Header.Set(DPoPHeader, createDPoPProof(t, key, jwk, claims))
+		status, _ := controller.ValidateDPoPProof(ctx, request, "")
+		require.Equal(t, enums.IncorrectDPoPProof, status)
+	}
+}
+
+func TestValidateDPoPProofWithoutNonce(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller, _ := initAuthControllerWithMockedStore(ctrl)
+	controller.environment.DPoPNonceRequired = true
+	key, jwk := createDPoPKey(t)
+
+	request := httptest.NewRequest(http.MethodPost, "http://hive.local/api/v1/sessions", bytes.NewReader([]byte{}))
+	request.Header.Set(DPoPHeader, createDPoPProof(t, key, jwk, createDPoPProofClaims(http.MethodPost, "http://hive.local/api/v1/sessions")))
+
+	status, _ := controller.ValidateDPoPProof(ctx, request, "")
+	require.Equal(t, enums.DPoPNonceRequired, status)
+}
+
+func TestLoginWithDPoPBoundToken(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller, store := initAuthControllerWithMockedStore(ctrl)
+	key, jwk := createDPoPKey(t)
+
+	secret := &models.Secret{
+		Id:      uuid.NewV4(),
+		Created: 1,
+		Value:   uuid.NewV4(),
+	}
+
+	session := &models.Session{
+		Expires:       time.Now().Add(time.Minute).Unix(),
+		KeyThumbprint: jwk.Thumbprint(),
+	}
+
+	environment := config.InitEnvironment()
+	jwtBackend := backends.InitJWTAuthenticationBackend(store, environment, config.InitClaimsMapping(environment))
+	userView := &models.UserView{Id: uuid.NewV4()}
+	accessToken := jwtBackend.EncodeAccessToken(ctx, userView, session, "", secret)
+
+	store.
+		EXPECT().
+		GetSecret(ctx, secret.Id).
+		Return(secret).
+		Times(2)
+
+	store.
+		EXPECT().
+		GetUserView(ctx, userView.Id).
+		Return(userView).
+		Times(2)
+
+	request := httptest.NewRequest(http.MethodGet, "http://hive.local/api/v1/users", bytes.NewReader([]byte{}))
+	request.Header.Set("Authorization", "Bearer "+accessToken)
+	status, user := controller.Login(ctx, request)
+	require.Equal(t, enums.IncorrectToken, status)
+	require.Nil(t, user)
+
+	claims := createDPoPProofClaims(http.MethodGet, "http://hive.local/api/v1/users")
+	claims.AccessTokenHash = HashAccessToken(accessToken)
+	request = httptest.NewRequest(http.MethodGet, "http://hive.local/api/v1/users", bytes.NewReader([]byte{}))
+	request.Header.Set("Authorization", "DPoP "+accessToken)
+	request.Header.Set(DPoPHeader, createDPoPProof(t, key, jwk, claims))
+
+	store.
+		EXPECT().
+		RegisterDPoPProof(ctx, jwk.Thumbprint(), claims.Id).
+		Return(true).
+		Times(1)
+
+	status, user = controller.Login(ctx, request)
+	require.Equal(t, enums.Ok, status)
+	require.NotNil(t, user)
+}
@@ -7,21 +7,28 @@ import (
 	"hive/enums"
 	"hive/models"
 	"hive/repositories"
+	"hive/stores"
 	"net/http"
 	"strings"
 )
 
+const BearerAuthorization = "Bearer"
+
 type IAuthenticationController interface {
 	Login(ctx context.Context, r *http.Request) (int, models.IAuthenticationBackendUser)
+	GetTokenUser(ctx context.Context, token string) (int, models.IAuthenticationBackendUser)
+	ValidateDPoPProof(ctx context.Context, r *http.Request, accessToken string) (int, string)
+	CreateDPoPNonce(ctx context.Context) string
 }
 
 type AuthenticationController struct {
 	backends    map[string]backends.IAuthenticationBackend
+	store       stores.IStore
 	environment *config.Environment
 }
 
-func InitAuthController(backends map[string]backends.IAuthenticationBackend, environment *config.Environment) *AuthenticationController {
-	return &AuthenticationController{backends: backends, environment: environment}
+func InitAuthController(backends map[string]backends.IAuthenticationBackend, store stores.IStore, environment *config.Environment) *AuthenticationController {
+	return &AuthenticationController{backends: backends, store: store, environment: environment}
 }
 
 func (controller *AuthenticationController) GetToken(authorizationHeader string) (string, string) {
@@ -53,5 +60,49 @@ func (controller *AuthenticationController) Login(ctx context.Context, r *http.R
 	}
 
 	status, user := backend.GetUser(ctx, token)
+	if status != enums.Ok {
+		return status, user
+	}
+
+	// DPoP bound token can't be used as bearer token and vice versa
+	var keyThumbprint string
+	if popUser, ok := user.(models.IProofOfPossessionAuthenticationBackendUser); ok {
+		keyThumbprint = popUser.GetKeyThumbprint()
+	}
+
+	if tokenType == DPoPAuthorization {
+		var proofKeyThumbprint string
+		status, proofKeyThumbprint = controller.ValidateDPoPProof(ctx, r, token)
+		if status != enums.Ok {
+			return status, nil
+		}
+
+		if keyThumbprint == "" || keyThumbprint != proofKeyThumbprint {
+			return enums.IncorrectDPoPProof, nil
+		}
+	} else if keyThumbprint != "" {
+		return enums.IncorrectToken, nil
+	}
+
+	if !controller.environment.SessionFingerprintRequired {
+		return status, user
+	}
+
+	sessionUser, ok := user.(models.ISessionAuthenticationBackendUser)
+	if ok && sessionUser.GetFingerprintHash() != backends.HashFingerprint(repositories.GetFingerprintHeader(r)) {
+		return enums.IncorrectFingerprint, nil
+	}
+
 	return status, user
 }
+
+// GetTokenUser returns owner of access token presented by other service, proof of possession
+// and fingerprint are checked by the service which received the token
+func (controller *AuthenticationController) GetTokenUser(ctx context.Context, token string) (int, models.IAuthenticationBackendUser) {
+	backend := controller.backends[BearerAuthorization]
+	if backend == nil {
+		return enums.BackendNotFound, nil
+	}
+
+	return backend.GetUser(ctx, token)
+}
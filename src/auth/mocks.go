@@ -49,3 +49,47 @@ func (mr *MockIAuthenticationControllerMockRecorder) Login(ctx, r interface{}) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockIAuthenticationController)(nil).Login), ctx, r)
 }
+
+// GetTokenUser mocks base method
+func (m *MockIAuthenticationController) GetTokenUser(ctx context.Context, token string) (int, models.IAuthenticationBackendUser) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTokenUser", ctx, token)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(models.IAuthenticationBackendUser)
+	return ret0, ret1
+}
+
+// GetTokenUser indicates an expected call of GetTokenUser
+func (mr *MockIAuthenticationControllerMockRecorder) GetTokenUser(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTokenUser", reflect.TypeOf((*MockIAuthenticationController)(nil).GetTokenUser), ctx, token)
+}
+
+// ValidateDPoPProof mocks base method
+func (m *MockIAuthenticationController) ValidateDPoPProof(ctx context.Context, r *http.Request, accessToken string) (int, string) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateDPoPProof", ctx, r, accessToken)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(string)
+	return ret0, ret1
+}
+
+// ValidateDPoPProof indicates an expected call of ValidateDPoPProof
+func (mr *MockIAuthenticationControllerMockRecorder) ValidateDPoPProof(ctx, r, accessToken interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateDPoPProof", reflect.TypeOf((*MockIAuthenticationController)(nil).ValidateDPoPProof), ctx, r, accessToken)
+}
+
+// CreateDPoPNonce mocks base method
+func (m *MockIAuthenticationController) CreateDPoPNonce(ctx context.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDPoPNonce", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CreateDPoPNonce indicates an expected call of CreateDPoPNonce
+func (mr *MockIAuthenticationControllerMockRecorder) CreateDPoPNonce(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDPoPNonce", reflect.TypeOf((*MockIAuthenticationController)(nil).CreateDPoPNonce), ctx)
+}
@@ -0,0 +1,27 @@
+package auth
+
+import (
+	"hive/enums"
+	"hive/models"
+	"time"
+)
+
+// CheckAuthenticationContext checks that user was authenticated not earlier than maxAge seconds ago
+// and, if required, with second factor
+func CheckAuthenticationContext(user models.IAuthenticationBackendUser, maxAge int64, secondFactorRequired bool) int {
+	authenticationContextUser, ok := user.(models.IAuthenticationContextUser)
+	if !ok {
+		return enums.ReauthenticationRequired
+	}
+
+	authentication := authenticationContextUser.GetAuthenticationContext()
+	if authentication == nil || authentication.Time < time.Now().Unix()-maxAge {
+		return enums.ReauthenticationRequired
+	}
+
+	if secondFactorRequired && authentication.Level != enums.MultiFactorAuthenticationLevel {
+		return enums.SecondFactorRequired
+	}
+
+	return enums.Ok
+}
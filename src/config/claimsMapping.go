@@ -0,0 +1,83 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"io/ioutil"
+)
+
+// ClaimsMappingRule describes which additional claims are added to access token,
+// keys of Claims are claim names and values are names of user view fields
+type ClaimsMappingRule struct {
+	Issuer   string            `json:"issuer"`
+	Audience string            `json:"audience"`
+	Claims   map[string]string `json:"claims"`
+}
+
+type ClaimsMapping struct {
+	ClaimsMappingRule
+	Clients map[string]ClaimsMappingRule `json:"clients"`
+}
+
+// GetRule returns default rule merged with claims of the client, client claim mapped to empty field name
+// removes default claim. Client ID isn't authenticated, so issuer and audience always come from default rule
+func (mapping *ClaimsMapping) GetRule(clientID string) ClaimsMappingRule {
+	rule := ClaimsMappingRule{
+		Issuer:   mapping.Issuer,
+		Audience: mapping.Audience,
+		Claims:   map[string]string{},
+	}
+
+	for claim, field := range mapping.Claims {
+		rule.Claims[claim] = field
+	}
+
+	client, ok := mapping.Clients[clientID]
+	if clientID == "" || !ok {
+		return rule
+	}
+
+	for claim, field := range client.Claims {
+		if field == "" {
+			delete(rule.Claims, claim)
+		} else {
+			rule.Claims[claim] = field
+		}
+	}
+
+	return rule
+}
+
+func InitClaimsMapping(environment *Environment) *ClaimsMapping {
+	mapping := &ClaimsMapping{}
+
+	if environment.AccessTokenClaimsFile != "" {
+		content, err := ioutil.ReadFile(environment.AccessTokenClaimsFile)
+		if err != nil {
+			panic(err)
+		}
+
+		err = json.Unmarshal(content, mapping)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	for clientID, client := range mapping.Clients {
+		if client.Issuer != "" || client.Audience != "" {
+			panic(fmt.Sprintf("issuer and audience of client %s can't be overridden", clientID))
+		}
+	}
+
+	if mapping.Issuer == "" {
+		mapping.Issuer = environment.AccessTokenIssuer
+	}
+
+	if mapping.Audience == "" {
+		mapping.Audience = environment.AccessTokenAudience
+	}
+
+	log.Log().Msg("Access token claims mapping successfully loaded")
+	return mapping
+}
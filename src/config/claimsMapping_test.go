@@ -0,0 +1,31 @@
+package config
+
+import (
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestGetRuleKeepsDefaultIssuerAndAudience(t *testing.T) {
+	t.Parallel()
+	mapping := &ClaimsMapping{
+		ClaimsMappingRule: ClaimsMappingRule{Issuer: "hive", Audience: "services", Claims: map[string]string{"emails": "emails"}},
+		Clients: map[string]ClaimsMappingRule{
+			"mobile": {Issuer: "mobile", Audience: "mobile", Claims: map[string]string{"phones": "phones"}},
+		},
+	}
+
+	rule := mapping.GetRule("mobile")
+	require.Equal(t, "hive", rule.Issuer)
+	require.Equal(t, "services", rule.Audience)
+	require.Equal(t, map[string]string{"emails": "emails", "phones": "phones"}, rule.Claims)
+}
+
+func TestInitClaimsMappingWithClientAudience(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "claims.json")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`{"clients": {"mobile": {"audience": "mobile"}}}`), 0600))
+
+	require.Panics(t, func() { InitClaimsMapping(&Environment{AccessTokenClaimsFile: path}) })
+}
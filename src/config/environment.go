@@ -22,6 +22,47 @@ type Environment struct {
 	ActualSecretLifetime   int64  `env:"ACTUAL_SECRET_LIFETIME" envDefault:"1440"` // Minutes
 	DefaultPaginationLimit int    `env:"DEFAULT_PAGINATION_LIMIT" envDefault:"50"`
 
+	AccessTokenClaimsFile string `env:"ACCESS_TOKEN_CLAIMS_FILE"` // Path to JSON file with claims mapping
+	AccessTokenIssuer     string `env:"ACCESS_TOKEN_ISSUER" envDefault:"hive"`
+	AccessTokenAudience   string `env:"ACCESS_TOKEN_AUDIENCE"`
+	AccessTokenMaxSize    int    `env:"ACCESS_TOKEN_MAX_SIZE" envDefault:"4096"` // Bytes, custom claims are dropped from bigger tokens
+
+	RealmsFile string `env:"REALMS_FILE"` // Path to JSON file with list of realms, default realm is always served
+
+	PoliciesFile           string `env:"POLICIES_FILE"`                            // Path to JSON file with access policies, everything is allowed without it
+	PoliciesReloadInterval int64  `env:"POLICIES_RELOAD_INTERVAL" envDefault:"30"` // Seconds between checks of policy file modification, 0 disables reloading
+
+	AuthorizationDecisionLifetime int64 `env:"AUTHORIZATION_DECISION_LIFETIME" envDefault:"3600"` // Seconds, decisions are also invalidated by change of user view
+	AuthorizationMaxChecks        int   `env:"AUTHORIZATION_MAX_CHECKS" envDefault:"100"`         // Maximum number of checks in one authorization request
+
+	UserRolesCheckInterval int64 `env:"USER_ROLES_CHECK_INTERVAL" envDefault:"30"` // Seconds between checks of temporary user roles starts and expiries
+
+	UserExportsCheckInterval int64 `env:"USER_EXPORTS_CHECK_INTERVAL" envDefault:"10"` // Seconds between checks of requested user data exports
+	UserExportLifetime       int64 `env:"USER_EXPORT_LIFETIME" envDefault:"72"`        // Hours, archive can be downloaded within
+
+	UserDeletionsCheckInterval int64 `env:"USER_DELETIONS_CHECK_INTERVAL" envDefault:"60"` // Seconds between checks of users due to be anonymized
+	UserDeletionGracePeriod    int64 `env:"USER_DELETION_GRACE_PERIOD" envDefault:"30"`    // Days, deletion could be cancelled by logging in within
+
+	ContactTransferConfirmation bool `env:"CONTACT_TRANSFER_CONFIRMATION" envDefault:"false"` // Email or phone owned by another user is moved only after previous owner approves
+
+	UsersViewRedacted bool `env:"USERS_VIEW_REDACTED" envDefault:"false"` // Views of other users are shown without contacts, roles and permissions instead of being hidden
+
+	ProfileSchemaFile       string   `env:"PROFILE_SCHEMA_FILE"`                        // Path to JSON Schema of profile attributes, any object is accepted without it
+	ProfileFilterAttributes []string `env:"PROFILE_FILTER_ATTRIBUTES" envSeparator:","` // Profile attributes allowed in users view filters
+
+	SessionFingerprintRequired bool `env:"SESSION_FINGERPRINT_REQUIRED" envDefault:"false"` // Requests with access token must present fingerprint of session
+
+	DPoPNonceRequired bool  `env:"DPOP_NONCE_REQUIRED" envDefault:"false"`
+	DPoPNonceLifetime int64 `env:"DPOP_NONCE_LIFETIME" envDefault:"300"` // Seconds
+	DPoPProofLifetime int64 `env:"DPOP_PROOF_LIFETIME" envDefault:"60"`  // Seconds, maximum age of proof and clock skew
+
+	StepUpMaxAge int64 `env:"STEP_UP_MAX_AGE" envDefault:"300"` // Seconds since authentication allowed for sensitive operations
+
+	SecretsMasterKey             string `env:"SECRETS_MASTER_KEY"`               // Base64 encoded AES key, generated per deployment
+	SecretsMasterKeyFile         string `env:"SECRETS_MASTER_KEY_FILE"`          // Path to file with base64 encoded AES key
+	SecretsPreviousMasterKey     string `env:"SECRETS_PREVIOUS_MASTER_KEY"`      // Used only for decryption while master key rotating
+	SecretsPreviousMasterKeyFile string `env:"SECRETS_PREVIOUS_MASTER_KEY_FILE"` // Used only for decryption while master key rotating
+
 	ServerAddress         string `env:"SERVER_ADDRESS" envDefault:"0.0.0.0:8080"`
 	LocalNetworkNamespace string `env:"LOCAL_NETWORK_NAMESPACE" envDefault:"[::1]:"`
 }
@@ -36,6 +77,7 @@ func InitEnvironment() *Environment {
 	return &cfg
 }
 
-func isTest() bool {
+// isTest is a variable, so tests could check behaviour outside of tests
+var isTest = func() bool {
 	return flag.Lookup("test.v") != nil
 }
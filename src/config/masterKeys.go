@@ -0,0 +1,65 @@
+package config
+
+import (
+	"encoding/base64"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"io/ioutil"
+	"strings"
+)
+
+// Static key used only by tests, never use it in any environment
+var testMasterKey = []byte("hive-test-master-key-0123456789a")
+
+func loadMasterKey(value string, path string) []byte {
+
+	if value == "" && path != "" {
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			panic(err)
+		}
+
+		value = string(content)
+	}
+
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil
+	}
+
+	key, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		panic(err)
+	}
+
+	switch len(key) {
+	case 16, 24, 32:
+		return key
+	default:
+		panic(fmt.Sprintf("master key must be 16, 24 or 32 bytes long, got %d", len(key)))
+	}
+}
+
+// InitMasterKeys returns current master key used for encryption of signing secrets and
+// previous master keys which are still accepted for decryption
+func InitMasterKeys(environment *Environment) ([]byte, [][]byte) {
+
+	key := loadMasterKey(environment.SecretsMasterKey, environment.SecretsMasterKeyFile)
+	if key == nil {
+		if !isTest() {
+			panic("secrets master key is not provided, set SECRETS_MASTER_KEY or SECRETS_MASTER_KEY_FILE")
+		}
+
+		key = testMasterKey
+	}
+
+	var previousKeys [][]byte
+
+	previousKey := loadMasterKey(environment.SecretsPreviousMasterKey, environment.SecretsPreviousMasterKeyFile)
+	if previousKey != nil {
+		previousKeys = append(previousKeys, previousKey)
+	}
+
+	log.Log().Msg("Secrets master keys successfully loaded")
+	return key, previousKeys
+}
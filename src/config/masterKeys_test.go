@@ -0,0 +1,57 @@
+package config
+
+import (
+	"encoding/base64"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestLoadMasterKey(t *testing.T) {
+	t.Parallel()
+
+	for _, testCase := range []struct {
+		key    []byte
+		panics bool
+	}{
+		{make([]byte, 16), false},
+		{make([]byte, 24), false},
+		{make([]byte, 32), false},
+		{make([]byte, 15), true},
+		{make([]byte, 33), true},
+	} {
+		value := base64.StdEncoding.EncodeToString(testCase.key)
+		if testCase.panics {
+			require.Panics(t, func() { loadMasterKey(value, "") })
+		} else {
+			require.Equal(t, testCase.key, loadMasterKey(value, ""))
+		}
+	}
+
+	require.Panics(t, func() { loadMasterKey("not base64!", "") })
+	require.Nil(t, loadMasterKey("", ""))
+}
+
+func TestInitMasterKeysWithoutKey(t *testing.T) {
+	environment := &Environment{}
+
+	key, previousKeys := InitMasterKeys(environment)
+	require.Equal(t, testMasterKey, key)
+	require.Empty(t, previousKeys)
+
+	defer func(original func() bool) { isTest = original }(isTest)
+	isTest = func() bool { return false }
+	require.Panics(t, func() { InitMasterKeys(environment) })
+}
+
+func TestInitMasterKeysWithPreviousKey(t *testing.T) {
+	t.Parallel()
+	current, previous := make([]byte, 32), make([]byte, 16)
+	previous[0] = 1
+
+	key, previousKeys := InitMasterKeys(&Environment{
+		SecretsMasterKey:         base64.StdEncoding.EncodeToString(current),
+		SecretsPreviousMasterKey: base64.StdEncoding.EncodeToString(previous),
+	})
+	require.Equal(t, current, key)
+	require.Equal(t, [][]byte{previous}, previousKeys)
+}
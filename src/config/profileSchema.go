@@ -0,0 +1,36 @@
+package config
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// ProfileSchema validates profile attributes of users against JSON Schema configured by administrator,
+// without schema file any object is accepted
+type ProfileSchema struct {
+	schema *jsonschema.Schema
+}
+
+func (profileSchema *ProfileSchema) Validate(profile map[string]interface{}) error {
+	if profileSchema.schema == nil {
+		return nil
+	}
+
+	return profileSchema.schema.Validate(profile)
+}
+
+func InitProfileSchema(environment *Environment) *ProfileSchema {
+	profileSchema := &ProfileSchema{}
+
+	if environment.ProfileSchemaFile != "" {
+		schema, err := jsonschema.Compile(environment.ProfileSchemaFile)
+		if err != nil {
+			panic(err)
+		}
+
+		profileSchema.schema = schema
+	}
+
+	log.Log().Msg("Profile schema successfully loaded")
+	return profileSchema
+}
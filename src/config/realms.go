@@ -0,0 +1,142 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"io/ioutil"
+	"net"
+	"regexp"
+	"strings"
+)
+
+const DefaultRealm = "default"
+
+// Realm names are used in cache keys and NSQ topics, so they are limited to safe characters
+var realmNameRE = regexp.MustCompile("^[a-zA-Z0-9_]{1,64}$")
+
+// Realm is isolated pool of users with its own roles, sessions and signing secrets,
+// zero lifetimes fall back to environment ones
+type Realm struct {
+	Name                 string   `json:"name"`
+	Hosts                []string `json:"hosts"`
+	PathPrefix           string   `json:"pathPrefix"`
+	AccessTokenLifetime  int64    `json:"accessTokenLifetime"`  // Minutes
+	RefreshTokenLifetime int64    `json:"refreshTokenLifetime"` // Days
+	ActualSecretLifetime int64    `json:"actualSecretLifetime"` // Minutes
+}
+
+func (realm *Realm) IsDefault() bool {
+	return realm.Name == DefaultRealm
+}
+
+func (realm *Realm) GetAccessTokenLifetime(environment *Environment) int64 {
+	if realm.AccessTokenLifetime > 0 {
+		return realm.AccessTokenLifetime
+	}
+
+	return environment.AccessTokenLifetime
+}
+
+func (realm *Realm) GetRefreshTokenLifetime(environment *Environment) int64 {
+	if realm.RefreshTokenLifetime > 0 {
+		return realm.RefreshTokenLifetime
+	}
+
+	return environment.RefreshTokenLifetime
+}
+
+func (realm *Realm) GetActualSecretLifetime(environment *Environment) int64 {
+	if realm.ActualSecretLifetime > 0 {
+		return realm.ActualSecretLifetime
+	}
+
+	return environment.ActualSecretLifetime
+}
+
+type Realms struct {
+	Default *Realm
+	realms  []*Realm
+}
+
+// Resolve returns realm served on host of request or realm which path prefix matches path of request,
+// the second value is the path without realm prefix. Requests matching no realm belong to default realm
+func (realms *Realms) Resolve(host string, path string) (*Realm, string) {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+
+	for _, realm := range realms.realms {
+		for _, h := range realm.Hosts {
+			if strings.EqualFold(h, host) {
+				return realm, path
+			}
+		}
+	}
+
+	for _, realm := range realms.realms {
+		if realm.PathPrefix == "" {
+			continue
+		}
+
+		if path == realm.PathPrefix || strings.HasPrefix(path, realm.PathPrefix+"/") {
+			return realm, "/" + strings.TrimLeft(strings.TrimPrefix(path, realm.PathPrefix), "/")
+		}
+	}
+
+	return realms.Default, path
+}
+
+func (realms *Realms) GetRealm(name string) *Realm {
+	for _, realm := range realms.realms {
+		if realm.Name == name {
+			return realm
+		}
+	}
+
+	return nil
+}
+
+func (realms *Realms) List() []*Realm {
+	return realms.realms
+}
+
+func InitRealms(environment *Environment) *Realms {
+	var list []*Realm
+
+	if environment.RealmsFile != "" {
+		content, err := ioutil.ReadFile(environment.RealmsFile)
+		if err != nil {
+			panic(err)
+		}
+
+		err = json.Unmarshal(content, &list)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	realms := &Realms{}
+
+	for _, realm := range list {
+		if !realmNameRE.MatchString(realm.Name) {
+			panic(fmt.Sprintf("incorrect realm name %q", realm.Name))
+		}
+
+		if realms.GetRealm(realm.Name) != nil {
+			panic(fmt.Sprintf("realm %s is declared twice", realm.Name))
+		}
+
+		realm.PathPrefix = strings.TrimRight(realm.PathPrefix, "/")
+		realms.realms = append(realms.realms, realm)
+	}
+
+	realms.Default = realms.GetRealm(DefaultRealm)
+	if realms.Default == nil {
+		realms.Default = &Realm{Name: DefaultRealm}
+		realms.realms = append(realms.realms, realms.Default)
+	}
+
+	log.Log().Msg(fmt.Sprintf("%d realms successfully loaded", len(realms.realms)))
+	return realms
+}
@@ -0,0 +1,82 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/models"
+	"context"
+	"fmt"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+func decide(userView *models.UserView, check *models.AuthorizationCheck) *models.AuthorizationDecision {
+	permission := check.GetPermission()
+
+	if functools.HasPermission(userView.Permissions, permission) {
+		return &models.AuthorizationDecision{Allowed: true, Reason: fmt.Sprintf("permission %s is granted", permission)}
+	}
+
+	if uuid.Equal(check.OrganizationID, uuid.Nil) {
+		return &models.AuthorizationDecision{Reason: fmt.Sprintf("permission %s is not granted", permission)}
+	}
+
+	organization := userView.GetOrganization(check.OrganizationID)
+	if organization == nil {
+		return &models.AuthorizationDecision{Reason: fmt.Sprintf("user is not a member of organization %s", check.OrganizationID)}
+	}
+
+	if functools.HasPermission(organization.Permissions, permission) {
+		return &models.AuthorizationDecision{Allowed: true, Reason: fmt.Sprintf("permission %s is granted within organization %s", permission, check.OrganizationID)}
+	}
+
+	return &models.AuthorizationDecision{Reason: fmt.Sprintf("permission %s is not granted within organization %s", permission, check.OrganizationID)}
+}
+
+// Authorize decides whether user may perform actions on resources by permissions granted by roles of user,
+// decisions are made in order of checks and cached until view of user changes, inactive users are denied everything
+func (controller *Controller) Authorize(ctx context.Context, userID uuid.UUID, checks []*models.AuthorizationCheck) (int, *models.Authorization) {
+	for _, check := range checks {
+		if !functools.IsPermission(check.GetPermission()) {
+			return enums.IncorrectPermission, nil
+		}
+	}
+
+	userView := controller.store.GetUserView(ctx, userID)
+	if userView == nil {
+		return enums.UserNotFound, nil
+	}
+
+	decisions := make([]*models.AuthorizationDecision, len(checks))
+
+	// blocked and suspended users keep their roles, so their checks are denied before cached decisions are read
+	if !userView.IsActive(time.Now().UnixNano() / int64(time.Millisecond)) {
+		for i := range checks {
+			decisions[i] = &models.AuthorizationDecision{Reason: fmt.Sprintf("user is %s", userView.Status)}
+		}
+
+		return enums.Ok, &models.Authorization{
+			UserID:    userView.Id,
+			Version:   userView.Version,
+			Decisions: decisions,
+		}
+	}
+
+	lifetime := time.Second * time.Duration(controller.environment.AuthorizationDecisionLifetime)
+
+	for i, check := range checks {
+		decision := controller.store.GetAuthorizationDecisionFromCache(ctx, userView, check)
+		if decision == nil {
+			decision = decide(userView, check)
+			controller.store.CacheAuthorizationDecision(ctx, userView, check, decision, lifetime)
+		}
+
+		decisions[i] = decision
+	}
+
+	return enums.Ok, &models.Authorization{
+		UserID:    userView.Id,
+		Version:   userView.Version,
+		Decisions: decisions,
+	}
+}
@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestAuthorize(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	organizationID := uuid.NewV4()
+	userView := &models.UserView{
+		Id:          uuid.NewV4(),
+		Version:     3,
+		Permissions: []string{"users:read"},
+		Organizations: []*models.UserViewOrganization{
+			{Id: organizationID, Permissions: []string{"invoices:*"}},
+		},
+	}
+
+	checks := []*models.AuthorizationCheck{
+		{Action: "read", Resource: "users"},
+		{Action: "delete", Resource: "users"},
+		{Action: "create", Resource: "invoices", OrganizationID: organizationID},
+		{Action: "create", Resource: "invoices", OrganizationID: uuid.NewV4()},
+		{Action: "create", Resource: "roles"},
+	}
+
+	controller.
+		Store.
+		EXPECT().
+		GetUserView(ctx, userView.Id).
+		Return(userView).
+		Times(1)
+
+	cached := &models.AuthorizationDecision{Allowed: true, Reason: "cached"}
+	controller.
+		Store.
+		EXPECT().
+		GetAuthorizationDecisionFromCache(ctx, userView, checks[4]).
+		Return(cached).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		GetAuthorizationDecisionFromCache(ctx, userView, gomock.Any()).
+		Return(nil).
+		Times(4)
+
+	controller.
+		Store.
+		EXPECT().
+		CacheAuthorizationDecision(ctx, userView, gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(4)
+
+	status, authorization := controller.Controller.Authorize(ctx, userView.Id, checks)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, userView.Id, authorization.UserID)
+	require.Equal(t, int64(3), authorization.Version)
+
+	allowed := make([]bool, len(authorization.Decisions))
+	for i, decision := range authorization.Decisions {
+		allowed[i] = decision.Allowed
+	}
+
+	require.Equal(t, []bool{true, false, true, false, true}, allowed)
+	require.Equal(t, cached, authorization.Decisions[4])
+}
+
+func TestAuthorizeWithIncorrectCheck(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+
+	status, authorization := controller.Controller.Authorize(context.Background(), uuid.NewV4(), []*models.AuthorizationCheck{
+		{Action: "read", Resource: ""},
+	})
+	require.Equal(t, enums.IncorrectPermission, status)
+	require.Nil(t, authorization)
+}
+
+func TestAuthorizeInactiveUser(t *testing.T) {
+	t.Parallel()
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	userViews := map[string]*models.UserView{
+		"blocked": {
+			Id:          uuid.NewV4(),
+			Status:      enums.UserBlocked,
+			Permissions: []string{"users:*"},
+		},
+		"suspended": {
+			Id:             uuid.NewV4(),
+			Status:         enums.UserSuspended,
+			SuspendedUntil: now + 60000,
+			Permissions:    []string{"users:*"},
+		},
+	}
+
+	for name, userView := range userViews {
+		name, userView := name, userView
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			controller := InitControllerWithMockedInternals(ctrl)
+			ctx := context.Background()
+
+			controller.
+				Store.
+				EXPECT().
+				GetUserView(ctx, userView.Id).
+				Return(userView).
+				Times(1)
+
+			checks := []*models.AuthorizationCheck{
+				{Action: "read", Resource: "users"},
+				{Action: "delete", Resource: "users"},
+			}
+
+			status, authorization := controller.Controller.Authorize(ctx, userView.Id, checks)
+			require.Equal(t, enums.Ok, status)
+			require.Len(t, authorization.Decisions, len(checks))
+
+			for _, decision := range authorization.Decisions {
+				require.False(t, decision.Allowed)
+				require.Equal(t, "user is "+name, decision.Reason)
+			}
+		})
+	}
+}
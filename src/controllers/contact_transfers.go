@@ -0,0 +1,49 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (controller *Controller) GetContactTransfer(ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer) {
+	return controller.store.GetContactTransfer(ctx, id)
+}
+
+// GetUserContactTransfers returns ownership history of contacts user gave away or received, the newest go first
+func (controller *Controller) GetUserContactTransfers(ctx context.Context, userID uuid.UUID) []*models.ContactTransfer {
+	return controller.store.GetUserContactTransfers(ctx, userID)
+}
+
+// ReviewContactTransfer completes or rejects pending transfer, completed transfer moves contact to requesting user
+// unless it is the last login identifier of previous owner
+func (controller *Controller) ReviewContactTransfer(ctx context.Context, id uuid.UUID, status string) (int, *models.ContactTransfer) {
+	switch status {
+	case enums.ContactTransferCompleted:
+		s, transfer := controller.store.ApproveContactTransfer(ctx, id)
+		if s != enums.Ok {
+			return s, nil
+		}
+
+		identifiers := []uuid.UUID{transfer.FromUserId, transfer.ToUserId}
+		if transfer.Kind == enums.PhoneContact {
+			controller.OnPhoneChanged(ctx, identifiers)
+		} else {
+			controller.OnEmailChanged(ctx, identifiers)
+		}
+
+		controller.OnContactTransferredV1(ctx, transfer)
+		return enums.Ok, transfer
+	case enums.ContactTransferRejected:
+		s, transfer := controller.store.RejectContactTransfer(ctx, id)
+		if s != enums.Ok {
+			return s, nil
+		}
+
+		controller.OnContactTransferRejectedV1(ctx, transfer)
+		return enums.Ok, transfer
+	default:
+		return enums.IncorrectContactTransferStatus, nil
+	}
+}
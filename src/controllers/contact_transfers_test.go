@@ -0,0 +1,138 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateEmailRequestsContactTransfer(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	controller.Controller.environment.ContactTransferConfirmation = true
+	ctx := context.Background()
+
+	userID, ownerID := uuid.NewV4(), uuid.NewV4()
+	value := "test@test.com"
+	transfer := &models.ContactTransfer{Id: uuid.NewV4(), Kind: enums.EmailContact, Value: value,
+		FromUserId: ownerID, ToUserId: userID, Status: enums.ContactTransferPending}
+
+	controller.
+		Store.
+		EXPECT().
+		GetEmailConfirmationCode(ctx, value).
+		Return("123456").
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		GetEmail(ctx, value).
+		Return(enums.Ok, &models.Email{Id: uuid.NewV4(), UserId: ownerID, Value: value}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		RequestContactTransfer(ctx, enums.EmailContact, value, ownerID, userID).
+		Return(enums.Ok, transfer).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "contactTransferRequested", int32(1), gomock.Any()).
+		Times(1)
+
+	status, email, requested := controller.Controller.CreateEmail(ctx, value, "123456", userID)
+	require.Equal(t, enums.ContactTransferRequested, status)
+	require.Nil(t, email)
+	require.Equal(t, transfer, requested)
+}
+
+func TestCreatePhoneTransfersContact(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID, ownerID := uuid.NewV4(), uuid.NewV4()
+	value := "+7 923 456-78-90"
+	phone := &models.Phone{Id: uuid.NewV4(), UserId: userID, Value: value}
+	transfer := &models.ContactTransfer{Id: uuid.NewV4(), Kind: enums.PhoneContact, Value: value,
+		FromUserId: ownerID, ToUserId: userID, Status: enums.ContactTransferCompleted}
+
+	controller.
+		Store.
+		EXPECT().
+		GetPhoneConfirmationCode(ctx, value).
+		Return("123456").
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		GetPhone(ctx, value).
+		Return(enums.Ok, &models.Phone{Id: uuid.NewV4(), UserId: ownerID, Value: value}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CreatePhone(ctx, userID, value).
+		Return(enums.Ok, phone, transfer).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "contactTransferred", int32(1), gomock.Any()).
+		Times(1)
+
+	expectUserChanged(controller, userID, ownerID)
+
+	status, created, transferred := controller.Controller.CreatePhone(ctx, "+79234567890", "123456", userID)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, phone, created)
+	require.Equal(t, transfer, transferred)
+}
+
+func TestReviewContactTransfer(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	transfer := &models.ContactTransfer{Id: uuid.NewV4(), Kind: enums.EmailContact, Value: "test@test.com",
+		FromUserId: uuid.NewV4(), ToUserId: uuid.NewV4(), Status: enums.ContactTransferCompleted}
+
+	status, _ := controller.Controller.ReviewContactTransfer(ctx, transfer.Id, enums.ContactTransferPending)
+	require.Equal(t, enums.IncorrectContactTransferStatus, status)
+
+	controller.
+		Store.
+		EXPECT().
+		ApproveContactTransfer(ctx, transfer.Id).
+		Return(enums.Ok, transfer).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "contactTransferred", int32(1), gomock.Any()).
+		Times(1)
+
+	expectUserChanged(controller, transfer.FromUserId, transfer.ToUserId)
+
+	status, approved := controller.Controller.ReviewContactTransfer(ctx, transfer.Id, enums.ContactTransferCompleted)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, transfer, approved)
+}
@@ -45,25 +45,42 @@ func (controller *Controller) validateEmail(ctx context.Context, email string, c
 	return status, email
 }
 
-func (controller *Controller) CreateEmail(ctx context.Context, email string, code string, userId uuid.UUID) (int, *models.Email) {
+// CreateEmail adds confirmed email to user. Email owned by another user is moved and previous owner is notified,
+// with required confirmation pending transfer is created instead and previous owner should approve it
+func (controller *Controller) CreateEmail(ctx context.Context, email string, code string, userId uuid.UUID) (int, *models.Email, *models.ContactTransfer) {
 
 	status, email := controller.validateEmail(ctx, email, code)
 
 	if status != enums.Ok {
-		return status, nil
+		return status, nil, nil
 	}
 
 	_, oldEmail := controller.store.GetEmail(ctx, email)
 
+	if oldEmail != nil && !uuid.Equal(oldEmail.UserId, userId) && controller.environment.ContactTransferConfirmation {
+		status, transfer := controller.store.RequestContactTransfer(ctx, enums.EmailContact, email, oldEmail.UserId, userId)
+		if status != enums.Ok {
+			return status, nil, nil
+		}
+
+		controller.OnContactTransferRequestedV1(ctx, transfer)
+		return enums.ContactTransferRequested, nil, transfer
+	}
+
+	status, emailObject, transfer := controller.store.CreateEmail(ctx, userId, email)
+	if status != enums.Ok {
+		return status, nil, nil
+	}
+
 	identifiers := []uuid.UUID{userId}
 
-	if oldEmail != nil {
-		identifiers = append(identifiers, oldEmail.UserId)
+	if transfer != nil {
+		identifiers = append(identifiers, transfer.FromUserId)
+		controller.OnContactTransferredV1(ctx, transfer)
 	}
 
-	status, phoneObject := controller.store.CreateEmail(ctx, userId, email)
-	controller.OnEmailChanged(identifiers)
-	return status, phoneObject
+	controller.OnEmailChanged(ctx, identifiers)
+	return enums.Ok, emailObject, transfer
 }
 
 func (controller *Controller) CreateEmailConfirmation(ctx context.Context, email string) (int, *models.EmailConfirmation) {
@@ -76,6 +93,36 @@ func (controller *Controller) CreateEmailConfirmation(ctx context.Context, email
 
 	code := controller.store.GetRandomCodeForEmailConfirmation()
 	emailConfirmation := controller.store.CreateEmailConfirmationCode(ctx, email, code, time.Minute*15)
-	controller.OnEmailCodeConfirmationCreated(email, code)
+	controller.OnEmailCodeConfirmationCreated(ctx, email, code)
 	return enums.Ok, emailConfirmation
 }
+
+func (controller *Controller) GetEmailByID(ctx context.Context, id uuid.UUID) (int, *models.Email) {
+	return controller.store.GetEmailByID(ctx, id)
+}
+
+// GetUserEmails returns emails of user, primary email goes first
+func (controller *Controller) GetUserEmails(ctx context.Context, userID uuid.UUID) []*models.Email {
+	return controller.store.GetUserEmails(ctx, userID)
+}
+
+// DeleteEmail removes email of user, the last email or phone of user can't be removed since user couldn't log in
+func (controller *Controller) DeleteEmail(ctx context.Context, id uuid.UUID) (int, *models.Email) {
+	status, email := controller.store.DeleteEmail(ctx, id)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	controller.OnEmailChanged(ctx, []uuid.UUID{email.UserId})
+	return enums.Ok, email
+}
+
+func (controller *Controller) SetPrimaryEmail(ctx context.Context, id uuid.UUID) (int, *models.Email) {
+	status, email := controller.store.SetPrimaryEmail(ctx, id)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	controller.OnEmailChanged(ctx, []uuid.UUID{email.UserId})
+	return enums.Ok, email
+}
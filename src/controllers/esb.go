@@ -5,6 +5,7 @@ import (
 	"hive/functools"
 	"hive/inout"
 	"hive/models"
+	"hive/repositories"
 	"hive/stores"
 	"context"
 	"github.com/opentracing/opentracing-go"
@@ -21,8 +22,13 @@ type EventController struct {
 
 // Private methods / Implementation
 
-func (controller *Controller) onUserChanged(userId []uuid.UUID) {
-	ctx := context.Background()
+// detachContext keeps only realm of request, so handlers of events are not cancelled together with request
+func detachContext(ctx context.Context) context.Context {
+	return repositories.SetRealmToContext(context.Background(), repositories.GetRealmFromContext(ctx))
+}
+
+func (controller *Controller) onUserChanged(ctx context.Context, userId []uuid.UUID) {
+	ctx = detachContext(ctx)
 	span, ctx := opentracing.StartSpanFromContext(ctx, "OnUserChanged")
 	controller.CreateOrUpdateUsersView(ctx, userId)
 	span.LogFields(log.String("user_id", strings.Join(functools.UUIDListToStringList(userId), ", ")))
@@ -30,8 +36,8 @@ func (controller *Controller) onUserChanged(userId []uuid.UUID) {
 	ctx.Done()
 }
 
-func (controller *Controller) onPhoneChanged(userId []uuid.UUID) {
-	ctx := context.Background()
+func (controller *Controller) onPhoneChanged(ctx context.Context, userId []uuid.UUID) {
+	ctx = detachContext(ctx)
 	span, ctx := opentracing.StartSpanFromContext(ctx, "OnPhoneChanged")
 	controller.CreateOrUpdateUsersView(ctx, userId)
 	span.LogFields(log.String("user_id", strings.Join(functools.UUIDListToStringList(userId), ", ")))
@@ -39,8 +45,8 @@ func (controller *Controller) onPhoneChanged(userId []uuid.UUID) {
 	ctx.Done()
 }
 
-func (controller *Controller) onEmailChanged(userId []uuid.UUID) {
-	ctx := context.Background()
+func (controller *Controller) onEmailChanged(ctx context.Context, userId []uuid.UUID) {
+	ctx = detachContext(ctx)
 	span, ctx := opentracing.StartSpanFromContext(ctx, "OnEmailChanged")
 	controller.CreateOrUpdateUsersView(ctx, userId)
 	span.LogFields(log.String("user_id", strings.Join(functools.UUIDListToStringList(userId), ", ")))
@@ -48,8 +54,8 @@ func (controller *Controller) onEmailChanged(userId []uuid.UUID) {
 	ctx.Done()
 }
 
-func (controller *Controller) onRoleChanged(roleId []uuid.UUID) {
-	ctx := context.Background()
+func (controller *Controller) onRoleChanged(ctx context.Context, roleId []uuid.UUID) {
+	ctx = detachContext(ctx)
 	span, ctx := opentracing.StartSpanFromContext(ctx, "OnRoleChanged")
 	controller.CreateOrUpdateUsersViewByRoles(ctx, roleId)
 	span.LogFields(log.String("role_id", strings.Join(functools.UUIDListToStringList(roleId), "")))
@@ -57,8 +63,19 @@ func (controller *Controller) onRoleChanged(roleId []uuid.UUID) {
 	ctx.Done()
 }
 
-func (controller *Controller) onUsersViewChanged(usersView []*models.UserView) {
-	ctx := context.Background()
+// onGroupChanged recalculates views of all group members at once
+func (controller *Controller) onGroupChanged(ctx context.Context, groupId uuid.UUID) {
+	ctx = detachContext(ctx)
+	span, ctx := opentracing.StartSpanFromContext(ctx, "OnGroupChanged")
+	controller.CreateOrUpdateUsersView(ctx, controller.store.GetGroupUsersID(ctx, groupId))
+
+	span.LogFields(log.String("group_id", groupId.String()))
+	span.Finish()
+	ctx.Done()
+}
+
+func (controller *Controller) onUsersViewChanged(ctx context.Context, usersView []*models.UserView) {
+	ctx = detachContext(ctx)
 	span, ctx := opentracing.StartSpanFromContext(ctx, "OnUserViewChanged")
 	identifiers := make([]uuid.UUID, len(usersView))
 
@@ -67,7 +84,7 @@ func (controller *Controller) onUsersViewChanged(usersView []*models.UserView) {
 	}
 
 	controller.store.CacheUserView(ctx, usersView)
-	controller.dispatcher.Send("userView", 1, &inout.ChangedUserViewsEventV1{
+	controller.dispatcher.Send(ctx, "userView", 1, &inout.ChangedUserViewsEventV1{
 		Identifiers: functools.UUIDListToStringList(identifiers),
 	})
 	span.LogFields(log.String("user_id", functools.UUIDListToString(identifiers, ", ")))
@@ -75,62 +92,277 @@ func (controller *Controller) onUsersViewChanged(usersView []*models.UserView) {
 	ctx.Done()
 }
 
-func (controller *Controller) onPhoneCodeConfirmationCreated(phone string, code string) {
-	controller.dispatcher.Send("phoneConfirmation", 1, &inout.CreatePhoneConfirmationEventV1{
+func (controller *Controller) onPhoneCodeConfirmationCreated(ctx context.Context, phone string, code string) {
+	controller.dispatcher.Send(ctx, "phoneConfirmation", 1, &inout.CreatePhoneConfirmationEventV1{
 		Phone: phone,
 		Code:  code,
 	})
 }
 
-func (controller *Controller) onEmailCodeConfirmationCreated(email string, code string) {
-	controller.dispatcher.Send("emailConfirmation", 1, &inout.CreateEmailConfirmationEventV1{
+func (controller *Controller) onEmailCodeConfirmationCreated(ctx context.Context, email string, code string) {
+	controller.dispatcher.Send(ctx, "emailConfirmation", 1, &inout.CreateEmailConfirmationEventV1{
 		Email: email,
 		Code:  code,
 	})
 }
 
-func (controller *Controller) onSecretCreatedV1(secret *models.Secret) {
-	controller.dispatcher.Send("secret", 1, &inout.SecretCreatedV1{
+func (controller *Controller) onSecretCreatedV1(ctx context.Context, secret *models.Secret) {
+	controller.dispatcher.Send(ctx, "secret", 1, &inout.SecretCreatedV1{
 		Id:      secret.Id.Bytes(),
 		Created: secret.Created,
 		Value:   secret.Value.Bytes(),
+		Expires: secret.Expires,
+	})
+}
+
+func (controller *Controller) onSecretRevokedV1(ctx context.Context, secret *models.Secret) {
+	controller.dispatcher.Send(ctx, "secretRevoked", 1, &inout.SecretRevokedV1{
+		Id:      secret.Id.Bytes(),
+		Revoked: secret.Revoked,
+	})
+}
+
+func (controller *Controller) onRoleUpdatedV1(ctx context.Context, role *models.Role) {
+	controller.dispatcher.Send(ctx, "role", 1, &inout.RoleUpdatedV1{
+		Id:          role.Id.Bytes(),
+		Title:       role.Title,
+		Description: role.Description,
+	})
+}
+
+func (controller *Controller) onRoleDeletedV1(ctx context.Context, role *models.Role) {
+	controller.dispatcher.Send(ctx, "roleDeleted", 1, &inout.RoleDeletedV1{
+		Id:    role.Id.Bytes(),
+		Title: role.Title,
+	})
+}
+
+func userRoleToEvent(userRole *models.UserRole) *inout.UserRoleEventV1 {
+	return &inout.UserRoleEventV1{
+		Id:             userRole.Id.Bytes(),
+		UserID:         userRole.UserId.Bytes(),
+		RoleID:         userRole.RoleId.Bytes(),
+		OrganizationID: userRole.OrganizationId.Bytes(),
+		StartsAt:       userRole.StartsAt,
+		ExpiresAt:      userRole.ExpiresAt,
+	}
+}
+
+func (controller *Controller) onUserRoleStartedV1(ctx context.Context, userRole *models.UserRole) {
+	controller.dispatcher.Send(ctx, "userRoleStarted", 1, userRoleToEvent(userRole))
+}
+
+func (controller *Controller) onUserRoleExpiredV1(ctx context.Context, userRole *models.UserRole) {
+	controller.dispatcher.Send(ctx, "userRoleExpired", 1, userRoleToEvent(userRole))
+}
+
+// roleRequestToEvent lists roles which users could review request, so notifications reach approvers
+func (controller *Controller) roleRequestToEvent(ctx context.Context, request *models.RoleRequest) *inout.RoleRequestEventV1 {
+	approversRolesID := controller.store.GetRoleManagersID(ctx, request.RoleId)
+
+	return &inout.RoleRequestEventV1{
+		Id:               request.Id.Bytes(),
+		UserID:           request.UserId.Bytes(),
+		RoleID:           request.RoleId.Bytes(),
+		Reason:           request.Reason,
+		Status:           request.Status,
+		ExpiresAt:        request.ExpiresAt,
+		ReviewerID:       request.ReviewerId.Bytes(),
+		Comment:          request.Comment,
+		UserRoleID:       request.UserRoleId.Bytes(),
+		ApproversRolesID: functools.UUIDSliceToByteArraySlice(approversRolesID),
+	}
+}
+
+func (controller *Controller) onRoleRequestCreatedV1(ctx context.Context, request *models.RoleRequest) {
+	controller.dispatcher.Send(ctx, "roleRequestCreated", 1, controller.roleRequestToEvent(ctx, request))
+}
+
+func (controller *Controller) onRoleRequestApprovedV1(ctx context.Context, request *models.RoleRequest) {
+	controller.dispatcher.Send(ctx, "roleRequestApproved", 1, controller.roleRequestToEvent(ctx, request))
+}
+
+func (controller *Controller) onRoleRequestDeniedV1(ctx context.Context, request *models.RoleRequest) {
+	controller.dispatcher.Send(ctx, "roleRequestDenied", 1, controller.roleRequestToEvent(ctx, request))
+}
+
+func (controller *Controller) onRoleRequestCancelledV1(ctx context.Context, request *models.RoleRequest) {
+	controller.dispatcher.Send(ctx, "roleRequestCancelled", 1, controller.roleRequestToEvent(ctx, request))
+}
+
+func (controller *Controller) onUserStatusChangedV1(ctx context.Context, user *models.User) {
+	controller.dispatcher.Send(ctx, "userStatusChanged", 1, &inout.UserStatusChangedV1{
+		Id:             user.Id.Bytes(),
+		Status:         user.Status,
+		SuspendedUntil: user.SuspendedUntil,
+		Reason:         user.StatusReason,
+		ActorID:        user.StatusActorId.Bytes(),
+		Changed:        user.StatusChanged,
+	})
+}
+
+func (controller *Controller) onUserExportCompletedV1(ctx context.Context, export *models.UserExport) {
+	controller.dispatcher.Send(ctx, "userExportCompleted", 1, &inout.UserExportCompletedV1{
+		Id:          export.Id.Bytes(),
+		UserID:      export.UserId.Bytes(),
+		RequesterID: export.RequesterId.Bytes(),
+		Status:      export.Status,
+		Expires:     export.Expires,
+	})
+}
+
+func (controller *Controller) onUserDeletionRequestedV1(ctx context.Context, user *models.User) {
+	controller.dispatcher.Send(ctx, "userDeletionRequested", 1, &inout.UserDeletionRequestedV1{
+		Id:        user.Id.Bytes(),
+		Scheduled: user.DeletionScheduled,
+	})
+}
+
+func (controller *Controller) onUserDeletionCancelledV1(ctx context.Context, user *models.User) {
+	controller.dispatcher.Send(ctx, "userDeletionCancelled", 1, &inout.UserDeletionCancelledV1{
+		Id: user.Id.Bytes(),
 	})
 }
 
+func (controller *Controller) onUserAnonymizedV1(ctx context.Context, user *models.User) {
+	controller.dispatcher.Send(ctx, "userAnonymized", 1, &inout.UserAnonymizedV1{
+		Id:         user.Id.Bytes(),
+		Anonymized: user.StatusChanged,
+	})
+}
+
+func contactTransferToEvent(transfer *models.ContactTransfer) *inout.ContactTransferV1 {
+	return &inout.ContactTransferV1{
+		Id:         transfer.Id.Bytes(),
+		Kind:       transfer.Kind,
+		Value:      transfer.Value,
+		FromUserID: transfer.FromUserId.Bytes(),
+		ToUserID:   transfer.ToUserId.Bytes(),
+		Status:     transfer.Status,
+		Created:    transfer.Created,
+		Resolved:   transfer.Resolved,
+	}
+}
+
+// Previous owner is notified through these events, so contact is never moved silently
+func (controller *Controller) onContactTransferRequestedV1(ctx context.Context, transfer *models.ContactTransfer) {
+	controller.dispatcher.Send(ctx, "contactTransferRequested", 1, contactTransferToEvent(transfer))
+}
+
+func (controller *Controller) onContactTransferredV1(ctx context.Context, transfer *models.ContactTransfer) {
+	controller.dispatcher.Send(ctx, "contactTransferred", 1, contactTransferToEvent(transfer))
+}
+
+func (controller *Controller) onContactTransferRejectedV1(ctx context.Context, transfer *models.ContactTransfer) {
+	controller.dispatcher.Send(ctx, "contactTransferRejected", 1, contactTransferToEvent(transfer))
+}
+
 // Public methods / Header
 
-func (controller *Controller) OnEmailCodeConfirmationCreated(email string, code string) {
-	controller.onEmailCodeConfirmationCreated(email, code)
+func (controller *Controller) OnEmailCodeConfirmationCreated(ctx context.Context, email string, code string) {
+	controller.onEmailCodeConfirmationCreated(ctx, email, code)
 }
 
-func (controller *Controller) OnPhoneCodeConfirmationCreated(phone string, code string) {
-	controller.onPhoneCodeConfirmationCreated(phone, code)
+func (controller *Controller) OnPhoneCodeConfirmationCreated(ctx context.Context, phone string, code string) {
+	controller.onPhoneCodeConfirmationCreated(ctx, phone, code)
 }
 
-func (controller *Controller) OnUsersViewChanged(usersView []*models.UserView) {
-	controller.onUsersViewChanged(usersView)
+func (controller *Controller) OnUsersViewChanged(ctx context.Context, usersView []*models.UserView) {
+	controller.onUsersViewChanged(ctx, usersView)
 }
 
-func (controller *Controller) OnPasswordChanged(userId uuid.UUID) {
+func (controller *Controller) OnPasswordChanged(ctx context.Context, userId uuid.UUID) {
 	// Todo tokens invalidation
 }
 
-func (controller *Controller) OnUserChanged(id []uuid.UUID) {
-	controller.onUserChanged(id)
+func (controller *Controller) OnUserChanged(ctx context.Context, id []uuid.UUID) {
+	controller.onUserChanged(ctx, id)
+}
+
+func (controller *Controller) OnEmailChanged(ctx context.Context, userId []uuid.UUID) {
+	controller.onEmailChanged(ctx, userId)
+}
+
+func (controller *Controller) OnPhoneChanged(ctx context.Context, userId []uuid.UUID) {
+	controller.onPhoneChanged(ctx, userId)
+}
+
+func (controller *Controller) OnRoleChanged(ctx context.Context, roleId []uuid.UUID) {
+	controller.onRoleChanged(ctx, roleId)
+}
+
+func (controller *Controller) OnGroupChanged(ctx context.Context, groupId uuid.UUID) {
+	controller.onGroupChanged(ctx, groupId)
+}
+
+func (controller *Controller) OnSecretCreatedV1(ctx context.Context, secret *models.Secret) {
+	controller.onSecretCreatedV1(ctx, secret)
+}
+
+func (controller *Controller) OnSecretRevokedV1(ctx context.Context, secret *models.Secret) {
+	controller.onSecretRevokedV1(ctx, secret)
+}
+
+func (controller *Controller) OnRoleUpdatedV1(ctx context.Context, role *models.Role) {
+	controller.onRoleUpdatedV1(ctx, role)
+}
+
+func (controller *Controller) OnRoleDeletedV1(ctx context.Context, role *models.Role) {
+	controller.onRoleDeletedV1(ctx, role)
+}
+
+func (controller *Controller) OnUserRoleStartedV1(ctx context.Context, userRole *models.UserRole) {
+	controller.onUserRoleStartedV1(ctx, userRole)
+}
+
+func (controller *Controller) OnUserRoleExpiredV1(ctx context.Context, userRole *models.UserRole) {
+	controller.onUserRoleExpiredV1(ctx, userRole)
+}
+
+func (controller *Controller) OnRoleRequestCreatedV1(ctx context.Context, request *models.RoleRequest) {
+	controller.onRoleRequestCreatedV1(ctx, request)
+}
+
+func (controller *Controller) OnRoleRequestApprovedV1(ctx context.Context, request *models.RoleRequest) {
+	controller.onRoleRequestApprovedV1(ctx, request)
+}
+
+func (controller *Controller) OnRoleRequestDeniedV1(ctx context.Context, request *models.RoleRequest) {
+	controller.onRoleRequestDeniedV1(ctx, request)
+}
+
+func (controller *Controller) OnRoleRequestCancelledV1(ctx context.Context, request *models.RoleRequest) {
+	controller.onRoleRequestCancelledV1(ctx, request)
+}
+
+func (controller *Controller) OnUserStatusChangedV1(ctx context.Context, user *models.User) {
+	controller.onUserStatusChangedV1(ctx, user)
+}
+
+func (controller *Controller) OnUserExportCompletedV1(ctx context.Context, export *models.UserExport) {
+	controller.onUserExportCompletedV1(ctx, export)
+}
+
+func (controller *Controller) OnUserDeletionRequestedV1(ctx context.Context, user *models.User) {
+	controller.onUserDeletionRequestedV1(ctx, user)
+}
+
+func (controller *Controller) OnUserDeletionCancelledV1(ctx context.Context, user *models.User) {
+	controller.onUserDeletionCancelledV1(ctx, user)
 }
 
-func (controller *Controller) OnEmailChanged(userId []uuid.UUID) {
-	controller.onEmailChanged(userId)
+func (controller *Controller) OnUserAnonymizedV1(ctx context.Context, user *models.User) {
+	controller.onUserAnonymizedV1(ctx, user)
 }
 
-func (controller *Controller) OnPhoneChanged(userId []uuid.UUID) {
-	controller.onPhoneChanged(userId)
+func (controller *Controller) OnContactTransferRequestedV1(ctx context.Context, transfer *models.ContactTransfer) {
+	controller.onContactTransferRequestedV1(ctx, transfer)
 }
 
-func (controller *Controller) OnRoleChanged(roleId []uuid.UUID) {
-	controller.onRoleChanged(roleId)
+func (controller *Controller) OnContactTransferredV1(ctx context.Context, transfer *models.ContactTransfer) {
+	controller.onContactTransferredV1(ctx, transfer)
 }
 
-func (controller *Controller) OnSecretCreatedV1(secret *models.Secret) {
-	controller.onSecretCreatedV1(secret)
+func (controller *Controller) OnContactTransferRejectedV1(ctx context.Context, transfer *models.ContactTransfer) {
+	controller.onContactTransferRejectedV1(ctx, transfer)
 }
@@ -0,0 +1,88 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"hive/repositories"
+	"context"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (controller *Controller) CreateGroup(ctx context.Context, title string, description string) (int, *models.Group) {
+	return controller.store.CreateGroup(ctx, title, description)
+}
+
+func (controller *Controller) UpdateGroup(ctx context.Context, id uuid.UUID, title string, description string) (int, *models.Group) {
+	return controller.store.UpdateGroup(ctx, id, title, description)
+}
+
+// DeleteGroup deletes group, views of its members are recalculated since roles of group are revoked from them
+func (controller *Controller) DeleteGroup(ctx context.Context, id uuid.UUID) (int, *models.Group) {
+	// Memberships are deleted together with group, so members are found beforehand
+	usersID := controller.store.GetGroupUsersID(ctx, id)
+
+	status, group := controller.store.DeleteGroup(ctx, id)
+
+	if status == enums.Ok {
+		controller.OnUserChanged(ctx, usersID)
+	}
+
+	return status, group
+}
+
+func (controller *Controller) GetGroup(ctx context.Context, id uuid.UUID) (int, *models.Group) {
+	return controller.store.GetGroup(ctx, id)
+}
+
+func (controller *Controller) GetGroups(ctx context.Context, query repositories.GetGroupsQuery) ([]*models.Group, *models.PaginationResponse) {
+	return controller.store.GetGroups(ctx, query)
+}
+
+func (controller *Controller) CreateGroupMember(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) (int, *models.GroupMember) {
+	status, member := controller.store.CreateGroupMember(ctx, groupID, userID)
+
+	if status == enums.Ok {
+		controller.OnUserChanged(ctx, []uuid.UUID{member.UserId})
+	}
+
+	return status, member
+}
+
+func (controller *Controller) GetGroupMembers(ctx context.Context, query repositories.GetGroupMembersQuery) ([]*models.GroupMember, *models.PaginationResponse) {
+	return controller.store.GetGroupMembers(ctx, query)
+}
+
+func (controller *Controller) DeleteGroupMember(ctx context.Context, id uuid.UUID) (int, *models.GroupMember) {
+	status, member := controller.store.DeleteGroupMember(ctx, id)
+
+	if status == enums.Ok {
+		controller.OnUserChanged(ctx, []uuid.UUID{member.UserId})
+	}
+
+	return status, member
+}
+
+// CreateGroupRole grants role to every member of group
+func (controller *Controller) CreateGroupRole(ctx context.Context, groupID uuid.UUID, roleID uuid.UUID) (int, *models.GroupRole) {
+	status, groupRole := controller.store.CreateGroupRole(ctx, groupID, roleID)
+
+	if status == enums.Ok {
+		controller.OnGroupChanged(ctx, groupRole.GroupId)
+	}
+
+	return status, groupRole
+}
+
+func (controller *Controller) GetGroupRoles(ctx context.Context, query repositories.GetGroupRolesQuery) ([]*models.GroupRole, *models.PaginationResponse) {
+	return controller.store.GetGroupRoles(ctx, query)
+}
+
+func (controller *Controller) DeleteGroupRole(ctx context.Context, id uuid.UUID) (int, *models.GroupRole) {
+	status, groupRole := controller.store.DeleteGroupRole(ctx, id)
+
+	if status == enums.Ok {
+		controller.OnGroupChanged(ctx, groupRole.GroupId)
+	}
+
+	return status, groupRole
+}
@@ -0,0 +1,124 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteGroupRecalculatesMembers(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	group := &models.Group{Id: uuid.NewV4(), Title: "developers"}
+	usersID := []uuid.UUID{uuid.NewV4(), uuid.NewV4()}
+
+	controller.
+		Store.
+		EXPECT().
+		GetGroupUsersID(ctx, group.Id).
+		Return(usersID).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		DeleteGroup(ctx, group.Id).
+		Return(enums.Ok, group).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CreateOrUpdateUsersViewByUsersID(gomock.Any(), usersID).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CacheUserView(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(gomock.Any(), "userView", int32(1), gomock.Any()).
+		Times(1)
+
+	status, deleted := controller.Controller.DeleteGroup(ctx, group.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, group, deleted)
+}
+
+func TestCreateGroupRoleInEmptyGroup(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	groupRole := &models.GroupRole{Id: uuid.NewV4(), GroupId: uuid.NewV4(), RoleId: uuid.NewV4()}
+
+	controller.
+		Store.
+		EXPECT().
+		CreateGroupRole(ctx, groupRole.GroupId, groupRole.RoleId).
+		Return(enums.Ok, groupRole).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		GetGroupUsersID(gomock.Any(), groupRole.GroupId).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CreateOrUpdateUsersViewByUsersID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	status, created := controller.Controller.CreateGroupRole(ctx, groupRole.GroupId, groupRole.RoleId)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, groupRole, created)
+}
+
+func TestDeleteEmptyGroup(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	group := &models.Group{Id: uuid.NewV4()}
+
+	controller.
+		Store.
+		EXPECT().
+		GetGroupUsersID(ctx, group.Id).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		DeleteGroup(ctx, group.Id).
+		Return(enums.Ok, group).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CreateOrUpdateUsersViewByUsersID(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	status, deleted := controller.Controller.DeleteGroup(ctx, group.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, group, deleted)
+}
@@ -9,6 +9,7 @@ import (
 	"hive/models"
 	"hive/passwordProcessors"
 	"hive/repositories"
+	"hive/repositories/postgresRepository"
 	"hive/stores"
 )
 
@@ -18,11 +19,14 @@ type IController interface {
 
 	GetActualSecret(ctx context.Context) *models.Secret
 	GetSecret(ctx context.Context, id uuid.UUID) *models.Secret
+	GetSecrets(ctx context.Context, query postgresRepository.GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse)
+	RotateSecret(ctx context.Context) *models.Secret
+	RevokeSecret(ctx context.Context, id uuid.UUID) (int, *models.Secret)
 
 	// Sessions
 
-	CreateSession(ctx context.Context, userID uuid.UUID, fingerprint, userAgent string) *models.Session
-	UpdateSession(ctx context.Context, id uuid.UUID, fingerprint, userAgent string) (int, *models.Session)
+	CreateSession(ctx context.Context, userID uuid.UUID, fingerprint, userAgent, clientID, keyThumbprint string, organizationID uuid.UUID, authentication *models.AuthenticationContext) (int, *models.Session)
+	UpdateSession(ctx context.Context, id uuid.UUID, fingerprint, userAgent, clientID, keyThumbprint string) (int, *models.Session)
 
 	// Passwords
 
@@ -31,7 +35,11 @@ type IController interface {
 	// Emails
 
 	CreateEmailConfirmation(ctx context.Context, email string) (int, *models.EmailConfirmation)
-	CreateEmail(ctx context.Context, email string, code string, userId uuid.UUID) (int, *models.Email)
+	CreateEmail(ctx context.Context, email string, code string, userId uuid.UUID) (int, *models.Email, *models.ContactTransfer)
+	GetEmailByID(ctx context.Context, id uuid.UUID) (int, *models.Email)
+	GetUserEmails(ctx context.Context, userID uuid.UUID) []*models.Email
+	DeleteEmail(ctx context.Context, id uuid.UUID) (int, *models.Email)
+	SetPrimaryEmail(ctx context.Context, id uuid.UUID) (int, *models.Email)
 
 	// Users
 
@@ -39,42 +47,133 @@ type IController interface {
 	GetUsers(ctx context.Context, query repositories.GetUsersQuery) []*models.User
 	DeleteUser(ctx context.Context, id uuid.UUID) (int, *models.User)
 	GetUser(ctx context.Context, id uuid.UUID) *models.User
+	UpdateUserProfile(ctx context.Context, id uuid.UUID, attributes map[string]interface{}) (int, *models.User)
+	UpdateUserStatus(ctx context.Context, id uuid.UUID, actorID uuid.UUID, userStatus string, suspendedUntil int64, reason string) (int, *models.User)
 
 	// Phone
 
 	CreatePhoneConfirmation(ctx context.Context, phone string) (int, *models.PhoneConfirmation)
-	CreatePhone(ctx context.Context, phone string, code string, userId uuid.UUID) (int, *models.Phone)
+	CreatePhone(ctx context.Context, phone string, code string, userId uuid.UUID) (int, *models.Phone, *models.ContactTransfer)
+	GetPhoneByID(ctx context.Context, id uuid.UUID) (int, *models.Phone)
+	GetUserPhones(ctx context.Context, userID uuid.UUID) []*models.Phone
+	DeletePhone(ctx context.Context, id uuid.UUID) (int, *models.Phone)
+	SetPrimaryPhone(ctx context.Context, id uuid.UUID) (int, *models.Phone)
+
+	// Contact Transfers
+
+	GetContactTransfer(ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer)
+	GetUserContactTransfers(ctx context.Context, userID uuid.UUID) []*models.ContactTransfer
+	ReviewContactTransfer(ctx context.Context, id uuid.UUID, status string) (int, *models.ContactTransfer)
 
 	// Roles
 
 	GetRole(ctx context.Context, id uuid.UUID) (int, *models.Role)
-	CreateRole(ctx context.Context, title string) (int, *models.Role)
+	CreateRole(ctx context.Context, title string, permissions []string, parentsID []uuid.UUID) (int, *models.Role)
+	UpdateRoleParents(ctx context.Context, id uuid.UUID, parentsID []uuid.UUID) (int, *models.Role)
+	UpdateRole(ctx context.Context, id uuid.UUID, title string, description string) (int, *models.Role)
+	DeleteRole(ctx context.Context, id uuid.UUID) (int, *models.Role)
+	UpdateRoleManagedRoles(ctx context.Context, id uuid.UUID, managedRolesID []uuid.UUID) (int, *models.Role)
+	GetManageableRoles(ctx context.Context, user models.IAuthenticationBackendUser, query repositories.GetRolesQuery) ([]*models.Role, *models.PaginationResponse)
 	GetRoles(ctx context.Context, query repositories.GetRolesQuery) ([]*models.Role, *models.PaginationResponse)
 
 	// User Roles
 
+	GetUserRole(ctx context.Context, id uuid.UUID) (int, *models.UserRole)
 	GetUserRoles(ctx context.Context, query repositories.GetUserRoleQuery) ([]*models.UserRole, *models.PaginationResponse)
-	CreateUserRole(ctx context.Context, userId uuid.UUID, roleID uuid.UUID) (int, *models.UserRole)
+	CanManageUserRole(ctx context.Context, user models.IAuthenticationBackendUser, roleID uuid.UUID, permission string) bool
+	CreateUserRole(ctx context.Context, userId uuid.UUID, roleID uuid.UUID, organizationID uuid.UUID, startsAt int64, expiresAt int64) (int, *models.UserRole)
 	DeleteUserRole(ctx context.Context, id uuid.UUID) (int, *models.UserRole)
 
+	// Role Requests
+
+	CreateRoleRequest(ctx context.Context, userId uuid.UUID, roleID uuid.UUID, reason string, expiresAt int64) (int, *models.RoleRequest)
+	GetRoleRequest(ctx context.Context, id uuid.UUID) (int, *models.RoleRequest)
+	GetRoleRequests(ctx context.Context, user models.IAuthenticationBackendUser, query repositories.GetRoleRequestsQuery) ([]*models.RoleRequest, *models.PaginationResponse)
+	ReviewRoleRequest(ctx context.Context, id uuid.UUID, reviewerId uuid.UUID, status string, comment string, expiresAt int64) (int, *models.RoleRequest)
+	CancelRoleRequest(ctx context.Context, id uuid.UUID, userId uuid.UUID) (int, *models.RoleRequest)
+
+	// User Exports
+
+	CreateUserExport(ctx context.Context, userID uuid.UUID, requesterID uuid.UUID) (int, *models.UserExport)
+	GetUserExport(ctx context.Context, id uuid.UUID) (int, *models.UserExport)
+	GetUserExportArchive(ctx context.Context, id uuid.UUID, token string) (int, *models.UserExport)
+	ProcessUserExports(ctx context.Context)
+
+	// User Deletions
+
+	RequestUserDeletion(ctx context.Context, id uuid.UUID) (int, *models.User)
+	CancelUserDeletion(ctx context.Context, id uuid.UUID) (int, *models.User)
+	ProcessUserDeletions(ctx context.Context)
+
+	// Organizations
+
+	GetOrganization(ctx context.Context, id uuid.UUID) (int, *models.Organization)
+	CreateOrganization(ctx context.Context, title string) (int, *models.Organization)
+	GetOrganizations(ctx context.Context, query repositories.GetOrganizationsQuery) ([]*models.Organization, *models.PaginationResponse)
+
+	// Organization Members
+
+	GetOrganizationMembers(ctx context.Context, query repositories.GetOrganizationMembersQuery) ([]*models.OrganizationMember, *models.PaginationResponse)
+	CreateOrganizationMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) (int, *models.OrganizationMember)
+	DeleteOrganizationMember(ctx context.Context, id uuid.UUID) (int, *models.OrganizationMember)
+
+	// Groups
+
+	GetGroup(ctx context.Context, id uuid.UUID) (int, *models.Group)
+	CreateGroup(ctx context.Context, title string, description string) (int, *models.Group)
+	UpdateGroup(ctx context.Context, id uuid.UUID, title string, description string) (int, *models.Group)
+	DeleteGroup(ctx context.Context, id uuid.UUID) (int, *models.Group)
+	GetGroups(ctx context.Context, query repositories.GetGroupsQuery) ([]*models.Group, *models.PaginationResponse)
+
+	// Group Members
+
+	GetGroupMembers(ctx context.Context, query repositories.GetGroupMembersQuery) ([]*models.GroupMember, *models.PaginationResponse)
+	CreateGroupMember(ctx context.Context, groupID uuid.UUID, userID uuid.UUID) (int, *models.GroupMember)
+	DeleteGroupMember(ctx context.Context, id uuid.UUID) (int, *models.GroupMember)
+
+	// Group Roles
+
+	GetGroupRoles(ctx context.Context, query repositories.GetGroupRolesQuery) ([]*models.GroupRole, *models.PaginationResponse)
+	CreateGroupRole(ctx context.Context, groupID uuid.UUID, roleID uuid.UUID) (int, *models.GroupRole)
+	DeleteGroupRole(ctx context.Context, id uuid.UUID) (int, *models.GroupRole)
+
 	// User Views
 
 	CreateOrUpdateUsersView(ctx context.Context, id []uuid.UUID) []*models.UserView
 	CreateOrUpdateUsersViewByRoles(ctx context.Context, rolesIds []uuid.UUID) []*models.UserView
 	GetUserView(ctx context.Context, id uuid.UUID) *models.UserView
 	GetUserViews(ctx context.Context, query repositories.GetUsersViewStoreQuery) ([]*models.UserView, *models.PaginationResponse)
+	SearchUsersView(ctx context.Context, query repositories.SearchUsersViewQuery) (int, []*models.UserView, *models.PaginationResponse)
+
+	// Authorization
+
+	Authorize(ctx context.Context, userID uuid.UUID, checks []*models.AuthorizationCheck) (int, *models.Authorization)
 
 	// Events
 
-	OnUserChanged(id []uuid.UUID)
-	OnEmailCodeConfirmationCreated(email string, code string)
-	OnPhoneCodeConfirmationCreated(phone string, code string)
-	OnUsersViewChanged(usersView []*models.UserView)
-	OnPasswordChanged(userId uuid.UUID)
-	OnPhoneChanged(userId []uuid.UUID)
-	OnEmailChanged(userId []uuid.UUID)
-	OnRoleChanged(roleId []uuid.UUID)
-	OnSecretCreatedV1(secret *models.Secret)
+	OnUserChanged(ctx context.Context, id []uuid.UUID)
+	OnEmailCodeConfirmationCreated(ctx context.Context, email string, code string)
+	OnPhoneCodeConfirmationCreated(ctx context.Context, phone string, code string)
+	OnUsersViewChanged(ctx context.Context, usersView []*models.UserView)
+	OnPasswordChanged(ctx context.Context, userId uuid.UUID)
+	OnPhoneChanged(ctx context.Context, userId []uuid.UUID)
+	OnEmailChanged(ctx context.Context, userId []uuid.UUID)
+	OnRoleChanged(ctx context.Context, roleId []uuid.UUID)
+	OnGroupChanged(ctx context.Context, groupId uuid.UUID)
+	OnSecretCreatedV1(ctx context.Context, secret *models.Secret)
+	OnSecretRevokedV1(ctx context.Context, secret *models.Secret)
+	OnRoleUpdatedV1(ctx context.Context, role *models.Role)
+	OnRoleDeletedV1(ctx context.Context, role *models.Role)
+	OnUserRoleStartedV1(ctx context.Context, userRole *models.UserRole)
+	OnUserRoleExpiredV1(ctx context.Context, userRole *models.UserRole)
+	OnUserStatusChangedV1(ctx context.Context, user *models.User)
+	OnUserExportCompletedV1(ctx context.Context, export *models.UserExport)
+	OnUserDeletionRequestedV1(ctx context.Context, user *models.User)
+	OnUserDeletionCancelledV1(ctx context.Context, user *models.User)
+	OnUserAnonymizedV1(ctx context.Context, user *models.User)
+	OnContactTransferRequestedV1(ctx context.Context, transfer *models.ContactTransfer)
+	OnContactTransferredV1(ctx context.Context, transfer *models.ContactTransfer)
+	OnContactTransferRejectedV1(ctx context.Context, transfer *models.ContactTransfer)
 }
 
 type Controller struct {
@@ -83,15 +182,17 @@ type Controller struct {
 	dispatcher         eventDispatchers.IEventDispatcher
 	environment        *config.Environment
 	accessTokenEncoder models.AccessTokenEncoder
+	profileSchema      *config.ProfileSchema
 }
 
-func InitController(store stores.IStore, passwordProcessor passwordProcessors.IPasswordProcessor, dispatcher eventDispatchers.IEventDispatcher, environment *config.Environment, accessTokenEncoder models.AccessTokenEncoder) *Controller {
+func InitController(store stores.IStore, passwordProcessor passwordProcessors.IPasswordProcessor, dispatcher eventDispatchers.IEventDispatcher, environment *config.Environment, accessTokenEncoder models.AccessTokenEncoder, profileSchema *config.ProfileSchema) *Controller {
 	return &Controller{
 		store:              store,
 		passwordProcessor:  passwordProcessor,
 		dispatcher:         dispatcher,
 		environment:        environment,
 		accessTokenEncoder: accessTokenEncoder,
+		profileSchema:      profileSchema,
 	}
 }
 
@@ -108,9 +209,9 @@ func InitControllerWithMockedInternals(ctrl *gomock.Controller) *ControllerWithM
 	passwordProcessor := passwordProcessors.NewMockIPasswordProcessor(ctrl)
 	environment := config.InitEnvironment()
 	return &ControllerWithMockedInternals{
-		Controller: InitController(store, passwordProcessor, dispatcher, environment, func(_ context.Context, userID uuid.UUID, roles []string, secret *models.Secret, expires int64) string {
+		Controller: InitController(store, passwordProcessor, dispatcher, environment, func(_ context.Context, user *models.UserView, session *models.Session, clientID string, secret *models.Secret) string {
 			return ""
-		}),
+		}, config.InitProfileSchema(environment)),
 		Dispatcher:        dispatcher,
 		Store:             store,
 		PasswordProcessor: passwordProcessor,
@@ -10,6 +10,7 @@ import (
 	go_uuid "github.com/satori/go.uuid"
 	models "hive/models"
 	repositories "hive/repositories"
+	postgresRepository "hive/repositories/postgresRepository"
 	reflect "reflect"
 )
 
@@ -64,33 +65,78 @@ func (mr *MockIControllerMockRecorder) GetSecret(ctx, id interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockIController)(nil).GetSecret), ctx, id)
 }
 
-// CreateSession mocks base method
-func (m *MockIController) CreateSession(ctx context.Context, userID go_uuid.UUID, fingerprint, userAgent string) *models.Session {
+// GetSecrets mocks base method
+func (m *MockIController) GetSecrets(ctx context.Context, query postgresRepository.GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecrets", ctx, query)
+	ret0, _ := ret[0].([]*models.Secret)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetSecrets indicates an expected call of GetSecrets
+func (mr *MockIControllerMockRecorder) GetSecrets(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecrets", reflect.TypeOf((*MockIController)(nil).GetSecrets), ctx, query)
+}
+
+// RotateSecret mocks base method
+func (m *MockIController) RotateSecret(ctx context.Context) *models.Secret {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, fingerprint, userAgent)
-	ret0, _ := ret[0].(*models.Session)
+	ret := m.ctrl.Call(m, "RotateSecret", ctx)
+	ret0, _ := ret[0].(*models.Secret)
 	return ret0
 }
 
+// RotateSecret indicates an expected call of RotateSecret
+func (mr *MockIControllerMockRecorder) RotateSecret(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecret", reflect.TypeOf((*MockIController)(nil).RotateSecret), ctx)
+}
+
+// RevokeSecret mocks base method
+func (m *MockIController) RevokeSecret(ctx context.Context, id go_uuid.UUID) (int, *models.Secret) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecret", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Secret)
+	return ret0, ret1
+}
+
+// RevokeSecret indicates an expected call of RevokeSecret
+func (mr *MockIControllerMockRecorder) RevokeSecret(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecret", reflect.TypeOf((*MockIController)(nil).RevokeSecret), ctx, id)
+}
+
+// CreateSession mocks base method
+func (m *MockIController) CreateSession(ctx context.Context, userID go_uuid.UUID, fingerprint, userAgent, clientID, keyThumbprint string, organizationID go_uuid.UUID, authentication *models.AuthenticationContext) (int, *models.Session) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, fingerprint, userAgent, clientID, keyThumbprint, organizationID, authentication)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Session)
+	return ret0, ret1
+}
+
 // CreateSession indicates an expected call of CreateSession
-func (mr *MockIControllerMockRecorder) CreateSession(ctx, userID, fingerprint, userAgent interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) CreateSession(ctx, userID, fingerprint, userAgent, clientID, keyThumbprint, organizationID, authentication interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockIController)(nil).CreateSession), ctx, userID, fingerprint, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockIController)(nil).CreateSession), ctx, userID, fingerprint, userAgent, clientID, keyThumbprint, organizationID, authentication)
 }
 
 // UpdateSession mocks base method
-func (m *MockIController) UpdateSession(ctx context.Context, id go_uuid.UUID, fingerprint, userAgent string) (int, *models.Session) {
+func (m *MockIController) UpdateSession(ctx context.Context, id go_uuid.UUID, fingerprint, userAgent, clientID, keyThumbprint string) (int, *models.Session) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateSession", ctx, id, fingerprint, userAgent)
+	ret := m.ctrl.Call(m, "UpdateSession", ctx, id, fingerprint, userAgent, clientID, keyThumbprint)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.Session)
 	return ret0, ret1
 }
 
 // UpdateSession indicates an expected call of UpdateSession
-func (mr *MockIControllerMockRecorder) UpdateSession(ctx, id, fingerprint, userAgent interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) UpdateSession(ctx, id, fingerprint, userAgent, clientID, keyThumbprint interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*MockIController)(nil).UpdateSession), ctx, id, fingerprint, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSession", reflect.TypeOf((*MockIController)(nil).UpdateSession), ctx, id, fingerprint, userAgent, clientID, keyThumbprint)
 }
 
 // CreatePassword mocks base method
@@ -124,12 +170,13 @@ func (mr *MockIControllerMockRecorder) CreateEmailConfirmation(ctx, email interf
 }
 
 // CreateEmail mocks base method
-func (m *MockIController) CreateEmail(ctx context.Context, email, code string, userId go_uuid.UUID) (int, *models.Email) {
+func (m *MockIController) CreateEmail(ctx context.Context, email, code string, userId go_uuid.UUID) (int, *models.Email, *models.ContactTransfer) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateEmail", ctx, email, code, userId)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.Email)
-	return ret0, ret1
+	ret2, _ := ret[2].(*models.ContactTransfer)
+	return ret0, ret1, ret2
 }
 
 // CreateEmail indicates an expected call of CreateEmail
@@ -138,6 +185,65 @@ func (mr *MockIControllerMockRecorder) CreateEmail(ctx, email, code, userId inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmail", reflect.TypeOf((*MockIController)(nil).CreateEmail), ctx, email, code, userId)
 }
 
+// GetEmailByID mocks base method
+func (m *MockIController) GetEmailByID(ctx context.Context, id go_uuid.UUID) (int, *models.Email) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailByID", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Email)
+	return ret0, ret1
+}
+
+// GetEmailByID indicates an expected call of GetEmailByID
+func (mr *MockIControllerMockRecorder) GetEmailByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailByID", reflect.TypeOf((*MockIController)(nil).GetEmailByID), ctx, id)
+}
+
+// GetUserEmails mocks base method
+func (m *MockIController) GetUserEmails(ctx context.Context, userID go_uuid.UUID) []*models.Email {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEmails", ctx, userID)
+	ret0, _ := ret[0].([]*models.Email)
+	return ret0
+}
+
+// GetUserEmails indicates an expected call of GetUserEmails
+func (mr *MockIControllerMockRecorder) GetUserEmails(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEmails", reflect.TypeOf((*MockIController)(nil).GetUserEmails), ctx, userID)
+}
+
+// DeleteEmail mocks base method
+func (m *MockIController) DeleteEmail(ctx context.Context, id go_uuid.UUID) (int, *models.Email) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmail", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Email)
+	return ret0, ret1
+}
+
+// DeleteEmail indicates an expected call of DeleteEmail
+func (mr *MockIControllerMockRecorder) DeleteEmail(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmail", reflect.TypeOf((*MockIController)(nil).DeleteEmail), ctx, id)
+}
+
+// SetPrimaryEmail mocks base method
+func (m *MockIController) SetPrimaryEmail(ctx context.Context, id go_uuid.UUID) (int, *models.Email) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimaryEmail", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Email)
+	return ret0, ret1
+}
+
+// SetPrimaryEmail indicates an expected call of SetPrimaryEmail
+func (mr *MockIControllerMockRecorder) SetPrimaryEmail(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryEmail", reflect.TypeOf((*MockIController)(nil).SetPrimaryEmail), ctx, id)
+}
+
 // CreateUser mocks base method
 func (m *MockIController) CreateUser(ctx context.Context, password, email, emailCode, phone, phoneCode string) (int, *models.User) {
 	m.ctrl.T.Helper()
@@ -196,6 +302,36 @@ func (mr *MockIControllerMockRecorder) GetUser(ctx, id interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUser", reflect.TypeOf((*MockIController)(nil).GetUser), ctx, id)
 }
 
+// UpdateUserProfile mocks base method
+func (m *MockIController) UpdateUserProfile(ctx context.Context, id go_uuid.UUID, attributes map[string]interface{}) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserProfile", ctx, id, attributes)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// UpdateUserProfile indicates an expected call of UpdateUserProfile
+func (mr *MockIControllerMockRecorder) UpdateUserProfile(ctx, id, attributes interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfile", reflect.TypeOf((*MockIController)(nil).UpdateUserProfile), ctx, id, attributes)
+}
+
+// UpdateUserStatus mocks base method
+func (m *MockIController) UpdateUserStatus(ctx context.Context, id, actorID go_uuid.UUID, userStatus string, suspendedUntil int64, reason string) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserStatus", ctx, id, actorID, userStatus, suspendedUntil, reason)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus
+func (mr *MockIControllerMockRecorder) UpdateUserStatus(ctx, id, actorID, userStatus, suspendedUntil, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockIController)(nil).UpdateUserStatus), ctx, id, actorID, userStatus, suspendedUntil, reason)
+}
+
 // CreatePhoneConfirmation mocks base method
 func (m *MockIController) CreatePhoneConfirmation(ctx context.Context, phone string) (int, *models.PhoneConfirmation) {
 	m.ctrl.T.Helper()
@@ -212,12 +348,13 @@ func (mr *MockIControllerMockRecorder) CreatePhoneConfirmation(ctx, phone interf
 }
 
 // CreatePhone mocks base method
-func (m *MockIController) CreatePhone(ctx context.Context, phone, code string, userId go_uuid.UUID) (int, *models.Phone) {
+func (m *MockIController) CreatePhone(ctx context.Context, phone, code string, userId go_uuid.UUID) (int, *models.Phone, *models.ContactTransfer) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePhone", ctx, phone, code, userId)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.Phone)
-	return ret0, ret1
+	ret2, _ := ret[2].(*models.ContactTransfer)
+	return ret0, ret1, ret2
 }
 
 // CreatePhone indicates an expected call of CreatePhone
@@ -226,6 +363,109 @@ func (mr *MockIControllerMockRecorder) CreatePhone(ctx, phone, code, userId inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhone", reflect.TypeOf((*MockIController)(nil).CreatePhone), ctx, phone, code, userId)
 }
 
+// GetPhoneByID mocks base method
+func (m *MockIController) GetPhoneByID(ctx context.Context, id go_uuid.UUID) (int, *models.Phone) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhoneByID", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Phone)
+	return ret0, ret1
+}
+
+// GetPhoneByID indicates an expected call of GetPhoneByID
+func (mr *MockIControllerMockRecorder) GetPhoneByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhoneByID", reflect.TypeOf((*MockIController)(nil).GetPhoneByID), ctx, id)
+}
+
+// GetUserPhones mocks base method
+func (m *MockIController) GetUserPhones(ctx context.Context, userID go_uuid.UUID) []*models.Phone {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPhones", ctx, userID)
+	ret0, _ := ret[0].([]*models.Phone)
+	return ret0
+}
+
+// GetUserPhones indicates an expected call of GetUserPhones
+func (mr *MockIControllerMockRecorder) GetUserPhones(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPhones", reflect.TypeOf((*MockIController)(nil).GetUserPhones), ctx, userID)
+}
+
+// DeletePhone mocks base method
+func (m *MockIController) DeletePhone(ctx context.Context, id go_uuid.UUID) (int, *models.Phone) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhone", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Phone)
+	return ret0, ret1
+}
+
+// DeletePhone indicates an expected call of DeletePhone
+func (mr *MockIControllerMockRecorder) DeletePhone(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhone", reflect.TypeOf((*MockIController)(nil).DeletePhone), ctx, id)
+}
+
+// SetPrimaryPhone mocks base method
+func (m *MockIController) SetPrimaryPhone(ctx context.Context, id go_uuid.UUID) (int, *models.Phone) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimaryPhone", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Phone)
+	return ret0, ret1
+}
+
+// SetPrimaryPhone indicates an expected call of SetPrimaryPhone
+func (mr *MockIControllerMockRecorder) SetPrimaryPhone(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryPhone", reflect.TypeOf((*MockIController)(nil).SetPrimaryPhone), ctx, id)
+}
+
+// GetContactTransfer mocks base method
+func (m *MockIController) GetContactTransfer(ctx context.Context, id go_uuid.UUID) (int, *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactTransfer", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.ContactTransfer)
+	return ret0, ret1
+}
+
+// GetContactTransfer indicates an expected call of GetContactTransfer
+func (mr *MockIControllerMockRecorder) GetContactTransfer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactTransfer", reflect.TypeOf((*MockIController)(nil).GetContactTransfer), ctx, id)
+}
+
+// GetUserContactTransfers mocks base method
+func (m *MockIController) GetUserContactTransfers(ctx context.Context, userID go_uuid.UUID) []*models.ContactTransfer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserContactTransfers", ctx, userID)
+	ret0, _ := ret[0].([]*models.ContactTransfer)
+	return ret0
+}
+
+// GetUserContactTransfers indicates an expected call of GetUserContactTransfers
+func (mr *MockIControllerMockRecorder) GetUserContactTransfers(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserContactTransfers", reflect.TypeOf((*MockIController)(nil).GetUserContactTransfers), ctx, userID)
+}
+
+// ReviewContactTransfer mocks base method
+func (m *MockIController) ReviewContactTransfer(ctx context.Context, id go_uuid.UUID, status string) (int, *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewContactTransfer", ctx, id, status)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.ContactTransfer)
+	return ret0, ret1
+}
+
+// ReviewContactTransfer indicates an expected call of ReviewContactTransfer
+func (mr *MockIControllerMockRecorder) ReviewContactTransfer(ctx, id, status interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewContactTransfer", reflect.TypeOf((*MockIController)(nil).ReviewContactTransfer), ctx, id, status)
+}
+
 // GetRole mocks base method
 func (m *MockIController) GetRole(ctx context.Context, id go_uuid.UUID) (int, *models.Role) {
 	m.ctrl.T.Helper()
@@ -242,18 +482,93 @@ func (mr *MockIControllerMockRecorder) GetRole(ctx, id interface{}) *gomock.Call
 }
 
 // CreateRole mocks base method
-func (m *MockIController) CreateRole(ctx context.Context, title string) (int, *models.Role) {
+func (m *MockIController) CreateRole(ctx context.Context, title string, permissions []string, parentsID []go_uuid.UUID) (int, *models.Role) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRole", ctx, title)
+	ret := m.ctrl.Call(m, "CreateRole", ctx, title, permissions, parentsID)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.Role)
 	return ret0, ret1
 }
 
 // CreateRole indicates an expected call of CreateRole
-func (mr *MockIControllerMockRecorder) CreateRole(ctx, title interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) CreateRole(ctx, title, permissions, parentsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockIController)(nil).CreateRole), ctx, title, permissions, parentsID)
+}
+
+// UpdateRoleParents mocks base method
+func (m *MockIController) UpdateRoleParents(ctx context.Context, id go_uuid.UUID, parentsID []go_uuid.UUID) (int, *models.Role) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleParents", ctx, id, parentsID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Role)
+	return ret0, ret1
+}
+
+// UpdateRoleParents indicates an expected call of UpdateRoleParents
+func (mr *MockIControllerMockRecorder) UpdateRoleParents(ctx, id, parentsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleParents", reflect.TypeOf((*MockIController)(nil).UpdateRoleParents), ctx, id, parentsID)
+}
+
+// UpdateRole mocks base method
+func (m *MockIController) UpdateRole(ctx context.Context, id go_uuid.UUID, title, description string) (int, *models.Role) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, id, title, description)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Role)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole
+func (mr *MockIControllerMockRecorder) UpdateRole(ctx, id, title, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockIController)(nil).UpdateRole), ctx, id, title, description)
+}
+
+// DeleteRole mocks base method
+func (m *MockIController) DeleteRole(ctx context.Context, id go_uuid.UUID) (int, *models.Role) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Role)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole
+func (mr *MockIControllerMockRecorder) DeleteRole(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockIController)(nil).DeleteRole), ctx, id)
+}
+
+// UpdateRoleManagedRoles mocks base method
+func (m *MockIController) UpdateRoleManagedRoles(ctx context.Context, id go_uuid.UUID, managedRolesID []go_uuid.UUID) (int, *models.Role) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleManagedRoles", ctx, id, managedRolesID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Role)
+	return ret0, ret1
+}
+
+// UpdateRoleManagedRoles indicates an expected call of UpdateRoleManagedRoles
+func (mr *MockIControllerMockRecorder) UpdateRoleManagedRoles(ctx, id, managedRolesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleManagedRoles", reflect.TypeOf((*MockIController)(nil).UpdateRoleManagedRoles), ctx, id, managedRolesID)
+}
+
+// GetManageableRoles mocks base method
+func (m *MockIController) GetManageableRoles(ctx context.Context, user models.IAuthenticationBackendUser, query repositories.GetRolesQuery) ([]*models.Role, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManageableRoles", ctx, user, query)
+	ret0, _ := ret[0].([]*models.Role)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetManageableRoles indicates an expected call of GetManageableRoles
+func (mr *MockIControllerMockRecorder) GetManageableRoles(ctx, user, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockIController)(nil).CreateRole), ctx, title)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManageableRoles", reflect.TypeOf((*MockIController)(nil).GetManageableRoles), ctx, user, query)
 }
 
 // GetRoles mocks base method
@@ -271,6 +586,21 @@ func (mr *MockIControllerMockRecorder) GetRoles(ctx, query interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoles", reflect.TypeOf((*MockIController)(nil).GetRoles), ctx, query)
 }
 
+// GetUserRole mocks base method
+func (m *MockIController) GetUserRole(ctx context.Context, id go_uuid.UUID) (int, *models.UserRole) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRole", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserRole)
+	return ret0, ret1
+}
+
+// GetUserRole indicates an expected call of GetUserRole
+func (mr *MockIControllerMockRecorder) GetUserRole(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRole", reflect.TypeOf((*MockIController)(nil).GetUserRole), ctx, id)
+}
+
 // GetUserRoles mocks base method
 func (m *MockIController) GetUserRoles(ctx context.Context, query repositories.GetUserRoleQuery) ([]*models.UserRole, *models.PaginationResponse) {
 	m.ctrl.T.Helper()
@@ -286,19 +616,33 @@ func (mr *MockIControllerMockRecorder) GetUserRoles(ctx, query interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockIController)(nil).GetUserRoles), ctx, query)
 }
 
+// CanManageUserRole mocks base method
+func (m *MockIController) CanManageUserRole(ctx context.Context, user models.IAuthenticationBackendUser, roleID go_uuid.UUID, permission string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CanManageUserRole", ctx, user, roleID, permission)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// CanManageUserRole indicates an expected call of CanManageUserRole
+func (mr *MockIControllerMockRecorder) CanManageUserRole(ctx, user, roleID, permission interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CanManageUserRole", reflect.TypeOf((*MockIController)(nil).CanManageUserRole), ctx, user, roleID, permission)
+}
+
 // CreateUserRole mocks base method
-func (m *MockIController) CreateUserRole(ctx context.Context, userId, roleID go_uuid.UUID) (int, *models.UserRole) {
+func (m *MockIController) CreateUserRole(ctx context.Context, userId, roleID, organizationID go_uuid.UUID, startsAt, expiresAt int64) (int, *models.UserRole) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserRole", ctx, userId, roleID)
+	ret := m.ctrl.Call(m, "CreateUserRole", ctx, userId, roleID, organizationID, startsAt, expiresAt)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.UserRole)
 	return ret0, ret1
 }
 
 // CreateUserRole indicates an expected call of CreateUserRole
-func (mr *MockIControllerMockRecorder) CreateUserRole(ctx, userId, roleID interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) CreateUserRole(ctx, userId, roleID, organizationID, startsAt, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserRole", reflect.TypeOf((*MockIController)(nil).CreateUserRole), ctx, userId, roleID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserRole", reflect.TypeOf((*MockIController)(nil).CreateUserRole), ctx, userId, roleID, organizationID, startsAt, expiresAt)
 }
 
 // DeleteUserRole mocks base method
@@ -316,167 +660,795 @@ func (mr *MockIControllerMockRecorder) DeleteUserRole(ctx, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserRole", reflect.TypeOf((*MockIController)(nil).DeleteUserRole), ctx, id)
 }
 
-// CreateOrUpdateUsersView mocks base method
-func (m *MockIController) CreateOrUpdateUsersView(ctx context.Context, id []go_uuid.UUID) []*models.UserView {
+// CreateRoleRequest mocks base method
+func (m *MockIController) CreateRoleRequest(ctx context.Context, userId, roleID go_uuid.UUID, reason string, expiresAt int64) (int, *models.RoleRequest) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrUpdateUsersView", ctx, id)
-	ret0, _ := ret[0].([]*models.UserView)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateRoleRequest", ctx, userId, roleID, reason, expiresAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.RoleRequest)
+	return ret0, ret1
 }
 
-// CreateOrUpdateUsersView indicates an expected call of CreateOrUpdateUsersView
-func (mr *MockIControllerMockRecorder) CreateOrUpdateUsersView(ctx, id interface{}) *gomock.Call {
+// CreateRoleRequest indicates an expected call of CreateRoleRequest
+func (mr *MockIControllerMockRecorder) CreateRoleRequest(ctx, userId, roleID, reason, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUsersView", reflect.TypeOf((*MockIController)(nil).CreateOrUpdateUsersView), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoleRequest", reflect.TypeOf((*MockIController)(nil).CreateRoleRequest), ctx, userId, roleID, reason, expiresAt)
 }
 
-// CreateOrUpdateUsersViewByRoles mocks base method
-func (m *MockIController) CreateOrUpdateUsersViewByRoles(ctx context.Context, rolesIds []go_uuid.UUID) []*models.UserView {
+// GetRoleRequest mocks base method
+func (m *MockIController) GetRoleRequest(ctx context.Context, id go_uuid.UUID) (int, *models.RoleRequest) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateOrUpdateUsersViewByRoles", ctx, rolesIds)
-	ret0, _ := ret[0].([]*models.UserView)
-	return ret0
+	ret := m.ctrl.Call(m, "GetRoleRequest", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.RoleRequest)
+	return ret0, ret1
 }
 
-// CreateOrUpdateUsersViewByRoles indicates an expected call of CreateOrUpdateUsersViewByRoles
-func (mr *MockIControllerMockRecorder) CreateOrUpdateUsersViewByRoles(ctx, rolesIds interface{}) *gomock.Call {
+// GetRoleRequest indicates an expected call of GetRoleRequest
+func (mr *MockIControllerMockRecorder) GetRoleRequest(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUsersViewByRoles", reflect.TypeOf((*MockIController)(nil).CreateOrUpdateUsersViewByRoles), ctx, rolesIds)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleRequest", reflect.TypeOf((*MockIController)(nil).GetRoleRequest), ctx, id)
 }
 
-// GetUserView mocks base method
-func (m *MockIController) GetUserView(ctx context.Context, id go_uuid.UUID) *models.UserView {
+// GetRoleRequests mocks base method
+func (m *MockIController) GetRoleRequests(ctx context.Context, user models.IAuthenticationBackendUser, query repositories.GetRoleRequestsQuery) ([]*models.RoleRequest, *models.PaginationResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserView", ctx, id)
-	ret0, _ := ret[0].(*models.UserView)
-	return ret0
+	ret := m.ctrl.Call(m, "GetRoleRequests", ctx, user, query)
+	ret0, _ := ret[0].([]*models.RoleRequest)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
 }
 
-// GetUserView indicates an expected call of GetUserView
-func (mr *MockIControllerMockRecorder) GetUserView(ctx, id interface{}) *gomock.Call {
+// GetRoleRequests indicates an expected call of GetRoleRequests
+func (mr *MockIControllerMockRecorder) GetRoleRequests(ctx, user, query interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserView", reflect.TypeOf((*MockIController)(nil).GetUserView), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleRequests", reflect.TypeOf((*MockIController)(nil).GetRoleRequests), ctx, user, query)
 }
 
-// GetUserViews mocks base method
-func (m *MockIController) GetUserViews(ctx context.Context, query repositories.GetUsersViewStoreQuery) ([]*models.UserView, *models.PaginationResponse) {
+// ReviewRoleRequest mocks base method
+func (m *MockIController) ReviewRoleRequest(ctx context.Context, id, reviewerId go_uuid.UUID, status, comment string, expiresAt int64) (int, *models.RoleRequest) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetUserViews", ctx, query)
-	ret0, _ := ret[0].([]*models.UserView)
-	ret1, _ := ret[1].(*models.PaginationResponse)
+	ret := m.ctrl.Call(m, "ReviewRoleRequest", ctx, id, reviewerId, status, comment, expiresAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.RoleRequest)
 	return ret0, ret1
 }
 
-// GetUserViews indicates an expected call of GetUserViews
-func (mr *MockIControllerMockRecorder) GetUserViews(ctx, query interface{}) *gomock.Call {
+// ReviewRoleRequest indicates an expected call of ReviewRoleRequest
+func (mr *MockIControllerMockRecorder) ReviewRoleRequest(ctx, id, reviewerId, status, comment, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserViews", reflect.TypeOf((*MockIController)(nil).GetUserViews), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewRoleRequest", reflect.TypeOf((*MockIController)(nil).ReviewRoleRequest), ctx, id, reviewerId, status, comment, expiresAt)
 }
 
-// OnUserChanged mocks base method
-func (m *MockIController) OnUserChanged(id []go_uuid.UUID) {
+// CancelRoleRequest mocks base method
+func (m *MockIController) CancelRoleRequest(ctx context.Context, id, userId go_uuid.UUID) (int, *models.RoleRequest) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnUserChanged", id)
+	ret := m.ctrl.Call(m, "CancelRoleRequest", ctx, id, userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.RoleRequest)
+	return ret0, ret1
 }
 
-// OnUserChanged indicates an expected call of OnUserChanged
-func (mr *MockIControllerMockRecorder) OnUserChanged(id interface{}) *gomock.Call {
+// CancelRoleRequest indicates an expected call of CancelRoleRequest
+func (mr *MockIControllerMockRecorder) CancelRoleRequest(ctx, id, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserChanged", reflect.TypeOf((*MockIController)(nil).OnUserChanged), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelRoleRequest", reflect.TypeOf((*MockIController)(nil).CancelRoleRequest), ctx, id, userId)
 }
 
-// OnEmailCodeConfirmationCreated mocks base method
-func (m *MockIController) OnEmailCodeConfirmationCreated(email, code string) {
+// CreateUserExport mocks base method
+func (m *MockIController) CreateUserExport(ctx context.Context, userID, requesterID go_uuid.UUID) (int, *models.UserExport) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnEmailCodeConfirmationCreated", email, code)
+	ret := m.ctrl.Call(m, "CreateUserExport", ctx, userID, requesterID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserExport)
+	return ret0, ret1
 }
 
-// OnEmailCodeConfirmationCreated indicates an expected call of OnEmailCodeConfirmationCreated
-func (mr *MockIControllerMockRecorder) OnEmailCodeConfirmationCreated(email, code interface{}) *gomock.Call {
+// CreateUserExport indicates an expected call of CreateUserExport
+func (mr *MockIControllerMockRecorder) CreateUserExport(ctx, userID, requesterID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnEmailCodeConfirmationCreated", reflect.TypeOf((*MockIController)(nil).OnEmailCodeConfirmationCreated), email, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserExport", reflect.TypeOf((*MockIController)(nil).CreateUserExport), ctx, userID, requesterID)
 }
 
-// OnPhoneCodeConfirmationCreated mocks base method
-func (m *MockIController) OnPhoneCodeConfirmationCreated(phone, code string) {
+// GetUserExport mocks base method
+func (m *MockIController) GetUserExport(ctx context.Context, id go_uuid.UUID) (int, *models.UserExport) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnPhoneCodeConfirmationCreated", phone, code)
+	ret := m.ctrl.Call(m, "GetUserExport", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserExport)
+	return ret0, ret1
 }
 
-// OnPhoneCodeConfirmationCreated indicates an expected call of OnPhoneCodeConfirmationCreated
-func (mr *MockIControllerMockRecorder) OnPhoneCodeConfirmationCreated(phone, code interface{}) *gomock.Call {
+// GetUserExport indicates an expected call of GetUserExport
+func (mr *MockIControllerMockRecorder) GetUserExport(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPhoneCodeConfirmationCreated", reflect.TypeOf((*MockIController)(nil).OnPhoneCodeConfirmationCreated), phone, code)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExport", reflect.TypeOf((*MockIController)(nil).GetUserExport), ctx, id)
 }
 
-// OnUsersViewChanged mocks base method
-func (m *MockIController) OnUsersViewChanged(usersView []*models.UserView) {
+// GetUserExportArchive mocks base method
+func (m *MockIController) GetUserExportArchive(ctx context.Context, id go_uuid.UUID, token string) (int, *models.UserExport) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnUsersViewChanged", usersView)
+	ret := m.ctrl.Call(m, "GetUserExportArchive", ctx, id, token)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserExport)
+	return ret0, ret1
 }
 
-// OnUsersViewChanged indicates an expected call of OnUsersViewChanged
-func (mr *MockIControllerMockRecorder) OnUsersViewChanged(usersView interface{}) *gomock.Call {
+// GetUserExportArchive indicates an expected call of GetUserExportArchive
+func (mr *MockIControllerMockRecorder) GetUserExportArchive(ctx, id, token interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUsersViewChanged", reflect.TypeOf((*MockIController)(nil).OnUsersViewChanged), usersView)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExportArchive", reflect.TypeOf((*MockIController)(nil).GetUserExportArchive), ctx, id, token)
 }
 
-// OnPasswordChanged mocks base method
-func (m *MockIController) OnPasswordChanged(userId go_uuid.UUID) {
+// ProcessUserExports mocks base method
+func (m *MockIController) ProcessUserExports(ctx context.Context) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnPasswordChanged", userId)
+	m.ctrl.Call(m, "ProcessUserExports", ctx)
+}
+
+// ProcessUserExports indicates an expected call of ProcessUserExports
+func (mr *MockIControllerMockRecorder) ProcessUserExports(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessUserExports", reflect.TypeOf((*MockIController)(nil).ProcessUserExports), ctx)
+}
+
+// RequestUserDeletion mocks base method
+func (m *MockIController) RequestUserDeletion(ctx context.Context, id go_uuid.UUID) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestUserDeletion", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// RequestUserDeletion indicates an expected call of RequestUserDeletion
+func (mr *MockIControllerMockRecorder) RequestUserDeletion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestUserDeletion", reflect.TypeOf((*MockIController)(nil).RequestUserDeletion), ctx, id)
+}
+
+// CancelUserDeletion mocks base method
+func (m *MockIController) CancelUserDeletion(ctx context.Context, id go_uuid.UUID) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUserDeletion", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// CancelUserDeletion indicates an expected call of CancelUserDeletion
+func (mr *MockIControllerMockRecorder) CancelUserDeletion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserDeletion", reflect.TypeOf((*MockIController)(nil).CancelUserDeletion), ctx, id)
+}
+
+// ProcessUserDeletions mocks base method
+func (m *MockIController) ProcessUserDeletions(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ProcessUserDeletions", ctx)
+}
+
+// ProcessUserDeletions indicates an expected call of ProcessUserDeletions
+func (mr *MockIControllerMockRecorder) ProcessUserDeletions(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessUserDeletions", reflect.TypeOf((*MockIController)(nil).ProcessUserDeletions), ctx)
+}
+
+// GetOrganization mocks base method
+func (m *MockIController) GetOrganization(ctx context.Context, id go_uuid.UUID) (int, *models.Organization) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Organization)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization
+func (mr *MockIControllerMockRecorder) GetOrganization(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockIController)(nil).GetOrganization), ctx, id)
+}
+
+// CreateOrganization mocks base method
+func (m *MockIController) CreateOrganization(ctx context.Context, title string) (int, *models.Organization) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, title)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Organization)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization
+func (mr *MockIControllerMockRecorder) CreateOrganization(ctx, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockIController)(nil).CreateOrganization), ctx, title)
+}
+
+// GetOrganizations mocks base method
+func (m *MockIController) GetOrganizations(ctx context.Context, query repositories.GetOrganizationsQuery) ([]*models.Organization, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizations", ctx, query)
+	ret0, _ := ret[0].([]*models.Organization)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetOrganizations indicates an expected call of GetOrganizations
+func (mr *MockIControllerMockRecorder) GetOrganizations(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*MockIController)(nil).GetOrganizations), ctx, query)
+}
+
+// GetOrganizationMembers mocks base method
+func (m *MockIController) GetOrganizationMembers(ctx context.Context, query repositories.GetOrganizationMembersQuery) ([]*models.OrganizationMember, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembers", ctx, query)
+	ret0, _ := ret[0].([]*models.OrganizationMember)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetOrganizationMembers indicates an expected call of GetOrganizationMembers
+func (mr *MockIControllerMockRecorder) GetOrganizationMembers(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembers", reflect.TypeOf((*MockIController)(nil).GetOrganizationMembers), ctx, query)
+}
+
+// CreateOrganizationMember mocks base method
+func (m *MockIController) CreateOrganizationMember(ctx context.Context, organizationID, userID go_uuid.UUID) (int, *models.OrganizationMember) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationMember", ctx, organizationID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.OrganizationMember)
+	return ret0, ret1
+}
+
+// CreateOrganizationMember indicates an expected call of CreateOrganizationMember
+func (mr *MockIControllerMockRecorder) CreateOrganizationMember(ctx, organizationID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMember", reflect.TypeOf((*MockIController)(nil).CreateOrganizationMember), ctx, organizationID, userID)
+}
+
+// DeleteOrganizationMember mocks base method
+func (m *MockIController) DeleteOrganizationMember(ctx context.Context, id go_uuid.UUID) (int, *models.OrganizationMember) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationMember", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.OrganizationMember)
+	return ret0, ret1
+}
+
+// DeleteOrganizationMember indicates an expected call of DeleteOrganizationMember
+func (mr *MockIControllerMockRecorder) DeleteOrganizationMember(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationMember", reflect.TypeOf((*MockIController)(nil).DeleteOrganizationMember), ctx, id)
+}
+
+// GetGroup mocks base method
+func (m *MockIController) GetGroup(ctx context.Context, id go_uuid.UUID) (int, *models.Group) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Group)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup
+func (mr *MockIControllerMockRecorder) GetGroup(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockIController)(nil).GetGroup), ctx, id)
+}
+
+// CreateGroup mocks base method
+func (m *MockIController) CreateGroup(ctx context.Context, title, description string) (int, *models.Group) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, title, description)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Group)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup
+func (mr *MockIControllerMockRecorder) CreateGroup(ctx, title, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockIController)(nil).CreateGroup), ctx, title, description)
+}
+
+// UpdateGroup mocks base method
+func (m *MockIController) UpdateGroup(ctx context.Context, id go_uuid.UUID, title, description string) (int, *models.Group) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroup", ctx, id, title, description)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Group)
+	return ret0, ret1
+}
+
+// UpdateGroup indicates an expected call of UpdateGroup
+func (mr *MockIControllerMockRecorder) UpdateGroup(ctx, id, title, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockIController)(nil).UpdateGroup), ctx, id, title, description)
+}
+
+// DeleteGroup mocks base method
+func (m *MockIController) DeleteGroup(ctx context.Context, id go_uuid.UUID) (int, *models.Group) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Group)
+	return ret0, ret1
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup
+func (mr *MockIControllerMockRecorder) DeleteGroup(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockIController)(nil).DeleteGroup), ctx, id)
+}
+
+// GetGroups mocks base method
+func (m *MockIController) GetGroups(ctx context.Context, query repositories.GetGroupsQuery) ([]*models.Group, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroups", ctx, query)
+	ret0, _ := ret[0].([]*models.Group)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetGroups indicates an expected call of GetGroups
+func (mr *MockIControllerMockRecorder) GetGroups(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*MockIController)(nil).GetGroups), ctx, query)
+}
+
+// GetGroupMembers mocks base method
+func (m *MockIController) GetGroupMembers(ctx context.Context, query repositories.GetGroupMembersQuery) ([]*models.GroupMember, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembers", ctx, query)
+	ret0, _ := ret[0].([]*models.GroupMember)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetGroupMembers indicates an expected call of GetGroupMembers
+func (mr *MockIControllerMockRecorder) GetGroupMembers(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockIController)(nil).GetGroupMembers), ctx, query)
+}
+
+// CreateGroupMember mocks base method
+func (m *MockIController) CreateGroupMember(ctx context.Context, groupID, userID go_uuid.UUID) (int, *models.GroupMember) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupMember", ctx, groupID, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.GroupMember)
+	return ret0, ret1
+}
+
+// CreateGroupMember indicates an expected call of CreateGroupMember
+func (mr *MockIControllerMockRecorder) CreateGroupMember(ctx, groupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupMember", reflect.TypeOf((*MockIController)(nil).CreateGroupMember), ctx, groupID, userID)
+}
+
+// DeleteGroupMember mocks base method
+func (m *MockIController) DeleteGroupMember(ctx context.Context, id go_uuid.UUID) (int, *models.GroupMember) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupMember", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.GroupMember)
+	return ret0, ret1
+}
+
+// DeleteGroupMember indicates an expected call of DeleteGroupMember
+func (mr *MockIControllerMockRecorder) DeleteGroupMember(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupMember", reflect.TypeOf((*MockIController)(nil).DeleteGroupMember), ctx, id)
+}
+
+// GetGroupRoles mocks base method
+func (m *MockIController) GetGroupRoles(ctx context.Context, query repositories.GetGroupRolesQuery) ([]*models.GroupRole, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupRoles", ctx, query)
+	ret0, _ := ret[0].([]*models.GroupRole)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetGroupRoles indicates an expected call of GetGroupRoles
+func (mr *MockIControllerMockRecorder) GetGroupRoles(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRoles", reflect.TypeOf((*MockIController)(nil).GetGroupRoles), ctx, query)
+}
+
+// CreateGroupRole mocks base method
+func (m *MockIController) CreateGroupRole(ctx context.Context, groupID, roleID go_uuid.UUID) (int, *models.GroupRole) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupRole", ctx, groupID, roleID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.GroupRole)
+	return ret0, ret1
+}
+
+// CreateGroupRole indicates an expected call of CreateGroupRole
+func (mr *MockIControllerMockRecorder) CreateGroupRole(ctx, groupID, roleID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupRole", reflect.TypeOf((*MockIController)(nil).CreateGroupRole), ctx, groupID, roleID)
+}
+
+// DeleteGroupRole mocks base method
+func (m *MockIController) DeleteGroupRole(ctx context.Context, id go_uuid.UUID) (int, *models.GroupRole) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupRole", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.GroupRole)
+	return ret0, ret1
+}
+
+// DeleteGroupRole indicates an expected call of DeleteGroupRole
+func (mr *MockIControllerMockRecorder) DeleteGroupRole(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupRole", reflect.TypeOf((*MockIController)(nil).DeleteGroupRole), ctx, id)
+}
+
+// CreateOrUpdateUsersView mocks base method
+func (m *MockIController) CreateOrUpdateUsersView(ctx context.Context, id []go_uuid.UUID) []*models.UserView {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateUsersView", ctx, id)
+	ret0, _ := ret[0].([]*models.UserView)
+	return ret0
+}
+
+// CreateOrUpdateUsersView indicates an expected call of CreateOrUpdateUsersView
+func (mr *MockIControllerMockRecorder) CreateOrUpdateUsersView(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUsersView", reflect.TypeOf((*MockIController)(nil).CreateOrUpdateUsersView), ctx, id)
+}
+
+// CreateOrUpdateUsersViewByRoles mocks base method
+func (m *MockIController) CreateOrUpdateUsersViewByRoles(ctx context.Context, rolesIds []go_uuid.UUID) []*models.UserView {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdateUsersViewByRoles", ctx, rolesIds)
+	ret0, _ := ret[0].([]*models.UserView)
+	return ret0
+}
+
+// CreateOrUpdateUsersViewByRoles indicates an expected call of CreateOrUpdateUsersViewByRoles
+func (mr *MockIControllerMockRecorder) CreateOrUpdateUsersViewByRoles(ctx, rolesIds interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdateUsersViewByRoles", reflect.TypeOf((*MockIController)(nil).CreateOrUpdateUsersViewByRoles), ctx, rolesIds)
+}
+
+// GetUserView mocks base method
+func (m *MockIController) GetUserView(ctx context.Context, id go_uuid.UUID) *models.UserView {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserView", ctx, id)
+	ret0, _ := ret[0].(*models.UserView)
+	return ret0
+}
+
+// GetUserView indicates an expected call of GetUserView
+func (mr *MockIControllerMockRecorder) GetUserView(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserView", reflect.TypeOf((*MockIController)(nil).GetUserView), ctx, id)
+}
+
+// GetUserViews mocks base method
+func (m *MockIController) GetUserViews(ctx context.Context, query repositories.GetUsersViewStoreQuery) ([]*models.UserView, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserViews", ctx, query)
+	ret0, _ := ret[0].([]*models.UserView)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetUserViews indicates an expected call of GetUserViews
+func (mr *MockIControllerMockRecorder) GetUserViews(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserViews", reflect.TypeOf((*MockIController)(nil).GetUserViews), ctx, query)
+}
+
+// SearchUsersView mocks base method
+func (m *MockIController) SearchUsersView(ctx context.Context, query repositories.SearchUsersViewQuery) (int, []*models.UserView, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsersView", ctx, query)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].([]*models.UserView)
+	ret2, _ := ret[2].(*models.PaginationResponse)
+	return ret0, ret1, ret2
+}
+
+// SearchUsersView indicates an expected call of SearchUsersView
+func (mr *MockIControllerMockRecorder) SearchUsersView(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersView", reflect.TypeOf((*MockIController)(nil).SearchUsersView), ctx, query)
+}
+
+// Authorize mocks base method
+func (m *MockIController) Authorize(ctx context.Context, userID go_uuid.UUID, checks []*models.AuthorizationCheck) (int, *models.Authorization) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", ctx, userID, checks)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Authorization)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize
+func (mr *MockIControllerMockRecorder) Authorize(ctx, userID, checks interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockIController)(nil).Authorize), ctx, userID, checks)
+}
+
+// OnUserChanged mocks base method
+func (m *MockIController) OnUserChanged(ctx context.Context, id []go_uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUserChanged", ctx, id)
+}
+
+// OnUserChanged indicates an expected call of OnUserChanged
+func (mr *MockIControllerMockRecorder) OnUserChanged(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserChanged", reflect.TypeOf((*MockIController)(nil).OnUserChanged), ctx, id)
+}
+
+// OnEmailCodeConfirmationCreated mocks base method
+func (m *MockIController) OnEmailCodeConfirmationCreated(ctx context.Context, email, code string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnEmailCodeConfirmationCreated", ctx, email, code)
+}
+
+// OnEmailCodeConfirmationCreated indicates an expected call of OnEmailCodeConfirmationCreated
+func (mr *MockIControllerMockRecorder) OnEmailCodeConfirmationCreated(ctx, email, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnEmailCodeConfirmationCreated", reflect.TypeOf((*MockIController)(nil).OnEmailCodeConfirmationCreated), ctx, email, code)
+}
+
+// OnPhoneCodeConfirmationCreated mocks base method
+func (m *MockIController) OnPhoneCodeConfirmationCreated(ctx context.Context, phone, code string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnPhoneCodeConfirmationCreated", ctx, phone, code)
+}
+
+// OnPhoneCodeConfirmationCreated indicates an expected call of OnPhoneCodeConfirmationCreated
+func (mr *MockIControllerMockRecorder) OnPhoneCodeConfirmationCreated(ctx, phone, code interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPhoneCodeConfirmationCreated", reflect.TypeOf((*MockIController)(nil).OnPhoneCodeConfirmationCreated), ctx, phone, code)
+}
+
+// OnUsersViewChanged mocks base method
+func (m *MockIController) OnUsersViewChanged(ctx context.Context, usersView []*models.UserView) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUsersViewChanged", ctx, usersView)
+}
+
+// OnUsersViewChanged indicates an expected call of OnUsersViewChanged
+func (mr *MockIControllerMockRecorder) OnUsersViewChanged(ctx, usersView interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUsersViewChanged", reflect.TypeOf((*MockIController)(nil).OnUsersViewChanged), ctx, usersView)
+}
+
+// OnPasswordChanged mocks base method
+func (m *MockIController) OnPasswordChanged(ctx context.Context, userId go_uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnPasswordChanged", ctx, userId)
 }
 
 // OnPasswordChanged indicates an expected call of OnPasswordChanged
-func (mr *MockIControllerMockRecorder) OnPasswordChanged(userId interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) OnPasswordChanged(ctx, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPasswordChanged", reflect.TypeOf((*MockIController)(nil).OnPasswordChanged), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPasswordChanged", reflect.TypeOf((*MockIController)(nil).OnPasswordChanged), ctx, userId)
 }
 
 // OnPhoneChanged mocks base method
-func (m *MockIController) OnPhoneChanged(userId []go_uuid.UUID) {
+func (m *MockIController) OnPhoneChanged(ctx context.Context, userId []go_uuid.UUID) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnPhoneChanged", userId)
+	m.ctrl.Call(m, "OnPhoneChanged", ctx, userId)
 }
 
 // OnPhoneChanged indicates an expected call of OnPhoneChanged
-func (mr *MockIControllerMockRecorder) OnPhoneChanged(userId interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) OnPhoneChanged(ctx, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPhoneChanged", reflect.TypeOf((*MockIController)(nil).OnPhoneChanged), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnPhoneChanged", reflect.TypeOf((*MockIController)(nil).OnPhoneChanged), ctx, userId)
 }
 
 // OnEmailChanged mocks base method
-func (m *MockIController) OnEmailChanged(userId []go_uuid.UUID) {
+func (m *MockIController) OnEmailChanged(ctx context.Context, userId []go_uuid.UUID) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnEmailChanged", userId)
+	m.ctrl.Call(m, "OnEmailChanged", ctx, userId)
 }
 
 // OnEmailChanged indicates an expected call of OnEmailChanged
-func (mr *MockIControllerMockRecorder) OnEmailChanged(userId interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) OnEmailChanged(ctx, userId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnEmailChanged", reflect.TypeOf((*MockIController)(nil).OnEmailChanged), userId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnEmailChanged", reflect.TypeOf((*MockIController)(nil).OnEmailChanged), ctx, userId)
 }
 
 // OnRoleChanged mocks base method
-func (m *MockIController) OnRoleChanged(roleId []go_uuid.UUID) {
+func (m *MockIController) OnRoleChanged(ctx context.Context, roleId []go_uuid.UUID) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnRoleChanged", roleId)
+	m.ctrl.Call(m, "OnRoleChanged", ctx, roleId)
 }
 
 // OnRoleChanged indicates an expected call of OnRoleChanged
-func (mr *MockIControllerMockRecorder) OnRoleChanged(roleId interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) OnRoleChanged(ctx, roleId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnRoleChanged", reflect.TypeOf((*MockIController)(nil).OnRoleChanged), roleId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnRoleChanged", reflect.TypeOf((*MockIController)(nil).OnRoleChanged), ctx, roleId)
+}
+
+// OnGroupChanged mocks base method
+func (m *MockIController) OnGroupChanged(ctx context.Context, groupId go_uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnGroupChanged", ctx, groupId)
+}
+
+// OnGroupChanged indicates an expected call of OnGroupChanged
+func (mr *MockIControllerMockRecorder) OnGroupChanged(ctx, groupId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnGroupChanged", reflect.TypeOf((*MockIController)(nil).OnGroupChanged), ctx, groupId)
 }
 
 // OnSecretCreatedV1 mocks base method
-func (m *MockIController) OnSecretCreatedV1(secret *models.Secret) {
+func (m *MockIController) OnSecretCreatedV1(ctx context.Context, secret *models.Secret) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "OnSecretCreatedV1", secret)
+	m.ctrl.Call(m, "OnSecretCreatedV1", ctx, secret)
 }
 
 // OnSecretCreatedV1 indicates an expected call of OnSecretCreatedV1
-func (mr *MockIControllerMockRecorder) OnSecretCreatedV1(secret interface{}) *gomock.Call {
+func (mr *MockIControllerMockRecorder) OnSecretCreatedV1(ctx, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnSecretCreatedV1", reflect.TypeOf((*MockIController)(nil).OnSecretCreatedV1), ctx, secret)
+}
+
+// OnSecretRevokedV1 mocks base method
+func (m *MockIController) OnSecretRevokedV1(ctx context.Context, secret *models.Secret) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnSecretRevokedV1", ctx, secret)
+}
+
+// OnSecretRevokedV1 indicates an expected call of OnSecretRevokedV1
+func (mr *MockIControllerMockRecorder) OnSecretRevokedV1(ctx, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnSecretRevokedV1", reflect.TypeOf((*MockIController)(nil).OnSecretRevokedV1), ctx, secret)
+}
+
+// OnRoleUpdatedV1 mocks base method
+func (m *MockIController) OnRoleUpdatedV1(ctx context.Context, role *models.Role) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnRoleUpdatedV1", ctx, role)
+}
+
+// OnRoleUpdatedV1 indicates an expected call of OnRoleUpdatedV1
+func (mr *MockIControllerMockRecorder) OnRoleUpdatedV1(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnRoleUpdatedV1", reflect.TypeOf((*MockIController)(nil).OnRoleUpdatedV1), ctx, role)
+}
+
+// OnRoleDeletedV1 mocks base method
+func (m *MockIController) OnRoleDeletedV1(ctx context.Context, role *models.Role) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnRoleDeletedV1", ctx, role)
+}
+
+// OnRoleDeletedV1 indicates an expected call of OnRoleDeletedV1
+func (mr *MockIControllerMockRecorder) OnRoleDeletedV1(ctx, role interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnRoleDeletedV1", reflect.TypeOf((*MockIController)(nil).OnRoleDeletedV1), ctx, role)
+}
+
+// OnUserRoleStartedV1 mocks base method
+func (m *MockIController) OnUserRoleStartedV1(ctx context.Context, userRole *models.UserRole) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUserRoleStartedV1", ctx, userRole)
+}
+
+// OnUserRoleStartedV1 indicates an expected call of OnUserRoleStartedV1
+func (mr *MockIControllerMockRecorder) OnUserRoleStartedV1(ctx, userRole interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserRoleStartedV1", reflect.TypeOf((*MockIController)(nil).OnUserRoleStartedV1), ctx, userRole)
+}
+
+// OnUserRoleExpiredV1 mocks base method
+func (m *MockIController) OnUserRoleExpiredV1(ctx context.Context, userRole *models.UserRole) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUserRoleExpiredV1", ctx, userRole)
+}
+
+// OnUserRoleExpiredV1 indicates an expected call of OnUserRoleExpiredV1
+func (mr *MockIControllerMockRecorder) OnUserRoleExpiredV1(ctx, userRole interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserRoleExpiredV1", reflect.TypeOf((*MockIController)(nil).OnUserRoleExpiredV1), ctx, userRole)
+}
+
+// OnUserStatusChangedV1 mocks base method
+func (m *MockIController) OnUserStatusChangedV1(ctx context.Context, user *models.User) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUserStatusChangedV1", ctx, user)
+}
+
+// OnUserStatusChangedV1 indicates an expected call of OnUserStatusChangedV1
+func (mr *MockIControllerMockRecorder) OnUserStatusChangedV1(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserStatusChangedV1", reflect.TypeOf((*MockIController)(nil).OnUserStatusChangedV1), ctx, user)
+}
+
+// OnUserExportCompletedV1 mocks base method
+func (m *MockIController) OnUserExportCompletedV1(ctx context.Context, export *models.UserExport) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUserExportCompletedV1", ctx, export)
+}
+
+// OnUserExportCompletedV1 indicates an expected call of OnUserExportCompletedV1
+func (mr *MockIControllerMockRecorder) OnUserExportCompletedV1(ctx, export interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserExportCompletedV1", reflect.TypeOf((*MockIController)(nil).OnUserExportCompletedV1), ctx, export)
+}
+
+// OnUserDeletionRequestedV1 mocks base method
+func (m *MockIController) OnUserDeletionRequestedV1(ctx context.Context, user *models.User) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUserDeletionRequestedV1", ctx, user)
+}
+
+// OnUserDeletionRequestedV1 indicates an expected call of OnUserDeletionRequestedV1
+func (mr *MockIControllerMockRecorder) OnUserDeletionRequestedV1(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserDeletionRequestedV1", reflect.TypeOf((*MockIController)(nil).OnUserDeletionRequestedV1), ctx, user)
+}
+
+// OnUserDeletionCancelledV1 mocks base method
+func (m *MockIController) OnUserDeletionCancelledV1(ctx context.Context, user *models.User) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUserDeletionCancelledV1", ctx, user)
+}
+
+// OnUserDeletionCancelledV1 indicates an expected call of OnUserDeletionCancelledV1
+func (mr *MockIControllerMockRecorder) OnUserDeletionCancelledV1(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserDeletionCancelledV1", reflect.TypeOf((*MockIController)(nil).OnUserDeletionCancelledV1), ctx, user)
+}
+
+// OnUserAnonymizedV1 mocks base method
+func (m *MockIController) OnUserAnonymizedV1(ctx context.Context, user *models.User) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnUserAnonymizedV1", ctx, user)
+}
+
+// OnUserAnonymizedV1 indicates an expected call of OnUserAnonymizedV1
+func (mr *MockIControllerMockRecorder) OnUserAnonymizedV1(ctx, user interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnUserAnonymizedV1", reflect.TypeOf((*MockIController)(nil).OnUserAnonymizedV1), ctx, user)
+}
+
+// OnContactTransferRequestedV1 mocks base method
+func (m *MockIController) OnContactTransferRequestedV1(ctx context.Context, transfer *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnContactTransferRequestedV1", ctx, transfer)
+}
+
+// OnContactTransferRequestedV1 indicates an expected call of OnContactTransferRequestedV1
+func (mr *MockIControllerMockRecorder) OnContactTransferRequestedV1(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnContactTransferRequestedV1", reflect.TypeOf((*MockIController)(nil).OnContactTransferRequestedV1), ctx, transfer)
+}
+
+// OnContactTransferredV1 mocks base method
+func (m *MockIController) OnContactTransferredV1(ctx context.Context, transfer *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnContactTransferredV1", ctx, transfer)
+}
+
+// OnContactTransferredV1 indicates an expected call of OnContactTransferredV1
+func (mr *MockIControllerMockRecorder) OnContactTransferredV1(ctx, transfer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnContactTransferredV1", reflect.TypeOf((*MockIController)(nil).OnContactTransferredV1), ctx, transfer)
+}
+
+// OnContactTransferRejectedV1 mocks base method
+func (m *MockIController) OnContactTransferRejectedV1(ctx context.Context, transfer *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "OnContactTransferRejectedV1", ctx, transfer)
+}
+
+// OnContactTransferRejectedV1 indicates an expected call of OnContactTransferRejectedV1
+func (mr *MockIControllerMockRecorder) OnContactTransferRejectedV1(ctx, transfer interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnSecretCreatedV1", reflect.TypeOf((*MockIController)(nil).OnSecretCreatedV1), secret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OnContactTransferRejectedV1", reflect.TypeOf((*MockIController)(nil).OnContactTransferRejectedV1), ctx, transfer)
 }
@@ -0,0 +1,46 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"hive/repositories"
+	"context"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (controller *Controller) CreateOrganization(ctx context.Context, title string) (int, *models.Organization) {
+	return controller.store.CreateOrganization(ctx, title)
+}
+
+func (controller *Controller) GetOrganization(ctx context.Context, id uuid.UUID) (int, *models.Organization) {
+	return controller.store.GetOrganization(ctx, id)
+}
+
+func (controller *Controller) GetOrganizations(ctx context.Context, query repositories.GetOrganizationsQuery) ([]*models.Organization, *models.PaginationResponse) {
+	return controller.store.GetOrganizations(ctx, query)
+}
+
+func (controller *Controller) CreateOrganizationMember(ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) (int, *models.OrganizationMember) {
+	status, member := controller.store.CreateOrganizationMember(ctx, organizationID, userID)
+
+	if status == enums.Ok {
+		controller.OnUserChanged(ctx, []uuid.UUID{member.UserId})
+	}
+
+	return status, member
+}
+
+func (controller *Controller) GetOrganizationMembers(ctx context.Context, query repositories.GetOrganizationMembersQuery) ([]*models.OrganizationMember, *models.PaginationResponse) {
+	return controller.store.GetOrganizationMembers(ctx, query)
+}
+
+// DeleteOrganizationMember removes user from organization together with roles granted within it
+func (controller *Controller) DeleteOrganizationMember(ctx context.Context, id uuid.UUID) (int, *models.OrganizationMember) {
+	status, member := controller.store.DeleteOrganizationMember(ctx, id)
+
+	if status == enums.Ok {
+		controller.OnUserChanged(ctx, []uuid.UUID{member.UserId})
+	}
+
+	return status, member
+}
@@ -16,7 +16,7 @@ func (controller *Controller) CreatePassword(ctx context.Context, userId uuid.UU
 
 	status, password := controller.store.CreatePassword(ctx, userId, value)
 	if status == enums.Ok {
-		controller.OnPasswordChanged(userId)
+		controller.OnPasswordChanged(ctx, userId)
 	}
 
 	return status, password
@@ -9,31 +9,48 @@ import (
 	"time"
 )
 
-func (controller *Controller) CreatePhone(ctx context.Context, phone string, code string, userId uuid.UUID) (int, *models.Phone) {
+// CreatePhone adds confirmed phone to user. Phone owned by another user is moved and previous owner is notified,
+// with required confirmation pending transfer is created instead and previous owner should approve it
+func (controller *Controller) CreatePhone(ctx context.Context, phone string, code string, userId uuid.UUID) (int, *models.Phone, *models.ContactTransfer) {
 
 	phone = functools.NormalizePhone(phone)
 	if phone == "" {
-		return enums.IncorrectPhone, nil
+		return enums.IncorrectPhone, nil, nil
 	}
 
 	cachedCode := controller.store.GetPhoneConfirmationCode(ctx, phone)
 	if cachedCode == "" {
-		return enums.PhoneNotFound, nil
+		return enums.PhoneNotFound, nil, nil
 	} else if cachedCode != code {
-		return enums.IncorrectPhoneCode, nil
+		return enums.IncorrectPhoneCode, nil, nil
 	}
 
 	_, oldPhone := controller.store.GetPhone(ctx, phone)
 
+	if oldPhone != nil && !uuid.Equal(oldPhone.UserId, userId) && controller.environment.ContactTransferConfirmation {
+		status, transfer := controller.store.RequestContactTransfer(ctx, enums.PhoneContact, phone, oldPhone.UserId, userId)
+		if status != enums.Ok {
+			return status, nil, nil
+		}
+
+		controller.OnContactTransferRequestedV1(ctx, transfer)
+		return enums.ContactTransferRequested, nil, transfer
+	}
+
+	status, phoneObject, transfer := controller.store.CreatePhone(ctx, userId, phone)
+	if status != enums.Ok {
+		return status, nil, nil
+	}
+
 	identifiers := []uuid.UUID{userId}
 
-	if oldPhone != nil {
-		identifiers = append(identifiers, oldPhone.UserId)
+	if transfer != nil {
+		identifiers = append(identifiers, transfer.FromUserId)
+		controller.OnContactTransferredV1(ctx, transfer)
 	}
 
-	status, phoneObject := controller.store.CreatePhone(ctx, userId, phone)
-	controller.OnPhoneChanged(identifiers)
-	return status, phoneObject
+	controller.OnPhoneChanged(ctx, identifiers)
+	return enums.Ok, phoneObject, transfer
 }
 
 func (controller *Controller) CreatePhoneConfirmation(ctx context.Context, phone string) (int, *models.PhoneConfirmation) {
@@ -45,6 +62,36 @@ func (controller *Controller) CreatePhoneConfirmation(ctx context.Context, phone
 
 	code := controller.store.GetRandomCodeForPhoneConfirmation()
 	phoneConfirmation := controller.store.CreatePhoneConfirmationCode(ctx, phone, code, time.Minute*15)
-	controller.OnPhoneCodeConfirmationCreated(phone, code)
+	controller.OnPhoneCodeConfirmationCreated(ctx, phone, code)
 	return enums.Ok, phoneConfirmation
 }
+
+func (controller *Controller) GetPhoneByID(ctx context.Context, id uuid.UUID) (int, *models.Phone) {
+	return controller.store.GetPhoneByID(ctx, id)
+}
+
+// GetUserPhones returns phones of user, primary phone goes first
+func (controller *Controller) GetUserPhones(ctx context.Context, userID uuid.UUID) []*models.Phone {
+	return controller.store.GetUserPhones(ctx, userID)
+}
+
+// DeletePhone removes phone of user, the last email or phone of user can't be removed since user couldn't log in
+func (controller *Controller) DeletePhone(ctx context.Context, id uuid.UUID) (int, *models.Phone) {
+	status, phone := controller.store.DeletePhone(ctx, id)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	controller.OnPhoneChanged(ctx, []uuid.UUID{phone.UserId})
+	return enums.Ok, phone
+}
+
+func (controller *Controller) SetPrimaryPhone(ctx context.Context, id uuid.UUID) (int, *models.Phone) {
+	status, phone := controller.store.SetPrimaryPhone(ctx, id)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	controller.OnPhoneChanged(ctx, []uuid.UUID{phone.UserId})
+	return enums.Ok, phone
+}
@@ -7,6 +7,7 @@ import (
 	"hive/models"
 	"context"
 	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/require"
 	"testing"
 	"time"
@@ -43,7 +44,7 @@ func TestCreatePhoneConfirmation(t *testing.T) {
 	controller.
 		Dispatcher.
 		EXPECT().
-		Send("phoneConfirmation", int32(1), &inout.CreatePhoneConfirmationEventV1{
+		Send(ctx, "phoneConfirmation", int32(1), &inout.CreatePhoneConfirmationEventV1{
 			Phone: formattedPhone,
 			Code:  "123456",
 		})
@@ -65,3 +66,37 @@ func TestCreatePhoneConfirmationWithIncorrectPhone(t *testing.T) {
 	require.Nil(t, confirmation)
 	require.Equal(t, enums.IncorrectPhone, status)
 }
+
+func TestDeletePhone(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID := uuid.NewV4()
+	last, phone := uuid.NewV4(), &models.Phone{Id: uuid.NewV4(), UserId: userID, Value: "79999999999"}
+
+	controller.
+		Store.
+		EXPECT().
+		DeletePhone(ctx, last).
+		Return(enums.LastLoginIdentifier, nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		DeletePhone(ctx, phone.Id).
+		Return(enums.Ok, phone).
+		Times(1)
+
+	expectUserChanged(controller, userID)
+
+	status, _ := controller.Controller.DeletePhone(ctx, last)
+	require.Equal(t, enums.LastLoginIdentifier, status)
+
+	status, deleted := controller.Controller.DeletePhone(ctx, phone.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, phone, deleted)
+}
@@ -0,0 +1,117 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/models"
+	"hive/repositories"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+)
+
+// CreateRoleRequest files request of user for role, zero expiresAt requests role forever
+func (controller *Controller) CreateRoleRequest(ctx context.Context, userId uuid.UUID, roleID uuid.UUID, reason string, expiresAt int64) (int, *models.RoleRequest) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return enums.IncorrectRoleRequestReason, nil
+	}
+
+	if !isUserRolePeriodValid(0, expiresAt) {
+		return enums.IncorrectUserRolePeriod, nil
+	}
+
+	status, request := controller.store.CreateRoleRequest(ctx, userId, roleID, reason, expiresAt)
+
+	if status == enums.Ok {
+		controller.OnRoleRequestCreatedV1(ctx, request)
+	}
+
+	return status, request
+}
+
+func (controller *Controller) GetRoleRequest(ctx context.Context, id uuid.UUID) (int, *models.RoleRequest) {
+	return controller.store.GetRoleRequest(ctx, id)
+}
+
+// GetRoleRequests returns requests visible to user, users with permission see every request
+// and others only their own ones and requests for roles they could grant
+func (controller *Controller) GetRoleRequests(ctx context.Context, user models.IAuthenticationBackendUser, query repositories.GetRoleRequestsQuery) ([]*models.RoleRequest, *models.PaginationResponse) {
+	if functools.HasPermission(user.GetPermissions(), enums.RoleRequestsRead) {
+		return controller.store.GetRoleRequests(ctx, query)
+	}
+
+	query.ViewerId = user.GetUserID()
+
+	if userView := controller.store.GetUserView(ctx, user.GetUserID()); userView != nil {
+		query.ManagersID = userView.RolesID
+	}
+
+	return controller.store.GetRoleRequests(ctx, query)
+}
+
+// ReviewRoleRequest approves or denies pending request, approval grants requested role and
+// reviewer could override requested expiry with non-zero expiresAt
+func (controller *Controller) ReviewRoleRequest(ctx context.Context, id uuid.UUID, reviewerId uuid.UUID, status string, comment string, expiresAt int64) (int, *models.RoleRequest) {
+	if status != enums.RoleRequestApproved && status != enums.RoleRequestDenied {
+		return enums.IncorrectRoleRequestStatus, nil
+	}
+
+	if !isUserRolePeriodValid(0, expiresAt) {
+		return enums.IncorrectUserRolePeriod, nil
+	}
+
+	s, request := controller.store.GetRoleRequest(ctx, id)
+	if s != enums.Ok {
+		return s, nil
+	}
+
+	if request.Status != enums.RoleRequestPending {
+		return enums.RoleRequestReviewed, nil
+	}
+
+	if status == enums.RoleRequestDenied {
+		s, request = controller.store.ReviewRoleRequest(ctx, id, status, reviewerId, strings.TrimSpace(comment))
+		if s == enums.Ok {
+			controller.OnRoleRequestDeniedV1(ctx, request)
+		}
+
+		return s, request
+	}
+
+	// requested expiry could pass while request was pending, so granted period is validated as direct grant
+	if expiresAt == 0 {
+		expiresAt = request.ExpiresAt
+	}
+
+	if !isUserRolePeriodValid(0, expiresAt) {
+		return enums.IncorrectUserRolePeriod, nil
+	}
+
+	s, request = controller.store.ApproveRoleRequest(ctx, id, reviewerId, strings.TrimSpace(comment), expiresAt)
+	if s == enums.Ok {
+		controller.OnUserChanged(ctx, []uuid.UUID{request.UserId})
+		controller.OnRoleRequestApprovedV1(ctx, request)
+	}
+
+	return s, request
+}
+
+// CancelRoleRequest withdraws pending request by its author
+func (controller *Controller) CancelRoleRequest(ctx context.Context, id uuid.UUID, userId uuid.UUID) (int, *models.RoleRequest) {
+	status, request := controller.store.GetRoleRequest(ctx, id)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	if request.Status != enums.RoleRequestPending {
+		return enums.RoleRequestReviewed, nil
+	}
+
+	status, request = controller.store.ReviewRoleRequest(ctx, id, enums.RoleRequestCancelled, userId, "")
+	if status == enums.Ok {
+		controller.OnRoleRequestCancelledV1(ctx, request)
+	}
+
+	return status, request
+}
@@ -0,0 +1,137 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateRoleRequestWithoutReason(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	status, request := controller.Controller.CreateRoleRequest(ctx, uuid.NewV4(), uuid.NewV4(), "  ", 0)
+	require.Equal(t, enums.IncorrectRoleRequestReason, status)
+	require.Nil(t, request)
+
+	status, request = controller.Controller.CreateRoleRequest(ctx, uuid.NewV4(), uuid.NewV4(), "Backend tasks", 1)
+	require.Equal(t, enums.IncorrectUserRolePeriod, status)
+	require.Nil(t, request)
+}
+
+func TestApproveRoleRequest(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	reviewerID := uuid.NewV4()
+	leadRoleID := uuid.NewV4()
+	pending := &models.RoleRequest{Id: uuid.NewV4(), UserId: uuid.NewV4(), RoleId: uuid.NewV4(), Status: enums.RoleRequestPending}
+	approved := &models.RoleRequest{Id: pending.Id, UserId: pending.UserId, RoleId: pending.RoleId, Status: enums.RoleRequestApproved,
+		ReviewerId: reviewerID, UserRoleId: uuid.NewV4()}
+
+	controller.
+		Store.
+		EXPECT().
+		GetRoleRequest(ctx, pending.Id).
+		Return(enums.Ok, pending).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		ApproveRoleRequest(ctx, pending.Id, reviewerID, "Welcome", int64(0)).
+		Return(enums.Ok, approved).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CreateOrUpdateUsersViewByUsersID(gomock.Any(), []uuid.UUID{pending.UserId}).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CacheUserView(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(gomock.Any(), "userView", int32(1), gomock.Any()).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		GetRoleManagersID(ctx, pending.RoleId).
+		Return([]uuid.UUID{leadRoleID}).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "roleRequestApproved", int32(1), gomock.Any()).
+		Times(1)
+
+	status, request := controller.Controller.ReviewRoleRequest(ctx, pending.Id, reviewerID, enums.RoleRequestApproved, " Welcome ", 0)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, approved, request)
+}
+
+func TestReviewReviewedRoleRequest(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	denied := &models.RoleRequest{Id: uuid.NewV4(), UserId: uuid.NewV4(), RoleId: uuid.NewV4(), Status: enums.RoleRequestDenied}
+
+	status, _ := controller.Controller.ReviewRoleRequest(ctx, denied.Id, uuid.NewV4(), enums.RoleRequestCancelled, "", 0)
+	require.Equal(t, enums.IncorrectRoleRequestStatus, status)
+
+	controller.
+		Store.
+		EXPECT().
+		GetRoleRequest(ctx, denied.Id).
+		Return(enums.Ok, denied).
+		Times(2)
+
+	status, _ = controller.Controller.ReviewRoleRequest(ctx, denied.Id, uuid.NewV4(), enums.RoleRequestApproved, "", 0)
+	require.Equal(t, enums.RoleRequestReviewed, status)
+
+	status, _ = controller.Controller.CancelRoleRequest(ctx, denied.Id, denied.UserId)
+	require.Equal(t, enums.RoleRequestReviewed, status)
+}
+
+func TestApproveOutdatedRoleRequest(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	pending := &models.RoleRequest{Id: uuid.NewV4(), UserId: uuid.NewV4(), RoleId: uuid.NewV4(), Status: enums.RoleRequestPending,
+		ExpiresAt: time.Now().UnixNano()/int64(time.Millisecond) - 1000}
+
+	controller.
+		Store.
+		EXPECT().
+		GetRoleRequest(ctx, pending.Id).
+		Return(enums.Ok, pending).
+		Times(1)
+
+	status, request := controller.Controller.ReviewRoleRequest(ctx, pending.Id, uuid.NewV4(), enums.RoleRequestApproved, "", 0)
+	require.Equal(t, enums.IncorrectUserRolePeriod, status)
+	require.Nil(t, request)
+}
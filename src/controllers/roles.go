@@ -1,23 +1,115 @@
 package controllers
 
 import (
+	"hive/config"
 	"hive/enums"
+	"hive/functools"
 	"hive/models"
 	"hive/repositories"
 	"context"
 	uuid "github.com/satori/go.uuid"
 )
 
-func (controller *Controller) CreateRole(ctx context.Context, title string) (int, *models.Role) {
-	status, role := controller.store.CreateRole(ctx, title)
+func (controller *Controller) CreateRole(ctx context.Context, title string, permissions []string, parentsID []uuid.UUID) (int, *models.Role) {
+	for _, permission := range permissions {
+		if !functools.IsPermission(permission) {
+			return enums.IncorrectPermission, nil
+		}
+	}
+
+	status, role := controller.store.CreateRole(ctx, title, permissions, parentsID)
+
+	if status == enums.Ok {
+		controller.OnRoleChanged(ctx, []uuid.UUID{role.Id})
+	}
+
+	return status, role
+}
+
+// UpdateRoleParents replaces parents of role and recalculates views of users which have it directly or by inheritance
+func (controller *Controller) UpdateRoleParents(ctx context.Context, id uuid.UUID, parentsID []uuid.UUID) (int, *models.Role) {
+	status, role := controller.store.UpdateRoleParents(ctx, id, parentsID)
+
+	if status == enums.Ok {
+		controller.OnRoleChanged(ctx, []uuid.UUID{role.Id})
+	}
+
+	return status, role
+}
+
+// UpdateRole renames and describes role, admin role can't be renamed since administrators are recognized by its title
+func (controller *Controller) UpdateRole(ctx context.Context, id uuid.UUID, title string, description string) (int, *models.Role) {
+	status, role := controller.store.GetRole(ctx, id)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	if role.Title == config.AdminRole && title != config.AdminRole {
+		return enums.RoleProtected, nil
+	}
+
+	status, role = controller.store.UpdateRole(ctx, id, title, description)
 
 	if status == enums.Ok {
-		controller.OnRoleChanged([]uuid.UUID{role.Id})
+		controller.OnRoleChanged(ctx, []uuid.UUID{role.Id})
+		controller.OnRoleUpdatedV1(ctx, role)
 	}
 
 	return status, role
 }
 
+// DeleteRole deletes role except admin one, views of users which had it directly or by inheritance are recalculated
+func (controller *Controller) DeleteRole(ctx context.Context, id uuid.UUID) (int, *models.Role) {
+	status, role := controller.store.GetRole(ctx, id)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	if role.Title == config.AdminRole {
+		return enums.RoleProtected, nil
+	}
+
+	// Assignments are deleted together with role, so users are found beforehand
+	usersID := controller.store.GetRoleUsersID(ctx, id)
+
+	status, role = controller.store.DeleteRole(ctx, id)
+
+	if status == enums.Ok {
+		controller.OnUserChanged(ctx, usersID)
+		controller.OnRoleDeletedV1(ctx, role)
+	}
+
+	return status, role
+}
+
+// UpdateRoleManagedRoles replaces roles which users having role could grant and revoke
+func (controller *Controller) UpdateRoleManagedRoles(ctx context.Context, id uuid.UUID, managedRolesID []uuid.UUID) (int, *models.Role) {
+	status, role := controller.store.UpdateRoleManagedRoles(ctx, id, managedRolesID)
+
+	if status == enums.Ok {
+		controller.OnRoleUpdatedV1(ctx, role)
+	}
+
+	return status, role
+}
+
+// GetManageableRoles returns roles which user could grant, permission to create user roles allows to grant any role
+func (controller *Controller) GetManageableRoles(ctx context.Context, user models.IAuthenticationBackendUser, query repositories.GetRolesQuery) ([]*models.Role, *models.PaginationResponse) {
+	if functools.HasPermission(user.GetPermissions(), enums.UserRolesCreate) {
+		return controller.store.GetRoles(ctx, query)
+	}
+
+	userView := controller.store.GetUserView(ctx, user.GetUserID())
+
+	// Empty list of managers would select every role
+	if userView == nil || len(userView.RolesID) == 0 {
+		return []*models.Role{}, &models.PaginationResponse{HasPrevious: functools.HasPrevious(query.Pagination.Page)}
+	}
+
+	query.ManagersID = userView.RolesID
+	return controller.store.GetRoles(ctx, query)
+}
+
 func (controller *Controller) GetRole(ctx context.Context, id uuid.UUID) (int, *models.Role) {
 	return controller.store.GetRole(ctx, id)
 }
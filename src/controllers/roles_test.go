@@ -0,0 +1,75 @@
+package controllers
+
+import (
+	"hive/config"
+	"hive/enums"
+	"hive/inout"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestDeleteAdminRole(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	role := &models.Role{Id: uuid.NewV4(), Title: config.AdminRole}
+
+	controller.
+		Store.
+		EXPECT().
+		GetRole(ctx, role.Id).
+		Return(enums.Ok, role).
+		Times(2)
+
+	status, _ := controller.Controller.DeleteRole(ctx, role.Id)
+	require.Equal(t, enums.RoleProtected, status)
+
+	status, _ = controller.Controller.UpdateRole(ctx, role.Id, "root", "")
+	require.Equal(t, enums.RoleProtected, status)
+}
+
+func TestDeleteRoleWithoutUsers(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	role := &models.Role{Id: uuid.NewV4(), Title: "manager"}
+
+	controller.
+		Store.
+		EXPECT().
+		GetRole(ctx, role.Id).
+		Return(enums.Ok, role).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		GetRoleUsersID(ctx, role.Id).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		DeleteRole(ctx, role.Id).
+		Return(enums.Ok, role).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "roleDeleted", int32(1), &inout.RoleDeletedV1{Id: role.Id.Bytes(), Title: role.Title}).
+		Times(1)
+
+	status, deletedRole := controller.Controller.DeleteRole(ctx, role.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, role, deletedRole)
+}
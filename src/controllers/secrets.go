@@ -1,7 +1,9 @@
 package controllers
 
 import (
+	"hive/enums"
 	"hive/models"
+	"hive/repositories/postgresRepository"
 	"context"
 	uuid "github.com/satori/go.uuid"
 )
@@ -17,6 +19,26 @@ func (controller *Controller) GetActualSecret(ctx context.Context) *models.Secre
 	}
 
 	secret = controller.store.CreateSecret(ctx)
-	controller.OnSecretCreatedV1(secret)
+	controller.OnSecretCreatedV1(ctx, secret)
 	return secret
 }
+
+func (controller *Controller) GetSecrets(ctx context.Context, query postgresRepository.GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse) {
+	return controller.store.GetSecrets(ctx, query)
+}
+
+func (controller *Controller) RotateSecret(ctx context.Context) *models.Secret {
+	secret := controller.store.RotateSecret(ctx)
+	controller.OnSecretCreatedV1(ctx, secret)
+	return secret
+}
+
+func (controller *Controller) RevokeSecret(ctx context.Context, id uuid.UUID) (int, *models.Secret) {
+	status, secret := controller.store.RevokeSecret(ctx, id)
+
+	if status == enums.Ok {
+		controller.OnSecretRevokedV1(ctx, secret)
+	}
+
+	return status, secret
+}
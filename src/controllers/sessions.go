@@ -8,21 +8,45 @@ import (
 	"time"
 )
 
-func (controller *Controller) CreateSession(ctx context.Context, userID uuid.UUID, fingerprint, userAgent string) *models.Session {
-	secret := controller.GetActualSecret(ctx)
-	session := controller.store.CreateSession(ctx, userID, secret.Id, fingerprint, userAgent)
+// CreateSession logs user in with organizationID as active organization, user must be a member of it.
+// Logging in within grace period cancels requested deletion, refreshing session doesn't
+func (controller *Controller) CreateSession(ctx context.Context, userID uuid.UUID, fingerprint, userAgent, clientID, keyThumbprint string, organizationID uuid.UUID, authentication *models.AuthenticationContext) (int, *models.Session) {
 	user := controller.GetUserView(ctx, userID)
-	session.AccessToken = controller.accessTokenEncoder(ctx, user.Id, user.Roles, secret, session.Expires)
-	return session
+	status, session := controller.createSession(ctx, user, userID, fingerprint, userAgent, clientID, keyThumbprint, organizationID, authentication)
+
+	if status == enums.Ok && user != nil && user.DeletionScheduled > 0 {
+		controller.CancelUserDeletion(ctx, userID)
+	}
+
+	return status, session
+}
+
+// createSession is shared by login and refresh, suspended and blocked users can neither create nor refresh sessions
+func (controller *Controller) createSession(ctx context.Context, user *models.UserView, userID uuid.UUID, fingerprint, userAgent, clientID, keyThumbprint string, organizationID uuid.UUID, authentication *models.AuthenticationContext) (int, *models.Session) {
+	if user != nil && !user.IsActive(time.Now().UnixNano()/int64(time.Millisecond)) {
+		return enums.UserInactive, nil
+	}
+
+	if organizationID != uuid.Nil && (user == nil || user.GetOrganization(organizationID) == nil) {
+		return enums.OrganizationMemberNotFound, nil
+	}
+
+	secret := controller.GetActualSecret(ctx)
+	session := controller.store.CreateSession(ctx, userID, secret.Id, fingerprint, userAgent, keyThumbprint, organizationID, authentication)
+	session.AccessToken = controller.accessTokenEncoder(ctx, user, session, clientID, secret)
+	return enums.Ok, session
 }
 
-func (controller *Controller) UpdateSession(ctx context.Context, id uuid.UUID, fingerprint, userAgent string) (int, *models.Session) {
+func (controller *Controller) UpdateSession(ctx context.Context, id uuid.UUID, fingerprint, userAgent, clientID, keyThumbprint string) (int, *models.Session) {
 	oldSession := controller.store.DeleteSession(ctx, id)
 	if oldSession == nil ||
 		oldSession.Fingerprint != fingerprint ||
+		oldSession.KeyThumbprint != keyThumbprint ||
 		oldSession.Expires <= time.Now().Unix() {
 		return enums.SessionNotFound, nil
 	}
 
-	return enums.Ok, controller.CreateSession(ctx, oldSession.UserID, fingerprint, userAgent)
+	user := controller.GetUserView(ctx, oldSession.UserID)
+	return controller.createSession(ctx, user, oldSession.UserID, fingerprint, userAgent, clientID, keyThumbprint, oldSession.OrganizationID,
+		models.InitAuthenticationContext(oldSession.AuthMethods, oldSession.AuthTime))
 }
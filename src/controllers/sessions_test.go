@@ -1 +1,80 @@
 package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateSessionWithOrganization(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID, organizationID := uuid.NewV4(), uuid.NewV4()
+	secret := &models.Secret{Id: uuid.NewV4(), Value: uuid.NewV4()}
+	authentication := models.InitAuthenticationContext([]string{enums.PasswordAuthenticationMethod}, 1)
+
+	controller.Store.
+		EXPECT().
+		GetUserView(ctx, userID).
+		Return(&models.UserView{
+			Id:            userID,
+			Organizations: []*models.UserViewOrganization{{Id: organizationID}},
+		}).
+		Times(2)
+
+	controller.Store.
+		EXPECT().
+		GetActualSecret(ctx).
+		Return(secret).
+		Times(1)
+
+	controller.Store.
+		EXPECT().
+		CreateSession(ctx, userID, secret.Id, "fingerprint", "chrome", "", organizationID, authentication).
+		Return(&models.Session{UserID: userID, OrganizationID: organizationID}).
+		Times(1)
+
+	status, session := controller.Controller.CreateSession(ctx, userID, "fingerprint", "chrome", "", "", organizationID, authentication)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, organizationID, session.OrganizationID)
+
+	status, session = controller.Controller.CreateSession(ctx, userID, "fingerprint", "chrome", "", "", uuid.NewV4(), authentication)
+	require.Equal(t, enums.OrganizationMemberNotFound, status)
+	require.Nil(t, session)
+}
+
+func TestUpdateSessionOfBlockedUser(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID := uuid.NewV4()
+	session := &models.Session{Id: uuid.NewV4(), UserID: userID, Fingerprint: "fingerprint", Expires: time.Now().Add(time.Minute).Unix()}
+
+	controller.Store.
+		EXPECT().
+		DeleteSession(ctx, session.Id).
+		Return(session).
+		Times(1)
+
+	controller.Store.
+		EXPECT().
+		GetUserView(ctx, userID).
+		Return(&models.UserView{Id: userID, Status: enums.UserBlocked}).
+		Times(1)
+
+	status, updated := controller.Controller.UpdateSession(ctx, session.Id, "fingerprint", "chrome", "", "")
+	require.Equal(t, enums.UserInactive, status)
+	require.Nil(t, updated)
+}
@@ -0,0 +1,74 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+// Users anonymized by one check, the rest wait for the next one
+const userDeletionsBatchSize = 10
+
+// RequestUserDeletion schedules anonymization of user after grace period and revokes sessions of user,
+// logging in again within grace period cancels deletion
+func (controller *Controller) RequestUserDeletion(ctx context.Context, id uuid.UUID) (int, *models.User) {
+	scheduled := time.Now().Add(time.Hour*24*time.Duration(controller.environment.UserDeletionGracePeriod)).UnixNano() / int64(time.Millisecond)
+
+	status, user := controller.store.ScheduleUserDeletion(ctx, id, scheduled)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	controller.store.DeleteUserSessions(ctx, id)
+	controller.OnUserChanged(ctx, []uuid.UUID{id})
+	controller.OnUserDeletionRequestedV1(ctx, user)
+	return enums.Ok, user
+}
+
+func (controller *Controller) CancelUserDeletion(ctx context.Context, id uuid.UUID) (int, *models.User) {
+	status, user := controller.store.CancelUserDeletion(ctx, id)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	controller.OnUserChanged(ctx, []uuid.UUID{id})
+	controller.OnUserDeletionCancelledV1(ctx, user)
+	return enums.Ok, user
+}
+
+// ProcessUserDeletions anonymizes users which grace period is over
+func (controller *Controller) ProcessUserDeletions(ctx context.Context) {
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+
+	for _, id := range controller.store.GetDueUserDeletions(ctx, now, userDeletionsBatchSize) {
+		status, user := controller.store.AnonymizeUser(ctx, id, now)
+		if status != enums.Ok {
+			continue
+		}
+
+		controller.OnUserChanged(ctx, []uuid.UUID{id})
+		controller.OnUserAnonymizedV1(ctx, user)
+	}
+}
+
+// WatchUserDeletions periodically anonymizes users of realm, blocks until context is done.
+// Concurrent instances don't conflict since every user is anonymized only once
+func (controller *Controller) WatchUserDeletions(ctx context.Context) {
+	if controller.environment.UserDeletionsCheckInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Second * time.Duration(controller.environment.UserDeletionsCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			controller.ProcessUserDeletions(ctx)
+		}
+	}
+}
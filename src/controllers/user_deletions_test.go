@@ -0,0 +1,207 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func expectUserChanged(controller *ControllerWithMockedInternals, id ...uuid.UUID) {
+	controller.
+		Store.
+		EXPECT().
+		CreateOrUpdateUsersViewByUsersID(gomock.Any(), id).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CacheUserView(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(gomock.Any(), "userView", int32(1), gomock.Any()).
+		Times(1)
+}
+
+func TestRequestUserDeletion(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	id := uuid.NewV4()
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+	scheduled := &models.User{Id: id, Status: enums.UserActive, DeletionScheduled: now}
+
+	controller.
+		Store.
+		EXPECT().
+		ScheduleUserDeletion(ctx, id, gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, at int64) (int, *models.User) {
+			require.Greater(t, at, now)
+			return enums.Ok, scheduled
+		}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		DeleteUserSessions(ctx, id).
+		Return(nil).
+		Times(1)
+
+	expectUserChanged(controller, id)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "userDeletionRequested", int32(1), gomock.Any()).
+		Times(1)
+
+	status, user := controller.Controller.RequestUserDeletion(ctx, id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, scheduled, user)
+}
+
+func TestCreateSessionCancelsUserDeletion(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID := uuid.NewV4()
+	secret := &models.Secret{Id: uuid.NewV4(), Value: uuid.NewV4()}
+	authentication := models.InitAuthenticationContext([]string{enums.PasswordAuthenticationMethod}, 1)
+
+	controller.Store.
+		EXPECT().
+		GetUserView(ctx, userID).
+		Return(&models.UserView{Id: userID, Status: enums.UserActive, DeletionScheduled: 1}).
+		Times(1)
+
+	controller.Store.
+		EXPECT().
+		CancelUserDeletion(ctx, userID).
+		Return(enums.Ok, &models.User{Id: userID, Status: enums.UserActive}).
+		Times(1)
+
+	expectUserChanged(controller, userID)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "userDeletionCancelled", int32(1), gomock.Any()).
+		Times(1)
+
+	controller.Store.
+		EXPECT().
+		GetActualSecret(ctx).
+		Return(secret).
+		Times(1)
+
+	controller.Store.
+		EXPECT().
+		CreateSession(ctx, userID, secret.Id, "fingerprint", "chrome", "", uuid.Nil, authentication).
+		Return(&models.Session{UserID: userID}).
+		Times(1)
+
+	status, _ := controller.Controller.CreateSession(ctx, userID, "fingerprint", "chrome", "", "", uuid.Nil, authentication)
+	require.Equal(t, enums.Ok, status)
+}
+
+func TestUpdateSessionKeepsUserDeletion(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID := uuid.NewV4()
+	secret := &models.Secret{Id: uuid.NewV4(), Value: uuid.NewV4()}
+	session := &models.Session{Id: uuid.NewV4(), UserID: userID, Fingerprint: "fingerprint", Expires: time.Now().Add(time.Minute).Unix(),
+		AuthMethods: []string{enums.PasswordAuthenticationMethod}, AuthTime: 1}
+
+	controller.Store.
+		EXPECT().
+		DeleteSession(ctx, session.Id).
+		Return(session).
+		Times(1)
+
+	controller.Store.
+		EXPECT().
+		GetUserView(ctx, userID).
+		Return(&models.UserView{Id: userID, Status: enums.UserActive, DeletionScheduled: 1}).
+		Times(1)
+
+	controller.Store.
+		EXPECT().
+		CancelUserDeletion(gomock.Any(), gomock.Any()).
+		Times(0)
+
+	controller.Store.
+		EXPECT().
+		GetActualSecret(ctx).
+		Return(secret).
+		Times(1)
+
+	controller.Store.
+		EXPECT().
+		CreateSession(ctx, userID, secret.Id, "fingerprint", "chrome", "", uuid.Nil, gomock.Any()).
+		Return(&models.Session{UserID: userID}).
+		Times(1)
+
+	status, _ := controller.Controller.UpdateSession(ctx, session.Id, "fingerprint", "chrome", "", "")
+	require.Equal(t, enums.Ok, status)
+}
+
+func TestProcessUserDeletions(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	id, cancelledID := uuid.NewV4(), uuid.NewV4()
+
+	controller.
+		Store.
+		EXPECT().
+		GetDueUserDeletions(ctx, gomock.Any(), userDeletionsBatchSize).
+		Return([]uuid.UUID{id, cancelledID}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		AnonymizeUser(ctx, id, gomock.Any()).
+		Return(enums.Ok, &models.User{Id: id, Status: enums.UserDeleted}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		AnonymizeUser(ctx, cancelledID, gomock.Any()).
+		Return(enums.UserDeletionNotScheduled, nil).
+		Times(1)
+
+	expectUserChanged(controller, id)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "userAnonymized", int32(1), gomock.Any()).
+		Times(1)
+
+	controller.Controller.ProcessUserDeletions(ctx)
+}
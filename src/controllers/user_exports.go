@@ -0,0 +1,236 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/inout"
+	"hive/models"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"github.com/getsentry/sentry-go"
+	uuid "github.com/satori/go.uuid"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// Exports claimed by one check, the rest wait for the next one
+const userExportsBatchSize = 10
+
+// Only hash of download token is stored, so archives can't be downloaded with database contents
+func hashUserExportToken(token string) string {
+	hash := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(hash[:])
+}
+
+func generateUserExportToken() (string, error) {
+	token := make([]byte, 32)
+	_, err := rand.Read(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(token), nil
+}
+
+// nullUUIDToBytes returns nil for missing identifiers
+func nullUUIDToBytes(id uuid.UUID) []byte {
+	if id == uuid.Nil {
+		return nil
+	}
+
+	return id.Bytes()
+}
+
+// UserExportDataToProto converts user data to content of export archive, tokens and fingerprints of sessions
+// and previous owners of received contacts are not exported
+func UserExportDataToProto(data *models.UserExportData) *inout.UserExportData {
+	user := data.User
+	message := &inout.UserExportData{
+		User: &inout.User{
+			Id:                user.Id.Bytes(),
+			Created:           user.Created,
+			Status:            user.Status,
+			SuspendedUntil:    user.SuspendedUntil,
+			StatusReason:      user.StatusReason,
+			StatusActorID:     nullUUIDToBytes(user.StatusActorId),
+			StatusChanged:     user.StatusChanged,
+			DeletionScheduled: user.DeletionScheduled,
+		},
+		Profile:          functools.MapToStruct(user.Profile),
+		PasswordsChanged: data.PasswordsChanged,
+	}
+
+	for _, email := range data.Emails {
+		message.Emails = append(message.Emails, &inout.Email{
+			Id:         email.Id.Bytes(),
+			Created:    email.Created,
+			UserID:     email.UserId.Bytes(),
+			Email:      email.Value,
+			Primary:    email.Primary,
+			VerifiedAt: email.VerifiedAt,
+		})
+	}
+
+	for _, phone := range data.Phones {
+		message.Phones = append(message.Phones, &inout.Phone{
+			Id:         phone.Id.Bytes(),
+			Created:    phone.Created,
+			UserID:     phone.UserId.Bytes(),
+			Phone:      phone.Value,
+			Primary:    phone.Primary,
+			VerifiedAt: phone.VerifiedAt,
+		})
+	}
+
+	for _, userRole := range data.Roles {
+		message.Roles = append(message.Roles, &inout.UserRole{
+			Id:             userRole.Id.Bytes(),
+			Created:        userRole.Created,
+			UserID:         userRole.UserId.Bytes(),
+			RoleID:         userRole.RoleId.Bytes(),
+			OrganizationID: nullUUIDToBytes(userRole.OrganizationId),
+			StartsAt:       userRole.StartsAt,
+			ExpiresAt:      userRole.ExpiresAt,
+		})
+	}
+
+	for _, session := range data.Sessions {
+		message.Sessions = append(message.Sessions, &inout.UserExportSession{
+			Created:        session.Created,
+			Expires:        session.Expires,
+			UserAgent:      session.UserAgent,
+			AuthTime:       session.AuthTime,
+			AuthMethods:    session.AuthMethods,
+			OrganizationID: nullUUIDToBytes(session.OrganizationID),
+		})
+	}
+
+	for _, request := range data.RoleRequests {
+		message.RoleRequests = append(message.RoleRequests, &inout.RoleRequest{
+			Id:         request.Id.Bytes(),
+			Created:    request.Created,
+			UserID:     request.UserId.Bytes(),
+			RoleID:     request.RoleId.Bytes(),
+			Reason:     request.Reason,
+			Status:     request.Status,
+			ExpiresAt:  request.ExpiresAt,
+			ReviewerID: nullUUIDToBytes(request.ReviewerId),
+			Reviewed:   request.Reviewed,
+			Comment:    request.Comment,
+			UserRoleID: nullUUIDToBytes(request.UserRoleId),
+		})
+	}
+
+	for _, transfer := range data.ContactTransfers {
+		contactTransfer := &inout.ContactTransfer{
+			Id:       transfer.Id.Bytes(),
+			Created:  transfer.Created,
+			Kind:     transfer.Kind,
+			Value:    transfer.Value,
+			ToUserID: transfer.ToUserId.Bytes(),
+			Status:   transfer.Status,
+			Resolved: transfer.Resolved,
+		}
+
+		if uuid.Equal(transfer.FromUserId, user.Id) {
+			contactTransfer.FromUserID = transfer.FromUserId.Bytes()
+		}
+
+		message.ContactTransfers = append(message.ContactTransfers, contactTransfer)
+	}
+
+	return message
+}
+
+// CreateUserExport requests export of everything hive holds for user, archive is built in background
+// and downloaded with token of returned export
+func (controller *Controller) CreateUserExport(ctx context.Context, userID uuid.UUID, requesterID uuid.UUID) (int, *models.UserExport) {
+	token, err := generateUserExportToken()
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, export := controller.store.CreateUserExport(ctx, userID, requesterID, hashUserExportToken(token))
+	if status == enums.Ok {
+		export.Token = token
+	}
+
+	return status, export
+}
+
+func (controller *Controller) GetUserExport(ctx context.Context, id uuid.UUID) (int, *models.UserExport) {
+	return controller.store.GetUserExport(ctx, id)
+}
+
+// GetUserExportArchive returns export with archive if token matches, archive is protobuf encoded UserExportData
+func (controller *Controller) GetUserExportArchive(ctx context.Context, id uuid.UUID, token string) (int, *models.UserExport) {
+	if token == "" {
+		return enums.UserExportNotFound, nil
+	}
+
+	status, export := controller.store.GetUserExportArchive(ctx, id, hashUserExportToken(token))
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	if export.Status != enums.UserExportReady {
+		return enums.UserExportNotReady, nil
+	}
+
+	return enums.Ok, export
+}
+
+func (controller *Controller) processUserExport(ctx context.Context, export *models.UserExport) {
+	expires := time.Now().Add(time.Hour*time.Duration(controller.environment.UserExportLifetime)).UnixNano() / int64(time.Millisecond)
+	result := enums.UserExportFailed
+	var archive []byte
+
+	status, data := controller.store.GetUserExportData(ctx, export.UserId)
+	if status == enums.Ok {
+		var err error
+		archive, err = proto.Marshal(UserExportDataToProto(data))
+		if err != nil {
+			sentry.CaptureException(err)
+		} else {
+			result = enums.UserExportReady
+		}
+	}
+
+	status, completed := controller.store.CompleteUserExport(ctx, export.Id, result, archive, expires)
+	if status == enums.Ok {
+		controller.OnUserExportCompletedV1(ctx, completed)
+	}
+}
+
+// ProcessUserExports builds archives of requested exports and deletes expired ones
+func (controller *Controller) ProcessUserExports(ctx context.Context) {
+	controller.store.DeleteExpiredUserExports(ctx)
+
+	for _, export := range controller.store.ClaimUserExports(ctx, userExportsBatchSize) {
+		controller.processUserExport(ctx, export)
+	}
+}
+
+// WatchUserExports periodically processes requested exports of realm, blocks until context is done.
+// Concurrent instances don't conflict since every export is claimed only once
+func (controller *Controller) WatchUserExports(ctx context.Context) {
+	if controller.environment.UserExportsCheckInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Second * time.Duration(controller.environment.UserExportsCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			controller.ProcessUserExports(ctx)
+		}
+	}
+}
@@ -0,0 +1,133 @@
+package controllers
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateUserExport(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID := uuid.NewV4()
+	var tokenHash string
+
+	controller.
+		Store.
+		EXPECT().
+		CreateUserExport(ctx, userID, userID, gomock.Any()).
+		DoAndReturn(func(_ context.Context, userID uuid.UUID, requesterID uuid.UUID, hash string) (int, *models.UserExport) {
+			tokenHash = hash
+			return enums.Ok, &models.UserExport{Id: uuid.NewV4(), UserId: userID, RequesterId: requesterID, Status: enums.UserExportPending}
+		}).
+		Times(1)
+
+	status, export := controller.Controller.CreateUserExport(ctx, userID, userID)
+	require.Equal(t, enums.Ok, status)
+	require.NotEmpty(t, export.Token)
+	require.NotEqual(t, export.Token, tokenHash)
+	require.Equal(t, hashUserExportToken(export.Token), tokenHash)
+}
+
+func TestGetUserExportArchiveNotReady(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	id := uuid.NewV4()
+
+	controller.
+		Store.
+		EXPECT().
+		GetUserExportArchive(ctx, id, hashUserExportToken("token")).
+		Return(enums.Ok, &models.UserExport{Id: id, Status: enums.UserExportProcessing}).
+		Times(1)
+
+	status, _ := controller.Controller.GetUserExportArchive(ctx, id, "token")
+	require.Equal(t, enums.UserExportNotReady, status)
+
+	status, _ = controller.Controller.GetUserExportArchive(ctx, id, "")
+	require.Equal(t, enums.UserExportNotFound, status)
+}
+
+func TestProcessUserExports(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID := uuid.NewV4()
+	export := &models.UserExport{Id: uuid.NewV4(), UserId: userID, RequesterId: userID, Status: enums.UserExportProcessing}
+
+	controller.
+		Store.
+		EXPECT().
+		DeleteExpiredUserExports(ctx).
+		Return(int64(0)).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		ClaimUserExports(ctx, userExportsBatchSize).
+		Return([]*models.UserExport{export}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		GetUserExportData(ctx, userID).
+		Return(enums.Ok, &models.UserExportData{
+			User:             &models.User{Id: userID, Profile: map[string]interface{}{"name": "Ivan"}},
+			Emails:           []*models.Email{{Id: uuid.NewV4(), UserId: userID, Value: "user@example.com"}},
+			PasswordsChanged: []int64{1},
+		}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CompleteUserExport(ctx, export.Id, enums.UserExportReady, gomock.Not(gomock.Nil()), gomock.Any()).
+		Return(enums.Ok, &models.UserExport{Id: export.Id, UserId: userID, RequesterId: userID, Status: enums.UserExportReady}).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "userExportCompleted", int32(1), gomock.Any()).
+		Times(1)
+
+	controller.Controller.ProcessUserExports(ctx)
+}
+
+func TestUserExportDataToProtoHidesPreviousOwners(t *testing.T) {
+	t.Parallel()
+	userID := uuid.NewV4()
+	previousOwnerID := uuid.NewV4()
+	receiverID := uuid.NewV4()
+
+	message := UserExportDataToProto(&models.UserExportData{
+		User: &models.User{Id: userID},
+		ContactTransfers: []*models.ContactTransfer{
+			{Id: uuid.NewV4(), Kind: enums.EmailContact, Value: "received@mail.com", FromUserId: previousOwnerID, ToUserId: userID},
+			{Id: uuid.NewV4(), Kind: enums.PhoneContact, Value: "+7 923 456-78-90", FromUserId: userID, ToUserId: receiverID},
+		},
+	})
+
+	require.Len(t, message.ContactTransfers, 2)
+	require.Empty(t, message.ContactTransfers[0].FromUserID)
+	require.Equal(t, userID.Bytes(), message.ContactTransfers[0].ToUserID)
+	require.Equal(t, userID.Bytes(), message.ContactTransfers[1].FromUserID)
+	require.Equal(t, receiverID.Bytes(), message.ContactTransfers[1].ToUserID)
+}
@@ -2,22 +2,58 @@ package controllers
 
 import (
 	"hive/enums"
+	"hive/functools"
 	"hive/models"
 	"hive/repositories"
 	"context"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
-func (controller *Controller) CreateUserRole(ctx context.Context, userId uuid.UUID, roleID uuid.UUID) (int, *models.UserRole) {
-	status, userRole := controller.store.CreateUserRole(ctx, userId, roleID)
+// CreateUserRole grants role to user, assignment with zero startsAt is active right away and with zero expiresAt never expires
+func (controller *Controller) CreateUserRole(ctx context.Context, userId uuid.UUID, roleID uuid.UUID, organizationID uuid.UUID, startsAt int64, expiresAt int64) (int, *models.UserRole) {
+	if !isUserRolePeriodValid(startsAt, expiresAt) {
+		return enums.IncorrectUserRolePeriod, nil
+	}
+
+	status, userRole := controller.store.CreateUserRole(ctx, userId, roleID, organizationID, startsAt, expiresAt)
 
 	if status == enums.Ok {
-		controller.OnUserChanged([]uuid.UUID{userRole.UserId})
+		controller.OnUserChanged(ctx, []uuid.UUID{userRole.UserId})
 	}
 
 	return status, userRole
 }
 
+// isUserRolePeriodValid checks period of assignment, it is shared by direct grants and approved role requests
+func isUserRolePeriodValid(startsAt int64, expiresAt int64) bool {
+	if startsAt < 0 || expiresAt < 0 {
+		return false
+	}
+
+	return expiresAt == 0 || (expiresAt > time.Now().UnixNano()/int64(time.Millisecond) && expiresAt > startsAt)
+}
+
+// CanManageUserRole checks whether user could grant or revoke role, users with permission could manage any role
+// and others only roles managed by their own roles including inherited and granted to their groups
+func (controller *Controller) CanManageUserRole(ctx context.Context, user models.IAuthenticationBackendUser, roleID uuid.UUID, permission string) bool {
+	if functools.HasPermission(user.GetPermissions(), permission) {
+		return true
+	}
+
+	userView := controller.store.GetUserView(ctx, user.GetUserID())
+	if userView == nil || len(userView.RolesID) == 0 {
+		return false
+	}
+
+	status, manageable := controller.store.IsRoleManageable(ctx, userView.RolesID, roleID)
+	return status == enums.Ok && manageable
+}
+
+func (controller *Controller) GetUserRole(ctx context.Context, id uuid.UUID) (int, *models.UserRole) {
+	return controller.store.GetUserRole(ctx, id)
+}
+
 func (controller *Controller) GetUserRoles(ctx context.Context, query repositories.GetUserRoleQuery) ([]*models.UserRole, *models.PaginationResponse) {
 	return controller.store.GetUserRoles(ctx, query)
 }
@@ -26,8 +62,54 @@ func (controller *Controller) DeleteUserRole(ctx context.Context, id uuid.UUID)
 	status, userRole := controller.store.DeleteUserRole(ctx, id)
 
 	if status == enums.Ok {
-		controller.OnUserChanged([]uuid.UUID{userRole.UserId})
+		controller.OnUserChanged(ctx, []uuid.UUID{userRole.UserId})
 	}
 
 	return status, userRole
 }
+
+// UpdateUserRolesPeriods applies temporary assignments which started and deletes expired ones,
+// views of affected users are recalculated
+func (controller *Controller) UpdateUserRolesPeriods(ctx context.Context) {
+	started := controller.store.StartUserRoles(ctx)
+	expired := controller.store.ExpireUserRoles(ctx)
+
+	affected := make(map[uuid.UUID]bool)
+	usersID := make([]uuid.UUID, 0, len(started)+len(expired))
+	for _, userRole := range append(started, expired...) {
+		if !affected[userRole.UserId] {
+			affected[userRole.UserId] = true
+			usersID = append(usersID, userRole.UserId)
+		}
+	}
+
+	controller.OnUserChanged(ctx, usersID)
+
+	for _, userRole := range started {
+		controller.OnUserRoleStartedV1(ctx, userRole)
+	}
+
+	for _, userRole := range expired {
+		controller.OnUserRoleExpiredV1(ctx, userRole)
+	}
+}
+
+// WatchUserRolesPeriods periodically updates temporary assignments of realm, blocks until context is done.
+// Concurrent instances don't conflict since every assignment is started or expired only once
+func (controller *Controller) WatchUserRolesPeriods(ctx context.Context) {
+	if controller.environment.UserRolesCheckInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Second * time.Duration(controller.environment.UserRolesCheckInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			controller.UpdateUserRolesPeriods(ctx)
+		}
+	}
+}
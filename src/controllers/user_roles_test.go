@@ -0,0 +1,140 @@
+package controllers
+
+import (
+	"hive/auth/backends"
+	"hive/enums"
+	"hive/inout"
+	"hive/models"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCreateUserRoleWithIncorrectPeriod(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	now := time.Now().UnixNano() / int64(time.Millisecond)
+
+	for _, period := range [][2]int64{{0, now - 1000}, {now + 2000, now + 1000}, {-1, 0}} {
+		status, userRole := controller.Controller.CreateUserRole(ctx, uuid.NewV4(), uuid.NewV4(), uuid.Nil, period[0], period[1])
+		require.Equal(t, enums.IncorrectUserRolePeriod, status)
+		require.Nil(t, userRole)
+	}
+}
+
+func TestUpdateUserRolesPeriods(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	userID := uuid.NewV4()
+	started := &models.UserRole{Id: uuid.NewV4(), UserId: userID, RoleId: uuid.NewV4(), StartsAt: 1}
+	expired := &models.UserRole{Id: uuid.NewV4(), UserId: userID, RoleId: uuid.NewV4(), ExpiresAt: 2}
+
+	controller.
+		Store.
+		EXPECT().
+		StartUserRoles(ctx).
+		Return([]*models.UserRole{started}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		ExpireUserRoles(ctx).
+		Return([]*models.UserRole{expired}).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CreateOrUpdateUsersViewByUsersID(gomock.Any(), []uuid.UUID{userID}).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CacheUserView(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(gomock.Any(), "userView", int32(1), gomock.Any()).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "userRoleStarted", int32(1), &inout.UserRoleEventV1{
+			Id:             started.Id.Bytes(),
+			UserID:         userID.Bytes(),
+			RoleID:         started.RoleId.Bytes(),
+			OrganizationID: uuid.Nil.Bytes(),
+			StartsAt:       1,
+		}).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "userRoleExpired", int32(1), &inout.UserRoleEventV1{
+			Id:             expired.Id.Bytes(),
+			UserID:         userID.Bytes(),
+			RoleID:         expired.RoleId.Bytes(),
+			OrganizationID: uuid.Nil.Bytes(),
+			ExpiresAt:      2,
+		}).
+		Times(1)
+
+	controller.Controller.UpdateUserRolesPeriods(ctx)
+}
+
+func TestCanManageUserRole(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+	leadRoleID := uuid.NewV4()
+	roleID := uuid.NewV4()
+	lead := &backends.BasicAuthenticationBackendUser{UserID: uuid.NewV4()}
+
+	require.True(t, controller.Controller.CanManageUserRole(ctx, &backends.BasicAuthenticationBackendUser{
+		UserID:      uuid.NewV4(),
+		Permissions: []string{enums.UserRolesCreate},
+	}, roleID, enums.UserRolesCreate))
+
+	controller.
+		Store.
+		EXPECT().
+		GetUserView(ctx, lead.UserID).
+		Return(&models.UserView{Id: lead.UserID, RolesID: []uuid.UUID{leadRoleID}}).
+		Times(2)
+
+	controller.
+		Store.
+		EXPECT().
+		IsRoleManageable(ctx, []uuid.UUID{leadRoleID}, roleID).
+		Return(enums.Ok, true).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		IsRoleManageable(ctx, []uuid.UUID{leadRoleID}, leadRoleID).
+		Return(enums.Ok, false).
+		Times(1)
+
+	require.True(t, controller.Controller.CanManageUserRole(ctx, lead, roleID, enums.UserRolesCreate))
+	require.False(t, controller.Controller.CanManageUserRole(ctx, lead, leadRoleID, enums.UserRolesDelete))
+}
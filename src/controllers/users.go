@@ -7,8 +7,12 @@ import (
 	"hive/repositories"
 	"context"
 	uuid "github.com/satori/go.uuid"
+	"strings"
+	"time"
 )
 
+// CreateUser registers user with confirmed email or phone. Contacts owned by other users are moved to the new user,
+// with required confirmation of transfers such contacts are refused since nobody could approve them yet
 func (controller *Controller) CreateUser(ctx context.Context, password, email, emailCode, phone, phoneCode string) (int, *models.User) {
 
 	var identifiers []uuid.UUID
@@ -40,33 +44,43 @@ func (controller *Controller) CreateUser(ctx context.Context, password, email, e
 		}
 
 		_, oldPhone := controller.store.GetPhone(ctx, phone)
-		if oldPhone != nil {
-			identifiers = append(identifiers, oldPhone.UserId)
+		if oldPhone != nil && controller.environment.ContactTransferConfirmation {
+			return enums.ContactOwnedByAnotherUser, nil
 		}
 	}
 
 	if len(email) > 0 {
-		emailStatus, email := controller.validateEmail(ctx, email, emailCode)
+		var emailStatus int
+		emailStatus, email = controller.validateEmail(ctx, email, emailCode)
 		if emailStatus != enums.Ok {
 			return emailStatus, nil
 		}
 
 		_, oldEmail := controller.store.GetEmail(ctx, email)
-		if oldEmail != nil {
-			identifiers = append(identifiers, oldEmail.UserId)
+		if oldEmail != nil && controller.environment.ContactTransferConfirmation {
+			return enums.ContactOwnedByAnotherUser, nil
 		}
 	}
 
-	status, user := controller.store.CreateUser(ctx, password, email, phone)
+	status, user, transfers := controller.store.CreateUser(ctx, password, email, phone)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	for _, transfer := range transfers {
+		identifiers = append(identifiers, transfer.FromUserId)
+		controller.OnContactTransferredV1(ctx, transfer)
+	}
+
 	identifiers = append(identifiers, user.Id)
-	controller.OnUserChanged(identifiers)
+	controller.OnUserChanged(ctx, identifiers)
 	return status, user
 }
 
 func (controller *Controller) DeleteUser(ctx context.Context, id uuid.UUID) (int, *models.User) {
 	status, deletedUser := controller.store.DeleteUser(ctx, id)
 	if status == enums.Ok {
-		controller.OnUserChanged([]uuid.UUID{deletedUser.Id})
+		controller.OnUserChanged(ctx, []uuid.UUID{deletedUser.Id})
 	}
 
 	return status, deletedUser
@@ -79,3 +93,65 @@ func (controller *Controller) GetUsers(ctx context.Context, query repositories.G
 func (controller *Controller) GetUser(ctx context.Context, id uuid.UUID) *models.User {
 	return controller.store.GetUser(ctx, id)
 }
+
+// UpdateUserProfile merges attributes into profile of user, attributes with null value are removed.
+// Resulting profile must conform to profile schema
+func (controller *Controller) UpdateUserProfile(ctx context.Context, id uuid.UUID, attributes map[string]interface{}) (int, *models.User) {
+	user := controller.store.GetUser(ctx, id)
+	if user == nil {
+		return enums.UserNotFound, nil
+	}
+
+	profile := make(map[string]interface{}, len(user.Profile)+len(attributes))
+	for name, value := range user.Profile {
+		profile[name] = value
+	}
+
+	for name, value := range attributes {
+		if value == nil {
+			delete(profile, name)
+		} else {
+			profile[name] = value
+		}
+	}
+
+	if controller.profileSchema.Validate(profile) != nil {
+		return enums.IncorrectProfile, nil
+	}
+
+	status, user := controller.store.UpdateUserProfile(ctx, id, profile)
+
+	if status == enums.Ok {
+		controller.OnUserChanged(ctx, []uuid.UUID{id})
+	}
+
+	return status, user
+}
+
+// UpdateUserStatus activates, suspends until suspendedUntil (milliseconds) or blocks user. Sessions of blocked
+// user are revoked at once, suspended user keeps them but can't refresh them or use access tokens
+func (controller *Controller) UpdateUserStatus(ctx context.Context, id uuid.UUID, actorID uuid.UUID, userStatus string, suspendedUntil int64, reason string) (int, *models.User) {
+	switch userStatus {
+	case enums.UserSuspended:
+		if suspendedUntil <= time.Now().UnixNano()/int64(time.Millisecond) {
+			return enums.IncorrectSuspendedUntil, nil
+		}
+	case enums.UserActive, enums.UserBlocked:
+		suspendedUntil = 0
+	default:
+		return enums.IncorrectUserStatus, nil
+	}
+
+	status, user := controller.store.UpdateUserStatus(ctx, id, userStatus, suspendedUntil, strings.TrimSpace(reason), actorID)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	if user.Status == enums.UserBlocked {
+		controller.store.DeleteUserSessions(ctx, id)
+	}
+
+	controller.OnUserChanged(ctx, []uuid.UUID{id})
+	controller.OnUserStatusChangedV1(ctx, user)
+	return enums.Ok, user
+}
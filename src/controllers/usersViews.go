@@ -1,21 +1,32 @@
 package controllers
 
 import (
+	"hive/enums"
 	"hive/models"
 	"hive/repositories"
 	"context"
 	uuid "github.com/satori/go.uuid"
+	"strings"
+	"unicode/utf8"
 )
 
+// Shorter queries can't use trigram index and match almost every user
+const minSearchQueryLength = 3
+
+// CreateOrUpdateUsersView recalculates views of users, empty list is ignored since store would recalculate views of everyone
 func (controller *Controller) CreateOrUpdateUsersView(ctx context.Context, id []uuid.UUID) []*models.UserView {
+	if len(id) == 0 {
+		return nil
+	}
+
 	usersView := controller.store.CreateOrUpdateUsersViewByUsersID(ctx, id)
-	controller.OnUsersViewChanged(usersView)
+	controller.OnUsersViewChanged(ctx, usersView)
 	return usersView
 }
 
 func (controller *Controller) CreateOrUpdateUsersViewByRoles(ctx context.Context, rolesIds []uuid.UUID) []*models.UserView {
 	usersView := controller.store.CreateOrUpdateUsersViewByRolesID(ctx, rolesIds)
-	controller.OnUsersViewChanged(usersView)
+	controller.OnUsersViewChanged(ctx, usersView)
 	return usersView
 }
 
@@ -26,3 +37,14 @@ func (controller *Controller) GetUserView(ctx context.Context, id uuid.UUID) *mo
 func (controller *Controller) GetUserViews(ctx context.Context, query repositories.GetUsersViewStoreQuery) ([]*models.UserView, *models.PaginationResponse) {
 	return controller.store.GetUsersView(ctx, query)
 }
+
+// SearchUsersView finds views which contacts or profile attributes contain query
+func (controller *Controller) SearchUsersView(ctx context.Context, query repositories.SearchUsersViewQuery) (int, []*models.UserView, *models.PaginationResponse) {
+	query.Query = strings.TrimSpace(query.Query)
+	if utf8.RuneCountInString(query.Query) < minSearchQueryLength {
+		return enums.IncorrectSearchQuery, nil, nil
+	}
+
+	userViews, pagination := controller.store.SearchUsersView(ctx, query)
+	return enums.Ok, userViews, pagination
+}
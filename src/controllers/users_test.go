@@ -1,5 +1,20 @@
 package controllers
 
+import (
+	"hive/config"
+	"hive/enums"
+	"hive/models"
+	"hive/repositories"
+	"context"
+	"github.com/golang/mock/gomock"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+)
+
 //func TestCreateUser(t *testing.T) {
 //	t.Parallel()
 //	ctrl := gomock.NewController(t)
@@ -83,3 +98,134 @@ package controllers
 //
 //	require.Equal(t, enums.Ok, status)
 //}
+
+func TestUpdateUserProfile(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	file, err := ioutil.TempFile("", "profile*.json")
+	require.NoError(t, err)
+	defer os.Remove(file.Name())
+	_, err = file.WriteString(`{"type": "object", "properties": {"locale": {"type": "string", "maxLength": 2}}}`)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+	controller.Controller.profileSchema = config.InitProfileSchema(&config.Environment{ProfileSchemaFile: file.Name()})
+
+	user := &models.User{Id: uuid.NewV4(), Profile: map[string]interface{}{"locale": "en", "name": "Ivan"}}
+	updated := &models.User{Id: user.Id, Profile: map[string]interface{}{"locale": "ru"}}
+
+	controller.
+		Store.
+		EXPECT().
+		GetUser(ctx, user.Id).
+		Return(user).
+		Times(2)
+
+	status, _ := controller.Controller.UpdateUserProfile(ctx, user.Id, map[string]interface{}{"locale": "russian"})
+	require.Equal(t, enums.IncorrectProfile, status)
+
+	controller.
+		Store.
+		EXPECT().
+		UpdateUserProfile(ctx, user.Id, map[string]interface{}{"locale": "ru"}).
+		Return(enums.Ok, updated).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CreateOrUpdateUsersViewByUsersID(gomock.Any(), []uuid.UUID{user.Id}).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CacheUserView(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(gomock.Any(), "userView", int32(1), gomock.Any()).
+		Times(1)
+
+	status, profileUser := controller.Controller.UpdateUserProfile(ctx, user.Id, map[string]interface{}{"locale": "ru", "name": nil})
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, updated, profileUser)
+}
+
+func TestSearchUsersViewWithShortQuery(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	status, _, _ := controller.Controller.SearchUsersView(ctx, repositories.SearchUsersViewQuery{Query: " iv "})
+	require.Equal(t, enums.IncorrectSearchQuery, status)
+}
+
+func TestUpdateUserStatus(t *testing.T) {
+	t.Parallel()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	controller := InitControllerWithMockedInternals(ctrl)
+	ctx := context.Background()
+
+	id, actorID := uuid.NewV4(), uuid.NewV4()
+
+	status, _ := controller.Controller.UpdateUserStatus(ctx, id, actorID, "deleted", 0, "")
+	require.Equal(t, enums.IncorrectUserStatus, status)
+
+	status, _ = controller.Controller.UpdateUserStatus(ctx, id, actorID, enums.UserSuspended, time.Now().UnixNano()/int64(time.Millisecond)-1, "")
+	require.Equal(t, enums.IncorrectSuspendedUntil, status)
+
+	blocked := &models.User{Id: id, Status: enums.UserBlocked, StatusReason: "spam", StatusActorId: actorID}
+
+	controller.
+		Store.
+		EXPECT().
+		UpdateUserStatus(ctx, id, enums.UserBlocked, int64(0), "spam", actorID).
+		Return(enums.Ok, blocked).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		DeleteUserSessions(ctx, id).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CreateOrUpdateUsersViewByUsersID(gomock.Any(), []uuid.UUID{id}).
+		Return(nil).
+		Times(1)
+
+	controller.
+		Store.
+		EXPECT().
+		CacheUserView(gomock.Any(), gomock.Any()).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(gomock.Any(), "userView", int32(1), gomock.Any()).
+		Times(1)
+
+	controller.
+		Dispatcher.
+		EXPECT().
+		Send(ctx, "userStatusChanged", int32(1), gomock.Any()).
+		Times(1)
+
+	status, user := controller.Controller.UpdateUserStatus(ctx, id, actorID, enums.UserBlocked, 1, " spam ")
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, blocked, user)
+}
@@ -0,0 +1,16 @@
+package enums
+
+// Authentication methods references, see RFC 8176
+
+const (
+	PasswordAuthenticationMethod = "pwd"
+	OTPAuthenticationMethod      = "otp"
+	SMSAuthenticationMethod      = "sms"
+)
+
+// Authentication context class references
+
+const (
+	SingleFactorAuthenticationLevel = "1"
+	MultiFactorAuthenticationLevel  = "2"
+)
@@ -6,4 +6,9 @@ const (
 	EmailConfirmationCode = "emailConfirmationCode"
 	ActualSecret          = "actualSecret"
 	Secret                = "secret"
+	SecretsInvalidation   = "secretsInvalidation"
+	SessionExistence      = "sessionExistence"
+	DPoPNonce             = "dpopNonce"
+	DPoPProof             = "dpopProof"
+	AuthorizationDecision = "authorizationDecision"
 )
@@ -0,0 +1,14 @@
+package enums
+
+// Statuses of email and phone ownership transfers, only pending transfers could be reviewed by previous owner
+const (
+	ContactTransferPending   = "pending"
+	ContactTransferCompleted = "completed"
+	ContactTransferRejected  = "rejected"
+)
+
+// Kinds of transferred contacts
+const (
+	EmailContact = "email"
+	PhoneContact = "phone"
+)
@@ -63,4 +63,102 @@ const (
 	// Auth backends
 
 	BackendNotFound // 26
+
+	// Secrets
+
+	SecretRevoked // 27
+
+	// Sessions
+
+	IncorrectFingerprint // 28
+
+	// DPoP
+
+	IncorrectDPoPProof // 29
+	DPoPNonceRequired  // 30
+
+	// Step-up authentication
+
+	ReauthenticationRequired // 31
+	SecondFactorRequired     // 32
+
+	// Permissions
+
+	IncorrectPermission // 33
+
+	// Role hierarchy
+
+	ParentRoleNotFound // 34
+	RoleHierarchyCycle // 35
+
+	// Organizations
+
+	OrganizationNotFound           // 36
+	OrganizationAlreadyExist       // 37
+	OrganizationMemberNotFound     // 38
+	OrganizationMemberAlreadyExist // 39
+
+	// Protected roles
+
+	RoleProtected // 40
+
+	// Temporary user roles
+
+	IncorrectUserRolePeriod // 41
+
+	// Groups
+
+	GroupNotFound           // 42
+	GroupAlreadyExist       // 43
+	GroupMemberNotFound     // 44
+	GroupMemberAlreadyExist // 45
+	GroupRoleNotFound       // 46
+	GroupRoleAlreadyExist   // 47
+
+	// Delegated role administration
+
+	ManagedRoleNotFound // 48
+
+	// Role requests
+
+	RoleRequestNotFound        // 49
+	RoleRequestAlreadyExist    // 50
+	RoleRequestReviewed        // 51
+	IncorrectRoleRequestStatus // 52
+	IncorrectRoleRequestReason // 53
+
+	// Profiles
+
+	IncorrectProfile // 54
+
+	// Search
+
+	IncorrectSearchQuery // 55
+
+	// User statuses
+
+	IncorrectUserStatus     // 56
+	IncorrectSuspendedUntil // 57
+	UserInactive            // 58
+
+	// User exports
+
+	UserExportNotFound // 59
+	UserExportNotReady // 60
+
+	// User deletions
+
+	UserDeletionNotScheduled // 61
+
+	// Contacts
+
+	LastLoginIdentifier // 62
+
+	// Contact transfers
+
+	ContactTransferNotFound        // 63
+	ContactTransferRequested       // 64
+	ContactOwnedByAnotherUser      // 65
+	ContactTransferOutdated        // 66
+	IncorrectContactTransferStatus // 67
 )
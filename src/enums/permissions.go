@@ -0,0 +1,51 @@
+package enums
+
+// Permissions are granted to users by roles, permission "*" grants everything
+// and "<resource>:*" grants every action on resource
+
+const (
+	AllPermissions = "*"
+
+	UsersRead   = "users:read"
+	UsersUpdate = "users:update"
+	UsersDelete = "users:delete"
+	UsersBlock  = "users:block"
+	UsersExport = "users:export"
+
+	UserViewsRead = "userViews:read"
+
+	RolesCreate = "roles:create"
+	RolesUpdate = "roles:update"
+	RolesDelete = "roles:delete"
+
+	UserRolesCreate = "userRoles:create"
+	UserRolesDelete = "userRoles:delete"
+
+	RoleRequestsRead = "roleRequests:read"
+
+	OrganizationsRead   = "organizations:read"
+	OrganizationsCreate = "organizations:create"
+
+	OrganizationMembersRead   = "organizationMembers:read"
+	OrganizationMembersCreate = "organizationMembers:create"
+	OrganizationMembersDelete = "organizationMembers:delete"
+
+	GroupsRead   = "groups:read"
+	GroupsCreate = "groups:create"
+	GroupsUpdate = "groups:update"
+	GroupsDelete = "groups:delete"
+
+	GroupMembersRead   = "groupMembers:read"
+	GroupMembersCreate = "groupMembers:create"
+	GroupMembersDelete = "groupMembers:delete"
+
+	GroupRolesRead   = "groupRoles:read"
+	GroupRolesCreate = "groupRoles:create"
+	GroupRolesDelete = "groupRoles:delete"
+
+	SecretsRead   = "secrets:read"
+	SecretsCreate = "secrets:create"
+	SecretsDelete = "secrets:delete"
+
+	AuthorizationCheck = "authorization:check"
+)
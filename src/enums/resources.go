@@ -0,0 +1,15 @@
+package enums
+
+// Types of resources used by access policies
+const (
+	UserResource     = "user"
+	UserViewResource = "userView"
+	PasswordResource = "password"
+	EmailResource    = "email"
+	PhoneResource    = "phone"
+	RoleResource     = "role"
+	UserRoleResource = "userRole"
+
+	RoleRequestResource     = "roleRequest"
+	ContactTransferResource = "contactTransfer"
+)
@@ -0,0 +1,9 @@
+package enums
+
+// Statuses of role access requests, only pending requests could be reviewed or cancelled
+const (
+	RoleRequestPending   = "pending"
+	RoleRequestApproved  = "approved"
+	RoleRequestDenied    = "denied"
+	RoleRequestCancelled = "cancelled"
+)
@@ -0,0 +1,9 @@
+package enums
+
+// Statuses of user data exports, only ready exports could be downloaded
+const (
+	UserExportPending    = "pending"
+	UserExportProcessing = "processing"
+	UserExportReady      = "ready"
+	UserExportFailed     = "failed"
+)
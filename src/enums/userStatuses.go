@@ -0,0 +1,10 @@
+package enums
+
+// Statuses of users, suspended users become active again when suspension ends.
+// Deleted users are anonymized tombstones and never change status again
+const (
+	UserActive    = "active"
+	UserSuspended = "suspended"
+	UserBlocked   = "blocked"
+	UserDeleted   = "deleted"
+)
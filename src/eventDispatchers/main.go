@@ -1,7 +1,10 @@
 package eventDispatchers
 
-import "google.golang.org/protobuf/proto"
+import (
+	"context"
+	"google.golang.org/protobuf/proto"
+)
 
 type IEventDispatcher interface {
-	Send(object string, version int32, payload proto.Message)
+	Send(ctx context.Context, object string, version int32, payload proto.Message)
 }
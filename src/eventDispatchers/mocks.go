@@ -5,6 +5,7 @@
 package eventDispatchers
 
 import (
+	context "context"
 	gomock "github.com/golang/mock/gomock"
 	proto "google.golang.org/protobuf/proto"
 	reflect "reflect"
@@ -34,13 +35,13 @@ func (m *MockIEventDispatcher) EXPECT() *MockIEventDispatcherMockRecorder {
 }
 
 // Send mocks base method
-func (m *MockIEventDispatcher) Send(object string, version int32, payload proto.Message) {
+func (m *MockIEventDispatcher) Send(ctx context.Context, object string, version int32, payload proto.Message) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "Send", object, version, payload)
+	m.ctrl.Call(m, "Send", ctx, object, version, payload)
 }
 
 // Send indicates an expected call of Send
-func (mr *MockIEventDispatcherMockRecorder) Send(object, version, payload interface{}) *gomock.Call {
+func (mr *MockIEventDispatcherMockRecorder) Send(ctx, object, version, payload interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockIEventDispatcher)(nil).Send), object, version, payload)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockIEventDispatcher)(nil).Send), ctx, object, version, payload)
 }
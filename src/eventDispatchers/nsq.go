@@ -1,6 +1,7 @@
 package eventDispatchers
 
 import (
+	"context"
 	"fmt"
 	"github.com/getsentry/sentry-go"
 	"github.com/nsqio/go-nsq"
@@ -8,6 +9,7 @@ import (
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
 	"hive/config"
+	"hive/repositories"
 )
 
 type NSQEventDispatcher struct {
@@ -19,8 +21,16 @@ func InitNSQEventDispatcher(producer *nsq.Producer, environment *config.Environm
 	return &NSQEventDispatcher{producer: producer, environment: environment}
 }
 
-func (dispatcher NSQEventDispatcher) send(object string, version int32, payload proto.Message) {
-	topic := fmt.Sprintf("%s-%s-%d", dispatcher.environment.Service, object, version)
+// getTopic returns topic of object events, topics of default realm stay unprefixed for existing consumers
+func (dispatcher NSQEventDispatcher) getTopic(realm *config.Realm, object string, version int32) string {
+	if realm.IsDefault() {
+		return fmt.Sprintf("%s-%s-%d", dispatcher.environment.Service, object, version)
+	}
+
+	return fmt.Sprintf("%s-%s-%s-%d", dispatcher.environment.Service, realm.Name, object, version)
+}
+
+func (dispatcher NSQEventDispatcher) send(topic string, payload proto.Message) {
 	payloadJson, _ := protojson.Marshal(payload)
 	log.Log().Str("event", "message sent").Str("topic", topic).RawJSON("payload", payloadJson).Send()
 
@@ -39,6 +49,7 @@ func (dispatcher NSQEventDispatcher) send(object string, version int32, payload
 	}
 }
 
-func (dispatcher NSQEventDispatcher) Send(object string, version int32, payload proto.Message) {
-	go dispatcher.send(object, version, payload)
+func (dispatcher NSQEventDispatcher) Send(ctx context.Context, object string, version int32, payload proto.Message) {
+	topic := dispatcher.getTopic(repositories.GetRealmFromContext(ctx), object, version)
+	go dispatcher.send(topic, payload)
 }
@@ -10,3 +10,13 @@ func GetUUID(r *http.Request) (uuid.UUID, error) {
 	vars := mux.Vars(r)
 	return uuid.FromString(vars["id"])
 }
+
+// GetRouteName returns name of matched route, it is empty for requests served without router
+func GetRouteName(r *http.Request) string {
+	route := mux.CurrentRoute(r)
+	if route == nil {
+		return ""
+	}
+
+	return route.GetName()
+}
@@ -0,0 +1,26 @@
+package functools
+
+import (
+	"hive/enums"
+	"regexp"
+	"strings"
+)
+
+var permissionRE = regexp.MustCompile(`^(\*|[a-zA-Z]+:(\*|[a-zA-Z]+))$`)
+
+func IsPermission(value string) bool {
+	return permissionRE.MatchString(value)
+}
+
+// HasPermission checks that permission is granted by any of permissions including wildcards
+func HasPermission(permissions []string, permission string) bool {
+	resource := strings.SplitN(permission, ":", 2)[0]
+
+	for _, p := range permissions {
+		if p == permission || p == enums.AllPermissions || p == resource+":*" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,27 @@
+package functools
+
+import (
+	"hive/enums"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestIsPermission(t *testing.T) {
+	t.Parallel()
+	require.True(t, IsPermission(enums.UsersRead))
+	require.True(t, IsPermission("users:*"))
+	require.True(t, IsPermission(enums.AllPermissions))
+	require.False(t, IsPermission("users"))
+	require.False(t, IsPermission("users:read:all"))
+	require.False(t, IsPermission("*:read"))
+}
+
+func TestHasPermission(t *testing.T) {
+	t.Parallel()
+	require.True(t, HasPermission([]string{enums.UsersRead}, enums.UsersRead))
+	require.True(t, HasPermission([]string{"users:*"}, enums.UsersDelete))
+	require.True(t, HasPermission([]string{enums.AllPermissions}, enums.SecretsDelete))
+	require.False(t, HasPermission([]string{enums.UsersRead}, enums.UsersDelete))
+	require.False(t, HasPermission([]string{"roles:*"}, enums.UsersRead))
+	require.False(t, HasPermission(nil, enums.UsersRead))
+}
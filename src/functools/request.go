@@ -106,3 +106,13 @@ func GetPagination(values url.Values, environment *config.Environment) *models.P
 func (request *Request) GetAuthorizationHeader() string {
 	return request.Header.Get("Authorization")
 }
+
+// GetOriginalPath returns path of request before realm prefix was stripped from it
+func GetOriginalPath(r *http.Request) string {
+	requestURI, err := url.ParseRequestURI(r.RequestURI)
+	if err != nil || requestURI.Path == "" {
+		return r.URL.Path
+	}
+
+	return requestURI.Path
+}
@@ -0,0 +1,43 @@
+package functools
+
+import (
+	"encoding/json"
+	"github.com/getsentry/sentry-go"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// MapToStruct converts JSON object to protobuf struct, which is rendered as plain JSON object
+func MapToStruct(value map[string]interface{}) *structpb.Struct {
+	data := &structpb.Struct{}
+	if len(value) == 0 {
+		return data
+	}
+
+	bytes, err := json.Marshal(value)
+	if err == nil {
+		err = protojson.Unmarshal(bytes, data)
+	}
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	return data
+}
+
+func StructToMap(data *structpb.Struct) (map[string]interface{}, error) {
+	value := map[string]interface{}{}
+	if data == nil {
+		return value, nil
+	}
+
+	bytes, err := protojson.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal(bytes, &value)
+	return value, err
+}
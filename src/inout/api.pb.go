@@ -10,6 +10,7 @@ import (
 	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -194,9 +195,13 @@ type Role struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Title   string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Id             []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created        int64    `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Title          string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Permissions    []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ParentsID      [][]byte `protobuf:"bytes,5,rep,name=parentsID,proto3" json:"parentsID,omitempty"`
+	Description    string   `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	ManagedRolesID [][]byte `protobuf:"bytes,7,rep,name=managedRolesID,proto3" json:"managedRolesID,omitempty"`
 }
 
 func (x *Role) Reset() {
@@ -252,15 +257,46 @@ func (x *Role) GetTitle() string {
 	return ""
 }
 
+func (x *Role) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *Role) GetParentsID() [][]byte {
+	if x != nil {
+		return x.ParentsID
+	}
+	return nil
+}
+
+func (x *Role) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Role) GetManagedRolesID() [][]byte {
+	if x != nil {
+		return x.ManagedRolesID
+	}
+	return nil
+}
+
 type UserRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	UserID  []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	RoleID  []byte `protobuf:"bytes,4,opt,name=roleID,proto3" json:"roleID,omitempty"`
+	Id             []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created        int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	UserID         []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	RoleID         []byte `protobuf:"bytes,4,opt,name=roleID,proto3" json:"roleID,omitempty"`
+	OrganizationID []byte `protobuf:"bytes,5,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+	StartsAt       int64  `protobuf:"varint,6,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	ExpiresAt      int64  `protobuf:"varint,7,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
 }
 
 func (x *UserRole) Reset() {
@@ -323,19 +359,39 @@ func (x *UserRole) GetRoleID() []byte {
 	return nil
 }
 
-type Session struct {
+func (x *UserRole) GetOrganizationID() []byte {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return nil
+}
+
+func (x *UserRole) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *UserRole) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type Organization struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	RefreshToken []byte `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
-	AccessToken  string `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
-	Created      int64  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
-	Expired      int64  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Title   string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
 }
 
-func (x *Session) Reset() {
-	*x = Session{}
+func (x *Organization) Reset() {
+	*x = Organization{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -343,13 +399,13 @@ func (x *Session) Reset() {
 	}
 }
 
-func (x *Session) String() string {
+func (x *Organization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Session) ProtoMessage() {}
+func (*Organization) ProtoMessage() {}
 
-func (x *Session) ProtoReflect() protoreflect.Message {
+func (x *Organization) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -361,52 +417,45 @@ func (x *Session) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Session.ProtoReflect.Descriptor instead.
-func (*Session) Descriptor() ([]byte, []int) {
+// Deprecated: Use Organization.ProtoReflect.Descriptor instead.
+func (*Organization) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Session) GetRefreshToken() []byte {
+func (x *Organization) GetId() []byte {
 	if x != nil {
-		return x.RefreshToken
+		return x.Id
 	}
 	return nil
 }
 
-func (x *Session) GetAccessToken() string {
-	if x != nil {
-		return x.AccessToken
-	}
-	return ""
-}
-
-func (x *Session) GetCreated() int64 {
+func (x *Organization) GetCreated() int64 {
 	if x != nil {
 		return x.Created
 	}
 	return 0
 }
 
-func (x *Session) GetExpired() int64 {
+func (x *Organization) GetTitle() string {
 	if x != nil {
-		return x.Expired
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-type Email struct {
+type OrganizationMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	UserID  []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	Email   string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Id             []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created        int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	OrganizationID []byte `protobuf:"bytes,3,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+	UserID         []byte `protobuf:"bytes,4,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *Email) Reset() {
-	*x = Email{}
+func (x *OrganizationMember) Reset() {
+	*x = OrganizationMember{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,13 +463,13 @@ func (x *Email) Reset() {
 	}
 }
 
-func (x *Email) String() string {
+func (x *OrganizationMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Email) ProtoMessage() {}
+func (*OrganizationMember) ProtoMessage() {}
 
-func (x *Email) ProtoReflect() protoreflect.Message {
+func (x *OrganizationMember) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -432,51 +481,52 @@ func (x *Email) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Email.ProtoReflect.Descriptor instead.
-func (*Email) Descriptor() ([]byte, []int) {
+// Deprecated: Use OrganizationMember.ProtoReflect.Descriptor instead.
+func (*OrganizationMember) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Email) GetId() []byte {
+func (x *OrganizationMember) GetId() []byte {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *Email) GetCreated() int64 {
+func (x *OrganizationMember) GetCreated() int64 {
 	if x != nil {
 		return x.Created
 	}
 	return 0
 }
 
-func (x *Email) GetUserID() []byte {
+func (x *OrganizationMember) GetOrganizationID() []byte {
 	if x != nil {
-		return x.UserID
+		return x.OrganizationID
 	}
 	return nil
 }
 
-func (x *Email) GetEmail() string {
+func (x *OrganizationMember) GetUserID() []byte {
 	if x != nil {
-		return x.Email
+		return x.UserID
 	}
-	return ""
+	return nil
 }
 
-type EmailConfirmation struct {
+type Group struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created int64  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
-	Expire  int64  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
-	Email   string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	Id          []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created     int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Title       string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
 }
 
-func (x *EmailConfirmation) Reset() {
-	*x = EmailConfirmation{}
+func (x *Group) Reset() {
+	*x = Group{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -484,13 +534,13 @@ func (x *EmailConfirmation) Reset() {
 	}
 }
 
-func (x *EmailConfirmation) String() string {
+func (x *Group) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EmailConfirmation) ProtoMessage() {}
+func (*Group) ProtoMessage() {}
 
-func (x *EmailConfirmation) ProtoReflect() protoreflect.Message {
+func (x *Group) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -502,46 +552,52 @@ func (x *EmailConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EmailConfirmation.ProtoReflect.Descriptor instead.
-func (*EmailConfirmation) Descriptor() ([]byte, []int) {
+// Deprecated: Use Group.ProtoReflect.Descriptor instead.
+func (*Group) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *EmailConfirmation) GetCreated() int64 {
+func (x *Group) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *Group) GetCreated() int64 {
 	if x != nil {
 		return x.Created
 	}
 	return 0
 }
 
-func (x *EmailConfirmation) GetExpire() int64 {
+func (x *Group) GetTitle() string {
 	if x != nil {
-		return x.Expire
+		return x.Title
 	}
-	return 0
+	return ""
 }
 
-func (x *EmailConfirmation) GetEmail() string {
+func (x *Group) GetDescription() string {
 	if x != nil {
-		return x.Email
+		return x.Description
 	}
 	return ""
 }
 
-type Phone struct {
+type GroupMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id               []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created          int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	UserID           []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
-	Phone            string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
-	PhoneCountryCode string `protobuf:"bytes,5,opt,name=phoneCountryCode,proto3" json:"phoneCountryCode,omitempty"`
+	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	GroupID []byte `protobuf:"bytes,3,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID  []byte `protobuf:"bytes,4,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *Phone) Reset() {
-	*x = Phone{}
+func (x *GroupMember) Reset() {
+	*x = GroupMember{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -549,13 +605,13 @@ func (x *Phone) Reset() {
 	}
 }
 
-func (x *Phone) String() string {
+func (x *GroupMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Phone) ProtoMessage() {}
+func (*GroupMember) ProtoMessage() {}
 
-func (x *Phone) ProtoReflect() protoreflect.Message {
+func (x *GroupMember) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -567,59 +623,52 @@ func (x *Phone) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Phone.ProtoReflect.Descriptor instead.
-func (*Phone) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupMember.ProtoReflect.Descriptor instead.
+func (*GroupMember) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Phone) GetId() []byte {
+func (x *GroupMember) GetId() []byte {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *Phone) GetCreated() int64 {
+func (x *GroupMember) GetCreated() int64 {
 	if x != nil {
 		return x.Created
 	}
 	return 0
 }
 
-func (x *Phone) GetUserID() []byte {
+func (x *GroupMember) GetGroupID() []byte {
 	if x != nil {
-		return x.UserID
+		return x.GroupID
 	}
 	return nil
 }
 
-func (x *Phone) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
-}
-
-func (x *Phone) GetPhoneCountryCode() string {
+func (x *GroupMember) GetUserID() []byte {
 	if x != nil {
-		return x.PhoneCountryCode
+		return x.UserID
 	}
-	return ""
+	return nil
 }
 
-type PhoneConfirmation struct {
+type GroupRole struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Created          int64  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
-	Expire           int64  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
-	Phone            string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	PhoneCountryCode string `protobuf:"bytes,4,opt,name=phoneCountryCode,proto3" json:"phoneCountryCode,omitempty"`
+	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	GroupID []byte `protobuf:"bytes,3,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	RoleID  []byte `protobuf:"bytes,4,opt,name=roleID,proto3" json:"roleID,omitempty"`
 }
 
-func (x *PhoneConfirmation) Reset() {
-	*x = PhoneConfirmation{}
+func (x *GroupRole) Reset() {
+	*x = GroupRole{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -627,13 +676,13 @@ func (x *PhoneConfirmation) Reset() {
 	}
 }
 
-func (x *PhoneConfirmation) String() string {
+func (x *GroupRole) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PhoneConfirmation) ProtoMessage() {}
+func (*GroupRole) ProtoMessage() {}
 
-func (x *PhoneConfirmation) ProtoReflect() protoreflect.Message {
+func (x *GroupRole) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -645,51 +694,59 @@ func (x *PhoneConfirmation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PhoneConfirmation.ProtoReflect.Descriptor instead.
-func (*PhoneConfirmation) Descriptor() ([]byte, []int) {
+// Deprecated: Use GroupRole.ProtoReflect.Descriptor instead.
+func (*GroupRole) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *PhoneConfirmation) GetCreated() int64 {
+func (x *GroupRole) GetId() []byte {
 	if x != nil {
-		return x.Created
+		return x.Id
 	}
-	return 0
+	return nil
 }
 
-func (x *PhoneConfirmation) GetExpire() int64 {
+func (x *GroupRole) GetCreated() int64 {
 	if x != nil {
-		return x.Expire
+		return x.Created
 	}
 	return 0
 }
 
-func (x *PhoneConfirmation) GetPhone() string {
+func (x *GroupRole) GetGroupID() []byte {
 	if x != nil {
-		return x.Phone
+		return x.GroupID
 	}
-	return ""
+	return nil
 }
 
-func (x *PhoneConfirmation) GetPhoneCountryCode() string {
+func (x *GroupRole) GetRoleID() []byte {
 	if x != nil {
-		return x.PhoneCountryCode
+		return x.RoleID
 	}
-	return ""
+	return nil
 }
 
-type Password struct {
+type RoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	UserID  []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
-}
-
-func (x *Password) Reset() {
-	*x = Password{}
+	Id         []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created    int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	UserID     []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	RoleID     []byte `protobuf:"bytes,4,opt,name=roleID,proto3" json:"roleID,omitempty"`
+	Reason     string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status     string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt  int64  `protobuf:"varint,7,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	ReviewerID []byte `protobuf:"bytes,8,opt,name=reviewerID,proto3" json:"reviewerID,omitempty"`
+	Reviewed   int64  `protobuf:"varint,9,opt,name=reviewed,proto3" json:"reviewed,omitempty"`
+	Comment    string `protobuf:"bytes,10,opt,name=comment,proto3" json:"comment,omitempty"`
+	UserRoleID []byte `protobuf:"bytes,11,opt,name=userRoleID,proto3" json:"userRoleID,omitempty"`
+}
+
+func (x *RoleRequest) Reset() {
+	*x = RoleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -697,13 +754,13 @@ func (x *Password) Reset() {
 	}
 }
 
-func (x *Password) String() string {
+func (x *RoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Password) ProtoMessage() {}
+func (*RoleRequest) ProtoMessage() {}
 
-func (x *Password) ProtoReflect() protoreflect.Message {
+func (x *RoleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -715,43 +772,102 @@ func (x *Password) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Password.ProtoReflect.Descriptor instead.
-func (*Password) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoleRequest.ProtoReflect.Descriptor instead.
+func (*RoleRequest) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Password) GetId() []byte {
+func (x *RoleRequest) GetId() []byte {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *Password) GetCreated() int64 {
+func (x *RoleRequest) GetCreated() int64 {
 	if x != nil {
 		return x.Created
 	}
 	return 0
 }
 
-func (x *Password) GetUserID() []byte {
+func (x *RoleRequest) GetUserID() []byte {
 	if x != nil {
 		return x.UserID
 	}
 	return nil
 }
 
-type User struct {
+func (x *RoleRequest) GetRoleID() []byte {
+	if x != nil {
+		return x.RoleID
+	}
+	return nil
+}
+
+func (x *RoleRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RoleRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *RoleRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RoleRequest) GetReviewerID() []byte {
+	if x != nil {
+		return x.ReviewerID
+	}
+	return nil
+}
+
+func (x *RoleRequest) GetReviewed() int64 {
+	if x != nil {
+		return x.Reviewed
+	}
+	return 0
+}
+
+func (x *RoleRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *RoleRequest) GetUserRoleID() []byte {
+	if x != nil {
+		return x.UserRoleID
+	}
+	return nil
+}
+
+type Session struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	RefreshToken []byte `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	AccessToken  string `protobuf:"bytes,2,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	Created      int64  `protobuf:"varint,3,opt,name=created,proto3" json:"created,omitempty"`
+	Expired      int64  `protobuf:"varint,4,opt,name=expired,proto3" json:"expired,omitempty"`
+	TokenType    string `protobuf:"bytes,5,opt,name=tokenType,proto3" json:"tokenType,omitempty"`
 }
 
-func (x *User) Reset() {
-	*x = User{}
+func (x *Session) Reset() {
+	*x = Session{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -759,13 +875,13 @@ func (x *User) Reset() {
 	}
 }
 
-func (x *User) String() string {
+func (x *Session) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*User) ProtoMessage() {}
+func (*Session) ProtoMessage() {}
 
-func (x *User) ProtoReflect() protoreflect.Message {
+func (x *Session) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -777,37 +893,60 @@ func (x *User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use User.ProtoReflect.Descriptor instead.
-func (*User) Descriptor() ([]byte, []int) {
+// Deprecated: Use Session.ProtoReflect.Descriptor instead.
+func (*Session) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *User) GetId() []byte {
+func (x *Session) GetRefreshToken() []byte {
 	if x != nil {
-		return x.Id
+		return x.RefreshToken
 	}
 	return nil
 }
 
-func (x *User) GetCreated() int64 {
+func (x *Session) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *Session) GetCreated() int64 {
 	if x != nil {
 		return x.Created
 	}
 	return 0
 }
 
-type Secret struct {
+func (x *Session) GetExpired() int64 {
+	if x != nil {
+		return x.Expired
+	}
+	return 0
+}
+
+func (x *Session) GetTokenType() string {
+	if x != nil {
+		return x.TokenType
+	}
+	return ""
+}
+
+type ReauthenticationRequiredResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Value   []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	MaxAge               int64    `protobuf:"varint,1,opt,name=maxAge,proto3" json:"maxAge,omitempty"`
+	Acr                  string   `protobuf:"bytes,2,opt,name=acr,proto3" json:"acr,omitempty"`
+	SecondFactorRequired bool     `protobuf:"varint,3,opt,name=secondFactorRequired,proto3" json:"secondFactorRequired,omitempty"`
+	AuthTime             int64    `protobuf:"varint,4,opt,name=authTime,proto3" json:"authTime,omitempty"`
+	Amr                  []string `protobuf:"bytes,5,rep,name=amr,proto3" json:"amr,omitempty"`
 }
 
-func (x *Secret) Reset() {
-	*x = Secret{}
+func (x *ReauthenticationRequiredResponseV1) Reset() {
+	*x = ReauthenticationRequiredResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,13 +954,13 @@ func (x *Secret) Reset() {
 	}
 }
 
-func (x *Secret) String() string {
+func (x *ReauthenticationRequiredResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Secret) ProtoMessage() {}
+func (*ReauthenticationRequiredResponseV1) ProtoMessage() {}
 
-func (x *Secret) ProtoReflect() protoreflect.Message {
+func (x *ReauthenticationRequiredResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -833,46 +972,61 @@ func (x *Secret) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
-func (*Secret) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReauthenticationRequiredResponseV1.ProtoReflect.Descriptor instead.
+func (*ReauthenticationRequiredResponseV1) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Secret) GetId() []byte {
+func (x *ReauthenticationRequiredResponseV1) GetMaxAge() int64 {
 	if x != nil {
-		return x.Id
+		return x.MaxAge
 	}
-	return nil
+	return 0
 }
 
-func (x *Secret) GetCreated() int64 {
+func (x *ReauthenticationRequiredResponseV1) GetAcr() string {
 	if x != nil {
-		return x.Created
+		return x.Acr
+	}
+	return ""
+}
+
+func (x *ReauthenticationRequiredResponseV1) GetSecondFactorRequired() bool {
+	if x != nil {
+		return x.SecondFactorRequired
+	}
+	return false
+}
+
+func (x *ReauthenticationRequiredResponseV1) GetAuthTime() int64 {
+	if x != nil {
+		return x.AuthTime
 	}
 	return 0
 }
 
-func (x *Secret) GetValue() []byte {
+func (x *ReauthenticationRequiredResponseV1) GetAmr() []string {
 	if x != nil {
-		return x.Value
+		return x.Amr
 	}
 	return nil
 }
 
-type UserView struct {
+type Email struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64    `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Roles   []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
-	Phones  []string `protobuf:"bytes,4,rep,name=phones,proto3" json:"phones,omitempty"`
-	Emails  []string `protobuf:"bytes,5,rep,name=emails,proto3" json:"emails,omitempty"`
+	Id         []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created    int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	UserID     []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Email      string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Primary    bool   `protobuf:"varint,5,opt,name=primary,proto3" json:"primary,omitempty"`
+	VerifiedAt int64  `protobuf:"varint,6,opt,name=verifiedAt,proto3" json:"verifiedAt,omitempty"`
 }
 
-func (x *UserView) Reset() {
-	*x = UserView{}
+func (x *Email) Reset() {
+	*x = Email{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -880,13 +1034,13 @@ func (x *UserView) Reset() {
 	}
 }
 
-func (x *UserView) String() string {
+func (x *Email) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserView) ProtoMessage() {}
+func (*Email) ProtoMessage() {}
 
-func (x *UserView) ProtoReflect() protoreflect.Message {
+func (x *Email) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -898,56 +1052,65 @@ func (x *UserView) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserView.ProtoReflect.Descriptor instead.
-func (*UserView) Descriptor() ([]byte, []int) {
+// Deprecated: Use Email.ProtoReflect.Descriptor instead.
+func (*Email) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *UserView) GetId() []byte {
+func (x *Email) GetId() []byte {
 	if x != nil {
 		return x.Id
 	}
 	return nil
 }
 
-func (x *UserView) GetCreated() int64 {
+func (x *Email) GetCreated() int64 {
 	if x != nil {
 		return x.Created
 	}
 	return 0
 }
 
-func (x *UserView) GetRoles() []string {
+func (x *Email) GetUserID() []byte {
 	if x != nil {
-		return x.Roles
+		return x.UserID
 	}
 	return nil
 }
 
-func (x *UserView) GetPhones() []string {
+func (x *Email) GetEmail() string {
 	if x != nil {
-		return x.Phones
+		return x.Email
 	}
-	return nil
+	return ""
 }
 
-func (x *UserView) GetEmails() []string {
+func (x *Email) GetPrimary() bool {
 	if x != nil {
-		return x.Emails
+		return x.Primary
 	}
-	return nil
+	return false
 }
 
-type GetRoleResponseV1 struct {
+func (x *Email) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
+}
+
+type EmailConfirmation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data *Role `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Created int64  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Expire  int64  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	Email   string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
 }
 
-func (x *GetRoleResponseV1) Reset() {
-	*x = GetRoleResponseV1{}
+func (x *EmailConfirmation) Reset() {
+	*x = EmailConfirmation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -955,13 +1118,13 @@ func (x *GetRoleResponseV1) Reset() {
 	}
 }
 
-func (x *GetRoleResponseV1) String() string {
+func (x *EmailConfirmation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoleResponseV1) ProtoMessage() {}
+func (*EmailConfirmation) ProtoMessage() {}
 
-func (x *GetRoleResponseV1) ProtoReflect() protoreflect.Message {
+func (x *EmailConfirmation) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -973,32 +1136,48 @@ func (x *GetRoleResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoleResponseV1.ProtoReflect.Descriptor instead.
-func (*GetRoleResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use EmailConfirmation.ProtoReflect.Descriptor instead.
+func (*EmailConfirmation) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GetRoleResponseV1) GetData() *Role {
+func (x *EmailConfirmation) GetCreated() int64 {
 	if x != nil {
-		return x.Data
+		return x.Created
 	}
-	return nil
+	return 0
 }
 
-type CreateRoleResponseV1 struct {
+func (x *EmailConfirmation) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+func (x *EmailConfirmation) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type Phone struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*CreateRoleResponseV1_Ok
-	//	*CreateRoleResponseV1_ValidationError_
-	//	*CreateRoleResponseV1_Error
-	Data isCreateRoleResponseV1_Data `protobuf_oneof:"data"`
+	Id               []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created          int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	UserID           []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Phone            string `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	PhoneCountryCode string `protobuf:"bytes,5,opt,name=phoneCountryCode,proto3" json:"phoneCountryCode,omitempty"`
+	Primary          bool   `protobuf:"varint,6,opt,name=primary,proto3" json:"primary,omitempty"`
+	VerifiedAt       int64  `protobuf:"varint,7,opt,name=verifiedAt,proto3" json:"verifiedAt,omitempty"`
 }
 
-func (x *CreateRoleResponseV1) Reset() {
-	*x = CreateRoleResponseV1{}
+func (x *Phone) Reset() {
+	*x = Phone{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1006,13 +1185,13 @@ func (x *CreateRoleResponseV1) Reset() {
 	}
 }
 
-func (x *CreateRoleResponseV1) String() string {
+func (x *Phone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleResponseV1) ProtoMessage() {}
+func (*Phone) ProtoMessage() {}
 
-func (x *CreateRoleResponseV1) ProtoReflect() protoreflect.Message {
+func (x *Phone) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1024,72 +1203,73 @@ func (x *CreateRoleResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleResponseV1.ProtoReflect.Descriptor instead.
-func (*CreateRoleResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use Phone.ProtoReflect.Descriptor instead.
+func (*Phone) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{14}
 }
 
-func (m *CreateRoleResponseV1) GetData() isCreateRoleResponseV1_Data {
-	if m != nil {
-		return m.Data
+func (x *Phone) GetId() []byte {
+	if x != nil {
+		return x.Id
 	}
 	return nil
 }
 
-func (x *CreateRoleResponseV1) GetOk() *Role {
-	if x, ok := x.GetData().(*CreateRoleResponseV1_Ok); ok {
-		return x.Ok
+func (x *Phone) GetCreated() int64 {
+	if x != nil {
+		return x.Created
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateRoleResponseV1) GetValidationError() *CreateRoleResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreateRoleResponseV1_ValidationError_); ok {
-		return x.ValidationError
+func (x *Phone) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
 	}
 	return nil
 }
 
-func (x *CreateRoleResponseV1) GetError() *Error {
-	if x, ok := x.GetData().(*CreateRoleResponseV1_Error); ok {
-		return x.Error
+func (x *Phone) GetPhone() string {
+	if x != nil {
+		return x.Phone
 	}
-	return nil
-}
-
-type isCreateRoleResponseV1_Data interface {
-	isCreateRoleResponseV1_Data()
+	return ""
 }
 
-type CreateRoleResponseV1_Ok struct {
-	Ok *Role `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+func (x *Phone) GetPhoneCountryCode() string {
+	if x != nil {
+		return x.PhoneCountryCode
+	}
+	return ""
 }
 
-type CreateRoleResponseV1_ValidationError_ struct {
-	ValidationError *CreateRoleResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+func (x *Phone) GetPrimary() bool {
+	if x != nil {
+		return x.Primary
+	}
+	return false
 }
 
-type CreateRoleResponseV1_Error struct {
-	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+func (x *Phone) GetVerifiedAt() int64 {
+	if x != nil {
+		return x.VerifiedAt
+	}
+	return 0
 }
 
-func (*CreateRoleResponseV1_Ok) isCreateRoleResponseV1_Data() {}
-
-func (*CreateRoleResponseV1_ValidationError_) isCreateRoleResponseV1_Data() {}
-
-func (*CreateRoleResponseV1_Error) isCreateRoleResponseV1_Data() {}
-
-type ListRoleResponseV1 struct {
+type PhoneConfirmation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Data       []*Role     `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Created          int64  `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Expire           int64  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	Phone            string `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	PhoneCountryCode string `protobuf:"bytes,4,opt,name=phoneCountryCode,proto3" json:"phoneCountryCode,omitempty"`
 }
 
-func (x *ListRoleResponseV1) Reset() {
-	*x = ListRoleResponseV1{}
+func (x *PhoneConfirmation) Reset() {
+	*x = PhoneConfirmation{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1097,13 +1277,13 @@ func (x *ListRoleResponseV1) Reset() {
 	}
 }
 
-func (x *ListRoleResponseV1) String() string {
+func (x *PhoneConfirmation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListRoleResponseV1) ProtoMessage() {}
+func (*PhoneConfirmation) ProtoMessage() {}
 
-func (x *ListRoleResponseV1) ProtoReflect() protoreflect.Message {
+func (x *PhoneConfirmation) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1115,39 +1295,56 @@ func (x *ListRoleResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListRoleResponseV1.ProtoReflect.Descriptor instead.
-func (*ListRoleResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use PhoneConfirmation.ProtoReflect.Descriptor instead.
+func (*PhoneConfirmation) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListRoleResponseV1) GetPagination() *Pagination {
+func (x *PhoneConfirmation) GetCreated() int64 {
 	if x != nil {
-		return x.Pagination
+		return x.Created
 	}
-	return nil
+	return 0
 }
 
-func (x *ListRoleResponseV1) GetData() []*Role {
+func (x *PhoneConfirmation) GetExpire() int64 {
 	if x != nil {
-		return x.Data
+		return x.Expire
 	}
-	return nil
+	return 0
 }
 
-type CreateUserRoleResponseV1 struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *PhoneConfirmation) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
 
-	// Types that are assignable to Data:
-	//	*CreateUserRoleResponseV1_Ok
-	//	*CreateUserRoleResponseV1_ValidationError_
-	//	*CreateUserRoleResponseV1_Error
-	Data isCreateUserRoleResponseV1_Data `protobuf_oneof:"data"`
+func (x *PhoneConfirmation) GetPhoneCountryCode() string {
+	if x != nil {
+		return x.PhoneCountryCode
+	}
+	return ""
 }
 
-func (x *CreateUserRoleResponseV1) Reset() {
-	*x = CreateUserRoleResponseV1{}
+type ContactTransfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created    int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Kind       string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Value      string `protobuf:"bytes,4,opt,name=value,proto3" json:"value,omitempty"`
+	FromUserID []byte `protobuf:"bytes,5,opt,name=fromUserID,proto3" json:"fromUserID,omitempty"`
+	ToUserID   []byte `protobuf:"bytes,6,opt,name=toUserID,proto3" json:"toUserID,omitempty"`
+	Status     string `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	Resolved   int64  `protobuf:"varint,8,opt,name=resolved,proto3" json:"resolved,omitempty"`
+}
+
+func (x *ContactTransfer) Reset() {
+	*x = ContactTransfer{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1155,13 +1352,13 @@ func (x *CreateUserRoleResponseV1) Reset() {
 	}
 }
 
-func (x *CreateUserRoleResponseV1) String() string {
+func (x *ContactTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRoleResponseV1) ProtoMessage() {}
+func (*ContactTransfer) ProtoMessage() {}
 
-func (x *CreateUserRoleResponseV1) ProtoReflect() protoreflect.Message {
+func (x *ContactTransfer) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1173,71 +1370,79 @@ func (x *CreateUserRoleResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRoleResponseV1.ProtoReflect.Descriptor instead.
-func (*CreateUserRoleResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContactTransfer.ProtoReflect.Descriptor instead.
+func (*ContactTransfer) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (m *CreateUserRoleResponseV1) GetData() isCreateUserRoleResponseV1_Data {
-	if m != nil {
-		return m.Data
+func (x *ContactTransfer) GetId() []byte {
+	if x != nil {
+		return x.Id
 	}
 	return nil
 }
 
-func (x *CreateUserRoleResponseV1) GetOk() *UserRole {
-	if x, ok := x.GetData().(*CreateUserRoleResponseV1_Ok); ok {
-		return x.Ok
+func (x *ContactTransfer) GetCreated() int64 {
+	if x != nil {
+		return x.Created
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateUserRoleResponseV1) GetValidationError() *CreateUserRoleResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreateUserRoleResponseV1_ValidationError_); ok {
-		return x.ValidationError
+func (x *ContactTransfer) GetKind() string {
+	if x != nil {
+		return x.Kind
 	}
-	return nil
+	return ""
 }
 
-func (x *CreateUserRoleResponseV1) GetError() *Error {
-	if x, ok := x.GetData().(*CreateUserRoleResponseV1_Error); ok {
-		return x.Error
+func (x *ContactTransfer) GetValue() string {
+	if x != nil {
+		return x.Value
 	}
-	return nil
+	return ""
 }
 
-type isCreateUserRoleResponseV1_Data interface {
-	isCreateUserRoleResponseV1_Data()
+func (x *ContactTransfer) GetFromUserID() []byte {
+	if x != nil {
+		return x.FromUserID
+	}
+	return nil
 }
 
-type CreateUserRoleResponseV1_Ok struct {
-	Ok *UserRole `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+func (x *ContactTransfer) GetToUserID() []byte {
+	if x != nil {
+		return x.ToUserID
+	}
+	return nil
 }
 
-type CreateUserRoleResponseV1_ValidationError_ struct {
-	ValidationError *CreateUserRoleResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+func (x *ContactTransfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
 }
 
-type CreateUserRoleResponseV1_Error struct {
-	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+func (x *ContactTransfer) GetResolved() int64 {
+	if x != nil {
+		return x.Resolved
+	}
+	return 0
 }
 
-func (*CreateUserRoleResponseV1_Ok) isCreateUserRoleResponseV1_Data() {}
-
-func (*CreateUserRoleResponseV1_ValidationError_) isCreateUserRoleResponseV1_Data() {}
-
-func (*CreateUserRoleResponseV1_Error) isCreateUserRoleResponseV1_Data() {}
-
-type GetUserRoleResponseV1 struct {
+type Password struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data *UserRole `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	UserID  []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
 }
 
-func (x *GetUserRoleResponseV1) Reset() {
-	*x = GetUserRoleResponseV1{}
+func (x *Password) Reset() {
+	*x = Password{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1245,13 +1450,13 @@ func (x *GetUserRoleResponseV1) Reset() {
 	}
 }
 
-func (x *GetUserRoleResponseV1) String() string {
+func (x *Password) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRoleResponseV1) ProtoMessage() {}
+func (*Password) ProtoMessage() {}
 
-func (x *GetUserRoleResponseV1) ProtoReflect() protoreflect.Message {
+func (x *Password) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1263,29 +1468,49 @@ func (x *GetUserRoleResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRoleResponseV1.ProtoReflect.Descriptor instead.
-func (*GetUserRoleResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use Password.ProtoReflect.Descriptor instead.
+func (*Password) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetUserRoleResponseV1) GetData() *UserRole {
+func (x *Password) GetId() []byte {
 	if x != nil {
-		return x.Data
+		return x.Id
 	}
 	return nil
 }
 
-type ListUserRolesResponseV1 struct {
+func (x *Password) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *Password) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+type User struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Data       []*UserRole `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	Id                []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created           int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Status            string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	SuspendedUntil    int64  `protobuf:"varint,4,opt,name=suspendedUntil,proto3" json:"suspendedUntil,omitempty"`
+	StatusReason      string `protobuf:"bytes,5,opt,name=statusReason,proto3" json:"statusReason,omitempty"`
+	StatusActorID     []byte `protobuf:"bytes,6,opt,name=statusActorID,proto3" json:"statusActorID,omitempty"`
+	StatusChanged     int64  `protobuf:"varint,7,opt,name=statusChanged,proto3" json:"statusChanged,omitempty"`
+	DeletionScheduled int64  `protobuf:"varint,8,opt,name=deletionScheduled,proto3" json:"deletionScheduled,omitempty"`
 }
 
-func (x *ListUserRolesResponseV1) Reset() {
-	*x = ListUserRolesResponseV1{}
+func (x *User) Reset() {
+	*x = User{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1293,13 +1518,13 @@ func (x *ListUserRolesResponseV1) Reset() {
 	}
 }
 
-func (x *ListUserRolesResponseV1) String() string {
+func (x *User) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserRolesResponseV1) ProtoMessage() {}
+func (*User) ProtoMessage() {}
 
-func (x *ListUserRolesResponseV1) ProtoReflect() protoreflect.Message {
+func (x *User) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1311,39 +1536,81 @@ func (x *ListUserRolesResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserRolesResponseV1.ProtoReflect.Descriptor instead.
-func (*ListUserRolesResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ListUserRolesResponseV1) GetPagination() *Pagination {
+func (x *User) GetId() []byte {
 	if x != nil {
-		return x.Pagination
+		return x.Id
 	}
 	return nil
 }
 
-func (x *ListUserRolesResponseV1) GetData() []*UserRole {
+func (x *User) GetCreated() int64 {
 	if x != nil {
-		return x.Data
+		return x.Created
+	}
+	return 0
+}
+
+func (x *User) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *User) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *User) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *User) GetStatusActorID() []byte {
+	if x != nil {
+		return x.StatusActorID
 	}
 	return nil
 }
 
-type CreateEmailResponseV1 struct {
+func (x *User) GetStatusChanged() int64 {
+	if x != nil {
+		return x.StatusChanged
+	}
+	return 0
+}
+
+func (x *User) GetDeletionScheduled() int64 {
+	if x != nil {
+		return x.DeletionScheduled
+	}
+	return 0
+}
+
+type Secret struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*CreateEmailResponseV1_Ok
-	//	*CreateEmailResponseV1_ValidationError_
-	//	*CreateEmailResponseV1_Error
-	Data isCreateEmailResponseV1_Data `protobuf_oneof:"data"`
+	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Value   []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Expires int64  `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`
+	Revoked int64  `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
 }
 
-func (x *CreateEmailResponseV1) Reset() {
-	*x = CreateEmailResponseV1{}
+func (x *Secret) Reset() {
+	*x = Secret{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1351,13 +1618,13 @@ func (x *CreateEmailResponseV1) Reset() {
 	}
 }
 
-func (x *CreateEmailResponseV1) String() string {
+func (x *Secret) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmailResponseV1) ProtoMessage() {}
+func (*Secret) ProtoMessage() {}
 
-func (x *CreateEmailResponseV1) ProtoReflect() protoreflect.Message {
+func (x *Secret) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1369,88 +1636,83 @@ func (x *CreateEmailResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmailResponseV1.ProtoReflect.Descriptor instead.
-func (*CreateEmailResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use Secret.ProtoReflect.Descriptor instead.
+func (*Secret) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (m *CreateEmailResponseV1) GetData() isCreateEmailResponseV1_Data {
-	if m != nil {
-		return m.Data
+func (x *Secret) GetId() []byte {
+	if x != nil {
+		return x.Id
 	}
 	return nil
 }
 
-func (x *CreateEmailResponseV1) GetOk() *Email {
-	if x, ok := x.GetData().(*CreateEmailResponseV1_Ok); ok {
-		return x.Ok
+func (x *Secret) GetCreated() int64 {
+	if x != nil {
+		return x.Created
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateEmailResponseV1) GetValidationError() *CreateEmailResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreateEmailResponseV1_ValidationError_); ok {
-		return x.ValidationError
+func (x *Secret) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-func (x *CreateEmailResponseV1) GetError() *Error {
-	if x, ok := x.GetData().(*CreateEmailResponseV1_Error); ok {
-		return x.Error
+func (x *Secret) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
 	}
-	return nil
+	return 0
 }
 
-type isCreateEmailResponseV1_Data interface {
-	isCreateEmailResponseV1_Data()
+func (x *Secret) GetRevoked() int64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
 }
 
-type CreateEmailResponseV1_Ok struct {
-	Ok *Email `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
-}
+type UserView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-type CreateEmailResponseV1_ValidationError_ struct {
-	ValidationError *CreateEmailResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+	Id                []byte                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created           int64                   `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Roles             []string                `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Phones            []string                `protobuf:"bytes,4,rep,name=phones,proto3" json:"phones,omitempty"`
+	Emails            []string                `protobuf:"bytes,5,rep,name=emails,proto3" json:"emails,omitempty"`
+	Permissions       []string                `protobuf:"bytes,6,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Organizations     []*UserViewOrganization `protobuf:"bytes,7,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	Version           int64                   `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	Profile           *structpb.Struct        `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
+	Status            string                  `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`
+	SuspendedUntil    int64                   `protobuf:"varint,11,opt,name=suspendedUntil,proto3" json:"suspendedUntil,omitempty"`
+	DeletionScheduled int64                   `protobuf:"varint,12,opt,name=deletionScheduled,proto3" json:"deletionScheduled,omitempty"`
+	PrimaryEmail      string                  `protobuf:"bytes,13,opt,name=primaryEmail,proto3" json:"primaryEmail,omitempty"`
+	PrimaryPhone      string                  `protobuf:"bytes,14,opt,name=primaryPhone,proto3" json:"primaryPhone,omitempty"`
 }
 
-type CreateEmailResponseV1_Error struct {
-	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+func (x *UserView) Reset() {
+	*x = UserView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-func (*CreateEmailResponseV1_Ok) isCreateEmailResponseV1_Data() {}
-
-func (*CreateEmailResponseV1_ValidationError_) isCreateEmailResponseV1_Data() {}
+func (x *UserView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
-func (*CreateEmailResponseV1_Error) isCreateEmailResponseV1_Data() {}
+func (*UserView) ProtoMessage() {}
 
-type CreateEmailConfirmationResponseV1 struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Data:
-	//	*CreateEmailConfirmationResponseV1_Ok
-	//	*CreateEmailConfirmationResponseV1_ValidationError_
-	Data isCreateEmailConfirmationResponseV1_Data `protobuf_oneof:"data"`
-}
-
-func (x *CreateEmailConfirmationResponseV1) Reset() {
-	*x = CreateEmailConfirmationResponseV1{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[20]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CreateEmailConfirmationResponseV1) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateEmailConfirmationResponseV1) ProtoMessage() {}
-
-func (x *CreateEmailConfirmationResponseV1) ProtoReflect() protoreflect.Message {
+func (x *UserView) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1462,63 +1724,121 @@ func (x *CreateEmailConfirmationResponseV1) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmailConfirmationResponseV1.ProtoReflect.Descriptor instead.
-func (*CreateEmailConfirmationResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserView.ProtoReflect.Descriptor instead.
+func (*UserView) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (m *CreateEmailConfirmationResponseV1) GetData() isCreateEmailConfirmationResponseV1_Data {
-	if m != nil {
-		return m.Data
+func (x *UserView) GetId() []byte {
+	if x != nil {
+		return x.Id
 	}
 	return nil
 }
 
-func (x *CreateEmailConfirmationResponseV1) GetOk() *EmailConfirmation {
-	if x, ok := x.GetData().(*CreateEmailConfirmationResponseV1_Ok); ok {
-		return x.Ok
+func (x *UserView) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *UserView) GetRoles() []string {
+	if x != nil {
+		return x.Roles
 	}
 	return nil
 }
 
-func (x *CreateEmailConfirmationResponseV1) GetValidationError() *CreateEmailConfirmationResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreateEmailConfirmationResponseV1_ValidationError_); ok {
-		return x.ValidationError
+func (x *UserView) GetPhones() []string {
+	if x != nil {
+		return x.Phones
 	}
 	return nil
 }
 
-type isCreateEmailConfirmationResponseV1_Data interface {
-	isCreateEmailConfirmationResponseV1_Data()
+func (x *UserView) GetEmails() []string {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
 }
 
-type CreateEmailConfirmationResponseV1_Ok struct {
-	Ok *EmailConfirmation `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+func (x *UserView) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
 }
 
-type CreateEmailConfirmationResponseV1_ValidationError_ struct {
-	ValidationError *CreateEmailConfirmationResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+func (x *UserView) GetOrganizations() []*UserViewOrganization {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
 }
 
-func (*CreateEmailConfirmationResponseV1_Ok) isCreateEmailConfirmationResponseV1_Data() {}
+func (x *UserView) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
 
-func (*CreateEmailConfirmationResponseV1_ValidationError_) isCreateEmailConfirmationResponseV1_Data() {
+func (x *UserView) GetProfile() *structpb.Struct {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
 }
 
-type CreatePhoneResponseV1 struct {
+func (x *UserView) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserView) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *UserView) GetDeletionScheduled() int64 {
+	if x != nil {
+		return x.DeletionScheduled
+	}
+	return 0
+}
+
+func (x *UserView) GetPrimaryEmail() string {
+	if x != nil {
+		return x.PrimaryEmail
+	}
+	return ""
+}
+
+func (x *UserView) GetPrimaryPhone() string {
+	if x != nil {
+		return x.PrimaryPhone
+	}
+	return ""
+}
+
+type UserViewOrganization struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*CreatePhoneResponseV1_Ok
-	//	*CreatePhoneResponseV1_ValidationError_
-	//	*CreatePhoneResponseV1_Error
-	Data isCreatePhoneResponseV1_Data `protobuf_oneof:"data"`
+	Id          []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Roles       []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
 }
 
-func (x *CreatePhoneResponseV1) Reset() {
-	*x = CreatePhoneResponseV1{}
+func (x *UserViewOrganization) Reset() {
+	*x = UserViewOrganization{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1526,13 +1846,13 @@ func (x *CreatePhoneResponseV1) Reset() {
 	}
 }
 
-func (x *CreatePhoneResponseV1) String() string {
+func (x *UserViewOrganization) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePhoneResponseV1) ProtoMessage() {}
+func (*UserViewOrganization) ProtoMessage() {}
 
-func (x *CreatePhoneResponseV1) ProtoReflect() protoreflect.Message {
+func (x *UserViewOrganization) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1544,74 +1864,44 @@ func (x *CreatePhoneResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePhoneResponseV1.ProtoReflect.Descriptor instead.
-func (*CreatePhoneResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use UserViewOrganization.ProtoReflect.Descriptor instead.
+func (*UserViewOrganization) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (m *CreatePhoneResponseV1) GetData() isCreatePhoneResponseV1_Data {
-	if m != nil {
-		return m.Data
-	}
-	return nil
-}
-
-func (x *CreatePhoneResponseV1) GetOk() *Phone {
-	if x, ok := x.GetData().(*CreatePhoneResponseV1_Ok); ok {
-		return x.Ok
+func (x *UserViewOrganization) GetId() []byte {
+	if x != nil {
+		return x.Id
 	}
 	return nil
 }
 
-func (x *CreatePhoneResponseV1) GetValidationError() *CreatePhoneResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreatePhoneResponseV1_ValidationError_); ok {
-		return x.ValidationError
+func (x *UserViewOrganization) GetRoles() []string {
+	if x != nil {
+		return x.Roles
 	}
 	return nil
 }
 
-func (x *CreatePhoneResponseV1) GetError() *Error {
-	if x, ok := x.GetData().(*CreatePhoneResponseV1_Error); ok {
-		return x.Error
+func (x *UserViewOrganization) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
 	}
 	return nil
 }
 
-type isCreatePhoneResponseV1_Data interface {
-	isCreatePhoneResponseV1_Data()
-}
-
-type CreatePhoneResponseV1_Ok struct {
-	Ok *Phone `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
-}
-
-type CreatePhoneResponseV1_ValidationError_ struct {
-	ValidationError *CreatePhoneResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
-}
-
-type CreatePhoneResponseV1_Error struct {
-	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
-}
-
-func (*CreatePhoneResponseV1_Ok) isCreatePhoneResponseV1_Data() {}
-
-func (*CreatePhoneResponseV1_ValidationError_) isCreatePhoneResponseV1_Data() {}
-
-func (*CreatePhoneResponseV1_Error) isCreatePhoneResponseV1_Data() {}
-
-type CreatePhoneConfirmationResponseV1 struct {
+type AuthorizationCheck struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*CreatePhoneConfirmationResponseV1_Ok
-	//	*CreatePhoneConfirmationResponseV1_ValidationError_
-	Data isCreatePhoneConfirmationResponseV1_Data `protobuf_oneof:"data"`
+	Action         string `protobuf:"bytes,1,opt,name=action,proto3" json:"action,omitempty"`
+	Resource       string `protobuf:"bytes,2,opt,name=resource,proto3" json:"resource,omitempty"`
+	OrganizationID []byte `protobuf:"bytes,3,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
 }
 
-func (x *CreatePhoneConfirmationResponseV1) Reset() {
-	*x = CreatePhoneConfirmationResponseV1{}
+func (x *AuthorizationCheck) Reset() {
+	*x = AuthorizationCheck{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1619,13 +1909,13 @@ func (x *CreatePhoneConfirmationResponseV1) Reset() {
 	}
 }
 
-func (x *CreatePhoneConfirmationResponseV1) String() string {
+func (x *AuthorizationCheck) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePhoneConfirmationResponseV1) ProtoMessage() {}
+func (*AuthorizationCheck) ProtoMessage() {}
 
-func (x *CreatePhoneConfirmationResponseV1) ProtoReflect() protoreflect.Message {
+func (x *AuthorizationCheck) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1637,63 +1927,43 @@ func (x *CreatePhoneConfirmationResponseV1) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePhoneConfirmationResponseV1.ProtoReflect.Descriptor instead.
-func (*CreatePhoneConfirmationResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthorizationCheck.ProtoReflect.Descriptor instead.
+func (*AuthorizationCheck) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (m *CreatePhoneConfirmationResponseV1) GetData() isCreatePhoneConfirmationResponseV1_Data {
-	if m != nil {
-		return m.Data
+func (x *AuthorizationCheck) GetAction() string {
+	if x != nil {
+		return x.Action
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatePhoneConfirmationResponseV1) GetOk() *PhoneConfirmation {
-	if x, ok := x.GetData().(*CreatePhoneConfirmationResponseV1_Ok); ok {
-		return x.Ok
+func (x *AuthorizationCheck) GetResource() string {
+	if x != nil {
+		return x.Resource
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatePhoneConfirmationResponseV1) GetValidationError() *CreatePhoneConfirmationResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreatePhoneConfirmationResponseV1_ValidationError_); ok {
-		return x.ValidationError
+func (x *AuthorizationCheck) GetOrganizationID() []byte {
+	if x != nil {
+		return x.OrganizationID
 	}
 	return nil
 }
 
-type isCreatePhoneConfirmationResponseV1_Data interface {
-	isCreatePhoneConfirmationResponseV1_Data()
-}
-
-type CreatePhoneConfirmationResponseV1_Ok struct {
-	Ok *PhoneConfirmation `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
-}
-
-type CreatePhoneConfirmationResponseV1_ValidationError_ struct {
-	ValidationError *CreatePhoneConfirmationResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
-}
-
-func (*CreatePhoneConfirmationResponseV1_Ok) isCreatePhoneConfirmationResponseV1_Data() {}
-
-func (*CreatePhoneConfirmationResponseV1_ValidationError_) isCreatePhoneConfirmationResponseV1_Data() {
-}
-
-type CreatePasswordResponseV1 struct {
+type AuthorizationDecision struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Data:
-	//	*CreatePasswordResponseV1_Ok
-	//	*CreatePasswordResponseV1_ValidationError_
-	//	*CreatePasswordResponseV1_Error
-	Data isCreatePasswordResponseV1_Data `protobuf_oneof:"data"`
+	Allowed bool   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *CreatePasswordResponseV1) Reset() {
-	*x = CreatePasswordResponseV1{}
+func (x *AuthorizationDecision) Reset() {
+	*x = AuthorizationDecision{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1701,13 +1971,13 @@ func (x *CreatePasswordResponseV1) Reset() {
 	}
 }
 
-func (x *CreatePasswordResponseV1) String() string {
+func (x *AuthorizationDecision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePasswordResponseV1) ProtoMessage() {}
+func (*AuthorizationDecision) ProtoMessage() {}
 
-func (x *CreatePasswordResponseV1) ProtoReflect() protoreflect.Message {
+func (x *AuthorizationDecision) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1719,88 +1989,51 @@ func (x *CreatePasswordResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePasswordResponseV1.ProtoReflect.Descriptor instead.
-func (*CreatePasswordResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use AuthorizationDecision.ProtoReflect.Descriptor instead.
+func (*AuthorizationDecision) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{23}
 }
 
-func (m *CreatePasswordResponseV1) GetData() isCreatePasswordResponseV1_Data {
-	if m != nil {
-		return m.Data
+func (x *AuthorizationDecision) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
 	}
-	return nil
+	return false
 }
 
-func (x *CreatePasswordResponseV1) GetOk() *Password {
-	if x, ok := x.GetData().(*CreatePasswordResponseV1_Ok); ok {
-		return x.Ok
+func (x *AuthorizationDecision) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
-	return nil
+	return ""
 }
 
-func (x *CreatePasswordResponseV1) GetValidationError() *CreatePasswordResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreatePasswordResponseV1_ValidationError_); ok {
-		return x.ValidationError
-	}
-	return nil
+type Authorization struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID    []byte                   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Version   int64                    `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	Decisions []*AuthorizationDecision `protobuf:"bytes,3,rep,name=decisions,proto3" json:"decisions,omitempty"`
 }
 
-func (x *CreatePasswordResponseV1) GetError() *Error {
-	if x, ok := x.GetData().(*CreatePasswordResponseV1_Error); ok {
-		return x.Error
+func (x *Authorization) Reset() {
+	*x = Authorization{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isCreatePasswordResponseV1_Data interface {
-	isCreatePasswordResponseV1_Data()
+func (x *Authorization) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type CreatePasswordResponseV1_Ok struct {
-	Ok *Password `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
-}
+func (*Authorization) ProtoMessage() {}
 
-type CreatePasswordResponseV1_ValidationError_ struct {
-	ValidationError *CreatePasswordResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
-}
-
-type CreatePasswordResponseV1_Error struct {
-	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
-}
-
-func (*CreatePasswordResponseV1_Ok) isCreatePasswordResponseV1_Data() {}
-
-func (*CreatePasswordResponseV1_ValidationError_) isCreatePasswordResponseV1_Data() {}
-
-func (*CreatePasswordResponseV1_Error) isCreatePasswordResponseV1_Data() {}
-
-type CreateUserResponseV1 struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Types that are assignable to Data:
-	//	*CreateUserResponseV1_Ok
-	//	*CreateUserResponseV1_ValidationError_
-	Data isCreateUserResponseV1_Data `protobuf_oneof:"data"`
-}
-
-func (x *CreateUserResponseV1) Reset() {
-	*x = CreateUserResponseV1{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *CreateUserResponseV1) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*CreateUserResponseV1) ProtoMessage() {}
-
-func (x *CreateUserResponseV1) ProtoReflect() protoreflect.Message {
+func (x *Authorization) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1812,58 +2045,42 @@ func (x *CreateUserResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponseV1.ProtoReflect.Descriptor instead.
-func (*CreateUserResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use Authorization.ProtoReflect.Descriptor instead.
+func (*Authorization) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{24}
 }
 
-func (m *CreateUserResponseV1) GetData() isCreateUserResponseV1_Data {
-	if m != nil {
-		return m.Data
+func (x *Authorization) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
 	}
 	return nil
 }
 
-func (x *CreateUserResponseV1) GetOk() *User {
-	if x, ok := x.GetData().(*CreateUserResponseV1_Ok); ok {
-		return x.Ok
+func (x *Authorization) GetVersion() int64 {
+	if x != nil {
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateUserResponseV1) GetValidationError() *CreateUserResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreateUserResponseV1_ValidationError_); ok {
-		return x.ValidationError
+func (x *Authorization) GetDecisions() []*AuthorizationDecision {
+	if x != nil {
+		return x.Decisions
 	}
 	return nil
 }
 
-type isCreateUserResponseV1_Data interface {
-	isCreateUserResponseV1_Data()
-}
-
-type CreateUserResponseV1_Ok struct {
-	Ok *User `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
-}
-
-type CreateUserResponseV1_ValidationError_ struct {
-	ValidationError *CreateUserResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
-}
-
-func (*CreateUserResponseV1_Ok) isCreateUserResponseV1_Data() {}
-
-func (*CreateUserResponseV1_ValidationError_) isCreateUserResponseV1_Data() {}
-
-type GetUserResponseV1 struct {
+type GetRoleResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data *User `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Data *Role `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *GetUserResponseV1) Reset() {
-	*x = GetUserResponseV1{}
+func (x *GetRoleResponseV1) Reset() {
+	*x = GetRoleResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1871,13 +2088,13 @@ func (x *GetUserResponseV1) Reset() {
 	}
 }
 
-func (x *GetUserResponseV1) String() string {
+func (x *GetRoleResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponseV1) ProtoMessage() {}
+func (*GetRoleResponseV1) ProtoMessage() {}
 
-func (x *GetUserResponseV1) ProtoReflect() protoreflect.Message {
+func (x *GetRoleResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1889,28 +2106,32 @@ func (x *GetUserResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponseV1.ProtoReflect.Descriptor instead.
-func (*GetUserResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetRoleResponseV1.ProtoReflect.Descriptor instead.
+func (*GetRoleResponseV1) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *GetUserResponseV1) GetData() *User {
+func (x *GetRoleResponseV1) GetData() *Role {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ListUserResponseV1 struct {
+type CreateRoleResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data []*User `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// Types that are assignable to Data:
+	//	*CreateRoleResponseV1_Ok
+	//	*CreateRoleResponseV1_ValidationError_
+	//	*CreateRoleResponseV1_Error
+	Data isCreateRoleResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *ListUserResponseV1) Reset() {
-	*x = ListUserResponseV1{}
+func (x *CreateRoleResponseV1) Reset() {
+	*x = CreateRoleResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1918,13 +2139,13 @@ func (x *ListUserResponseV1) Reset() {
 	}
 }
 
-func (x *ListUserResponseV1) String() string {
+func (x *CreateRoleResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserResponseV1) ProtoMessage() {}
+func (*CreateRoleResponseV1) ProtoMessage() {}
 
-func (x *ListUserResponseV1) ProtoReflect() protoreflect.Message {
+func (x *CreateRoleResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1936,31 +2157,75 @@ func (x *ListUserResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserResponseV1.ProtoReflect.Descriptor instead.
-func (*ListUserResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRoleResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponseV1) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *ListUserResponseV1) GetData() []*User {
-	if x != nil {
-		return x.Data
+func (m *CreateRoleResponseV1) GetData() isCreateRoleResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-type CreateSessionResponseV1 struct {
+func (x *CreateRoleResponseV1) GetOk() *Role {
+	if x, ok := x.GetData().(*CreateRoleResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreateRoleResponseV1) GetValidationError() *CreateRoleResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateRoleResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreateRoleResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreateRoleResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateRoleResponseV1_Data interface {
+	isCreateRoleResponseV1_Data()
+}
+
+type CreateRoleResponseV1_Ok struct {
+	Ok *Role `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateRoleResponseV1_ValidationError_ struct {
+	ValidationError *CreateRoleResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreateRoleResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateRoleResponseV1_Ok) isCreateRoleResponseV1_Data() {}
+
+func (*CreateRoleResponseV1_ValidationError_) isCreateRoleResponseV1_Data() {}
+
+func (*CreateRoleResponseV1_Error) isCreateRoleResponseV1_Data() {}
+
+type UpdateRoleParentsResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	// Types that are assignable to Data:
-	//	*CreateSessionResponseV1_Ok
-	//	*CreateSessionResponseV1_ValidationError_
-	Data isCreateSessionResponseV1_Data `protobuf_oneof:"data"`
+	//	*UpdateRoleParentsResponseV1_Ok
+	//	*UpdateRoleParentsResponseV1_ValidationError_
+	//	*UpdateRoleParentsResponseV1_Error
+	Data isUpdateRoleParentsResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *CreateSessionResponseV1) Reset() {
-	*x = CreateSessionResponseV1{}
+func (x *UpdateRoleParentsResponseV1) Reset() {
+	*x = UpdateRoleParentsResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1968,13 +2233,13 @@ func (x *CreateSessionResponseV1) Reset() {
 	}
 }
 
-func (x *CreateSessionResponseV1) String() string {
+func (x *UpdateRoleParentsResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionResponseV1) ProtoMessage() {}
+func (*UpdateRoleParentsResponseV1) ProtoMessage() {}
 
-func (x *CreateSessionResponseV1) ProtoReflect() protoreflect.Message {
+func (x *UpdateRoleParentsResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1986,58 +2251,75 @@ func (x *CreateSessionResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionResponseV1.ProtoReflect.Descriptor instead.
-func (*CreateSessionResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateRoleParentsResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateRoleParentsResponseV1) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{27}
 }
 
-func (m *CreateSessionResponseV1) GetData() isCreateSessionResponseV1_Data {
+func (m *UpdateRoleParentsResponseV1) GetData() isUpdateRoleParentsResponseV1_Data {
 	if m != nil {
 		return m.Data
 	}
 	return nil
 }
 
-func (x *CreateSessionResponseV1) GetOk() *Session {
-	if x, ok := x.GetData().(*CreateSessionResponseV1_Ok); ok {
+func (x *UpdateRoleParentsResponseV1) GetOk() *Role {
+	if x, ok := x.GetData().(*UpdateRoleParentsResponseV1_Ok); ok {
 		return x.Ok
 	}
 	return nil
 }
 
-func (x *CreateSessionResponseV1) GetValidationError() *CreateSessionResponseV1_ValidationError {
-	if x, ok := x.GetData().(*CreateSessionResponseV1_ValidationError_); ok {
+func (x *UpdateRoleParentsResponseV1) GetValidationError() *UpdateRoleParentsResponseV1_ValidationError {
+	if x, ok := x.GetData().(*UpdateRoleParentsResponseV1_ValidationError_); ok {
 		return x.ValidationError
 	}
 	return nil
 }
 
-type isCreateSessionResponseV1_Data interface {
-	isCreateSessionResponseV1_Data()
+func (x *UpdateRoleParentsResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*UpdateRoleParentsResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
 }
 
-type CreateSessionResponseV1_Ok struct {
-	Ok *Session `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+type isUpdateRoleParentsResponseV1_Data interface {
+	isUpdateRoleParentsResponseV1_Data()
 }
 
-type CreateSessionResponseV1_ValidationError_ struct {
-	ValidationError *CreateSessionResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+type UpdateRoleParentsResponseV1_Ok struct {
+	Ok *Role `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
 }
 
-func (*CreateSessionResponseV1_Ok) isCreateSessionResponseV1_Data() {}
+type UpdateRoleParentsResponseV1_ValidationError_ struct {
+	ValidationError *UpdateRoleParentsResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
 
-func (*CreateSessionResponseV1_ValidationError_) isCreateSessionResponseV1_Data() {}
+type UpdateRoleParentsResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
 
-type GetSecretResponseV1 struct {
+func (*UpdateRoleParentsResponseV1_Ok) isUpdateRoleParentsResponseV1_Data() {}
+
+func (*UpdateRoleParentsResponseV1_ValidationError_) isUpdateRoleParentsResponseV1_Data() {}
+
+func (*UpdateRoleParentsResponseV1_Error) isUpdateRoleParentsResponseV1_Data() {}
+
+type UpdateRoleManagedRolesResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data *Secret `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Types that are assignable to Data:
+	//	*UpdateRoleManagedRolesResponseV1_Ok
+	//	*UpdateRoleManagedRolesResponseV1_ValidationError_
+	//	*UpdateRoleManagedRolesResponseV1_Error
+	Data isUpdateRoleManagedRolesResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *GetSecretResponseV1) Reset() {
-	*x = GetSecretResponseV1{}
+func (x *UpdateRoleManagedRolesResponseV1) Reset() {
+	*x = UpdateRoleManagedRolesResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2045,13 +2327,13 @@ func (x *GetSecretResponseV1) Reset() {
 	}
 }
 
-func (x *GetSecretResponseV1) String() string {
+func (x *UpdateRoleManagedRolesResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSecretResponseV1) ProtoMessage() {}
+func (*UpdateRoleManagedRolesResponseV1) ProtoMessage() {}
 
-func (x *GetSecretResponseV1) ProtoReflect() protoreflect.Message {
+func (x *UpdateRoleManagedRolesResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2063,28 +2345,75 @@ func (x *GetSecretResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSecretResponseV1.ProtoReflect.Descriptor instead.
-func (*GetSecretResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateRoleManagedRolesResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateRoleManagedRolesResponseV1) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *GetSecretResponseV1) GetData() *Secret {
-	if x != nil {
-		return x.Data
+func (m *UpdateRoleManagedRolesResponseV1) GetData() isUpdateRoleManagedRolesResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-type GetUserViewResponseV1 struct {
+func (x *UpdateRoleManagedRolesResponseV1) GetOk() *Role {
+	if x, ok := x.GetData().(*UpdateRoleManagedRolesResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *UpdateRoleManagedRolesResponseV1) GetValidationError() *UpdateRoleManagedRolesResponseV1_ValidationError {
+	if x, ok := x.GetData().(*UpdateRoleManagedRolesResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *UpdateRoleManagedRolesResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*UpdateRoleManagedRolesResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUpdateRoleManagedRolesResponseV1_Data interface {
+	isUpdateRoleManagedRolesResponseV1_Data()
+}
+
+type UpdateRoleManagedRolesResponseV1_Ok struct {
+	Ok *Role `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type UpdateRoleManagedRolesResponseV1_ValidationError_ struct {
+	ValidationError *UpdateRoleManagedRolesResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type UpdateRoleManagedRolesResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*UpdateRoleManagedRolesResponseV1_Ok) isUpdateRoleManagedRolesResponseV1_Data() {}
+
+func (*UpdateRoleManagedRolesResponseV1_ValidationError_) isUpdateRoleManagedRolesResponseV1_Data() {}
+
+func (*UpdateRoleManagedRolesResponseV1_Error) isUpdateRoleManagedRolesResponseV1_Data() {}
+
+type UpdateRoleResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Data *UserView `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// Types that are assignable to Data:
+	//	*UpdateRoleResponseV1_Ok
+	//	*UpdateRoleResponseV1_ValidationError_
+	//	*UpdateRoleResponseV1_Error
+	Data isUpdateRoleResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *GetUserViewResponseV1) Reset() {
-	*x = GetUserViewResponseV1{}
+func (x *UpdateRoleResponseV1) Reset() {
+	*x = UpdateRoleResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2092,13 +2421,13 @@ func (x *GetUserViewResponseV1) Reset() {
 	}
 }
 
-func (x *GetUserViewResponseV1) String() string {
+func (x *UpdateRoleResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserViewResponseV1) ProtoMessage() {}
+func (*UpdateRoleResponseV1) ProtoMessage() {}
 
-func (x *GetUserViewResponseV1) ProtoReflect() protoreflect.Message {
+func (x *UpdateRoleResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2110,29 +2439,73 @@ func (x *GetUserViewResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserViewResponseV1.ProtoReflect.Descriptor instead.
-func (*GetUserViewResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateRoleResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponseV1) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetUserViewResponseV1) GetData() *UserView {
-	if x != nil {
-		return x.Data
-	}
+func (m *UpdateRoleResponseV1) GetData() isUpdateRoleResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
 	return nil
 }
 
-type ListUserViewResponseV1 struct {
+func (x *UpdateRoleResponseV1) GetOk() *Role {
+	if x, ok := x.GetData().(*UpdateRoleResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *UpdateRoleResponseV1) GetValidationError() *UpdateRoleResponseV1_ValidationError {
+	if x, ok := x.GetData().(*UpdateRoleResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *UpdateRoleResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*UpdateRoleResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isUpdateRoleResponseV1_Data interface {
+	isUpdateRoleResponseV1_Data()
+}
+
+type UpdateRoleResponseV1_Ok struct {
+	Ok *Role `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type UpdateRoleResponseV1_ValidationError_ struct {
+	ValidationError *UpdateRoleResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type UpdateRoleResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*UpdateRoleResponseV1_Ok) isUpdateRoleResponseV1_Data() {}
+
+func (*UpdateRoleResponseV1_ValidationError_) isUpdateRoleResponseV1_Data() {}
+
+func (*UpdateRoleResponseV1_Error) isUpdateRoleResponseV1_Data() {}
+
+type DeleteRoleResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	Data       []*UserView `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	// Types that are assignable to Data:
+	//	*DeleteRoleResponseV1_ValidationError_
+	Data isDeleteRoleResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *ListUserViewResponseV1) Reset() {
-	*x = ListUserViewResponseV1{}
+func (x *DeleteRoleResponseV1) Reset() {
+	*x = DeleteRoleResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2140,13 +2513,13 @@ func (x *ListUserViewResponseV1) Reset() {
 	}
 }
 
-func (x *ListUserViewResponseV1) String() string {
+func (x *DeleteRoleResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserViewResponseV1) ProtoMessage() {}
+func (*DeleteRoleResponseV1) ProtoMessage() {}
 
-func (x *ListUserViewResponseV1) ProtoReflect() protoreflect.Message {
+func (x *DeleteRoleResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2158,35 +2531,46 @@ func (x *ListUserViewResponseV1) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserViewResponseV1.ProtoReflect.Descriptor instead.
-func (*ListUserViewResponseV1) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteRoleResponseV1.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponseV1) Descriptor() ([]byte, []int) {
 	return file_api_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *ListUserViewResponseV1) GetPagination() *Pagination {
-	if x != nil {
-		return x.Pagination
+func (m *DeleteRoleResponseV1) GetData() isDeleteRoleResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func (x *ListUserViewResponseV1) GetData() []*UserView {
-	if x != nil {
-		return x.Data
+func (x *DeleteRoleResponseV1) GetValidationError() *DeleteRoleResponseV1_ValidationError {
+	if x, ok := x.GetData().(*DeleteRoleResponseV1_ValidationError_); ok {
+		return x.ValidationError
 	}
 	return nil
 }
 
-type CreateRoleResponseV1_Request struct {
+type isDeleteRoleResponseV1_Data interface {
+	isDeleteRoleResponseV1_Data()
+}
+
+type DeleteRoleResponseV1_ValidationError_ struct {
+	ValidationError *DeleteRoleResponseV1_ValidationError `protobuf:"bytes,1,opt,name=validationError,proto3,oneof"`
+}
+
+func (*DeleteRoleResponseV1_ValidationError_) isDeleteRoleResponseV1_Data() {}
+
+type ListRoleResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*Role     `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreateRoleResponseV1_Request) Reset() {
-	*x = CreateRoleResponseV1_Request{}
+func (x *ListRoleResponseV1) Reset() {
+	*x = ListRoleResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2194,13 +2578,13 @@ func (x *CreateRoleResponseV1_Request) Reset() {
 	}
 }
 
-func (x *CreateRoleResponseV1_Request) String() string {
+func (x *ListRoleResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleResponseV1_Request) ProtoMessage() {}
+func (*ListRoleResponseV1) ProtoMessage() {}
 
-func (x *CreateRoleResponseV1_Request) ProtoReflect() protoreflect.Message {
+func (x *ListRoleResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2212,28 +2596,39 @@ func (x *CreateRoleResponseV1_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreateRoleResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{14, 0}
+// Deprecated: Use ListRoleResponseV1.ProtoReflect.Descriptor instead.
+func (*ListRoleResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CreateRoleResponseV1_Request) GetTitle() string {
+func (x *ListRoleResponseV1) GetPagination() *Pagination {
 	if x != nil {
-		return x.Title
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-type CreateRoleResponseV1_ValidationError struct {
+func (x *ListRoleResponseV1) GetData() []*Role {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateUserRoleResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title []string `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty"`
+	// Types that are assignable to Data:
+	//	*CreateUserRoleResponseV1_Ok
+	//	*CreateUserRoleResponseV1_ValidationError_
+	//	*CreateUserRoleResponseV1_Error
+	Data isCreateUserRoleResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *CreateRoleResponseV1_ValidationError) Reset() {
-	*x = CreateRoleResponseV1_ValidationError{}
+func (x *CreateUserRoleResponseV1) Reset() {
+	*x = CreateUserRoleResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2241,13 +2636,13 @@ func (x *CreateRoleResponseV1_ValidationError) Reset() {
 	}
 }
 
-func (x *CreateRoleResponseV1_ValidationError) String() string {
+func (x *CreateUserRoleResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoleResponseV1_ValidationError) ProtoMessage() {}
+func (*CreateUserRoleResponseV1) ProtoMessage() {}
 
-func (x *CreateRoleResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+func (x *CreateUserRoleResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2259,29 +2654,71 @@ func (x *CreateRoleResponseV1_ValidationError) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoleResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreateRoleResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{14, 1}
+// Deprecated: Use CreateUserRoleResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateUserRoleResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *CreateRoleResponseV1_ValidationError) GetTitle() []string {
-	if x != nil {
-		return x.Title
+func (m *CreateUserRoleResponseV1) GetData() isCreateUserRoleResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-type CreateUserRoleResponseV1_Request struct {
+func (x *CreateUserRoleResponseV1) GetOk() *UserRole {
+	if x, ok := x.GetData().(*CreateUserRoleResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1) GetValidationError() *CreateUserRoleResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateUserRoleResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreateUserRoleResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateUserRoleResponseV1_Data interface {
+	isCreateUserRoleResponseV1_Data()
+}
+
+type CreateUserRoleResponseV1_Ok struct {
+	Ok *UserRole `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateUserRoleResponseV1_ValidationError_ struct {
+	ValidationError *CreateUserRoleResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreateUserRoleResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateUserRoleResponseV1_Ok) isCreateUserRoleResponseV1_Data() {}
+
+func (*CreateUserRoleResponseV1_ValidationError_) isCreateUserRoleResponseV1_Data() {}
+
+func (*CreateUserRoleResponseV1_Error) isCreateUserRoleResponseV1_Data() {}
+
+type GetUserRoleResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID []byte `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	RoleID []byte `protobuf:"bytes,2,opt,name=roleID,proto3" json:"roleID,omitempty"`
+	Data *UserRole `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreateUserRoleResponseV1_Request) Reset() {
-	*x = CreateUserRoleResponseV1_Request{}
+func (x *GetUserRoleResponseV1) Reset() {
+	*x = GetUserRoleResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2289,13 +2726,13 @@ func (x *CreateUserRoleResponseV1_Request) Reset() {
 	}
 }
 
-func (x *CreateUserRoleResponseV1_Request) String() string {
+func (x *GetUserRoleResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRoleResponseV1_Request) ProtoMessage() {}
+func (*GetUserRoleResponseV1) ProtoMessage() {}
 
-func (x *CreateUserRoleResponseV1_Request) ProtoReflect() protoreflect.Message {
+func (x *GetUserRoleResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2307,37 +2744,29 @@ func (x *CreateUserRoleResponseV1_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRoleResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreateUserRoleResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{16, 0}
-}
-
-func (x *CreateUserRoleResponseV1_Request) GetUserID() []byte {
-	if x != nil {
-		return x.UserID
-	}
-	return nil
+// Deprecated: Use GetUserRoleResponseV1.ProtoReflect.Descriptor instead.
+func (*GetUserRoleResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *CreateUserRoleResponseV1_Request) GetRoleID() []byte {
+func (x *GetUserRoleResponseV1) GetData() *UserRole {
 	if x != nil {
-		return x.RoleID
+		return x.Data
 	}
 	return nil
 }
 
-type CreateUserRoleResponseV1_ValidationError struct {
+type ListUserRolesResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID []string `protobuf:"bytes,1,rep,name=userID,proto3" json:"userID,omitempty"`
-	RoleID []string `protobuf:"bytes,2,rep,name=roleID,proto3" json:"roleID,omitempty"`
-	Errors []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*UserRole `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreateUserRoleResponseV1_ValidationError) Reset() {
-	*x = CreateUserRoleResponseV1_ValidationError{}
+func (x *ListUserRolesResponseV1) Reset() {
+	*x = ListUserRolesResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2345,13 +2774,13 @@ func (x *CreateUserRoleResponseV1_ValidationError) Reset() {
 	}
 }
 
-func (x *CreateUserRoleResponseV1_ValidationError) String() string {
+func (x *ListUserRolesResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRoleResponseV1_ValidationError) ProtoMessage() {}
+func (*ListUserRolesResponseV1) ProtoMessage() {}
 
-func (x *CreateUserRoleResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+func (x *ListUserRolesResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2363,44 +2792,38 @@ func (x *CreateUserRoleResponseV1_ValidationError) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRoleResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreateUserRoleResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{16, 1}
-}
-
-func (x *CreateUserRoleResponseV1_ValidationError) GetUserID() []string {
-	if x != nil {
-		return x.UserID
-	}
-	return nil
+// Deprecated: Use ListUserRolesResponseV1.ProtoReflect.Descriptor instead.
+func (*ListUserRolesResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *CreateUserRoleResponseV1_ValidationError) GetRoleID() []string {
+func (x *ListUserRolesResponseV1) GetPagination() *Pagination {
 	if x != nil {
-		return x.RoleID
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *CreateUserRoleResponseV1_ValidationError) GetErrors() []string {
+func (x *ListUserRolesResponseV1) GetData() []*UserRole {
 	if x != nil {
-		return x.Errors
+		return x.Data
 	}
 	return nil
 }
 
-type CreateEmailResponseV1_Request struct {
+type CreateRoleRequestResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email  string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
-	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	UserID []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	// Types that are assignable to Data:
+	//	*CreateRoleRequestResponseV1_Ok
+	//	*CreateRoleRequestResponseV1_ValidationError_
+	Data isCreateRoleRequestResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *CreateEmailResponseV1_Request) Reset() {
-	*x = CreateEmailResponseV1_Request{}
+func (x *CreateRoleRequestResponseV1) Reset() {
+	*x = CreateRoleRequestResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2408,13 +2831,13 @@ func (x *CreateEmailResponseV1_Request) Reset() {
 	}
 }
 
-func (x *CreateEmailResponseV1_Request) String() string {
+func (x *CreateRoleRequestResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmailResponseV1_Request) ProtoMessage() {}
+func (*CreateRoleRequestResponseV1) ProtoMessage() {}
 
-func (x *CreateEmailResponseV1_Request) ProtoReflect() protoreflect.Message {
+func (x *CreateRoleRequestResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2426,44 +2849,61 @@ func (x *CreateEmailResponseV1_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmailResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreateEmailResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{19, 0}
+// Deprecated: Use CreateRoleRequestResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequestResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateEmailResponseV1_Request) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (m *CreateRoleRequestResponseV1) GetData() isCreateRoleRequestResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateEmailResponseV1_Request) GetCode() string {
-	if x != nil {
-		return x.Code
+func (x *CreateRoleRequestResponseV1) GetOk() *RoleRequest {
+	if x, ok := x.GetData().(*CreateRoleRequestResponseV1_Ok); ok {
+		return x.Ok
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateEmailResponseV1_Request) GetUserID() []byte {
-	if x != nil {
-		return x.UserID
+func (x *CreateRoleRequestResponseV1) GetValidationError() *CreateRoleRequestResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateRoleRequestResponseV1_ValidationError_); ok {
+		return x.ValidationError
 	}
 	return nil
 }
 
-type CreateEmailResponseV1_ValidationError struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type isCreateRoleRequestResponseV1_Data interface {
+	isCreateRoleRequestResponseV1_Data()
+}
 
-	Email  []string `protobuf:"bytes,1,rep,name=email,proto3" json:"email,omitempty"`
-	Code   []string `protobuf:"bytes,2,rep,name=code,proto3" json:"code,omitempty"`
-	UserId []string `protobuf:"bytes,3,rep,name=userId,proto3" json:"userId,omitempty"`
+type CreateRoleRequestResponseV1_Ok struct {
+	Ok *RoleRequest `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
 }
 
-func (x *CreateEmailResponseV1_ValidationError) Reset() {
-	*x = CreateEmailResponseV1_ValidationError{}
+type CreateRoleRequestResponseV1_ValidationError_ struct {
+	ValidationError *CreateRoleRequestResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*CreateRoleRequestResponseV1_Ok) isCreateRoleRequestResponseV1_Data() {}
+
+func (*CreateRoleRequestResponseV1_ValidationError_) isCreateRoleRequestResponseV1_Data() {}
+
+type ReviewRoleRequestResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*ReviewRoleRequestResponseV1_Ok
+	//	*ReviewRoleRequestResponseV1_ValidationError_
+	Data isReviewRoleRequestResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *ReviewRoleRequestResponseV1) Reset() {
+	*x = ReviewRoleRequestResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2471,13 +2911,13 @@ func (x *CreateEmailResponseV1_ValidationError) Reset() {
 	}
 }
 
-func (x *CreateEmailResponseV1_ValidationError) String() string {
+func (x *ReviewRoleRequestResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmailResponseV1_ValidationError) ProtoMessage() {}
+func (*ReviewRoleRequestResponseV1) ProtoMessage() {}
 
-func (x *CreateEmailResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+func (x *ReviewRoleRequestResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2489,42 +2929,61 @@ func (x *CreateEmailResponseV1_ValidationError) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmailResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreateEmailResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{19, 1}
+// Deprecated: Use ReviewRoleRequestResponseV1.ProtoReflect.Descriptor instead.
+func (*ReviewRoleRequestResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateEmailResponseV1_ValidationError) GetEmail() []string {
-	if x != nil {
-		return x.Email
+func (m *ReviewRoleRequestResponseV1) GetData() isReviewRoleRequestResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func (x *CreateEmailResponseV1_ValidationError) GetCode() []string {
-	if x != nil {
-		return x.Code
+func (x *ReviewRoleRequestResponseV1) GetOk() *RoleRequest {
+	if x, ok := x.GetData().(*ReviewRoleRequestResponseV1_Ok); ok {
+		return x.Ok
 	}
 	return nil
 }
 
-func (x *CreateEmailResponseV1_ValidationError) GetUserId() []string {
-	if x != nil {
-		return x.UserId
+func (x *ReviewRoleRequestResponseV1) GetValidationError() *ReviewRoleRequestResponseV1_ValidationError {
+	if x, ok := x.GetData().(*ReviewRoleRequestResponseV1_ValidationError_); ok {
+		return x.ValidationError
 	}
 	return nil
 }
 
-type CreateEmailConfirmationResponseV1_Request struct {
+type isReviewRoleRequestResponseV1_Data interface {
+	isReviewRoleRequestResponseV1_Data()
+}
+
+type ReviewRoleRequestResponseV1_Ok struct {
+	Ok *RoleRequest `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type ReviewRoleRequestResponseV1_ValidationError_ struct {
+	ValidationError *ReviewRoleRequestResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*ReviewRoleRequestResponseV1_Ok) isReviewRoleRequestResponseV1_Data() {}
+
+func (*ReviewRoleRequestResponseV1_ValidationError_) isReviewRoleRequestResponseV1_Data() {}
+
+type CancelRoleRequestResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	// Types that are assignable to Data:
+	//	*CancelRoleRequestResponseV1_Ok
+	//	*CancelRoleRequestResponseV1_ValidationError_
+	Data isCancelRoleRequestResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *CreateEmailConfirmationResponseV1_Request) Reset() {
-	*x = CreateEmailConfirmationResponseV1_Request{}
+func (x *CancelRoleRequestResponseV1) Reset() {
+	*x = CancelRoleRequestResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2532,13 +2991,13 @@ func (x *CreateEmailConfirmationResponseV1_Request) Reset() {
 	}
 }
 
-func (x *CreateEmailConfirmationResponseV1_Request) String() string {
+func (x *CancelRoleRequestResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmailConfirmationResponseV1_Request) ProtoMessage() {}
+func (*CancelRoleRequestResponseV1) ProtoMessage() {}
 
-func (x *CreateEmailConfirmationResponseV1_Request) ProtoReflect() protoreflect.Message {
+func (x *CancelRoleRequestResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2550,28 +3009,58 @@ func (x *CreateEmailConfirmationResponseV1_Request) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmailConfirmationResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreateEmailConfirmationResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{20, 0}
+// Deprecated: Use CancelRoleRequestResponseV1.ProtoReflect.Descriptor instead.
+func (*CancelRoleRequestResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *CreateEmailConfirmationResponseV1_Request) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (m *CancelRoleRequestResponseV1) GetData() isCancelRoleRequestResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-type CreateEmailConfirmationResponseV1_ValidationError struct {
+func (x *CancelRoleRequestResponseV1) GetOk() *RoleRequest {
+	if x, ok := x.GetData().(*CancelRoleRequestResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CancelRoleRequestResponseV1) GetValidationError() *CancelRoleRequestResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CancelRoleRequestResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isCancelRoleRequestResponseV1_Data interface {
+	isCancelRoleRequestResponseV1_Data()
+}
+
+type CancelRoleRequestResponseV1_Ok struct {
+	Ok *RoleRequest `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CancelRoleRequestResponseV1_ValidationError_ struct {
+	ValidationError *CancelRoleRequestResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*CancelRoleRequestResponseV1_Ok) isCancelRoleRequestResponseV1_Data() {}
+
+func (*CancelRoleRequestResponseV1_ValidationError_) isCancelRoleRequestResponseV1_Data() {}
+
+type GetRoleRequestResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email []string `protobuf:"bytes,1,rep,name=email,proto3" json:"email,omitempty"`
+	Data *RoleRequest `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreateEmailConfirmationResponseV1_ValidationError) Reset() {
-	*x = CreateEmailConfirmationResponseV1_ValidationError{}
+func (x *GetRoleRequestResponseV1) Reset() {
+	*x = GetRoleRequestResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2579,13 +3068,13 @@ func (x *CreateEmailConfirmationResponseV1_ValidationError) Reset() {
 	}
 }
 
-func (x *CreateEmailConfirmationResponseV1_ValidationError) String() string {
+func (x *GetRoleRequestResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateEmailConfirmationResponseV1_ValidationError) ProtoMessage() {}
+func (*GetRoleRequestResponseV1) ProtoMessage() {}
 
-func (x *CreateEmailConfirmationResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+func (x *GetRoleRequestResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2597,30 +3086,29 @@ func (x *CreateEmailConfirmationResponseV1_ValidationError) ProtoReflect() proto
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateEmailConfirmationResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreateEmailConfirmationResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{20, 1}
+// Deprecated: Use GetRoleRequestResponseV1.ProtoReflect.Descriptor instead.
+func (*GetRoleRequestResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *CreateEmailConfirmationResponseV1_ValidationError) GetEmail() []string {
+func (x *GetRoleRequestResponseV1) GetData() *RoleRequest {
 	if x != nil {
-		return x.Email
+		return x.Data
 	}
 	return nil
 }
 
-type CreatePhoneResponseV1_Request struct {
+type ListRoleRequestsResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone  string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
-	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
-	UserID []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Pagination *Pagination    `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*RoleRequest `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreatePhoneResponseV1_Request) Reset() {
-	*x = CreatePhoneResponseV1_Request{}
+func (x *ListRoleRequestsResponseV1) Reset() {
+	*x = ListRoleRequestsResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2628,13 +3116,13 @@ func (x *CreatePhoneResponseV1_Request) Reset() {
 	}
 }
 
-func (x *CreatePhoneResponseV1_Request) String() string {
+func (x *ListRoleRequestsResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePhoneResponseV1_Request) ProtoMessage() {}
+func (*ListRoleRequestsResponseV1) ProtoMessage() {}
 
-func (x *CreatePhoneResponseV1_Request) ProtoReflect() protoreflect.Message {
+func (x *ListRoleRequestsResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2646,44 +3134,35 @@ func (x *CreatePhoneResponseV1_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePhoneResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreatePhoneResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{21, 0}
-}
-
-func (x *CreatePhoneResponseV1_Request) GetPhone() string {
-	if x != nil {
-		return x.Phone
-	}
-	return ""
+// Deprecated: Use ListRoleRequestsResponseV1.ProtoReflect.Descriptor instead.
+func (*ListRoleRequestsResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *CreatePhoneResponseV1_Request) GetCode() string {
+func (x *ListRoleRequestsResponseV1) GetPagination() *Pagination {
 	if x != nil {
-		return x.Code
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *CreatePhoneResponseV1_Request) GetUserID() []byte {
+func (x *ListRoleRequestsResponseV1) GetData() []*RoleRequest {
 	if x != nil {
-		return x.UserID
+		return x.Data
 	}
 	return nil
 }
 
-type CreatePhoneResponseV1_ValidationError struct {
+type GetOrganizationResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone  []string `protobuf:"bytes,1,rep,name=phone,proto3" json:"phone,omitempty"`
-	Code   []string `protobuf:"bytes,2,rep,name=code,proto3" json:"code,omitempty"`
-	UserID []string `protobuf:"bytes,3,rep,name=userID,proto3" json:"userID,omitempty"`
+	Data *Organization `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreatePhoneResponseV1_ValidationError) Reset() {
-	*x = CreatePhoneResponseV1_ValidationError{}
+func (x *GetOrganizationResponseV1) Reset() {
+	*x = GetOrganizationResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2691,13 +3170,13 @@ func (x *CreatePhoneResponseV1_ValidationError) Reset() {
 	}
 }
 
-func (x *CreatePhoneResponseV1_ValidationError) String() string {
+func (x *GetOrganizationResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePhoneResponseV1_ValidationError) ProtoMessage() {}
+func (*GetOrganizationResponseV1) ProtoMessage() {}
 
-func (x *CreatePhoneResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+func (x *GetOrganizationResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2709,42 +3188,32 @@ func (x *CreatePhoneResponseV1_ValidationError) ProtoReflect() protoreflect.Mess
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePhoneResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreatePhoneResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{21, 1}
-}
-
-func (x *CreatePhoneResponseV1_ValidationError) GetPhone() []string {
-	if x != nil {
-		return x.Phone
-	}
-	return nil
-}
-
-func (x *CreatePhoneResponseV1_ValidationError) GetCode() []string {
-	if x != nil {
-		return x.Code
-	}
-	return nil
+// Deprecated: Use GetOrganizationResponseV1.ProtoReflect.Descriptor instead.
+func (*GetOrganizationResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CreatePhoneResponseV1_ValidationError) GetUserID() []string {
+func (x *GetOrganizationResponseV1) GetData() *Organization {
 	if x != nil {
-		return x.UserID
+		return x.Data
 	}
 	return nil
 }
 
-type CreatePhoneConfirmationResponseV1_Request struct {
+type CreateOrganizationResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	// Types that are assignable to Data:
+	//	*CreateOrganizationResponseV1_Ok
+	//	*CreateOrganizationResponseV1_ValidationError_
+	//	*CreateOrganizationResponseV1_Error
+	Data isCreateOrganizationResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *CreatePhoneConfirmationResponseV1_Request) Reset() {
-	*x = CreatePhoneConfirmationResponseV1_Request{}
+func (x *CreateOrganizationResponseV1) Reset() {
+	*x = CreateOrganizationResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2752,13 +3221,13 @@ func (x *CreatePhoneConfirmationResponseV1_Request) Reset() {
 	}
 }
 
-func (x *CreatePhoneConfirmationResponseV1_Request) String() string {
+func (x *CreateOrganizationResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePhoneConfirmationResponseV1_Request) ProtoMessage() {}
+func (*CreateOrganizationResponseV1) ProtoMessage() {}
 
-func (x *CreatePhoneConfirmationResponseV1_Request) ProtoReflect() protoreflect.Message {
+func (x *CreateOrganizationResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2770,28 +3239,72 @@ func (x *CreatePhoneConfirmationResponseV1_Request) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePhoneConfirmationResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreatePhoneConfirmationResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{22, 0}
+// Deprecated: Use CreateOrganizationResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CreatePhoneConfirmationResponseV1_Request) GetPhone() string {
-	if x != nil {
-		return x.Phone
+func (m *CreateOrganizationResponseV1) GetData() isCreateOrganizationResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-type CreatePhoneConfirmationResponseV1_ValidationError struct {
+func (x *CreateOrganizationResponseV1) GetOk() *Organization {
+	if x, ok := x.GetData().(*CreateOrganizationResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreateOrganizationResponseV1) GetValidationError() *CreateOrganizationResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateOrganizationResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreateOrganizationResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreateOrganizationResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateOrganizationResponseV1_Data interface {
+	isCreateOrganizationResponseV1_Data()
+}
+
+type CreateOrganizationResponseV1_Ok struct {
+	Ok *Organization `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateOrganizationResponseV1_ValidationError_ struct {
+	ValidationError *CreateOrganizationResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreateOrganizationResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateOrganizationResponseV1_Ok) isCreateOrganizationResponseV1_Data() {}
+
+func (*CreateOrganizationResponseV1_ValidationError_) isCreateOrganizationResponseV1_Data() {}
+
+func (*CreateOrganizationResponseV1_Error) isCreateOrganizationResponseV1_Data() {}
+
+type ListOrganizationResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Phone []string `protobuf:"bytes,1,rep,name=phone,proto3" json:"phone,omitempty"`
+	Pagination *Pagination     `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*Organization `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreatePhoneConfirmationResponseV1_ValidationError) Reset() {
-	*x = CreatePhoneConfirmationResponseV1_ValidationError{}
+func (x *ListOrganizationResponseV1) Reset() {
+	*x = ListOrganizationResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2799,13 +3312,13 @@ func (x *CreatePhoneConfirmationResponseV1_ValidationError) Reset() {
 	}
 }
 
-func (x *CreatePhoneConfirmationResponseV1_ValidationError) String() string {
+func (x *ListOrganizationResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePhoneConfirmationResponseV1_ValidationError) ProtoMessage() {}
+func (*ListOrganizationResponseV1) ProtoMessage() {}
 
-func (x *CreatePhoneConfirmationResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+func (x *ListOrganizationResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2817,29 +3330,39 @@ func (x *CreatePhoneConfirmationResponseV1_ValidationError) ProtoReflect() proto
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePhoneConfirmationResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreatePhoneConfirmationResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{22, 1}
+// Deprecated: Use ListOrganizationResponseV1.ProtoReflect.Descriptor instead.
+func (*ListOrganizationResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *CreatePhoneConfirmationResponseV1_ValidationError) GetPhone() []string {
+func (x *ListOrganizationResponseV1) GetPagination() *Pagination {
 	if x != nil {
-		return x.Phone
+		return x.Pagination
 	}
 	return nil
 }
 
-type CreatePasswordResponseV1_Request struct {
+func (x *ListOrganizationResponseV1) GetData() []*Organization {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateOrganizationMemberResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID []byte `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Types that are assignable to Data:
+	//	*CreateOrganizationMemberResponseV1_Ok
+	//	*CreateOrganizationMemberResponseV1_ValidationError_
+	//	*CreateOrganizationMemberResponseV1_Error
+	Data isCreateOrganizationMemberResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *CreatePasswordResponseV1_Request) Reset() {
-	*x = CreatePasswordResponseV1_Request{}
+func (x *CreateOrganizationMemberResponseV1) Reset() {
+	*x = CreateOrganizationMemberResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2847,13 +3370,13 @@ func (x *CreatePasswordResponseV1_Request) Reset() {
 	}
 }
 
-func (x *CreatePasswordResponseV1_Request) String() string {
+func (x *CreateOrganizationMemberResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePasswordResponseV1_Request) ProtoMessage() {}
+func (*CreateOrganizationMemberResponseV1) ProtoMessage() {}
 
-func (x *CreatePasswordResponseV1_Request) ProtoReflect() protoreflect.Message {
+func (x *CreateOrganizationMemberResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2865,36 +3388,73 @@ func (x *CreatePasswordResponseV1_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePasswordResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreatePasswordResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{23, 0}
+// Deprecated: Use CreateOrganizationMemberResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationMemberResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *CreatePasswordResponseV1_Request) GetUserID() []byte {
-	if x != nil {
-		return x.UserID
+func (m *CreateOrganizationMemberResponseV1) GetData() isCreateOrganizationMemberResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func (x *CreatePasswordResponseV1_Request) GetValue() string {
-	if x != nil {
-		return x.Value
+func (x *CreateOrganizationMemberResponseV1) GetOk() *OrganizationMember {
+	if x, ok := x.GetData().(*CreateOrganizationMemberResponseV1_Ok); ok {
+		return x.Ok
 	}
-	return ""
+	return nil
 }
 
-type CreatePasswordResponseV1_ValidationError struct {
+func (x *CreateOrganizationMemberResponseV1) GetValidationError() *CreateOrganizationMemberResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateOrganizationMemberResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreateOrganizationMemberResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreateOrganizationMemberResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateOrganizationMemberResponseV1_Data interface {
+	isCreateOrganizationMemberResponseV1_Data()
+}
+
+type CreateOrganizationMemberResponseV1_Ok struct {
+	Ok *OrganizationMember `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateOrganizationMemberResponseV1_ValidationError_ struct {
+	ValidationError *CreateOrganizationMemberResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreateOrganizationMemberResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateOrganizationMemberResponseV1_Ok) isCreateOrganizationMemberResponseV1_Data() {}
+
+func (*CreateOrganizationMemberResponseV1_ValidationError_) isCreateOrganizationMemberResponseV1_Data() {
+}
+
+func (*CreateOrganizationMemberResponseV1_Error) isCreateOrganizationMemberResponseV1_Data() {}
+
+type ListOrganizationMembersResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserID []string `protobuf:"bytes,1,rep,name=userID,proto3" json:"userID,omitempty"`
-	Value  []string `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+	Pagination *Pagination           `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*OrganizationMember `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreatePasswordResponseV1_ValidationError) Reset() {
-	*x = CreatePasswordResponseV1_ValidationError{}
+func (x *ListOrganizationMembersResponseV1) Reset() {
+	*x = ListOrganizationMembersResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2902,13 +3462,13 @@ func (x *CreatePasswordResponseV1_ValidationError) Reset() {
 	}
 }
 
-func (x *CreatePasswordResponseV1_ValidationError) String() string {
+func (x *ListOrganizationMembersResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreatePasswordResponseV1_ValidationError) ProtoMessage() {}
+func (*ListOrganizationMembersResponseV1) ProtoMessage() {}
 
-func (x *CreatePasswordResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+func (x *ListOrganizationMembersResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2920,39 +3480,35 @@ func (x *CreatePasswordResponseV1_ValidationError) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreatePasswordResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreatePasswordResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{23, 1}
+// Deprecated: Use ListOrganizationMembersResponseV1.ProtoReflect.Descriptor instead.
+func (*ListOrganizationMembersResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *CreatePasswordResponseV1_ValidationError) GetUserID() []string {
+func (x *ListOrganizationMembersResponseV1) GetPagination() *Pagination {
 	if x != nil {
-		return x.UserID
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *CreatePasswordResponseV1_ValidationError) GetValue() []string {
+func (x *ListOrganizationMembersResponseV1) GetData() []*OrganizationMember {
 	if x != nil {
-		return x.Value
+		return x.Data
 	}
 	return nil
 }
 
-type CreateUserResponseV1_Request struct {
+type GetGroupResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password  string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
-	Phone     string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
-	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
-	PhoneCode string `protobuf:"bytes,4,opt,name=phoneCode,proto3" json:"phoneCode,omitempty"`
-	EmailCode string `protobuf:"bytes,5,opt,name=emailCode,proto3" json:"emailCode,omitempty"`
+	Data *Group `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreateUserResponseV1_Request) Reset() {
-	*x = CreateUserResponseV1_Request{}
+func (x *GetGroupResponseV1) Reset() {
+	*x = GetGroupResponseV1{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2960,13 +3516,13 @@ func (x *CreateUserResponseV1_Request) Reset() {
 	}
 }
 
-func (x *CreateUserResponseV1_Request) String() string {
+func (x *GetGroupResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponseV1_Request) ProtoMessage() {}
+func (*GetGroupResponseV1) ProtoMessage() {}
 
-func (x *CreateUserResponseV1_Request) ProtoReflect() protoreflect.Message {
+func (x *GetGroupResponseV1) ProtoReflect() protoreflect.Message {
 	mi := &file_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2978,76 +3534,141 @@ func (x *CreateUserResponseV1_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreateUserResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{24, 0}
+// Deprecated: Use GetGroupResponseV1.ProtoReflect.Descriptor instead.
+func (*GetGroupResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *CreateUserResponseV1_Request) GetPassword() string {
+func (x *GetGroupResponseV1) GetData() *Group {
 	if x != nil {
-		return x.Password
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUserResponseV1_Request) GetPhone() string {
-	if x != nil {
-		return x.Phone
+type CreateGroupResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CreateGroupResponseV1_Ok
+	//	*CreateGroupResponseV1_ValidationError_
+	//	*CreateGroupResponseV1_Error
+	Data isCreateGroupResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *CreateGroupResponseV1) Reset() {
+	*x = CreateGroupResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *CreateUserResponseV1_Request) GetEmail() string {
-	if x != nil {
-		return x.Email
+func (x *CreateGroupResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponseV1) ProtoMessage() {}
+
+func (x *CreateGroupResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateUserResponseV1_Request) GetPhoneCode() string {
-	if x != nil {
-		return x.PhoneCode
+// Deprecated: Use CreateGroupResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{46}
+}
+
+func (m *CreateGroupResponseV1) GetData() isCreateGroupResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateUserResponseV1_Request) GetEmailCode() string {
-	if x != nil {
-		return x.EmailCode
+func (x *CreateGroupResponseV1) GetOk() *Group {
+	if x, ok := x.GetData().(*CreateGroupResponseV1_Ok); ok {
+		return x.Ok
 	}
-	return ""
+	return nil
 }
 
-type CreateUserResponseV1_ValidationError struct {
+func (x *CreateGroupResponseV1) GetValidationError() *CreateGroupResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateGroupResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreateGroupResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreateGroupResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateGroupResponseV1_Data interface {
+	isCreateGroupResponseV1_Data()
+}
+
+type CreateGroupResponseV1_Ok struct {
+	Ok *Group `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateGroupResponseV1_ValidationError_ struct {
+	ValidationError *CreateGroupResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreateGroupResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateGroupResponseV1_Ok) isCreateGroupResponseV1_Data() {}
+
+func (*CreateGroupResponseV1_ValidationError_) isCreateGroupResponseV1_Data() {}
+
+func (*CreateGroupResponseV1_Error) isCreateGroupResponseV1_Data() {}
+
+type UpdateGroupResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Password  []string `protobuf:"bytes,1,rep,name=password,proto3" json:"password,omitempty"`
-	Phone     []string `protobuf:"bytes,2,rep,name=phone,proto3" json:"phone,omitempty"`
-	Email     []string `protobuf:"bytes,3,rep,name=email,proto3" json:"email,omitempty"`
-	PhoneCode []string `protobuf:"bytes,4,rep,name=phoneCode,proto3" json:"phoneCode,omitempty"`
-	EmailCode []string `protobuf:"bytes,5,rep,name=emailCode,proto3" json:"emailCode,omitempty"`
-	Errors    []string `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+	// Types that are assignable to Data:
+	//	*UpdateGroupResponseV1_Ok
+	//	*UpdateGroupResponseV1_ValidationError_
+	//	*UpdateGroupResponseV1_Error
+	Data isUpdateGroupResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *CreateUserResponseV1_ValidationError) Reset() {
-	*x = CreateUserResponseV1_ValidationError{}
+func (x *UpdateGroupResponseV1) Reset() {
+	*x = UpdateGroupResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[46]
+		mi := &file_api_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateUserResponseV1_ValidationError) String() string {
+func (x *UpdateGroupResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponseV1_ValidationError) ProtoMessage() {}
+func (*UpdateGroupResponseV1) ProtoMessage() {}
 
-func (x *CreateUserResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[46]
+func (x *UpdateGroupResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3058,79 +3679,87 @@ func (x *CreateUserResponseV1_ValidationError) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreateUserResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{24, 1}
+// Deprecated: Use UpdateGroupResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreateUserResponseV1_ValidationError) GetPassword() []string {
-	if x != nil {
-		return x.Password
+func (m *UpdateGroupResponseV1) GetData() isUpdateGroupResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func (x *CreateUserResponseV1_ValidationError) GetPhone() []string {
-	if x != nil {
-		return x.Phone
+func (x *UpdateGroupResponseV1) GetOk() *Group {
+	if x, ok := x.GetData().(*UpdateGroupResponseV1_Ok); ok {
+		return x.Ok
 	}
 	return nil
 }
 
-func (x *CreateUserResponseV1_ValidationError) GetEmail() []string {
-	if x != nil {
-		return x.Email
+func (x *UpdateGroupResponseV1) GetValidationError() *UpdateGroupResponseV1_ValidationError {
+	if x, ok := x.GetData().(*UpdateGroupResponseV1_ValidationError_); ok {
+		return x.ValidationError
 	}
 	return nil
 }
 
-func (x *CreateUserResponseV1_ValidationError) GetPhoneCode() []string {
-	if x != nil {
-		return x.PhoneCode
+func (x *UpdateGroupResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*UpdateGroupResponseV1_Error); ok {
+		return x.Error
 	}
 	return nil
 }
 
-func (x *CreateUserResponseV1_ValidationError) GetEmailCode() []string {
-	if x != nil {
-		return x.EmailCode
-	}
-	return nil
+type isUpdateGroupResponseV1_Data interface {
+	isUpdateGroupResponseV1_Data()
 }
 
-func (x *CreateUserResponseV1_ValidationError) GetErrors() []string {
-	if x != nil {
-		return x.Errors
-	}
-	return nil
+type UpdateGroupResponseV1_Ok struct {
+	Ok *Group `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
 }
 
-type CreateSessionResponseV1_Request struct {
+type UpdateGroupResponseV1_ValidationError_ struct {
+	ValidationError *UpdateGroupResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type UpdateGroupResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*UpdateGroupResponseV1_Ok) isUpdateGroupResponseV1_Data() {}
+
+func (*UpdateGroupResponseV1_ValidationError_) isUpdateGroupResponseV1_Data() {}
+
+func (*UpdateGroupResponseV1_Error) isUpdateGroupResponseV1_Data() {}
+
+type ListGroupResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	UserAgent   string `protobuf:"bytes,2,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*Group    `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
 }
 
-func (x *CreateSessionResponseV1_Request) Reset() {
-	*x = CreateSessionResponseV1_Request{}
+func (x *ListGroupResponseV1) Reset() {
+	*x = ListGroupResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[47]
+		mi := &file_api_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSessionResponseV1_Request) String() string {
+func (x *ListGroupResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionResponseV1_Request) ProtoMessage() {}
+func (*ListGroupResponseV1) ProtoMessage() {}
 
-func (x *CreateSessionResponseV1_Request) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[47]
+func (x *ListGroupResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3141,57 +3770,54 @@ func (x *CreateSessionResponseV1_Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionResponseV1_Request.ProtoReflect.Descriptor instead.
-func (*CreateSessionResponseV1_Request) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{27, 0}
+// Deprecated: Use ListGroupResponseV1.ProtoReflect.Descriptor instead.
+func (*ListGroupResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *CreateSessionResponseV1_Request) GetFingerprint() string {
+func (x *ListGroupResponseV1) GetPagination() *Pagination {
 	if x != nil {
-		return x.Fingerprint
+		return x.Pagination
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateSessionResponseV1_Request) GetUserAgent() string {
+func (x *ListGroupResponseV1) GetData() []*Group {
 	if x != nil {
-		return x.UserAgent
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type CreateSessionResponseV1_ValidationError struct {
+type CreateGroupMemberResponseV1 struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Email            []string `protobuf:"bytes,1,rep,name=email,proto3" json:"email,omitempty"`
-	EmailCode        []string `protobuf:"bytes,2,rep,name=emailCode,proto3" json:"emailCode,omitempty"`
-	Phone            []string `protobuf:"bytes,3,rep,name=phone,proto3" json:"phone,omitempty"`
-	PhoneCode        []string `protobuf:"bytes,4,rep,name=phoneCode,proto3" json:"phoneCode,omitempty"`
-	PhoneCountryCode []string `protobuf:"bytes,5,rep,name=phoneCountryCode,proto3" json:"phoneCountryCode,omitempty"`
-	Password         []string `protobuf:"bytes,6,rep,name=password,proto3" json:"password,omitempty"`
-	Fingerprint      []string `protobuf:"bytes,7,rep,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	UserAgent        []string `protobuf:"bytes,8,rep,name=userAgent,proto3" json:"userAgent,omitempty"`
+	// Types that are assignable to Data:
+	//	*CreateGroupMemberResponseV1_Ok
+	//	*CreateGroupMemberResponseV1_ValidationError_
+	//	*CreateGroupMemberResponseV1_Error
+	Data isCreateGroupMemberResponseV1_Data `protobuf_oneof:"data"`
 }
 
-func (x *CreateSessionResponseV1_ValidationError) Reset() {
-	*x = CreateSessionResponseV1_ValidationError{}
+func (x *CreateGroupMemberResponseV1) Reset() {
+	*x = CreateGroupMemberResponseV1{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[48]
+		mi := &file_api_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateSessionResponseV1_ValidationError) String() string {
+func (x *CreateGroupMemberResponseV1) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateSessionResponseV1_ValidationError) ProtoMessage() {}
+func (*CreateGroupMemberResponseV1) ProtoMessage() {}
 
-func (x *CreateSessionResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[48]
+func (x *CreateGroupMemberResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3202,516 +3828,8238 @@ func (x *CreateSessionResponseV1_ValidationError) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateSessionResponseV1_ValidationError.ProtoReflect.Descriptor instead.
-func (*CreateSessionResponseV1_ValidationError) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{27, 1}
+// Deprecated: Use CreateGroupMemberResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateGroupMemberResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CreateSessionResponseV1_ValidationError) GetEmail() []string {
-	if x != nil {
-		return x.Email
+func (m *CreateGroupMemberResponseV1) GetData() isCreateGroupMemberResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
 	return nil
 }
 
-func (x *CreateSessionResponseV1_ValidationError) GetEmailCode() []string {
-	if x != nil {
-		return x.EmailCode
+func (x *CreateGroupMemberResponseV1) GetOk() *GroupMember {
+	if x, ok := x.GetData().(*CreateGroupMemberResponseV1_Ok); ok {
+		return x.Ok
 	}
 	return nil
 }
 
-func (x *CreateSessionResponseV1_ValidationError) GetPhone() []string {
-	if x != nil {
-		return x.Phone
+func (x *CreateGroupMemberResponseV1) GetValidationError() *CreateGroupMemberResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateGroupMemberResponseV1_ValidationError_); ok {
+		return x.ValidationError
 	}
 	return nil
 }
 
-func (x *CreateSessionResponseV1_ValidationError) GetPhoneCode() []string {
-	if x != nil {
-		return x.PhoneCode
+func (x *CreateGroupMemberResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreateGroupMemberResponseV1_Error); ok {
+		return x.Error
 	}
 	return nil
 }
 
-func (x *CreateSessionResponseV1_ValidationError) GetPhoneCountryCode() []string {
-	if x != nil {
-		return x.PhoneCountryCode
+type isCreateGroupMemberResponseV1_Data interface {
+	isCreateGroupMemberResponseV1_Data()
+}
+
+type CreateGroupMemberResponseV1_Ok struct {
+	Ok *GroupMember `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateGroupMemberResponseV1_ValidationError_ struct {
+	ValidationError *CreateGroupMemberResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreateGroupMemberResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateGroupMemberResponseV1_Ok) isCreateGroupMemberResponseV1_Data() {}
+
+func (*CreateGroupMemberResponseV1_ValidationError_) isCreateGroupMemberResponseV1_Data() {}
+
+func (*CreateGroupMemberResponseV1_Error) isCreateGroupMemberResponseV1_Data() {}
+
+type ListGroupMembersResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination    `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*GroupMember `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListGroupMembersResponseV1) Reset() {
+	*x = ListGroupMembersResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *CreateSessionResponseV1_ValidationError) GetPassword() []string {
-	if x != nil {
-		return x.Password
+func (x *ListGroupMembersResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupMembersResponseV1) ProtoMessage() {}
+
+func (x *ListGroupMembersResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *CreateSessionResponseV1_ValidationError) GetFingerprint() []string {
+// Deprecated: Use ListGroupMembersResponseV1.ProtoReflect.Descriptor instead.
+func (*ListGroupMembersResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListGroupMembersResponseV1) GetPagination() *Pagination {
 	if x != nil {
-		return x.Fingerprint
+		return x.Pagination
 	}
 	return nil
 }
 
-func (x *CreateSessionResponseV1_ValidationError) GetUserAgent() []string {
+func (x *ListGroupMembersResponseV1) GetData() []*GroupMember {
 	if x != nil {
-		return x.UserAgent
+		return x.Data
 	}
 	return nil
 }
 
-var File_api_proto protoreflect.FileDescriptor
+type CreateGroupRoleResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_api_proto_rawDesc = []byte{
-	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69, 0x6e, 0x6f,
-	0x75, 0x74, 0x22, 0x5e, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x22, 0x32, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x13, 0x0a, 0x0f, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74,
-	0x65, 0x64, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x7a, 0x65, 0x64, 0x10, 0x01, 0x22, 0x46, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x22, 0x64,
-	0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x6f,
-	0x6c, 0x65, 0x49, 0x44, 0x22, 0x83, 0x01, 0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64, 0x22, 0x5f, 0x0a, 0x05, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x5b, 0x0a, 0x11, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78,
-	0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x8b, 0x01, 0x0a, 0x05, 0x50, 0x68, 0x6f,
-	0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x50, 0x68, 0x6f, 0x6e, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x22, 0x4c, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x30,
-	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x22, 0x48, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x7a, 0x0a, 0x08, 0x55, 0x73,
-	0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75,
-	0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x86, 0x02, 0x0a,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1d, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00,
-	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x57, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
-	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69,
-	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x1a, 0x1f, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x1a, 0x27, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x06, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69,
-	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xde, 0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x21, 0x0a, 0x02,
-	0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12,
-	0x5b, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05,
-	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e,
-	0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x1a, 0x39, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x1a, 0x59, 0x0a,
-	0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65,
-	0x49, 0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
-	0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
-	0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x71,
-	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f,
-	0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xe1, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1e, 0x0a, 0x02, 0x6f,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x76,
-	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x4b, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x53, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x06, 0x0a,
-	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x87, 0x02, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x2a, 0x0a, 0x02, 0x6f,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x64, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x38, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x1f, 0x0a,
-	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x27,
-	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
-	0xe1, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1e, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x68,
-	0x6f, 0x6e, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x4b, 0x0a, 0x07, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16,
-	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x53, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63,
-	0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x06, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x87, 0x02, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68,
-	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x2a, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x68,
-	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
-	0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x64, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38,
-	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f,
-	0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x1f, 0x0a, 0x07, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x27, 0x0a, 0x0f,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x02,
-	0x0a, 0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x21, 0x0a, 0x02, 0x6f, 0x6b,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5b, 0x0a,
-	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72,
-	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75,
-	0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x1a, 0x37, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x1a, 0x3f, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0xd6, 0x03, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1d, 0x0a, 0x02, 0x6f,
-	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x57, 0x0a, 0x0f, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-	0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
-	0x72, 0x6f, 0x72, 0x1a, 0x8d, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43,
-	0x6f, 0x64, 0x65, 0x1a, 0xad, 0x01, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77,
-	0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
-	0x69, 0x6c, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a,
-	0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
-	0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
-	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x35, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
-	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xee, 0x03, 0x0a, 0x17, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5a, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x2e, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
-	0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
-	0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x1a, 0x81, 0x02,
-	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
-	0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x68, 0x6f,
-	0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72,
-	0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x38, 0x0a, 0x13, 0x47, 0x65, 0x74,
-	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
-	0x12, 0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69,
-	0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x23, 0x0a, 0x04,
-	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f,
-	0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0x70, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65,
-	0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x0a, 0x70,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23,
-	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69,
-	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	// Types that are assignable to Data:
+	//	*CreateGroupRoleResponseV1_Ok
+	//	*CreateGroupRoleResponseV1_ValidationError_
+	//	*CreateGroupRoleResponseV1_Error
+	Data isCreateGroupRoleResponseV1_Data `protobuf_oneof:"data"`
 }
 
-var (
-	file_api_proto_rawDescOnce sync.Once
-	file_api_proto_rawDescData = file_api_proto_rawDesc
-)
+func (x *CreateGroupRoleResponseV1) Reset() {
+	*x = CreateGroupRoleResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_api_proto_rawDescGZIP() []byte {
-	file_api_proto_rawDescOnce.Do(func() {
-		file_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_proto_rawDescData)
-	})
-	return file_api_proto_rawDescData
+func (x *CreateGroupRoleResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 49)
-var file_api_proto_goTypes = []interface{}{
-	(Error_ErrorType)(0),                                      // 0: inout.Error.ErrorType
-	(*Pagination)(nil),                                        // 1: inout.Pagination
-	(*Error)(nil),                                             // 2: inout.Error
-	(*Role)(nil),                                              // 3: inout.Role
-	(*UserRole)(nil),                                          // 4: inout.UserRole
-	(*Session)(nil),                                           // 5: inout.Session
-	(*Email)(nil),                                             // 6: inout.Email
-	(*EmailConfirmation)(nil),                                 // 7: inout.EmailConfirmation
-	(*Phone)(nil),                                             // 8: inout.Phone
-	(*PhoneConfirmation)(nil),                                 // 9: inout.PhoneConfirmation
-	(*Password)(nil),                                          // 10: inout.Password
-	(*User)(nil),                                              // 11: inout.User
-	(*Secret)(nil),                                            // 12: inout.Secret
-	(*UserView)(nil),                                          // 13: inout.UserView
-	(*GetRoleResponseV1)(nil),                                 // 14: inout.GetRoleResponseV1
-	(*CreateRoleResponseV1)(nil),                              // 15: inout.CreateRoleResponseV1
-	(*ListRoleResponseV1)(nil),                                // 16: inout.ListRoleResponseV1
-	(*CreateUserRoleResponseV1)(nil),                          // 17: inout.CreateUserRoleResponseV1
-	(*GetUserRoleResponseV1)(nil),                             // 18: inout.GetUserRoleResponseV1
-	(*ListUserRolesResponseV1)(nil),                           // 19: inout.ListUserRolesResponseV1
-	(*CreateEmailResponseV1)(nil),                             // 20: inout.CreateEmailResponseV1
-	(*CreateEmailConfirmationResponseV1)(nil),                 // 21: inout.CreateEmailConfirmationResponseV1
-	(*CreatePhoneResponseV1)(nil),                             // 22: inout.CreatePhoneResponseV1
-	(*CreatePhoneConfirmationResponseV1)(nil),                 // 23: inout.CreatePhoneConfirmationResponseV1
-	(*CreatePasswordResponseV1)(nil),                          // 24: inout.CreatePasswordResponseV1
-	(*CreateUserResponseV1)(nil),                              // 25: inout.CreateUserResponseV1
-	(*GetUserResponseV1)(nil),                                 // 26: inout.GetUserResponseV1
-	(*ListUserResponseV1)(nil),                                // 27: inout.ListUserResponseV1
-	(*CreateSessionResponseV1)(nil),                           // 28: inout.CreateSessionResponseV1
-	(*GetSecretResponseV1)(nil),                               // 29: inout.GetSecretResponseV1
-	(*GetUserViewResponseV1)(nil),                             // 30: inout.GetUserViewResponseV1
-	(*ListUserViewResponseV1)(nil),                            // 31: inout.ListUserViewResponseV1
-	(*CreateRoleResponseV1_Request)(nil),                      // 32: inout.CreateRoleResponseV1.Request
-	(*CreateRoleResponseV1_ValidationError)(nil),              // 33: inout.CreateRoleResponseV1.ValidationError
-	(*CreateUserRoleResponseV1_Request)(nil),                  // 34: inout.CreateUserRoleResponseV1.Request
-	(*CreateUserRoleResponseV1_ValidationError)(nil),          // 35: inout.CreateUserRoleResponseV1.ValidationError
-	(*CreateEmailResponseV1_Request)(nil),                     // 36: inout.CreateEmailResponseV1.Request
-	(*CreateEmailResponseV1_ValidationError)(nil),             // 37: inout.CreateEmailResponseV1.ValidationError
-	(*CreateEmailConfirmationResponseV1_Request)(nil),         // 38: inout.CreateEmailConfirmationResponseV1.Request
-	(*CreateEmailConfirmationResponseV1_ValidationError)(nil), // 39: inout.CreateEmailConfirmationResponseV1.ValidationError
-	(*CreatePhoneResponseV1_Request)(nil),                     // 40: inout.CreatePhoneResponseV1.Request
-	(*CreatePhoneResponseV1_ValidationError)(nil),             // 41: inout.CreatePhoneResponseV1.ValidationError
-	(*CreatePhoneConfirmationResponseV1_Request)(nil),         // 42: inout.CreatePhoneConfirmationResponseV1.Request
-	(*CreatePhoneConfirmationResponseV1_ValidationError)(nil), // 43: inout.CreatePhoneConfirmationResponseV1.ValidationError
-	(*CreatePasswordResponseV1_Request)(nil),                  // 44: inout.CreatePasswordResponseV1.Request
-	(*CreatePasswordResponseV1_ValidationError)(nil),          // 45: inout.CreatePasswordResponseV1.ValidationError
-	(*CreateUserResponseV1_Request)(nil),                      // 46: inout.CreateUserResponseV1.Request
-	(*CreateUserResponseV1_ValidationError)(nil),              // 47: inout.CreateUserResponseV1.ValidationError
-	(*CreateSessionResponseV1_Request)(nil),                   // 48: inout.CreateSessionResponseV1.Request
-	(*CreateSessionResponseV1_ValidationError)(nil),           // 49: inout.CreateSessionResponseV1.ValidationError
+func (*CreateGroupRoleResponseV1) ProtoMessage() {}
+
+func (x *CreateGroupRoleResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_api_proto_depIdxs = []int32{
-	0,  // 0: inout.Error.type:type_name -> inout.Error.ErrorType
-	3,  // 1: inout.GetRoleResponseV1.data:type_name -> inout.Role
-	3,  // 2: inout.CreateRoleResponseV1.ok:type_name -> inout.Role
-	33, // 3: inout.CreateRoleResponseV1.validationError:type_name -> inout.CreateRoleResponseV1.ValidationError
-	2,  // 4: inout.CreateRoleResponseV1.error:type_name -> inout.Error
-	1,  // 5: inout.ListRoleResponseV1.pagination:type_name -> inout.Pagination
-	3,  // 6: inout.ListRoleResponseV1.data:type_name -> inout.Role
-	4,  // 7: inout.CreateUserRoleResponseV1.ok:type_name -> inout.UserRole
-	35, // 8: inout.CreateUserRoleResponseV1.validationError:type_name -> inout.CreateUserRoleResponseV1.ValidationError
-	2,  // 9: inout.CreateUserRoleResponseV1.error:type_name -> inout.Error
-	4,  // 10: inout.GetUserRoleResponseV1.data:type_name -> inout.UserRole
-	1,  // 11: inout.ListUserRolesResponseV1.pagination:type_name -> inout.Pagination
-	4,  // 12: inout.ListUserRolesResponseV1.data:type_name -> inout.UserRole
-	6,  // 13: inout.CreateEmailResponseV1.ok:type_name -> inout.Email
-	37, // 14: inout.CreateEmailResponseV1.validationError:type_name -> inout.CreateEmailResponseV1.ValidationError
-	2,  // 15: inout.CreateEmailResponseV1.error:type_name -> inout.Error
-	7,  // 16: inout.CreateEmailConfirmationResponseV1.ok:type_name -> inout.EmailConfirmation
-	39, // 17: inout.CreateEmailConfirmationResponseV1.validationError:type_name -> inout.CreateEmailConfirmationResponseV1.ValidationError
-	8,  // 18: inout.CreatePhoneResponseV1.ok:type_name -> inout.Phone
-	41, // 19: inout.CreatePhoneResponseV1.validationError:type_name -> inout.CreatePhoneResponseV1.ValidationError
-	2,  // 20: inout.CreatePhoneResponseV1.error:type_name -> inout.Error
-	9,  // 21: inout.CreatePhoneConfirmationResponseV1.ok:type_name -> inout.PhoneConfirmation
-	43, // 22: inout.CreatePhoneConfirmationResponseV1.validationError:type_name -> inout.CreatePhoneConfirmationResponseV1.ValidationError
-	10, // 23: inout.CreatePasswordResponseV1.ok:type_name -> inout.Password
-	45, // 24: inout.CreatePasswordResponseV1.validationError:type_name -> inout.CreatePasswordResponseV1.ValidationError
-	2,  // 25: inout.CreatePasswordResponseV1.error:type_name -> inout.Error
-	11, // 26: inout.CreateUserResponseV1.ok:type_name -> inout.User
-	47, // 27: inout.CreateUserResponseV1.validationError:type_name -> inout.CreateUserResponseV1.ValidationError
-	11, // 28: inout.GetUserResponseV1.data:type_name -> inout.User
-	11, // 29: inout.ListUserResponseV1.data:type_name -> inout.User
-	5,  // 30: inout.CreateSessionResponseV1.ok:type_name -> inout.Session
-	49, // 31: inout.CreateSessionResponseV1.validationError:type_name -> inout.CreateSessionResponseV1.ValidationError
-	12, // 32: inout.GetSecretResponseV1.data:type_name -> inout.Secret
-	13, // 33: inout.GetUserViewResponseV1.data:type_name -> inout.UserView
-	1,  // 34: inout.ListUserViewResponseV1.pagination:type_name -> inout.Pagination
-	13, // 35: inout.ListUserViewResponseV1.data:type_name -> inout.UserView
-	36, // [36:36] is the sub-list for method output_type
-	36, // [36:36] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+
+// Deprecated: Use CreateGroupRoleResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoleResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{51}
 }
 
-func init() { file_api_proto_init() }
-func file_api_proto_init() {
-	if File_api_proto != nil {
-		return
+func (m *CreateGroupRoleResponseV1) GetData() isCreateGroupRoleResponseV1_Data {
+	if m != nil {
+		return m.Data
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
+	return nil
+}
+
+func (x *CreateGroupRoleResponseV1) GetOk() *GroupRole {
+	if x, ok := x.GetData().(*CreateGroupRoleResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreateGroupRoleResponseV1) GetValidationError() *CreateGroupRoleResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateGroupRoleResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreateGroupRoleResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreateGroupRoleResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreateGroupRoleResponseV1_Data interface {
+	isCreateGroupRoleResponseV1_Data()
+}
+
+type CreateGroupRoleResponseV1_Ok struct {
+	Ok *GroupRole `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateGroupRoleResponseV1_ValidationError_ struct {
+	ValidationError *CreateGroupRoleResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreateGroupRoleResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreateGroupRoleResponseV1_Ok) isCreateGroupRoleResponseV1_Data() {}
+
+func (*CreateGroupRoleResponseV1_ValidationError_) isCreateGroupRoleResponseV1_Data() {}
+
+func (*CreateGroupRoleResponseV1_Error) isCreateGroupRoleResponseV1_Data() {}
+
+type ListGroupRolesResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination  `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*GroupRole `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListGroupRolesResponseV1) Reset() {
+	*x = ListGroupRolesResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListGroupRolesResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGroupRolesResponseV1) ProtoMessage() {}
+
+func (x *ListGroupRolesResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGroupRolesResponseV1.ProtoReflect.Descriptor instead.
+func (*ListGroupRolesResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListGroupRolesResponseV1) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListGroupRolesResponseV1) GetData() []*GroupRole {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateEmailResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CreateEmailResponseV1_Ok
+	//	*CreateEmailResponseV1_ValidationError_
+	//	*CreateEmailResponseV1_Error
+	//	*CreateEmailResponseV1_Transfer
+	Data isCreateEmailResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *CreateEmailResponseV1) Reset() {
+	*x = CreateEmailResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmailResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailResponseV1) ProtoMessage() {}
+
+func (x *CreateEmailResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateEmailResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{53}
+}
+
+func (m *CreateEmailResponseV1) GetData() isCreateEmailResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CreateEmailResponseV1) GetOk() *Email {
+	if x, ok := x.GetData().(*CreateEmailResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreateEmailResponseV1) GetValidationError() *CreateEmailResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateEmailResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreateEmailResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreateEmailResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *CreateEmailResponseV1) GetTransfer() *ContactTransfer {
+	if x, ok := x.GetData().(*CreateEmailResponseV1_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+type isCreateEmailResponseV1_Data interface {
+	isCreateEmailResponseV1_Data()
+}
+
+type CreateEmailResponseV1_Ok struct {
+	Ok *Email `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateEmailResponseV1_ValidationError_ struct {
+	ValidationError *CreateEmailResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreateEmailResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+type CreateEmailResponseV1_Transfer struct {
+	Transfer *ContactTransfer `protobuf:"bytes,4,opt,name=transfer,proto3,oneof"`
+}
+
+func (*CreateEmailResponseV1_Ok) isCreateEmailResponseV1_Data() {}
+
+func (*CreateEmailResponseV1_ValidationError_) isCreateEmailResponseV1_Data() {}
+
+func (*CreateEmailResponseV1_Error) isCreateEmailResponseV1_Data() {}
+
+func (*CreateEmailResponseV1_Transfer) isCreateEmailResponseV1_Data() {}
+
+type ListEmailResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Email `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListEmailResponseV1) Reset() {
+	*x = ListEmailResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListEmailResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEmailResponseV1) ProtoMessage() {}
+
+func (x *ListEmailResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEmailResponseV1.ProtoReflect.Descriptor instead.
+func (*ListEmailResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListEmailResponseV1) GetData() []*Email {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeleteEmailResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*DeleteEmailResponseV1_Ok
+	//	*DeleteEmailResponseV1_ValidationError_
+	Data isDeleteEmailResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *DeleteEmailResponseV1) Reset() {
+	*x = DeleteEmailResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEmailResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmailResponseV1) ProtoMessage() {}
+
+func (x *DeleteEmailResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmailResponseV1.ProtoReflect.Descriptor instead.
+func (*DeleteEmailResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{55}
+}
+
+func (m *DeleteEmailResponseV1) GetData() isDeleteEmailResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *DeleteEmailResponseV1) GetOk() *Email {
+	if x, ok := x.GetData().(*DeleteEmailResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *DeleteEmailResponseV1) GetValidationError() *DeleteEmailResponseV1_ValidationError {
+	if x, ok := x.GetData().(*DeleteEmailResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isDeleteEmailResponseV1_Data interface {
+	isDeleteEmailResponseV1_Data()
+}
+
+type DeleteEmailResponseV1_Ok struct {
+	Ok *Email `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type DeleteEmailResponseV1_ValidationError_ struct {
+	ValidationError *DeleteEmailResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*DeleteEmailResponseV1_Ok) isDeleteEmailResponseV1_Data() {}
+
+func (*DeleteEmailResponseV1_ValidationError_) isDeleteEmailResponseV1_Data() {}
+
+type SetPrimaryEmailResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *Email `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SetPrimaryEmailResponseV1) Reset() {
+	*x = SetPrimaryEmailResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPrimaryEmailResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryEmailResponseV1) ProtoMessage() {}
+
+func (x *SetPrimaryEmailResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryEmailResponseV1.ProtoReflect.Descriptor instead.
+func (*SetPrimaryEmailResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetPrimaryEmailResponseV1) GetData() *Email {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateEmailConfirmationResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CreateEmailConfirmationResponseV1_Ok
+	//	*CreateEmailConfirmationResponseV1_ValidationError_
+	Data isCreateEmailConfirmationResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *CreateEmailConfirmationResponseV1) Reset() {
+	*x = CreateEmailConfirmationResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmailConfirmationResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailConfirmationResponseV1) ProtoMessage() {}
+
+func (x *CreateEmailConfirmationResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailConfirmationResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateEmailConfirmationResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{57}
+}
+
+func (m *CreateEmailConfirmationResponseV1) GetData() isCreateEmailConfirmationResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CreateEmailConfirmationResponseV1) GetOk() *EmailConfirmation {
+	if x, ok := x.GetData().(*CreateEmailConfirmationResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreateEmailConfirmationResponseV1) GetValidationError() *CreateEmailConfirmationResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateEmailConfirmationResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isCreateEmailConfirmationResponseV1_Data interface {
+	isCreateEmailConfirmationResponseV1_Data()
+}
+
+type CreateEmailConfirmationResponseV1_Ok struct {
+	Ok *EmailConfirmation `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateEmailConfirmationResponseV1_ValidationError_ struct {
+	ValidationError *CreateEmailConfirmationResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*CreateEmailConfirmationResponseV1_Ok) isCreateEmailConfirmationResponseV1_Data() {}
+
+func (*CreateEmailConfirmationResponseV1_ValidationError_) isCreateEmailConfirmationResponseV1_Data() {
+}
+
+type CreatePhoneResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CreatePhoneResponseV1_Ok
+	//	*CreatePhoneResponseV1_ValidationError_
+	//	*CreatePhoneResponseV1_Error
+	//	*CreatePhoneResponseV1_Transfer
+	Data isCreatePhoneResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *CreatePhoneResponseV1) Reset() {
+	*x = CreatePhoneResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePhoneResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePhoneResponseV1) ProtoMessage() {}
+
+func (x *CreatePhoneResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePhoneResponseV1.ProtoReflect.Descriptor instead.
+func (*CreatePhoneResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{58}
+}
+
+func (m *CreatePhoneResponseV1) GetData() isCreatePhoneResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CreatePhoneResponseV1) GetOk() *Phone {
+	if x, ok := x.GetData().(*CreatePhoneResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreatePhoneResponseV1) GetValidationError() *CreatePhoneResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreatePhoneResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreatePhoneResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreatePhoneResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (x *CreatePhoneResponseV1) GetTransfer() *ContactTransfer {
+	if x, ok := x.GetData().(*CreatePhoneResponseV1_Transfer); ok {
+		return x.Transfer
+	}
+	return nil
+}
+
+type isCreatePhoneResponseV1_Data interface {
+	isCreatePhoneResponseV1_Data()
+}
+
+type CreatePhoneResponseV1_Ok struct {
+	Ok *Phone `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreatePhoneResponseV1_ValidationError_ struct {
+	ValidationError *CreatePhoneResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreatePhoneResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+type CreatePhoneResponseV1_Transfer struct {
+	Transfer *ContactTransfer `protobuf:"bytes,4,opt,name=transfer,proto3,oneof"`
+}
+
+func (*CreatePhoneResponseV1_Ok) isCreatePhoneResponseV1_Data() {}
+
+func (*CreatePhoneResponseV1_ValidationError_) isCreatePhoneResponseV1_Data() {}
+
+func (*CreatePhoneResponseV1_Error) isCreatePhoneResponseV1_Data() {}
+
+func (*CreatePhoneResponseV1_Transfer) isCreatePhoneResponseV1_Data() {}
+
+type ListPhoneResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*Phone `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListPhoneResponseV1) Reset() {
+	*x = ListPhoneResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPhoneResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPhoneResponseV1) ProtoMessage() {}
+
+func (x *ListPhoneResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPhoneResponseV1.ProtoReflect.Descriptor instead.
+func (*ListPhoneResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ListPhoneResponseV1) GetData() []*Phone {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type DeletePhoneResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*DeletePhoneResponseV1_Ok
+	//	*DeletePhoneResponseV1_ValidationError_
+	Data isDeletePhoneResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *DeletePhoneResponseV1) Reset() {
+	*x = DeletePhoneResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePhoneResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePhoneResponseV1) ProtoMessage() {}
+
+func (x *DeletePhoneResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePhoneResponseV1.ProtoReflect.Descriptor instead.
+func (*DeletePhoneResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{60}
+}
+
+func (m *DeletePhoneResponseV1) GetData() isDeletePhoneResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *DeletePhoneResponseV1) GetOk() *Phone {
+	if x, ok := x.GetData().(*DeletePhoneResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *DeletePhoneResponseV1) GetValidationError() *DeletePhoneResponseV1_ValidationError {
+	if x, ok := x.GetData().(*DeletePhoneResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isDeletePhoneResponseV1_Data interface {
+	isDeletePhoneResponseV1_Data()
+}
+
+type DeletePhoneResponseV1_Ok struct {
+	Ok *Phone `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type DeletePhoneResponseV1_ValidationError_ struct {
+	ValidationError *DeletePhoneResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*DeletePhoneResponseV1_Ok) isDeletePhoneResponseV1_Data() {}
+
+func (*DeletePhoneResponseV1_ValidationError_) isDeletePhoneResponseV1_Data() {}
+
+type SetPrimaryPhoneResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *Phone `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *SetPrimaryPhoneResponseV1) Reset() {
+	*x = SetPrimaryPhoneResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetPrimaryPhoneResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetPrimaryPhoneResponseV1) ProtoMessage() {}
+
+func (x *SetPrimaryPhoneResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetPrimaryPhoneResponseV1.ProtoReflect.Descriptor instead.
+func (*SetPrimaryPhoneResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetPrimaryPhoneResponseV1) GetData() *Phone {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreatePhoneConfirmationResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CreatePhoneConfirmationResponseV1_Ok
+	//	*CreatePhoneConfirmationResponseV1_ValidationError_
+	Data isCreatePhoneConfirmationResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *CreatePhoneConfirmationResponseV1) Reset() {
+	*x = CreatePhoneConfirmationResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePhoneConfirmationResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePhoneConfirmationResponseV1) ProtoMessage() {}
+
+func (x *CreatePhoneConfirmationResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePhoneConfirmationResponseV1.ProtoReflect.Descriptor instead.
+func (*CreatePhoneConfirmationResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{62}
+}
+
+func (m *CreatePhoneConfirmationResponseV1) GetData() isCreatePhoneConfirmationResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CreatePhoneConfirmationResponseV1) GetOk() *PhoneConfirmation {
+	if x, ok := x.GetData().(*CreatePhoneConfirmationResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreatePhoneConfirmationResponseV1) GetValidationError() *CreatePhoneConfirmationResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreatePhoneConfirmationResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isCreatePhoneConfirmationResponseV1_Data interface {
+	isCreatePhoneConfirmationResponseV1_Data()
+}
+
+type CreatePhoneConfirmationResponseV1_Ok struct {
+	Ok *PhoneConfirmation `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreatePhoneConfirmationResponseV1_ValidationError_ struct {
+	ValidationError *CreatePhoneConfirmationResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*CreatePhoneConfirmationResponseV1_Ok) isCreatePhoneConfirmationResponseV1_Data() {}
+
+func (*CreatePhoneConfirmationResponseV1_ValidationError_) isCreatePhoneConfirmationResponseV1_Data() {
+}
+
+type ListContactTransferResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*ContactTransfer `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListContactTransferResponseV1) Reset() {
+	*x = ListContactTransferResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListContactTransferResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListContactTransferResponseV1) ProtoMessage() {}
+
+func (x *ListContactTransferResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListContactTransferResponseV1.ProtoReflect.Descriptor instead.
+func (*ListContactTransferResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListContactTransferResponseV1) GetData() []*ContactTransfer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetContactTransferResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *ContactTransfer `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetContactTransferResponseV1) Reset() {
+	*x = GetContactTransferResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetContactTransferResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetContactTransferResponseV1) ProtoMessage() {}
+
+func (x *GetContactTransferResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetContactTransferResponseV1.ProtoReflect.Descriptor instead.
+func (*GetContactTransferResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetContactTransferResponseV1) GetData() *ContactTransfer {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ReviewContactTransferResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*ReviewContactTransferResponseV1_Ok
+	//	*ReviewContactTransferResponseV1_ValidationError_
+	Data isReviewContactTransferResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *ReviewContactTransferResponseV1) Reset() {
+	*x = ReviewContactTransferResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewContactTransferResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewContactTransferResponseV1) ProtoMessage() {}
+
+func (x *ReviewContactTransferResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewContactTransferResponseV1.ProtoReflect.Descriptor instead.
+func (*ReviewContactTransferResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{65}
+}
+
+func (m *ReviewContactTransferResponseV1) GetData() isReviewContactTransferResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *ReviewContactTransferResponseV1) GetOk() *ContactTransfer {
+	if x, ok := x.GetData().(*ReviewContactTransferResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *ReviewContactTransferResponseV1) GetValidationError() *ReviewContactTransferResponseV1_ValidationError {
+	if x, ok := x.GetData().(*ReviewContactTransferResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isReviewContactTransferResponseV1_Data interface {
+	isReviewContactTransferResponseV1_Data()
+}
+
+type ReviewContactTransferResponseV1_Ok struct {
+	Ok *ContactTransfer `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type ReviewContactTransferResponseV1_ValidationError_ struct {
+	ValidationError *ReviewContactTransferResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*ReviewContactTransferResponseV1_Ok) isReviewContactTransferResponseV1_Data() {}
+
+func (*ReviewContactTransferResponseV1_ValidationError_) isReviewContactTransferResponseV1_Data() {}
+
+type CreatePasswordResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CreatePasswordResponseV1_Ok
+	//	*CreatePasswordResponseV1_ValidationError_
+	//	*CreatePasswordResponseV1_Error
+	Data isCreatePasswordResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *CreatePasswordResponseV1) Reset() {
+	*x = CreatePasswordResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePasswordResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePasswordResponseV1) ProtoMessage() {}
+
+func (x *CreatePasswordResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePasswordResponseV1.ProtoReflect.Descriptor instead.
+func (*CreatePasswordResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{66}
+}
+
+func (m *CreatePasswordResponseV1) GetData() isCreatePasswordResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CreatePasswordResponseV1) GetOk() *Password {
+	if x, ok := x.GetData().(*CreatePasswordResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreatePasswordResponseV1) GetValidationError() *CreatePasswordResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreatePasswordResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+func (x *CreatePasswordResponseV1) GetError() *Error {
+	if x, ok := x.GetData().(*CreatePasswordResponseV1_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isCreatePasswordResponseV1_Data interface {
+	isCreatePasswordResponseV1_Data()
+}
+
+type CreatePasswordResponseV1_Ok struct {
+	Ok *Password `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreatePasswordResponseV1_ValidationError_ struct {
+	ValidationError *CreatePasswordResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+type CreatePasswordResponseV1_Error struct {
+	Error *Error `protobuf:"bytes,3,opt,name=error,proto3,oneof"`
+}
+
+func (*CreatePasswordResponseV1_Ok) isCreatePasswordResponseV1_Data() {}
+
+func (*CreatePasswordResponseV1_ValidationError_) isCreatePasswordResponseV1_Data() {}
+
+func (*CreatePasswordResponseV1_Error) isCreatePasswordResponseV1_Data() {}
+
+type CreateUserResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CreateUserResponseV1_Ok
+	//	*CreateUserResponseV1_ValidationError_
+	Data isCreateUserResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *CreateUserResponseV1) Reset() {
+	*x = CreateUserResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponseV1) ProtoMessage() {}
+
+func (x *CreateUserResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateUserResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{67}
+}
+
+func (m *CreateUserResponseV1) GetData() isCreateUserResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CreateUserResponseV1) GetOk() *User {
+	if x, ok := x.GetData().(*CreateUserResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreateUserResponseV1) GetValidationError() *CreateUserResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateUserResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isCreateUserResponseV1_Data interface {
+	isCreateUserResponseV1_Data()
+}
+
+type CreateUserResponseV1_Ok struct {
+	Ok *User `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateUserResponseV1_ValidationError_ struct {
+	ValidationError *CreateUserResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*CreateUserResponseV1_Ok) isCreateUserResponseV1_Data() {}
+
+func (*CreateUserResponseV1_ValidationError_) isCreateUserResponseV1_Data() {}
+
+type GetUserResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *User `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUserResponseV1) Reset() {
+	*x = GetUserResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponseV1) ProtoMessage() {}
+
+func (x *GetUserResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponseV1.ProtoReflect.Descriptor instead.
+func (*GetUserResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetUserResponseV1) GetData() *User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListUserResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*User `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListUserResponseV1) Reset() {
+	*x = ListUserResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserResponseV1) ProtoMessage() {}
+
+func (x *ListUserResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserResponseV1.ProtoReflect.Descriptor instead.
+func (*ListUserResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListUserResponseV1) GetData() []*User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateUserStatusResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UpdateUserStatusResponseV1_Ok
+	//	*UpdateUserStatusResponseV1_ValidationError_
+	Data isUpdateUserStatusResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *UpdateUserStatusResponseV1) Reset() {
+	*x = UpdateUserStatusResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserStatusResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserStatusResponseV1) ProtoMessage() {}
+
+func (x *UpdateUserStatusResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserStatusResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateUserStatusResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{70}
+}
+
+func (m *UpdateUserStatusResponseV1) GetData() isUpdateUserStatusResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UpdateUserStatusResponseV1) GetOk() *User {
+	if x, ok := x.GetData().(*UpdateUserStatusResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *UpdateUserStatusResponseV1) GetValidationError() *UpdateUserStatusResponseV1_ValidationError {
+	if x, ok := x.GetData().(*UpdateUserStatusResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isUpdateUserStatusResponseV1_Data interface {
+	isUpdateUserStatusResponseV1_Data()
+}
+
+type UpdateUserStatusResponseV1_Ok struct {
+	Ok *User `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type UpdateUserStatusResponseV1_ValidationError_ struct {
+	ValidationError *UpdateUserStatusResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*UpdateUserStatusResponseV1_Ok) isUpdateUserStatusResponseV1_Data() {}
+
+func (*UpdateUserStatusResponseV1_ValidationError_) isUpdateUserStatusResponseV1_Data() {}
+
+type RequestUserDeletionResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *User `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *RequestUserDeletionResponseV1) Reset() {
+	*x = RequestUserDeletionResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RequestUserDeletionResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestUserDeletionResponseV1) ProtoMessage() {}
+
+func (x *RequestUserDeletionResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestUserDeletionResponseV1.ProtoReflect.Descriptor instead.
+func (*RequestUserDeletionResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RequestUserDeletionResponseV1) GetData() *User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CancelUserDeletionResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *User `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CancelUserDeletionResponseV1) Reset() {
+	*x = CancelUserDeletionResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelUserDeletionResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelUserDeletionResponseV1) ProtoMessage() {}
+
+func (x *CancelUserDeletionResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelUserDeletionResponseV1.ProtoReflect.Descriptor instead.
+func (*CancelUserDeletionResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CancelUserDeletionResponseV1) GetData() *User {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserExportData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User             *User                `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Profile          *structpb.Struct     `protobuf:"bytes,2,opt,name=profile,proto3" json:"profile,omitempty"`
+	Emails           []*Email             `protobuf:"bytes,3,rep,name=emails,proto3" json:"emails,omitempty"`
+	Phones           []*Phone             `protobuf:"bytes,4,rep,name=phones,proto3" json:"phones,omitempty"`
+	PasswordsChanged []int64              `protobuf:"varint,5,rep,packed,name=passwordsChanged,proto3" json:"passwordsChanged,omitempty"`
+	Roles            []*UserRole          `protobuf:"bytes,6,rep,name=roles,proto3" json:"roles,omitempty"`
+	Sessions         []*UserExportSession `protobuf:"bytes,7,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	RoleRequests     []*RoleRequest       `protobuf:"bytes,8,rep,name=roleRequests,proto3" json:"roleRequests,omitempty"`
+	ContactTransfers []*ContactTransfer   `protobuf:"bytes,9,rep,name=contactTransfers,proto3" json:"contactTransfers,omitempty"`
+}
+
+func (x *UserExportData) Reset() {
+	*x = UserExportData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserExportData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportData) ProtoMessage() {}
+
+func (x *UserExportData) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportData.ProtoReflect.Descriptor instead.
+func (*UserExportData) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UserExportData) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserExportData) GetProfile() *structpb.Struct {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UserExportData) GetEmails() []*Email {
+	if x != nil {
+		return x.Emails
+	}
+	return nil
+}
+
+func (x *UserExportData) GetPhones() []*Phone {
+	if x != nil {
+		return x.Phones
+	}
+	return nil
+}
+
+func (x *UserExportData) GetPasswordsChanged() []int64 {
+	if x != nil {
+		return x.PasswordsChanged
+	}
+	return nil
+}
+
+func (x *UserExportData) GetRoles() []*UserRole {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UserExportData) GetSessions() []*UserExportSession {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+func (x *UserExportData) GetRoleRequests() []*RoleRequest {
+	if x != nil {
+		return x.RoleRequests
+	}
+	return nil
+}
+
+func (x *UserExportData) GetContactTransfers() []*ContactTransfer {
+	if x != nil {
+		return x.ContactTransfers
+	}
+	return nil
+}
+
+type UserExportSession struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Created        int64    `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Expires        int64    `protobuf:"varint,2,opt,name=expires,proto3" json:"expires,omitempty"`
+	UserAgent      string   `protobuf:"bytes,3,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	AuthTime       int64    `protobuf:"varint,4,opt,name=authTime,proto3" json:"authTime,omitempty"`
+	AuthMethods    []string `protobuf:"bytes,5,rep,name=authMethods,proto3" json:"authMethods,omitempty"`
+	OrganizationID []byte   `protobuf:"bytes,6,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+}
+
+func (x *UserExportSession) Reset() {
+	*x = UserExportSession{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserExportSession) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExportSession) ProtoMessage() {}
+
+func (x *UserExportSession) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExportSession.ProtoReflect.Descriptor instead.
+func (*UserExportSession) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *UserExportSession) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *UserExportSession) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *UserExportSession) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *UserExportSession) GetAuthTime() int64 {
+	if x != nil {
+		return x.AuthTime
+	}
+	return 0
+}
+
+func (x *UserExportSession) GetAuthMethods() []string {
+	if x != nil {
+		return x.AuthMethods
+	}
+	return nil
+}
+
+func (x *UserExportSession) GetOrganizationID() []byte {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return nil
+}
+
+type UserExport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created     int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	UserID      []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	RequesterID []byte `protobuf:"bytes,4,opt,name=requesterID,proto3" json:"requesterID,omitempty"`
+	Status      string `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Completed   int64  `protobuf:"varint,6,opt,name=completed,proto3" json:"completed,omitempty"`
+	Expires     int64  `protobuf:"varint,7,opt,name=expires,proto3" json:"expires,omitempty"`
+	Token       string `protobuf:"bytes,8,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *UserExport) Reset() {
+	*x = UserExport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserExport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserExport) ProtoMessage() {}
+
+func (x *UserExport) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserExport.ProtoReflect.Descriptor instead.
+func (*UserExport) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *UserExport) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UserExport) GetCreated() int64 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *UserExport) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *UserExport) GetRequesterID() []byte {
+	if x != nil {
+		return x.RequesterID
+	}
+	return nil
+}
+
+func (x *UserExport) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserExport) GetCompleted() int64 {
+	if x != nil {
+		return x.Completed
+	}
+	return 0
+}
+
+func (x *UserExport) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *UserExport) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type CreateUserExportResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *UserExport `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CreateUserExportResponseV1) Reset() {
+	*x = CreateUserExportResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserExportResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserExportResponseV1) ProtoMessage() {}
+
+func (x *CreateUserExportResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserExportResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateUserExportResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateUserExportResponseV1) GetData() *UserExport {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetUserExportResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *UserExport `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUserExportResponseV1) Reset() {
+	*x = GetUserExportResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserExportResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserExportResponseV1) ProtoMessage() {}
+
+func (x *GetUserExportResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserExportResponseV1.ProtoReflect.Descriptor instead.
+func (*GetUserExportResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetUserExportResponseV1) GetData() *UserExport {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetUserExportArchiveResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *UserExportData `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUserExportArchiveResponseV1) Reset() {
+	*x = GetUserExportArchiveResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserExportArchiveResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserExportArchiveResponseV1) ProtoMessage() {}
+
+func (x *GetUserExportArchiveResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserExportArchiveResponseV1.ProtoReflect.Descriptor instead.
+func (*GetUserExportArchiveResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *GetUserExportArchiveResponseV1) GetData() *UserExportData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetUserProfileResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *structpb.Struct `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUserProfileResponseV1) Reset() {
+	*x = GetUserProfileResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserProfileResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserProfileResponseV1) ProtoMessage() {}
+
+func (x *GetUserProfileResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserProfileResponseV1.ProtoReflect.Descriptor instead.
+func (*GetUserProfileResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *GetUserProfileResponseV1) GetData() *structpb.Struct {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UpdateUserProfileResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*UpdateUserProfileResponseV1_Ok
+	//	*UpdateUserProfileResponseV1_ValidationError_
+	Data isUpdateUserProfileResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *UpdateUserProfileResponseV1) Reset() {
+	*x = UpdateUserProfileResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserProfileResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileResponseV1) ProtoMessage() {}
+
+func (x *UpdateUserProfileResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileResponseV1.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{80}
+}
+
+func (m *UpdateUserProfileResponseV1) GetData() isUpdateUserProfileResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *UpdateUserProfileResponseV1) GetOk() *structpb.Struct {
+	if x, ok := x.GetData().(*UpdateUserProfileResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *UpdateUserProfileResponseV1) GetValidationError() *UpdateUserProfileResponseV1_ValidationError {
+	if x, ok := x.GetData().(*UpdateUserProfileResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isUpdateUserProfileResponseV1_Data interface {
+	isUpdateUserProfileResponseV1_Data()
+}
+
+type UpdateUserProfileResponseV1_Ok struct {
+	Ok *structpb.Struct `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type UpdateUserProfileResponseV1_ValidationError_ struct {
+	ValidationError *UpdateUserProfileResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*UpdateUserProfileResponseV1_Ok) isUpdateUserProfileResponseV1_Data() {}
+
+func (*UpdateUserProfileResponseV1_ValidationError_) isUpdateUserProfileResponseV1_Data() {}
+
+type CreateSessionResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*CreateSessionResponseV1_Ok
+	//	*CreateSessionResponseV1_ValidationError_
+	Data isCreateSessionResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *CreateSessionResponseV1) Reset() {
+	*x = CreateSessionResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSessionResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionResponseV1) ProtoMessage() {}
+
+func (x *CreateSessionResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{81}
+}
+
+func (m *CreateSessionResponseV1) GetData() isCreateSessionResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1) GetOk() *Session {
+	if x, ok := x.GetData().(*CreateSessionResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1) GetValidationError() *CreateSessionResponseV1_ValidationError {
+	if x, ok := x.GetData().(*CreateSessionResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isCreateSessionResponseV1_Data interface {
+	isCreateSessionResponseV1_Data()
+}
+
+type CreateSessionResponseV1_Ok struct {
+	Ok *Session `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type CreateSessionResponseV1_ValidationError_ struct {
+	ValidationError *CreateSessionResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*CreateSessionResponseV1_Ok) isCreateSessionResponseV1_Data() {}
+
+func (*CreateSessionResponseV1_ValidationError_) isCreateSessionResponseV1_Data() {}
+
+type GetSecretResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *Secret `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetSecretResponseV1) Reset() {
+	*x = GetSecretResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSecretResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSecretResponseV1) ProtoMessage() {}
+
+func (x *GetSecretResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSecretResponseV1.ProtoReflect.Descriptor instead.
+func (*GetSecretResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetSecretResponseV1) GetData() *Secret {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type CreateSecretResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *Secret `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *CreateSecretResponseV1) Reset() {
+	*x = CreateSecretResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSecretResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSecretResponseV1) ProtoMessage() {}
+
+func (x *CreateSecretResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSecretResponseV1.ProtoReflect.Descriptor instead.
+func (*CreateSecretResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *CreateSecretResponseV1) GetData() *Secret {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListSecretResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*Secret   `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListSecretResponseV1) Reset() {
+	*x = ListSecretResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSecretResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSecretResponseV1) ProtoMessage() {}
+
+func (x *ListSecretResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSecretResponseV1.ProtoReflect.Descriptor instead.
+func (*ListSecretResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListSecretResponseV1) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListSecretResponseV1) GetData() []*Secret {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetUserViewResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data *UserView `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetUserViewResponseV1) Reset() {
+	*x = GetUserViewResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUserViewResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserViewResponseV1) ProtoMessage() {}
+
+func (x *GetUserViewResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserViewResponseV1.ProtoReflect.Descriptor instead.
+func (*GetUserViewResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *GetUserViewResponseV1) GetData() *UserView {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListUserViewResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data       []*UserView `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *ListUserViewResponseV1) Reset() {
+	*x = ListUserViewResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListUserViewResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserViewResponseV1) ProtoMessage() {}
+
+func (x *ListUserViewResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserViewResponseV1.ProtoReflect.Descriptor instead.
+func (*ListUserViewResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ListUserViewResponseV1) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *ListUserViewResponseV1) GetData() []*UserView {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserViewHighlight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Field  string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Start  int32  `protobuf:"varint,3,opt,name=start,proto3" json:"start,omitempty"`
+	Length int32  `protobuf:"varint,4,opt,name=length,proto3" json:"length,omitempty"`
+}
+
+func (x *UserViewHighlight) Reset() {
+	*x = UserViewHighlight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserViewHighlight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserViewHighlight) ProtoMessage() {}
+
+func (x *UserViewHighlight) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserViewHighlight.ProtoReflect.Descriptor instead.
+func (*UserViewHighlight) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *UserViewHighlight) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *UserViewHighlight) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *UserViewHighlight) GetStart() int32 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *UserViewHighlight) GetLength() int32 {
+	if x != nil {
+		return x.Length
+	}
+	return 0
+}
+
+type FoundUserView struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data       *UserView            `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	Highlights []*UserViewHighlight `protobuf:"bytes,2,rep,name=highlights,proto3" json:"highlights,omitempty"`
+}
+
+func (x *FoundUserView) Reset() {
+	*x = FoundUserView{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FoundUserView) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FoundUserView) ProtoMessage() {}
+
+func (x *FoundUserView) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FoundUserView.ProtoReflect.Descriptor instead.
+func (*FoundUserView) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *FoundUserView) GetData() *UserView {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *FoundUserView) GetHighlights() []*UserViewHighlight {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+type SearchUsersViewResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination      *Pagination                                `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Data            []*FoundUserView                           `protobuf:"bytes,2,rep,name=data,proto3" json:"data,omitempty"`
+	ValidationError *SearchUsersViewResponseV1_ValidationError `protobuf:"bytes,3,opt,name=validationError,proto3" json:"validationError,omitempty"`
+}
+
+func (x *SearchUsersViewResponseV1) Reset() {
+	*x = SearchUsersViewResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUsersViewResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersViewResponseV1) ProtoMessage() {}
+
+func (x *SearchUsersViewResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersViewResponseV1.ProtoReflect.Descriptor instead.
+func (*SearchUsersViewResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *SearchUsersViewResponseV1) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchUsersViewResponseV1) GetData() []*FoundUserView {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SearchUsersViewResponseV1) GetValidationError() *SearchUsersViewResponseV1_ValidationError {
+	if x != nil {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type AuthorizeResponseV1 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Data:
+	//	*AuthorizeResponseV1_Ok
+	//	*AuthorizeResponseV1_ValidationError_
+	Data isAuthorizeResponseV1_Data `protobuf_oneof:"data"`
+}
+
+func (x *AuthorizeResponseV1) Reset() {
+	*x = AuthorizeResponseV1{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeResponseV1) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeResponseV1) ProtoMessage() {}
+
+func (x *AuthorizeResponseV1) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeResponseV1.ProtoReflect.Descriptor instead.
+func (*AuthorizeResponseV1) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{90}
+}
+
+func (m *AuthorizeResponseV1) GetData() isAuthorizeResponseV1_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (x *AuthorizeResponseV1) GetOk() *Authorization {
+	if x, ok := x.GetData().(*AuthorizeResponseV1_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (x *AuthorizeResponseV1) GetValidationError() *AuthorizeResponseV1_ValidationError {
+	if x, ok := x.GetData().(*AuthorizeResponseV1_ValidationError_); ok {
+		return x.ValidationError
+	}
+	return nil
+}
+
+type isAuthorizeResponseV1_Data interface {
+	isAuthorizeResponseV1_Data()
+}
+
+type AuthorizeResponseV1_Ok struct {
+	Ok *Authorization `protobuf:"bytes,1,opt,name=ok,proto3,oneof"`
+}
+
+type AuthorizeResponseV1_ValidationError_ struct {
+	ValidationError *AuthorizeResponseV1_ValidationError `protobuf:"bytes,2,opt,name=validationError,proto3,oneof"`
+}
+
+func (*AuthorizeResponseV1_Ok) isAuthorizeResponseV1_Data() {}
+
+func (*AuthorizeResponseV1_ValidationError_) isAuthorizeResponseV1_Data() {}
+
+type CreateRoleResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ParentsID   [][]byte `protobuf:"bytes,3,rep,name=parentsID,proto3" json:"parentsID,omitempty"`
+}
+
+func (x *CreateRoleResponseV1_Request) Reset() {
+	*x = CreateRoleResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[91]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoleResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateRoleResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[91]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *CreateRoleResponseV1_Request) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateRoleResponseV1_Request) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CreateRoleResponseV1_Request) GetParentsID() [][]byte {
+	if x != nil {
+		return x.ParentsID
+	}
+	return nil
+}
+
+type CreateRoleResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       []string `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty"`
+	Permissions []string `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ParentsID   []string `protobuf:"bytes,3,rep,name=parentsID,proto3" json:"parentsID,omitempty"`
+}
+
+func (x *CreateRoleResponseV1_ValidationError) Reset() {
+	*x = CreateRoleResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[92]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoleResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateRoleResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[92]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateRoleResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{26, 1}
+}
+
+func (x *CreateRoleResponseV1_ValidationError) GetTitle() []string {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *CreateRoleResponseV1_ValidationError) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *CreateRoleResponseV1_ValidationError) GetParentsID() []string {
+	if x != nil {
+		return x.ParentsID
+	}
+	return nil
+}
+
+type UpdateRoleParentsResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentsID [][]byte `protobuf:"bytes,1,rep,name=parentsID,proto3" json:"parentsID,omitempty"`
+}
+
+func (x *UpdateRoleParentsResponseV1_Request) Reset() {
+	*x = UpdateRoleParentsResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[93]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleParentsResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleParentsResponseV1_Request) ProtoMessage() {}
+
+func (x *UpdateRoleParentsResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[93]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleParentsResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*UpdateRoleParentsResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *UpdateRoleParentsResponseV1_Request) GetParentsID() [][]byte {
+	if x != nil {
+		return x.ParentsID
+	}
+	return nil
+}
+
+type UpdateRoleParentsResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ParentsID []string `protobuf:"bytes,1,rep,name=parentsID,proto3" json:"parentsID,omitempty"`
+}
+
+func (x *UpdateRoleParentsResponseV1_ValidationError) Reset() {
+	*x = UpdateRoleParentsResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[94]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleParentsResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleParentsResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *UpdateRoleParentsResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[94]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleParentsResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*UpdateRoleParentsResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{27, 1}
+}
+
+func (x *UpdateRoleParentsResponseV1_ValidationError) GetParentsID() []string {
+	if x != nil {
+		return x.ParentsID
+	}
+	return nil
+}
+
+type UpdateRoleManagedRolesResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ManagedRolesID [][]byte `protobuf:"bytes,1,rep,name=managedRolesID,proto3" json:"managedRolesID,omitempty"`
+}
+
+func (x *UpdateRoleManagedRolesResponseV1_Request) Reset() {
+	*x = UpdateRoleManagedRolesResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[95]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleManagedRolesResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleManagedRolesResponseV1_Request) ProtoMessage() {}
+
+func (x *UpdateRoleManagedRolesResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[95]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleManagedRolesResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*UpdateRoleManagedRolesResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{28, 0}
+}
+
+func (x *UpdateRoleManagedRolesResponseV1_Request) GetManagedRolesID() [][]byte {
+	if x != nil {
+		return x.ManagedRolesID
+	}
+	return nil
+}
+
+type UpdateRoleManagedRolesResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ManagedRolesID []string `protobuf:"bytes,1,rep,name=managedRolesID,proto3" json:"managedRolesID,omitempty"`
+}
+
+func (x *UpdateRoleManagedRolesResponseV1_ValidationError) Reset() {
+	*x = UpdateRoleManagedRolesResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[96]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleManagedRolesResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleManagedRolesResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *UpdateRoleManagedRolesResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[96]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleManagedRolesResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*UpdateRoleManagedRolesResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{28, 1}
+}
+
+func (x *UpdateRoleManagedRolesResponseV1_ValidationError) GetManagedRolesID() []string {
+	if x != nil {
+		return x.ManagedRolesID
+	}
+	return nil
+}
+
+type UpdateRoleResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *UpdateRoleResponseV1_Request) Reset() {
+	*x = UpdateRoleResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[97]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponseV1_Request) ProtoMessage() {}
+
+func (x *UpdateRoleResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[97]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *UpdateRoleResponseV1_Request) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateRoleResponseV1_Request) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateRoleResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       []string `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty"`
+	Description []string `protobuf:"bytes,2,rep,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *UpdateRoleResponseV1_ValidationError) Reset() {
+	*x = UpdateRoleResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateRoleResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRoleResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *UpdateRoleResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRoleResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*UpdateRoleResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{29, 1}
+}
+
+func (x *UpdateRoleResponseV1_ValidationError) GetTitle() []string {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *UpdateRoleResponseV1_ValidationError) GetDescription() []string {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+type DeleteRoleResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *DeleteRoleResponseV1_ValidationError) Reset() {
+	*x = DeleteRoleResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRoleResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoleResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *DeleteRoleResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoleResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*DeleteRoleResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{30, 0}
+}
+
+func (x *DeleteRoleResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreateUserRoleResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         []byte `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	RoleID         []byte `protobuf:"bytes,2,opt,name=roleID,proto3" json:"roleID,omitempty"`
+	OrganizationID []byte `protobuf:"bytes,3,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+	StartsAt       int64  `protobuf:"varint,4,opt,name=startsAt,proto3" json:"startsAt,omitempty"`
+	ExpiresAt      int64  `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *CreateUserRoleResponseV1_Request) Reset() {
+	*x = CreateUserRoleResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserRoleResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRoleResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateUserRoleResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRoleResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateUserRoleResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *CreateUserRoleResponseV1_Request) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1_Request) GetRoleID() []byte {
+	if x != nil {
+		return x.RoleID
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1_Request) GetOrganizationID() []byte {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1_Request) GetStartsAt() int64 {
+	if x != nil {
+		return x.StartsAt
+	}
+	return 0
+}
+
+func (x *CreateUserRoleResponseV1_Request) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CreateUserRoleResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID         []string `protobuf:"bytes,1,rep,name=userID,proto3" json:"userID,omitempty"`
+	RoleID         []string `protobuf:"bytes,2,rep,name=roleID,proto3" json:"roleID,omitempty"`
+	Errors         []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	OrganizationID []string `protobuf:"bytes,4,rep,name=organizationID,proto3" json:"organizationID,omitempty"`
+	ExpiresAt      []string `protobuf:"bytes,5,rep,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *CreateUserRoleResponseV1_ValidationError) Reset() {
+	*x = CreateUserRoleResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserRoleResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRoleResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateUserRoleResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRoleResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateUserRoleResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{32, 1}
+}
+
+func (x *CreateUserRoleResponseV1_ValidationError) GetUserID() []string {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1_ValidationError) GetRoleID() []string {
+	if x != nil {
+		return x.RoleID
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1_ValidationError) GetOrganizationID() []string {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return nil
+}
+
+func (x *CreateUserRoleResponseV1_ValidationError) GetExpiresAt() []string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreateRoleRequestResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleID    []byte `protobuf:"bytes,1,opt,name=roleID,proto3" json:"roleID,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *CreateRoleRequestResponseV1_Request) Reset() {
+	*x = CreateRoleRequestResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoleRequestResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequestResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateRoleRequestResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequestResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequestResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *CreateRoleRequestResponseV1_Request) GetRoleID() []byte {
+	if x != nil {
+		return x.RoleID
+	}
+	return nil
+}
+
+func (x *CreateRoleRequestResponseV1_Request) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreateRoleRequestResponseV1_Request) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type CreateRoleRequestResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoleID    []string `protobuf:"bytes,1,rep,name=roleID,proto3" json:"roleID,omitempty"`
+	Reason    []string `protobuf:"bytes,2,rep,name=reason,proto3" json:"reason,omitempty"`
+	ExpiresAt []string `protobuf:"bytes,3,rep,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Errors    []string `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CreateRoleRequestResponseV1_ValidationError) Reset() {
+	*x = CreateRoleRequestResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateRoleRequestResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoleRequestResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateRoleRequestResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoleRequestResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateRoleRequestResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{35, 1}
+}
+
+func (x *CreateRoleRequestResponseV1_ValidationError) GetRoleID() []string {
+	if x != nil {
+		return x.RoleID
+	}
+	return nil
+}
+
+func (x *CreateRoleRequestResponseV1_ValidationError) GetReason() []string {
+	if x != nil {
+		return x.Reason
+	}
+	return nil
+}
+
+func (x *CreateRoleRequestResponseV1_ValidationError) GetExpiresAt() []string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateRoleRequestResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ReviewRoleRequestResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Comment   string `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
+	ExpiresAt int64  `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *ReviewRoleRequestResponseV1_Request) Reset() {
+	*x = ReviewRoleRequestResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewRoleRequestResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRoleRequestResponseV1_Request) ProtoMessage() {}
+
+func (x *ReviewRoleRequestResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRoleRequestResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*ReviewRoleRequestResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *ReviewRoleRequestResponseV1_Request) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReviewRoleRequestResponseV1_Request) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+func (x *ReviewRoleRequestResponseV1_Request) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type ReviewRoleRequestResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status    []string `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+	ExpiresAt []string `protobuf:"bytes,2,rep,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Errors    []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ReviewRoleRequestResponseV1_ValidationError) Reset() {
+	*x = ReviewRoleRequestResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewRoleRequestResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewRoleRequestResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *ReviewRoleRequestResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewRoleRequestResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*ReviewRoleRequestResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{36, 1}
+}
+
+func (x *ReviewRoleRequestResponseV1_ValidationError) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ReviewRoleRequestResponseV1_ValidationError) GetExpiresAt() []string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ReviewRoleRequestResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CancelRoleRequestResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CancelRoleRequestResponseV1_ValidationError) Reset() {
+	*x = CancelRoleRequestResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelRoleRequestResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelRoleRequestResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CancelRoleRequestResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelRoleRequestResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CancelRoleRequestResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{37, 0}
+}
+
+func (x *CancelRoleRequestResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreateOrganizationResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *CreateOrganizationResponseV1_Request) Reset() {
+	*x = CreateOrganizationResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrganizationResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateOrganizationResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{41, 0}
+}
+
+func (x *CreateOrganizationResponseV1_Request) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+type CreateOrganizationResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title []string `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty"`
+}
+
+func (x *CreateOrganizationResponseV1_ValidationError) Reset() {
+	*x = CreateOrganizationResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrganizationResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateOrganizationResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{41, 1}
+}
+
+func (x *CreateOrganizationResponseV1_ValidationError) GetTitle() []string {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+type CreateOrganizationMemberResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationID []byte `protobuf:"bytes,1,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+	UserID         []byte `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CreateOrganizationMemberResponseV1_Request) Reset() {
+	*x = CreateOrganizationMemberResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrganizationMemberResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationMemberResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateOrganizationMemberResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationMemberResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationMemberResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{43, 0}
+}
+
+func (x *CreateOrganizationMemberResponseV1_Request) GetOrganizationID() []byte {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return nil
+}
+
+func (x *CreateOrganizationMemberResponseV1_Request) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+type CreateOrganizationMemberResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrganizationID []string `protobuf:"bytes,1,rep,name=organizationID,proto3" json:"organizationID,omitempty"`
+	UserID         []string `protobuf:"bytes,2,rep,name=userID,proto3" json:"userID,omitempty"`
+	Errors         []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CreateOrganizationMemberResponseV1_ValidationError) Reset() {
+	*x = CreateOrganizationMemberResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateOrganizationMemberResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateOrganizationMemberResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateOrganizationMemberResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateOrganizationMemberResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateOrganizationMemberResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{43, 1}
+}
+
+func (x *CreateOrganizationMemberResponseV1_ValidationError) GetOrganizationID() []string {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return nil
+}
+
+func (x *CreateOrganizationMemberResponseV1_ValidationError) GetUserID() []string {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *CreateOrganizationMemberResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreateGroupResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *CreateGroupResponseV1_Request) Reset() {
+	*x = CreateGroupResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateGroupResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{46, 0}
+}
+
+func (x *CreateGroupResponseV1_Request) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateGroupResponseV1_Request) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateGroupResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       []string `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty"`
+	Description []string `protobuf:"bytes,2,rep,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *CreateGroupResponseV1_ValidationError) Reset() {
+	*x = CreateGroupResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateGroupResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateGroupResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{46, 1}
+}
+
+func (x *CreateGroupResponseV1_ValidationError) GetTitle() []string {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *CreateGroupResponseV1_ValidationError) GetDescription() []string {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+type UpdateGroupResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *UpdateGroupResponseV1_Request) Reset() {
+	*x = UpdateGroupResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupResponseV1_Request) ProtoMessage() {}
+
+func (x *UpdateGroupResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{47, 0}
+}
+
+func (x *UpdateGroupResponseV1_Request) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateGroupResponseV1_Request) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateGroupResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Title       []string `protobuf:"bytes,1,rep,name=title,proto3" json:"title,omitempty"`
+	Description []string `protobuf:"bytes,2,rep,name=description,proto3" json:"description,omitempty"`
+}
+
+func (x *UpdateGroupResponseV1_ValidationError) Reset() {
+	*x = UpdateGroupResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateGroupResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGroupResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *UpdateGroupResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGroupResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*UpdateGroupResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{47, 1}
+}
+
+func (x *UpdateGroupResponseV1_ValidationError) GetTitle() []string {
+	if x != nil {
+		return x.Title
+	}
+	return nil
+}
+
+func (x *UpdateGroupResponseV1_ValidationError) GetDescription() []string {
+	if x != nil {
+		return x.Description
+	}
+	return nil
+}
+
+type CreateGroupMemberResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID []byte `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID  []byte `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CreateGroupMemberResponseV1_Request) Reset() {
+	*x = CreateGroupMemberResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupMemberResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupMemberResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateGroupMemberResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupMemberResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateGroupMemberResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{49, 0}
+}
+
+func (x *CreateGroupMemberResponseV1_Request) GetGroupID() []byte {
+	if x != nil {
+		return x.GroupID
+	}
+	return nil
+}
+
+func (x *CreateGroupMemberResponseV1_Request) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+type CreateGroupMemberResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID []string `protobuf:"bytes,1,rep,name=groupID,proto3" json:"groupID,omitempty"`
+	UserID  []string `protobuf:"bytes,2,rep,name=userID,proto3" json:"userID,omitempty"`
+	Errors  []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CreateGroupMemberResponseV1_ValidationError) Reset() {
+	*x = CreateGroupMemberResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupMemberResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupMemberResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateGroupMemberResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupMemberResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateGroupMemberResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{49, 1}
+}
+
+func (x *CreateGroupMemberResponseV1_ValidationError) GetGroupID() []string {
+	if x != nil {
+		return x.GroupID
+	}
+	return nil
+}
+
+func (x *CreateGroupMemberResponseV1_ValidationError) GetUserID() []string {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *CreateGroupMemberResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreateGroupRoleResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID []byte `protobuf:"bytes,1,opt,name=groupID,proto3" json:"groupID,omitempty"`
+	RoleID  []byte `protobuf:"bytes,2,opt,name=roleID,proto3" json:"roleID,omitempty"`
+}
+
+func (x *CreateGroupRoleResponseV1_Request) Reset() {
+	*x = CreateGroupRoleResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRoleResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRoleResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateGroupRoleResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRoleResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoleResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *CreateGroupRoleResponseV1_Request) GetGroupID() []byte {
+	if x != nil {
+		return x.GroupID
+	}
+	return nil
+}
+
+func (x *CreateGroupRoleResponseV1_Request) GetRoleID() []byte {
+	if x != nil {
+		return x.RoleID
+	}
+	return nil
+}
+
+type CreateGroupRoleResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GroupID []string `protobuf:"bytes,1,rep,name=groupID,proto3" json:"groupID,omitempty"`
+	RoleID  []string `protobuf:"bytes,2,rep,name=roleID,proto3" json:"roleID,omitempty"`
+	Errors  []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CreateGroupRoleResponseV1_ValidationError) Reset() {
+	*x = CreateGroupRoleResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGroupRoleResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGroupRoleResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateGroupRoleResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGroupRoleResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateGroupRoleResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{51, 1}
+}
+
+func (x *CreateGroupRoleResponseV1_ValidationError) GetGroupID() []string {
+	if x != nil {
+		return x.GroupID
+	}
+	return nil
+}
+
+func (x *CreateGroupRoleResponseV1_ValidationError) GetRoleID() []string {
+	if x != nil {
+		return x.RoleID
+	}
+	return nil
+}
+
+func (x *CreateGroupRoleResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreateEmailResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email  string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	UserID []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CreateEmailResponseV1_Request) Reset() {
+	*x = CreateEmailResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmailResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateEmailResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateEmailResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{53, 0}
+}
+
+func (x *CreateEmailResponseV1_Request) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateEmailResponseV1_Request) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateEmailResponseV1_Request) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+type CreateEmailResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email  []string `protobuf:"bytes,1,rep,name=email,proto3" json:"email,omitempty"`
+	Code   []string `protobuf:"bytes,2,rep,name=code,proto3" json:"code,omitempty"`
+	UserId []string `protobuf:"bytes,3,rep,name=userId,proto3" json:"userId,omitempty"`
+}
+
+func (x *CreateEmailResponseV1_ValidationError) Reset() {
+	*x = CreateEmailResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmailResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateEmailResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateEmailResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{53, 1}
+}
+
+func (x *CreateEmailResponseV1_ValidationError) GetEmail() []string {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *CreateEmailResponseV1_ValidationError) GetCode() []string {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *CreateEmailResponseV1_ValidationError) GetUserId() []string {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type DeleteEmailResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *DeleteEmailResponseV1_ValidationError) Reset() {
+	*x = DeleteEmailResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteEmailResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteEmailResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *DeleteEmailResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteEmailResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*DeleteEmailResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{55, 0}
+}
+
+func (x *DeleteEmailResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreateEmailConfirmationResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email string `protobuf:"bytes,1,opt,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *CreateEmailConfirmationResponseV1_Request) Reset() {
+	*x = CreateEmailConfirmationResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmailConfirmationResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailConfirmationResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateEmailConfirmationResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailConfirmationResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateEmailConfirmationResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{57, 0}
+}
+
+func (x *CreateEmailConfirmationResponseV1_Request) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+type CreateEmailConfirmationResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email []string `protobuf:"bytes,1,rep,name=email,proto3" json:"email,omitempty"`
+}
+
+func (x *CreateEmailConfirmationResponseV1_ValidationError) Reset() {
+	*x = CreateEmailConfirmationResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateEmailConfirmationResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateEmailConfirmationResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateEmailConfirmationResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateEmailConfirmationResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateEmailConfirmationResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{57, 1}
+}
+
+func (x *CreateEmailConfirmationResponseV1_ValidationError) GetEmail() []string {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+type CreatePhoneResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone  string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Code   string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	UserID []byte `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CreatePhoneResponseV1_Request) Reset() {
+	*x = CreatePhoneResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePhoneResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePhoneResponseV1_Request) ProtoMessage() {}
+
+func (x *CreatePhoneResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePhoneResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreatePhoneResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{58, 0}
+}
+
+func (x *CreatePhoneResponseV1_Request) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreatePhoneResponseV1_Request) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreatePhoneResponseV1_Request) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+type CreatePhoneResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone  []string `protobuf:"bytes,1,rep,name=phone,proto3" json:"phone,omitempty"`
+	Code   []string `protobuf:"bytes,2,rep,name=code,proto3" json:"code,omitempty"`
+	UserID []string `protobuf:"bytes,3,rep,name=userID,proto3" json:"userID,omitempty"`
+}
+
+func (x *CreatePhoneResponseV1_ValidationError) Reset() {
+	*x = CreatePhoneResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePhoneResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePhoneResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreatePhoneResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePhoneResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreatePhoneResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{58, 1}
+}
+
+func (x *CreatePhoneResponseV1_ValidationError) GetPhone() []string {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+func (x *CreatePhoneResponseV1_ValidationError) GetCode() []string {
+	if x != nil {
+		return x.Code
+	}
+	return nil
+}
+
+func (x *CreatePhoneResponseV1_ValidationError) GetUserID() []string {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+type DeletePhoneResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Errors []string `protobuf:"bytes,1,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *DeletePhoneResponseV1_ValidationError) Reset() {
+	*x = DeletePhoneResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeletePhoneResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePhoneResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *DeletePhoneResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePhoneResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*DeletePhoneResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{60, 0}
+}
+
+func (x *DeletePhoneResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreatePhoneConfirmationResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone string `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *CreatePhoneConfirmationResponseV1_Request) Reset() {
+	*x = CreatePhoneConfirmationResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePhoneConfirmationResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePhoneConfirmationResponseV1_Request) ProtoMessage() {}
+
+func (x *CreatePhoneConfirmationResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePhoneConfirmationResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreatePhoneConfirmationResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{62, 0}
+}
+
+func (x *CreatePhoneConfirmationResponseV1_Request) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+type CreatePhoneConfirmationResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phone []string `protobuf:"bytes,1,rep,name=phone,proto3" json:"phone,omitempty"`
+}
+
+func (x *CreatePhoneConfirmationResponseV1_ValidationError) Reset() {
+	*x = CreatePhoneConfirmationResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePhoneConfirmationResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePhoneConfirmationResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreatePhoneConfirmationResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePhoneConfirmationResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreatePhoneConfirmationResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{62, 1}
+}
+
+func (x *CreatePhoneConfirmationResponseV1_ValidationError) GetPhone() []string {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+type ReviewContactTransferResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *ReviewContactTransferResponseV1_Request) Reset() {
+	*x = ReviewContactTransferResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewContactTransferResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewContactTransferResponseV1_Request) ProtoMessage() {}
+
+func (x *ReviewContactTransferResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewContactTransferResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*ReviewContactTransferResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{65, 0}
+}
+
+func (x *ReviewContactTransferResponseV1_Request) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ReviewContactTransferResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status []string `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+	Errors []string `protobuf:"bytes,2,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *ReviewContactTransferResponseV1_ValidationError) Reset() {
+	*x = ReviewContactTransferResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewContactTransferResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewContactTransferResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *ReviewContactTransferResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewContactTransferResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*ReviewContactTransferResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{65, 1}
+}
+
+func (x *ReviewContactTransferResponseV1_ValidationError) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *ReviewContactTransferResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type CreatePasswordResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID []byte `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Value  string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *CreatePasswordResponseV1_Request) Reset() {
+	*x = CreatePasswordResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePasswordResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePasswordResponseV1_Request) ProtoMessage() {}
+
+func (x *CreatePasswordResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePasswordResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreatePasswordResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{66, 0}
+}
+
+func (x *CreatePasswordResponseV1_Request) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *CreatePasswordResponseV1_Request) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type CreatePasswordResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID []string `protobuf:"bytes,1,rep,name=userID,proto3" json:"userID,omitempty"`
+	Value  []string `protobuf:"bytes,2,rep,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *CreatePasswordResponseV1_ValidationError) Reset() {
+	*x = CreatePasswordResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreatePasswordResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePasswordResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreatePasswordResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePasswordResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreatePasswordResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{66, 1}
+}
+
+func (x *CreatePasswordResponseV1_ValidationError) GetUserID() []string {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *CreatePasswordResponseV1_ValidationError) GetValue() []string {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type CreateUserResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password  string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Phone     string `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email     string `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	PhoneCode string `protobuf:"bytes,4,opt,name=phoneCode,proto3" json:"phoneCode,omitempty"`
+	EmailCode string `protobuf:"bytes,5,opt,name=emailCode,proto3" json:"emailCode,omitempty"`
+}
+
+func (x *CreateUserResponseV1_Request) Reset() {
+	*x = CreateUserResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateUserResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateUserResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{67, 0}
+}
+
+func (x *CreateUserResponseV1_Request) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserResponseV1_Request) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateUserResponseV1_Request) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserResponseV1_Request) GetPhoneCode() string {
+	if x != nil {
+		return x.PhoneCode
+	}
+	return ""
+}
+
+func (x *CreateUserResponseV1_Request) GetEmailCode() string {
+	if x != nil {
+		return x.EmailCode
+	}
+	return ""
+}
+
+type CreateUserResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Password  []string `protobuf:"bytes,1,rep,name=password,proto3" json:"password,omitempty"`
+	Phone     []string `protobuf:"bytes,2,rep,name=phone,proto3" json:"phone,omitempty"`
+	Email     []string `protobuf:"bytes,3,rep,name=email,proto3" json:"email,omitempty"`
+	PhoneCode []string `protobuf:"bytes,4,rep,name=phoneCode,proto3" json:"phoneCode,omitempty"`
+	EmailCode []string `protobuf:"bytes,5,rep,name=emailCode,proto3" json:"emailCode,omitempty"`
+	Errors    []string `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors,omitempty"`
+}
+
+func (x *CreateUserResponseV1_ValidationError) Reset() {
+	*x = CreateUserResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateUserResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateUserResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateUserResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{67, 1}
+}
+
+func (x *CreateUserResponseV1_ValidationError) GetPassword() []string {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *CreateUserResponseV1_ValidationError) GetPhone() []string {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+func (x *CreateUserResponseV1_ValidationError) GetEmail() []string {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *CreateUserResponseV1_ValidationError) GetPhoneCode() []string {
+	if x != nil {
+		return x.PhoneCode
+	}
+	return nil
+}
+
+func (x *CreateUserResponseV1_ValidationError) GetEmailCode() []string {
+	if x != nil {
+		return x.EmailCode
+	}
+	return nil
+}
+
+func (x *CreateUserResponseV1_ValidationError) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type UpdateUserStatusResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SuspendedUntil int64  `protobuf:"varint,2,opt,name=suspendedUntil,proto3" json:"suspendedUntil,omitempty"`
+	Reason         string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *UpdateUserStatusResponseV1_Request) Reset() {
+	*x = UpdateUserStatusResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserStatusResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserStatusResponseV1_Request) ProtoMessage() {}
+
+func (x *UpdateUserStatusResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserStatusResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*UpdateUserStatusResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{70, 0}
+}
+
+func (x *UpdateUserStatusResponseV1_Request) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateUserStatusResponseV1_Request) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *UpdateUserStatusResponseV1_Request) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateUserStatusResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status         []string `protobuf:"bytes,1,rep,name=status,proto3" json:"status,omitempty"`
+	SuspendedUntil []string `protobuf:"bytes,2,rep,name=suspendedUntil,proto3" json:"suspendedUntil,omitempty"`
+}
+
+func (x *UpdateUserStatusResponseV1_ValidationError) Reset() {
+	*x = UpdateUserStatusResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserStatusResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserStatusResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *UpdateUserStatusResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserStatusResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*UpdateUserStatusResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{70, 1}
+}
+
+func (x *UpdateUserStatusResponseV1_ValidationError) GetStatus() []string {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *UpdateUserStatusResponseV1_ValidationError) GetSuspendedUntil() []string {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return nil
+}
+
+type UpdateUserProfileResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile *structpb.Struct `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UpdateUserProfileResponseV1_Request) Reset() {
+	*x = UpdateUserProfileResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserProfileResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileResponseV1_Request) ProtoMessage() {}
+
+func (x *UpdateUserProfileResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{80, 0}
+}
+
+func (x *UpdateUserProfileResponseV1_Request) GetProfile() *structpb.Struct {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type UpdateUserProfileResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profile []string `protobuf:"bytes,1,rep,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *UpdateUserProfileResponseV1_ValidationError) Reset() {
+	*x = UpdateUserProfileResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateUserProfileResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserProfileResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *UpdateUserProfileResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserProfileResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*UpdateUserProfileResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{80, 1}
+}
+
+func (x *UpdateUserProfileResponseV1_ValidationError) GetProfile() []string {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+type CreateSessionResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fingerprint    string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	UserAgent      string `protobuf:"bytes,2,opt,name=userAgent,proto3" json:"userAgent,omitempty"`
+	ClientID       string `protobuf:"bytes,3,opt,name=clientID,proto3" json:"clientID,omitempty"`
+	OrganizationID []byte `protobuf:"bytes,4,opt,name=organizationID,proto3" json:"organizationID,omitempty"`
+}
+
+func (x *CreateSessionResponseV1_Request) Reset() {
+	*x = CreateSessionResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSessionResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionResponseV1_Request) ProtoMessage() {}
+
+func (x *CreateSessionResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{81, 0}
+}
+
+func (x *CreateSessionResponseV1_Request) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *CreateSessionResponseV1_Request) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *CreateSessionResponseV1_Request) GetClientID() string {
+	if x != nil {
+		return x.ClientID
+	}
+	return ""
+}
+
+func (x *CreateSessionResponseV1_Request) GetOrganizationID() []byte {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return nil
+}
+
+type CreateSessionResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Email            []string `protobuf:"bytes,1,rep,name=email,proto3" json:"email,omitempty"`
+	EmailCode        []string `protobuf:"bytes,2,rep,name=emailCode,proto3" json:"emailCode,omitempty"`
+	Phone            []string `protobuf:"bytes,3,rep,name=phone,proto3" json:"phone,omitempty"`
+	PhoneCode        []string `protobuf:"bytes,4,rep,name=phoneCode,proto3" json:"phoneCode,omitempty"`
+	PhoneCountryCode []string `protobuf:"bytes,5,rep,name=phoneCountryCode,proto3" json:"phoneCountryCode,omitempty"`
+	Password         []string `protobuf:"bytes,6,rep,name=password,proto3" json:"password,omitempty"`
+	Fingerprint      []string `protobuf:"bytes,7,rep,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	UserAgent        []string `protobuf:"bytes,8,rep,name=userAgent,proto3" json:"userAgent,omitempty"`
+	Dpop             []string `protobuf:"bytes,9,rep,name=dpop,proto3" json:"dpop,omitempty"`
+	OrganizationID   []string `protobuf:"bytes,10,rep,name=organizationID,proto3" json:"organizationID,omitempty"`
+}
+
+func (x *CreateSessionResponseV1_ValidationError) Reset() {
+	*x = CreateSessionResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSessionResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSessionResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *CreateSessionResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSessionResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*CreateSessionResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{81, 1}
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetEmail() []string {
+	if x != nil {
+		return x.Email
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetEmailCode() []string {
+	if x != nil {
+		return x.EmailCode
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetPhone() []string {
+	if x != nil {
+		return x.Phone
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetPhoneCode() []string {
+	if x != nil {
+		return x.PhoneCode
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetPhoneCountryCode() []string {
+	if x != nil {
+		return x.PhoneCountryCode
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetPassword() []string {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetFingerprint() []string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetUserAgent() []string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetDpop() []string {
+	if x != nil {
+		return x.Dpop
+	}
+	return nil
+}
+
+func (x *CreateSessionResponseV1_ValidationError) GetOrganizationID() []string {
+	if x != nil {
+		return x.OrganizationID
+	}
+	return nil
+}
+
+type SearchUsersViewResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Query []string `protobuf:"bytes,1,rep,name=query,proto3" json:"query,omitempty"`
+}
+
+func (x *SearchUsersViewResponseV1_ValidationError) Reset() {
+	*x = SearchUsersViewResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SearchUsersViewResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchUsersViewResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *SearchUsersViewResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchUsersViewResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*SearchUsersViewResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{89, 0}
+}
+
+func (x *SearchUsersViewResponseV1_ValidationError) GetQuery() []string {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+type AuthorizeResponseV1_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID []byte                `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Token  string                `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Checks []*AuthorizationCheck `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *AuthorizeResponseV1_Request) Reset() {
+	*x = AuthorizeResponseV1_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeResponseV1_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeResponseV1_Request) ProtoMessage() {}
+
+func (x *AuthorizeResponseV1_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeResponseV1_Request.ProtoReflect.Descriptor instead.
+func (*AuthorizeResponseV1_Request) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{90, 0}
+}
+
+func (x *AuthorizeResponseV1_Request) GetUserID() []byte {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *AuthorizeResponseV1_Request) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *AuthorizeResponseV1_Request) GetChecks() []*AuthorizationCheck {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+type AuthorizeResponseV1_ValidationError struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserID []string `protobuf:"bytes,1,rep,name=userID,proto3" json:"userID,omitempty"`
+	Token  []string `protobuf:"bytes,2,rep,name=token,proto3" json:"token,omitempty"`
+	Checks []string `protobuf:"bytes,3,rep,name=checks,proto3" json:"checks,omitempty"`
+}
+
+func (x *AuthorizeResponseV1_ValidationError) Reset() {
+	*x = AuthorizeResponseV1_ValidationError{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizeResponseV1_ValidationError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizeResponseV1_ValidationError) ProtoMessage() {}
+
+func (x *AuthorizeResponseV1_ValidationError) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizeResponseV1_ValidationError.ProtoReflect.Descriptor instead.
+func (*AuthorizeResponseV1_ValidationError) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{90, 1}
+}
+
+func (x *AuthorizeResponseV1_ValidationError) GetUserID() []string {
+	if x != nil {
+		return x.UserID
+	}
+	return nil
+}
+
+func (x *AuthorizeResponseV1_ValidationError) GetToken() []string {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *AuthorizeResponseV1_ValidationError) GetChecks() []string {
+	if x != nil {
+		return x.Checks
+	}
+	return nil
+}
+
+var File_api_proto protoreflect.FileDescriptor
+
+var file_api_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69, 0x6e, 0x6f,
+	0x75, 0x74, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x5e, 0x0a, 0x0a, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x68, 0x61, 0x73, 0x4e, 0x65, 0x78, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x81, 0x01, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x22, 0x32, 0x0a, 0x09, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x54, 0x79, 0x70, 0x65, 0x12, 0x13, 0x0a,
+	0x0f, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x6e, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a,
+	0x65, 0x64, 0x10, 0x01, 0x22, 0xd0, 0x01, 0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49,
+	0x44, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x44, 0x22, 0xc6, 0x01, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x26,
+	0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x4e, 0x0a, 0x0c, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
+	0x22, 0x7e, 0x0a, 0x12, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69,
+	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x22, 0x69, 0x0a, 0x05, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x0b, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16,
+	0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x67, 0x0a, 0x09, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x22,
+	0xab, 0x02, 0x0a, 0x0b, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x72, 0x49, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x72, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x76, 0x69, 0x65,
+	0x77, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x0a, 0x75, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x22, 0xa1, 0x01,
+	0x0a, 0x07, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65, 0x66,
+	0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0c, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x20, 0x0a,
+	0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x79, 0x70,
+	0x65, 0x22, 0xb0, 0x01, 0x0a, 0x22, 0x52, 0x65, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x78, 0x41,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x61, 0x63, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
+	0x63, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x14, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x6d, 0x72, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x03, 0x61, 0x6d, 0x72, 0x22, 0x99, 0x01, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x5b, 0x0a, 0x11, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0xc5, 0x01,
+	0x0a, 0x05, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12,
+	0x2a, 0x0a, 0x10, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x41, 0x74, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x22,
+	0xd5, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69, 0x6e,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a, 0x66, 0x72, 0x6f,
+	0x6d, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x74, 0x6f, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x22, 0x4c, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x22, 0x8e, 0x02, 0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e,
+	0x64, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x63, 0x74, 0x6f, 0x72, 0x49, 0x44, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x41, 0x63, 0x74, 0x6f, 0x72,
+	0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x22, 0x7c, 0x0a, 0x06, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65,
+	0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x72, 0x65, 0x76,
+	0x6f, 0x6b, 0x65, 0x64, 0x22, 0xe2, 0x03, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65,
+	0x77, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x41, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x6f,
+	0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73,
+	0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x6e,
+	0x74, 0x69, 0x6c, 0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x5e, 0x0a, 0x14, 0x55, 0x73, 0x65,
+	0x72, 0x56, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x70, 0x0a, 0x12, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x49, 0x0a, 0x15, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x7d, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a, 0x09, 0x64, 0x65, 0x63,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x44, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74,
+	0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x86, 0x03, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x12, 0x1d, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52,
+	0x02, 0x6f, 0x6b, 0x12, 0x57, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x5f, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
+	0x49, 0x44, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x73, 0x49, 0x44, 0x1a, 0x67, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x49, 0x44, 0x42, 0x06, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xa4, 0x02, 0x0a, 0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x6f, 0x6c, 0x65, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x12, 0x1d, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52,
+	0x02, 0x6f, 0x6b, 0x12, 0x5e, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x50,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x27, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
+	0x49, 0x44, 0x1a, 0x2f, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x73,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x73, 0x49, 0x44, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x02, 0x0a, 0x20,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x12, 0x1d, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12,
+	0x63, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67,
+	0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x31, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0e, 0x6d,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x44, 0x1a, 0x39, 0x0a,
+	0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x26, 0x0a, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x44, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xca, 0x02, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1d, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x57, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x41, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x49, 0x0a, 0x0f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa2, 0x01,
+	0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x57, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x29, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x68, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75,
+	0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x88, 0x04, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x21, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5b, 0x0a, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74,
+	0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x9b, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x9f, 0x01,
+	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x67,
+	0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x42,
+	0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x71, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xfd, 0x02, 0x0a, 0x1b, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x24, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5e,
+	0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x57,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49,
+	0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x77, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f,
+	0x6c, 0x65, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe7, 0x02, 0x0a, 0x1b, 0x52, 0x65, 0x76,
+	0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x24, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5e,
+	0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
+	0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x59,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x1a, 0x5f, 0x0a, 0x0f, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xd6, 0x01, 0x0a, 0x1b, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x24, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5e, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x29, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x42, 0x0a, 0x18, 0x47,
+	0x65, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x77, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x44, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9e,
+	0x02, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x25, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5f, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x33, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72,
+	0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x1f, 0x0a,
+	0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x1a, 0x27,
+	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x78, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x03, 0x0a, 0x22, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x12, 0x2b, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x65, 0x0a,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x49, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f,
+	0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x69, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61,
+	0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x85, 0x01, 0x0a, 0x21, 0x4c, 0x69, 0x73,
+	0x74, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2d, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0x36, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcd, 0x02, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x1e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x02,
+	0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x41, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x49, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xcd, 0x02, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x1e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x48, 0x00, 0x52, 0x02,
+	0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x41, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x49, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x6a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0xeb, 0x02, 0x0a, 0x1b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x12, 0x24, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5e, 0x0a, 0x0f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75,
+	0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x5b, 0x0a,
+	0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x77, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xe5, 0x02, 0x0a, 0x19,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x22, 0x0a, 0x02, 0x6f, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5c, 0x0a,
+	0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f,
+	0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x1a, 0x3b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x65, 0x49, 0x44, 0x1a, 0x5b,
+	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x6f, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6c,
+	0x65, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x73, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x97, 0x03, 0x0a, 0x15, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x1e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x48, 0x00, 0x52, 0x02,
+	0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x1a, 0x4b, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x53, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12,
+	0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f,
+	0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x37, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc4, 0x01, 0x0a, 0x15,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x48,
+	0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x29, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0x3d, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x87, 0x02, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x2a, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x6d, 0x61, 0x69,
+	0x6c, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52,
+	0x02, 0x6f, 0x6b, 0x12, 0x64, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x38, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x1f, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x1a, 0x27, 0x0a, 0x0f, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x97, 0x03, 0x0a, 0x15,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x48,
+	0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x34, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x1a, 0x4b, 0x0a, 0x07, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x53, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x70,
+	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x37, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f,
+	0x75, 0x74, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc4,
+	0x01, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x58, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48,
+	0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x1a, 0x29, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3d, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x50, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x56, 0x31, 0x12, 0x20, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x87, 0x02, 0x0a, 0x21, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x2a, 0x0a, 0x02, 0x6f, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x64, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x38, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x68,
+	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x1f, 0x0a, 0x07,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x1a, 0x27, 0x0a,
+	0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b,
+	0x0a, 0x1d, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4a, 0x0a, 0x1c, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x2a, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x6f, 0x75,
+	0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9d, 0x02, 0x0a, 0x1f, 0x52, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x28, 0x0a, 0x02, 0x6f,
+	0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48,
+	0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x62, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x36,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x21, 0x0a, 0x07, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x41, 0x0a, 0x0f,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42,
+	0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xc2, 0x02, 0x0a, 0x18, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x12, 0x21, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
+	0x64, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5b, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x24, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x37, 0x0a, 0x07, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x1a, 0x3f, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xd6, 0x03, 0x0a,
+	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1d, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00,
+	0x52, 0x02, 0x6f, 0x6b, 0x12, 0x57, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x8d, 0x01,
+	0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73,
+	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65,
+	0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x1a, 0xad, 0x01,
+	0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68,
+	0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x34, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x35, 0x0a, 0x12, 0x4c,
+	0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x12, 0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x22, 0xd8, 0x02, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x12, 0x1d, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b,
+	0x12, 0x5d, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x69, 0x6e, 0x6f, 0x75,
+	0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a,
+	0x61, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x1a, 0x51, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a,
+	0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64,
+	0x55, 0x6e, 0x74, 0x69, 0x6c, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a,
+	0x1d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x1f,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x3f, 0x0a, 0x1c, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x1f, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
+	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xb5, 0x03, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0b, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07,
+	0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x24, 0x0a,
+	0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x52, 0x06, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x03, 0x52, 0x10, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x73, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12,
+	0x25, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x73, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x0a, 0x0c,
+	0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x11, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x61, 0x75, 0x74, 0x68, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x61, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x12, 0x26,
+	0x0a, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0xd6, 0x01, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x43, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x25, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e,
+	0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x25, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x29, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x47, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12,
+	0x2b, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x9b, 0x02, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x29, 0x0a, 0x02,
+	0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5e, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x32, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x3c, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x31, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x52, 0x07, 0x70, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x1a, 0x2b, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0xef, 0x04, 0x0a, 0x17, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x20, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b, 0x12, 0x5a, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56,
+	0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x72, 0x72, 0x6f, 0x72, 0x1a, 0x8d, 0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69,
+	0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x44, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x1a, 0xbd, 0x02, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61, 0x69,
+	0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f,
+	0x6e, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x2a, 0x0a, 0x10, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x68, 0x6f, 0x6e,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x66, 0x69, 0x6e, 0x67,
+	0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x66,
+	0x69, 0x6e, 0x67, 0x65, 0x72, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73,
+	0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x75,
+	0x73, 0x65, 0x72, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x70, 0x6f, 0x70,
+	0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x70, 0x6f, 0x70, 0x12, 0x26, 0x0a, 0x0e,
+	0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x42, 0x06, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x38, 0x0a, 0x13,
+	0x47, 0x65, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x56, 0x31, 0x12, 0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x3b, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31,
+	0x12, 0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x6c, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x0a, 0x70,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69,
+	0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x3c, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22,
+	0x70, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x69, 0x6e, 0x6f,
+	0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x6d, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x48, 0x69, 0x67,
+	0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x22, 0x6e, 0x0a, 0x0d, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65,
+	0x77, 0x12, 0x23, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x69, 0x6e, 0x6f,
+	0x75, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x48, 0x69, 0x67, 0x68, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73,
+	0x22, 0xfd, 0x01, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73,
+	0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x31,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x55, 0x73, 0x65,
+	0x72, 0x56, 0x69, 0x65, 0x77, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x5a, 0x0a, 0x0f, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x55, 0x73, 0x65, 0x72, 0x73, 0x56, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x27, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75,
+	0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79,
+	0x22, 0xe2, 0x02, 0x0a, 0x13, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x56, 0x31, 0x12, 0x26, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x41, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x02, 0x6f, 0x6b,
+	0x12, 0x56, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x69, 0x6e, 0x6f, 0x75,
+	0x74, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x56, 0x31, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x1a, 0x6a, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x31, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x52, 0x06, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x1a, 0x57, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x42, 0x06, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x69, 0x6e, 0x6f, 0x75, 0x74,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_proto_rawDescOnce sync.Once
+	file_api_proto_rawDescData = file_api_proto_rawDesc
+)
+
+func file_api_proto_rawDescGZIP() []byte {
+	file_api_proto_rawDescOnce.Do(func() {
+		file_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_proto_rawDescData)
+	})
+	return file_api_proto_rawDescData
+}
+
+var file_api_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
+var file_api_proto_goTypes = []interface{}{
+	(Error_ErrorType)(0),       // 0: inout.Error.ErrorType
+	(*Pagination)(nil),         // 1: inout.Pagination
+	(*Error)(nil),              // 2: inout.Error
+	(*Role)(nil),               // 3: inout.Role
+	(*UserRole)(nil),           // 4: inout.UserRole
+	(*Organization)(nil),       // 5: inout.Organization
+	(*OrganizationMember)(nil), // 6: inout.OrganizationMember
+	(*Group)(nil),              // 7: inout.Group
+	(*GroupMember)(nil),        // 8: inout.GroupMember
+	(*GroupRole)(nil),          // 9: inout.GroupRole
+	(*RoleRequest)(nil),        // 10: inout.RoleRequest
+	(*Session)(nil),            // 11: inout.Session
+	(*ReauthenticationRequiredResponseV1)(nil), // 12: inout.ReauthenticationRequiredResponseV1
+	(*Email)(nil),                                              // 13: inout.Email
+	(*EmailConfirmation)(nil),                                  // 14: inout.EmailConfirmation
+	(*Phone)(nil),                                              // 15: inout.Phone
+	(*PhoneConfirmation)(nil),                                  // 16: inout.PhoneConfirmation
+	(*ContactTransfer)(nil),                                    // 17: inout.ContactTransfer
+	(*Password)(nil),                                           // 18: inout.Password
+	(*User)(nil),                                               // 19: inout.User
+	(*Secret)(nil),                                             // 20: inout.Secret
+	(*UserView)(nil),                                           // 21: inout.UserView
+	(*UserViewOrganization)(nil),                               // 22: inout.UserViewOrganization
+	(*AuthorizationCheck)(nil),                                 // 23: inout.AuthorizationCheck
+	(*AuthorizationDecision)(nil),                              // 24: inout.AuthorizationDecision
+	(*Authorization)(nil),                                      // 25: inout.Authorization
+	(*GetRoleResponseV1)(nil),                                  // 26: inout.GetRoleResponseV1
+	(*CreateRoleResponseV1)(nil),                               // 27: inout.CreateRoleResponseV1
+	(*UpdateRoleParentsResponseV1)(nil),                        // 28: inout.UpdateRoleParentsResponseV1
+	(*UpdateRoleManagedRolesResponseV1)(nil),                   // 29: inout.UpdateRoleManagedRolesResponseV1
+	(*UpdateRoleResponseV1)(nil),                               // 30: inout.UpdateRoleResponseV1
+	(*DeleteRoleResponseV1)(nil),                               // 31: inout.DeleteRoleResponseV1
+	(*ListRoleResponseV1)(nil),                                 // 32: inout.ListRoleResponseV1
+	(*CreateUserRoleResponseV1)(nil),                           // 33: inout.CreateUserRoleResponseV1
+	(*GetUserRoleResponseV1)(nil),                              // 34: inout.GetUserRoleResponseV1
+	(*ListUserRolesResponseV1)(nil),                            // 35: inout.ListUserRolesResponseV1
+	(*CreateRoleRequestResponseV1)(nil),                        // 36: inout.CreateRoleRequestResponseV1
+	(*ReviewRoleRequestResponseV1)(nil),                        // 37: inout.ReviewRoleRequestResponseV1
+	(*CancelRoleRequestResponseV1)(nil),                        // 38: inout.CancelRoleRequestResponseV1
+	(*GetRoleRequestResponseV1)(nil),                           // 39: inout.GetRoleRequestResponseV1
+	(*ListRoleRequestsResponseV1)(nil),                         // 40: inout.ListRoleRequestsResponseV1
+	(*GetOrganizationResponseV1)(nil),                          // 41: inout.GetOrganizationResponseV1
+	(*CreateOrganizationResponseV1)(nil),                       // 42: inout.CreateOrganizationResponseV1
+	(*ListOrganizationResponseV1)(nil),                         // 43: inout.ListOrganizationResponseV1
+	(*CreateOrganizationMemberResponseV1)(nil),                 // 44: inout.CreateOrganizationMemberResponseV1
+	(*ListOrganizationMembersResponseV1)(nil),                  // 45: inout.ListOrganizationMembersResponseV1
+	(*GetGroupResponseV1)(nil),                                 // 46: inout.GetGroupResponseV1
+	(*CreateGroupResponseV1)(nil),                              // 47: inout.CreateGroupResponseV1
+	(*UpdateGroupResponseV1)(nil),                              // 48: inout.UpdateGroupResponseV1
+	(*ListGroupResponseV1)(nil),                                // 49: inout.ListGroupResponseV1
+	(*CreateGroupMemberResponseV1)(nil),                        // 50: inout.CreateGroupMemberResponseV1
+	(*ListGroupMembersResponseV1)(nil),                         // 51: inout.ListGroupMembersResponseV1
+	(*CreateGroupRoleResponseV1)(nil),                          // 52: inout.CreateGroupRoleResponseV1
+	(*ListGroupRolesResponseV1)(nil),                           // 53: inout.ListGroupRolesResponseV1
+	(*CreateEmailResponseV1)(nil),                              // 54: inout.CreateEmailResponseV1
+	(*ListEmailResponseV1)(nil),                                // 55: inout.ListEmailResponseV1
+	(*DeleteEmailResponseV1)(nil),                              // 56: inout.DeleteEmailResponseV1
+	(*SetPrimaryEmailResponseV1)(nil),                          // 57: inout.SetPrimaryEmailResponseV1
+	(*CreateEmailConfirmationResponseV1)(nil),                  // 58: inout.CreateEmailConfirmationResponseV1
+	(*CreatePhoneResponseV1)(nil),                              // 59: inout.CreatePhoneResponseV1
+	(*ListPhoneResponseV1)(nil),                                // 60: inout.ListPhoneResponseV1
+	(*DeletePhoneResponseV1)(nil),                              // 61: inout.DeletePhoneResponseV1
+	(*SetPrimaryPhoneResponseV1)(nil),                          // 62: inout.SetPrimaryPhoneResponseV1
+	(*CreatePhoneConfirmationResponseV1)(nil),                  // 63: inout.CreatePhoneConfirmationResponseV1
+	(*ListContactTransferResponseV1)(nil),                      // 64: inout.ListContactTransferResponseV1
+	(*GetContactTransferResponseV1)(nil),                       // 65: inout.GetContactTransferResponseV1
+	(*ReviewContactTransferResponseV1)(nil),                    // 66: inout.ReviewContactTransferResponseV1
+	(*CreatePasswordResponseV1)(nil),                           // 67: inout.CreatePasswordResponseV1
+	(*CreateUserResponseV1)(nil),                               // 68: inout.CreateUserResponseV1
+	(*GetUserResponseV1)(nil),                                  // 69: inout.GetUserResponseV1
+	(*ListUserResponseV1)(nil),                                 // 70: inout.ListUserResponseV1
+	(*UpdateUserStatusResponseV1)(nil),                         // 71: inout.UpdateUserStatusResponseV1
+	(*RequestUserDeletionResponseV1)(nil),                      // 72: inout.RequestUserDeletionResponseV1
+	(*CancelUserDeletionResponseV1)(nil),                       // 73: inout.CancelUserDeletionResponseV1
+	(*UserExportData)(nil),                                     // 74: inout.UserExportData
+	(*UserExportSession)(nil),                                  // 75: inout.UserExportSession
+	(*UserExport)(nil),                                         // 76: inout.UserExport
+	(*CreateUserExportResponseV1)(nil),                         // 77: inout.CreateUserExportResponseV1
+	(*GetUserExportResponseV1)(nil),                            // 78: inout.GetUserExportResponseV1
+	(*GetUserExportArchiveResponseV1)(nil),                     // 79: inout.GetUserExportArchiveResponseV1
+	(*GetUserProfileResponseV1)(nil),                           // 80: inout.GetUserProfileResponseV1
+	(*UpdateUserProfileResponseV1)(nil),                        // 81: inout.UpdateUserProfileResponseV1
+	(*CreateSessionResponseV1)(nil),                            // 82: inout.CreateSessionResponseV1
+	(*GetSecretResponseV1)(nil),                                // 83: inout.GetSecretResponseV1
+	(*CreateSecretResponseV1)(nil),                             // 84: inout.CreateSecretResponseV1
+	(*ListSecretResponseV1)(nil),                               // 85: inout.ListSecretResponseV1
+	(*GetUserViewResponseV1)(nil),                              // 86: inout.GetUserViewResponseV1
+	(*ListUserViewResponseV1)(nil),                             // 87: inout.ListUserViewResponseV1
+	(*UserViewHighlight)(nil),                                  // 88: inout.UserViewHighlight
+	(*FoundUserView)(nil),                                      // 89: inout.FoundUserView
+	(*SearchUsersViewResponseV1)(nil),                          // 90: inout.SearchUsersViewResponseV1
+	(*AuthorizeResponseV1)(nil),                                // 91: inout.AuthorizeResponseV1
+	(*CreateRoleResponseV1_Request)(nil),                       // 92: inout.CreateRoleResponseV1.Request
+	(*CreateRoleResponseV1_ValidationError)(nil),               // 93: inout.CreateRoleResponseV1.ValidationError
+	(*UpdateRoleParentsResponseV1_Request)(nil),                // 94: inout.UpdateRoleParentsResponseV1.Request
+	(*UpdateRoleParentsResponseV1_ValidationError)(nil),        // 95: inout.UpdateRoleParentsResponseV1.ValidationError
+	(*UpdateRoleManagedRolesResponseV1_Request)(nil),           // 96: inout.UpdateRoleManagedRolesResponseV1.Request
+	(*UpdateRoleManagedRolesResponseV1_ValidationError)(nil),   // 97: inout.UpdateRoleManagedRolesResponseV1.ValidationError
+	(*UpdateRoleResponseV1_Request)(nil),                       // 98: inout.UpdateRoleResponseV1.Request
+	(*UpdateRoleResponseV1_ValidationError)(nil),               // 99: inout.UpdateRoleResponseV1.ValidationError
+	(*DeleteRoleResponseV1_ValidationError)(nil),               // 100: inout.DeleteRoleResponseV1.ValidationError
+	(*CreateUserRoleResponseV1_Request)(nil),                   // 101: inout.CreateUserRoleResponseV1.Request
+	(*CreateUserRoleResponseV1_ValidationError)(nil),           // 102: inout.CreateUserRoleResponseV1.ValidationError
+	(*CreateRoleRequestResponseV1_Request)(nil),                // 103: inout.CreateRoleRequestResponseV1.Request
+	(*CreateRoleRequestResponseV1_ValidationError)(nil),        // 104: inout.CreateRoleRequestResponseV1.ValidationError
+	(*ReviewRoleRequestResponseV1_Request)(nil),                // 105: inout.ReviewRoleRequestResponseV1.Request
+	(*ReviewRoleRequestResponseV1_ValidationError)(nil),        // 106: inout.ReviewRoleRequestResponseV1.ValidationError
+	(*CancelRoleRequestResponseV1_ValidationError)(nil),        // 107: inout.CancelRoleRequestResponseV1.ValidationError
+	(*CreateOrganizationResponseV1_Request)(nil),               // 108: inout.CreateOrganizationResponseV1.Request
+	(*CreateOrganizationResponseV1_ValidationError)(nil),       // 109: inout.CreateOrganizationResponseV1.ValidationError
+	(*CreateOrganizationMemberResponseV1_Request)(nil),         // 110: inout.CreateOrganizationMemberResponseV1.Request
+	(*CreateOrganizationMemberResponseV1_ValidationError)(nil), // 111: inout.CreateOrganizationMemberResponseV1.ValidationError
+	(*CreateGroupResponseV1_Request)(nil),                      // 112: inout.CreateGroupResponseV1.Request
+	(*CreateGroupResponseV1_ValidationError)(nil),              // 113: inout.CreateGroupResponseV1.ValidationError
+	(*UpdateGroupResponseV1_Request)(nil),                      // 114: inout.UpdateGroupResponseV1.Request
+	(*UpdateGroupResponseV1_ValidationError)(nil),              // 115: inout.UpdateGroupResponseV1.ValidationError
+	(*CreateGroupMemberResponseV1_Request)(nil),                // 116: inout.CreateGroupMemberResponseV1.Request
+	(*CreateGroupMemberResponseV1_ValidationError)(nil),        // 117: inout.CreateGroupMemberResponseV1.ValidationError
+	(*CreateGroupRoleResponseV1_Request)(nil),                  // 118: inout.CreateGroupRoleResponseV1.Request
+	(*CreateGroupRoleResponseV1_ValidationError)(nil),          // 119: inout.CreateGroupRoleResponseV1.ValidationError
+	(*CreateEmailResponseV1_Request)(nil),                      // 120: inout.CreateEmailResponseV1.Request
+	(*CreateEmailResponseV1_ValidationError)(nil),              // 121: inout.CreateEmailResponseV1.ValidationError
+	(*DeleteEmailResponseV1_ValidationError)(nil),              // 122: inout.DeleteEmailResponseV1.ValidationError
+	(*CreateEmailConfirmationResponseV1_Request)(nil),          // 123: inout.CreateEmailConfirmationResponseV1.Request
+	(*CreateEmailConfirmationResponseV1_ValidationError)(nil),  // 124: inout.CreateEmailConfirmationResponseV1.ValidationError
+	(*CreatePhoneResponseV1_Request)(nil),                      // 125: inout.CreatePhoneResponseV1.Request
+	(*CreatePhoneResponseV1_ValidationError)(nil),              // 126: inout.CreatePhoneResponseV1.ValidationError
+	(*DeletePhoneResponseV1_ValidationError)(nil),              // 127: inout.DeletePhoneResponseV1.ValidationError
+	(*CreatePhoneConfirmationResponseV1_Request)(nil),          // 128: inout.CreatePhoneConfirmationResponseV1.Request
+	(*CreatePhoneConfirmationResponseV1_ValidationError)(nil),  // 129: inout.CreatePhoneConfirmationResponseV1.ValidationError
+	(*ReviewContactTransferResponseV1_Request)(nil),            // 130: inout.ReviewContactTransferResponseV1.Request
+	(*ReviewContactTransferResponseV1_ValidationError)(nil),    // 131: inout.ReviewContactTransferResponseV1.ValidationError
+	(*CreatePasswordResponseV1_Request)(nil),                   // 132: inout.CreatePasswordResponseV1.Request
+	(*CreatePasswordResponseV1_ValidationError)(nil),           // 133: inout.CreatePasswordResponseV1.ValidationError
+	(*CreateUserResponseV1_Request)(nil),                       // 134: inout.CreateUserResponseV1.Request
+	(*CreateUserResponseV1_ValidationError)(nil),               // 135: inout.CreateUserResponseV1.ValidationError
+	(*UpdateUserStatusResponseV1_Request)(nil),                 // 136: inout.UpdateUserStatusResponseV1.Request
+	(*UpdateUserStatusResponseV1_ValidationError)(nil),         // 137: inout.UpdateUserStatusResponseV1.ValidationError
+	(*UpdateUserProfileResponseV1_Request)(nil),                // 138: inout.UpdateUserProfileResponseV1.Request
+	(*UpdateUserProfileResponseV1_ValidationError)(nil),        // 139: inout.UpdateUserProfileResponseV1.ValidationError
+	(*CreateSessionResponseV1_Request)(nil),                    // 140: inout.CreateSessionResponseV1.Request
+	(*CreateSessionResponseV1_ValidationError)(nil),            // 141: inout.CreateSessionResponseV1.ValidationError
+	(*SearchUsersViewResponseV1_ValidationError)(nil),          // 142: inout.SearchUsersViewResponseV1.ValidationError
+	(*AuthorizeResponseV1_Request)(nil),                        // 143: inout.AuthorizeResponseV1.Request
+	(*AuthorizeResponseV1_ValidationError)(nil),                // 144: inout.AuthorizeResponseV1.ValidationError
+	(*structpb.Struct)(nil),                                    // 145: google.protobuf.Struct
+}
+var file_api_proto_depIdxs = []int32{
+	0,   // 0: inout.Error.type:type_name -> inout.Error.ErrorType
+	22,  // 1: inout.UserView.organizations:type_name -> inout.UserViewOrganization
+	145, // 2: inout.UserView.profile:type_name -> google.protobuf.Struct
+	24,  // 3: inout.Authorization.decisions:type_name -> inout.AuthorizationDecision
+	3,   // 4: inout.GetRoleResponseV1.data:type_name -> inout.Role
+	3,   // 5: inout.CreateRoleResponseV1.ok:type_name -> inout.Role
+	93,  // 6: inout.CreateRoleResponseV1.validationError:type_name -> inout.CreateRoleResponseV1.ValidationError
+	2,   // 7: inout.CreateRoleResponseV1.error:type_name -> inout.Error
+	3,   // 8: inout.UpdateRoleParentsResponseV1.ok:type_name -> inout.Role
+	95,  // 9: inout.UpdateRoleParentsResponseV1.validationError:type_name -> inout.UpdateRoleParentsResponseV1.ValidationError
+	2,   // 10: inout.UpdateRoleParentsResponseV1.error:type_name -> inout.Error
+	3,   // 11: inout.UpdateRoleManagedRolesResponseV1.ok:type_name -> inout.Role
+	97,  // 12: inout.UpdateRoleManagedRolesResponseV1.validationError:type_name -> inout.UpdateRoleManagedRolesResponseV1.ValidationError
+	2,   // 13: inout.UpdateRoleManagedRolesResponseV1.error:type_name -> inout.Error
+	3,   // 14: inout.UpdateRoleResponseV1.ok:type_name -> inout.Role
+	99,  // 15: inout.UpdateRoleResponseV1.validationError:type_name -> inout.UpdateRoleResponseV1.ValidationError
+	2,   // 16: inout.UpdateRoleResponseV1.error:type_name -> inout.Error
+	100, // 17: inout.DeleteRoleResponseV1.validationError:type_name -> inout.DeleteRoleResponseV1.ValidationError
+	1,   // 18: inout.ListRoleResponseV1.pagination:type_name -> inout.Pagination
+	3,   // 19: inout.ListRoleResponseV1.data:type_name -> inout.Role
+	4,   // 20: inout.CreateUserRoleResponseV1.ok:type_name -> inout.UserRole
+	102, // 21: inout.CreateUserRoleResponseV1.validationError:type_name -> inout.CreateUserRoleResponseV1.ValidationError
+	2,   // 22: inout.CreateUserRoleResponseV1.error:type_name -> inout.Error
+	4,   // 23: inout.GetUserRoleResponseV1.data:type_name -> inout.UserRole
+	1,   // 24: inout.ListUserRolesResponseV1.pagination:type_name -> inout.Pagination
+	4,   // 25: inout.ListUserRolesResponseV1.data:type_name -> inout.UserRole
+	10,  // 26: inout.CreateRoleRequestResponseV1.ok:type_name -> inout.RoleRequest
+	104, // 27: inout.CreateRoleRequestResponseV1.validationError:type_name -> inout.CreateRoleRequestResponseV1.ValidationError
+	10,  // 28: inout.ReviewRoleRequestResponseV1.ok:type_name -> inout.RoleRequest
+	106, // 29: inout.ReviewRoleRequestResponseV1.validationError:type_name -> inout.ReviewRoleRequestResponseV1.ValidationError
+	10,  // 30: inout.CancelRoleRequestResponseV1.ok:type_name -> inout.RoleRequest
+	107, // 31: inout.CancelRoleRequestResponseV1.validationError:type_name -> inout.CancelRoleRequestResponseV1.ValidationError
+	10,  // 32: inout.GetRoleRequestResponseV1.data:type_name -> inout.RoleRequest
+	1,   // 33: inout.ListRoleRequestsResponseV1.pagination:type_name -> inout.Pagination
+	10,  // 34: inout.ListRoleRequestsResponseV1.data:type_name -> inout.RoleRequest
+	5,   // 35: inout.GetOrganizationResponseV1.data:type_name -> inout.Organization
+	5,   // 36: inout.CreateOrganizationResponseV1.ok:type_name -> inout.Organization
+	109, // 37: inout.CreateOrganizationResponseV1.validationError:type_name -> inout.CreateOrganizationResponseV1.ValidationError
+	2,   // 38: inout.CreateOrganizationResponseV1.error:type_name -> inout.Error
+	1,   // 39: inout.ListOrganizationResponseV1.pagination:type_name -> inout.Pagination
+	5,   // 40: inout.ListOrganizationResponseV1.data:type_name -> inout.Organization
+	6,   // 41: inout.CreateOrganizationMemberResponseV1.ok:type_name -> inout.OrganizationMember
+	111, // 42: inout.CreateOrganizationMemberResponseV1.validationError:type_name -> inout.CreateOrganizationMemberResponseV1.ValidationError
+	2,   // 43: inout.CreateOrganizationMemberResponseV1.error:type_name -> inout.Error
+	1,   // 44: inout.ListOrganizationMembersResponseV1.pagination:type_name -> inout.Pagination
+	6,   // 45: inout.ListOrganizationMembersResponseV1.data:type_name -> inout.OrganizationMember
+	7,   // 46: inout.GetGroupResponseV1.data:type_name -> inout.Group
+	7,   // 47: inout.CreateGroupResponseV1.ok:type_name -> inout.Group
+	113, // 48: inout.CreateGroupResponseV1.validationError:type_name -> inout.CreateGroupResponseV1.ValidationError
+	2,   // 49: inout.CreateGroupResponseV1.error:type_name -> inout.Error
+	7,   // 50: inout.UpdateGroupResponseV1.ok:type_name -> inout.Group
+	115, // 51: inout.UpdateGroupResponseV1.validationError:type_name -> inout.UpdateGroupResponseV1.ValidationError
+	2,   // 52: inout.UpdateGroupResponseV1.error:type_name -> inout.Error
+	1,   // 53: inout.ListGroupResponseV1.pagination:type_name -> inout.Pagination
+	7,   // 54: inout.ListGroupResponseV1.data:type_name -> inout.Group
+	8,   // 55: inout.CreateGroupMemberResponseV1.ok:type_name -> inout.GroupMember
+	117, // 56: inout.CreateGroupMemberResponseV1.validationError:type_name -> inout.CreateGroupMemberResponseV1.ValidationError
+	2,   // 57: inout.CreateGroupMemberResponseV1.error:type_name -> inout.Error
+	1,   // 58: inout.ListGroupMembersResponseV1.pagination:type_name -> inout.Pagination
+	8,   // 59: inout.ListGroupMembersResponseV1.data:type_name -> inout.GroupMember
+	9,   // 60: inout.CreateGroupRoleResponseV1.ok:type_name -> inout.GroupRole
+	119, // 61: inout.CreateGroupRoleResponseV1.validationError:type_name -> inout.CreateGroupRoleResponseV1.ValidationError
+	2,   // 62: inout.CreateGroupRoleResponseV1.error:type_name -> inout.Error
+	1,   // 63: inout.ListGroupRolesResponseV1.pagination:type_name -> inout.Pagination
+	9,   // 64: inout.ListGroupRolesResponseV1.data:type_name -> inout.GroupRole
+	13,  // 65: inout.CreateEmailResponseV1.ok:type_name -> inout.Email
+	121, // 66: inout.CreateEmailResponseV1.validationError:type_name -> inout.CreateEmailResponseV1.ValidationError
+	2,   // 67: inout.CreateEmailResponseV1.error:type_name -> inout.Error
+	17,  // 68: inout.CreateEmailResponseV1.transfer:type_name -> inout.ContactTransfer
+	13,  // 69: inout.ListEmailResponseV1.data:type_name -> inout.Email
+	13,  // 70: inout.DeleteEmailResponseV1.ok:type_name -> inout.Email
+	122, // 71: inout.DeleteEmailResponseV1.validationError:type_name -> inout.DeleteEmailResponseV1.ValidationError
+	13,  // 72: inout.SetPrimaryEmailResponseV1.data:type_name -> inout.Email
+	14,  // 73: inout.CreateEmailConfirmationResponseV1.ok:type_name -> inout.EmailConfirmation
+	124, // 74: inout.CreateEmailConfirmationResponseV1.validationError:type_name -> inout.CreateEmailConfirmationResponseV1.ValidationError
+	15,  // 75: inout.CreatePhoneResponseV1.ok:type_name -> inout.Phone
+	126, // 76: inout.CreatePhoneResponseV1.validationError:type_name -> inout.CreatePhoneResponseV1.ValidationError
+	2,   // 77: inout.CreatePhoneResponseV1.error:type_name -> inout.Error
+	17,  // 78: inout.CreatePhoneResponseV1.transfer:type_name -> inout.ContactTransfer
+	15,  // 79: inout.ListPhoneResponseV1.data:type_name -> inout.Phone
+	15,  // 80: inout.DeletePhoneResponseV1.ok:type_name -> inout.Phone
+	127, // 81: inout.DeletePhoneResponseV1.validationError:type_name -> inout.DeletePhoneResponseV1.ValidationError
+	15,  // 82: inout.SetPrimaryPhoneResponseV1.data:type_name -> inout.Phone
+	16,  // 83: inout.CreatePhoneConfirmationResponseV1.ok:type_name -> inout.PhoneConfirmation
+	129, // 84: inout.CreatePhoneConfirmationResponseV1.validationError:type_name -> inout.CreatePhoneConfirmationResponseV1.ValidationError
+	17,  // 85: inout.ListContactTransferResponseV1.data:type_name -> inout.ContactTransfer
+	17,  // 86: inout.GetContactTransferResponseV1.data:type_name -> inout.ContactTransfer
+	17,  // 87: inout.ReviewContactTransferResponseV1.ok:type_name -> inout.ContactTransfer
+	131, // 88: inout.ReviewContactTransferResponseV1.validationError:type_name -> inout.ReviewContactTransferResponseV1.ValidationError
+	18,  // 89: inout.CreatePasswordResponseV1.ok:type_name -> inout.Password
+	133, // 90: inout.CreatePasswordResponseV1.validationError:type_name -> inout.CreatePasswordResponseV1.ValidationError
+	2,   // 91: inout.CreatePasswordResponseV1.error:type_name -> inout.Error
+	19,  // 92: inout.CreateUserResponseV1.ok:type_name -> inout.User
+	135, // 93: inout.CreateUserResponseV1.validationError:type_name -> inout.CreateUserResponseV1.ValidationError
+	19,  // 94: inout.GetUserResponseV1.data:type_name -> inout.User
+	19,  // 95: inout.ListUserResponseV1.data:type_name -> inout.User
+	19,  // 96: inout.UpdateUserStatusResponseV1.ok:type_name -> inout.User
+	137, // 97: inout.UpdateUserStatusResponseV1.validationError:type_name -> inout.UpdateUserStatusResponseV1.ValidationError
+	19,  // 98: inout.RequestUserDeletionResponseV1.data:type_name -> inout.User
+	19,  // 99: inout.CancelUserDeletionResponseV1.data:type_name -> inout.User
+	19,  // 100: inout.UserExportData.user:type_name -> inout.User
+	145, // 101: inout.UserExportData.profile:type_name -> google.protobuf.Struct
+	13,  // 102: inout.UserExportData.emails:type_name -> inout.Email
+	15,  // 103: inout.UserExportData.phones:type_name -> inout.Phone
+	4,   // 104: inout.UserExportData.roles:type_name -> inout.UserRole
+	75,  // 105: inout.UserExportData.sessions:type_name -> inout.UserExportSession
+	10,  // 106: inout.UserExportData.roleRequests:type_name -> inout.RoleRequest
+	17,  // 107: inout.UserExportData.contactTransfers:type_name -> inout.ContactTransfer
+	76,  // 108: inout.CreateUserExportResponseV1.data:type_name -> inout.UserExport
+	76,  // 109: inout.GetUserExportResponseV1.data:type_name -> inout.UserExport
+	74,  // 110: inout.GetUserExportArchiveResponseV1.data:type_name -> inout.UserExportData
+	145, // 111: inout.GetUserProfileResponseV1.data:type_name -> google.protobuf.Struct
+	145, // 112: inout.UpdateUserProfileResponseV1.ok:type_name -> google.protobuf.Struct
+	139, // 113: inout.UpdateUserProfileResponseV1.validationError:type_name -> inout.UpdateUserProfileResponseV1.ValidationError
+	11,  // 114: inout.CreateSessionResponseV1.ok:type_name -> inout.Session
+	141, // 115: inout.CreateSessionResponseV1.validationError:type_name -> inout.CreateSessionResponseV1.ValidationError
+	20,  // 116: inout.GetSecretResponseV1.data:type_name -> inout.Secret
+	20,  // 117: inout.CreateSecretResponseV1.data:type_name -> inout.Secret
+	1,   // 118: inout.ListSecretResponseV1.pagination:type_name -> inout.Pagination
+	20,  // 119: inout.ListSecretResponseV1.data:type_name -> inout.Secret
+	21,  // 120: inout.GetUserViewResponseV1.data:type_name -> inout.UserView
+	1,   // 121: inout.ListUserViewResponseV1.pagination:type_name -> inout.Pagination
+	21,  // 122: inout.ListUserViewResponseV1.data:type_name -> inout.UserView
+	21,  // 123: inout.FoundUserView.data:type_name -> inout.UserView
+	88,  // 124: inout.FoundUserView.highlights:type_name -> inout.UserViewHighlight
+	1,   // 125: inout.SearchUsersViewResponseV1.pagination:type_name -> inout.Pagination
+	89,  // 126: inout.SearchUsersViewResponseV1.data:type_name -> inout.FoundUserView
+	142, // 127: inout.SearchUsersViewResponseV1.validationError:type_name -> inout.SearchUsersViewResponseV1.ValidationError
+	25,  // 128: inout.AuthorizeResponseV1.ok:type_name -> inout.Authorization
+	144, // 129: inout.AuthorizeResponseV1.validationError:type_name -> inout.AuthorizeResponseV1.ValidationError
+	145, // 130: inout.UpdateUserProfileResponseV1.Request.profile:type_name -> google.protobuf.Struct
+	23,  // 131: inout.AuthorizeResponseV1.Request.checks:type_name -> inout.AuthorizationCheck
+	132, // [132:132] is the sub-list for method output_type
+	132, // [132:132] is the sub-list for method input_type
+	132, // [132:132] is the sub-list for extension type_name
+	132, // [132:132] is the sub-list for extension extendee
+	0,   // [0:132] is the sub-list for field type_name
+}
+
+func init() { file_api_proto_init() }
+func file_api_proto_init() {
+	if File_api_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Role); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Organization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OrganizationMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Group); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GroupRole); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RoleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Session); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReauthenticationRequiredResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Email); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EmailConfirmation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Phone); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PhoneConfirmation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactTransfer); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Password); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*User); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Secret); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserView); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserViewOrganization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationCheck); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationDecision); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authorization); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleParentsResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleManagedRolesResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRoleResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoleResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRoleResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserRoleResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserRolesResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleRequestResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewRoleRequestResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRoleRequestResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRoleRequestResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoleRequestsResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrganizationResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrganizationResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationMemberResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrganizationMembersResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGroupResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupMemberResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupMembersResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRoleResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListGroupRolesResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEmailResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListEmailResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEmailResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPrimaryEmailResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateEmailConfirmationResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePhoneResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPhoneResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePhoneResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetPrimaryPhoneResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePhoneConfirmationResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListContactTransferResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactTransferResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewContactTransferResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreatePasswordResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserStatusResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RequestUserDeletionResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelUserDeletionResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserExportData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserExportSession); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserExport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserExportResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserExportResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserExportArchiveResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserProfileResponseV1); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserProfileResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3722,8 +12070,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Error); i {
+		file_api_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSessionResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3734,8 +12082,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Role); i {
+		file_api_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSecretResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3746,8 +12094,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserRole); i {
+		file_api_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSecretResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3758,8 +12106,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Session); i {
+		file_api_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSecretResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3770,8 +12118,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Email); i {
+		file_api_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserViewResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3782,8 +12130,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EmailConfirmation); i {
+		file_api_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListUserViewResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3794,8 +12142,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Phone); i {
+		file_api_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserViewHighlight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3806,8 +12154,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PhoneConfirmation); i {
+		file_api_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FoundUserView); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3818,8 +12166,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Password); i {
+		file_api_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersViewResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3830,8 +12178,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*User); i {
+		file_api_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeResponseV1); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3842,8 +12190,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Secret); i {
+		file_api_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3854,8 +12202,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserView); i {
+		file_api_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3866,8 +12214,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRoleResponseV1); i {
+		file_api_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleParentsResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3878,8 +12226,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoleResponseV1); i {
+		file_api_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleParentsResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3890,8 +12238,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListRoleResponseV1); i {
+		file_api_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleManagedRolesResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3902,8 +12250,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRoleResponseV1); i {
+		file_api_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleManagedRolesResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3914,8 +12262,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserRoleResponseV1); i {
+		file_api_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3926,8 +12274,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserRolesResponseV1); i {
+		file_api_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateRoleResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3938,8 +12286,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEmailResponseV1); i {
+		file_api_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteRoleResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3950,8 +12298,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEmailConfirmationResponseV1); i {
+		file_api_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRoleResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3962,8 +12310,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePhoneResponseV1); i {
+		file_api_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateUserRoleResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3974,8 +12322,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePhoneConfirmationResponseV1); i {
+		file_api_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleRequestResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3986,8 +12334,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreatePasswordResponseV1); i {
+		file_api_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoleRequestResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3998,8 +12346,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserResponseV1); i {
+		file_api_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewRoleRequestResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4010,8 +12358,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserResponseV1); i {
+		file_api_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewRoleRequestResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4022,8 +12370,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserResponseV1); i {
+		file_api_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelRoleRequestResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4034,8 +12382,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateSessionResponseV1); i {
+		file_api_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4046,8 +12394,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSecretResponseV1); i {
+		file_api_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4058,8 +12406,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserViewResponseV1); i {
+		file_api_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationMemberResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4070,8 +12418,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListUserViewResponseV1); i {
+		file_api_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateOrganizationMemberResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4082,8 +12430,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoleResponseV1_Request); i {
+		file_api_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4094,8 +12442,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRoleResponseV1_ValidationError); i {
+		file_api_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4106,8 +12454,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRoleResponseV1_Request); i {
+		file_api_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupResponseV1_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4118,8 +12466,8 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateUserRoleResponseV1_ValidationError); i {
+		file_api_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateGroupResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4130,7 +12478,55 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupMemberResponseV1_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupMemberResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRoleResponseV1_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGroupRoleResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateEmailResponseV1_Request); i {
 			case 0:
 				return &v.state
@@ -4142,7 +12538,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateEmailResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
@@ -4154,7 +12550,19 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteEmailResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateEmailConfirmationResponseV1_Request); i {
 			case 0:
 				return &v.state
@@ -4166,7 +12574,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateEmailConfirmationResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
@@ -4178,7 +12586,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePhoneResponseV1_Request); i {
 			case 0:
 				return &v.state
@@ -4190,7 +12598,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePhoneResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
@@ -4202,7 +12610,19 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeletePhoneResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePhoneConfirmationResponseV1_Request); i {
 			case 0:
 				return &v.state
@@ -4214,7 +12634,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePhoneConfirmationResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
@@ -4226,7 +12646,31 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewContactTransferResponseV1_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewContactTransferResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePasswordResponseV1_Request); i {
 			case 0:
 				return &v.state
@@ -4238,7 +12682,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreatePasswordResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
@@ -4250,7 +12694,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateUserResponseV1_Request); i {
 			case 0:
 				return &v.state
@@ -4262,7 +12706,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateUserResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
@@ -4274,7 +12718,55 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserStatusResponseV1_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserStatusResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserProfileResponseV1_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateUserProfileResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSessionResponseV1_Request); i {
 			case 0:
 				return &v.state
@@ -4286,7 +12778,7 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
-		file_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_api_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateSessionResponseV1_ValidationError); i {
 			case 0:
 				return &v.state
@@ -4298,55 +12790,177 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchUsersViewResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeResponseV1_Request); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizeResponseV1_ValidationError); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_api_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[26].OneofWrappers = []interface{}{
 		(*CreateRoleResponseV1_Ok)(nil),
 		(*CreateRoleResponseV1_ValidationError_)(nil),
 		(*CreateRoleResponseV1_Error)(nil),
 	}
-	file_api_proto_msgTypes[16].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*UpdateRoleParentsResponseV1_Ok)(nil),
+		(*UpdateRoleParentsResponseV1_ValidationError_)(nil),
+		(*UpdateRoleParentsResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[28].OneofWrappers = []interface{}{
+		(*UpdateRoleManagedRolesResponseV1_Ok)(nil),
+		(*UpdateRoleManagedRolesResponseV1_ValidationError_)(nil),
+		(*UpdateRoleManagedRolesResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[29].OneofWrappers = []interface{}{
+		(*UpdateRoleResponseV1_Ok)(nil),
+		(*UpdateRoleResponseV1_ValidationError_)(nil),
+		(*UpdateRoleResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[30].OneofWrappers = []interface{}{
+		(*DeleteRoleResponseV1_ValidationError_)(nil),
+	}
+	file_api_proto_msgTypes[32].OneofWrappers = []interface{}{
 		(*CreateUserRoleResponseV1_Ok)(nil),
 		(*CreateUserRoleResponseV1_ValidationError_)(nil),
 		(*CreateUserRoleResponseV1_Error)(nil),
 	}
-	file_api_proto_msgTypes[19].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[35].OneofWrappers = []interface{}{
+		(*CreateRoleRequestResponseV1_Ok)(nil),
+		(*CreateRoleRequestResponseV1_ValidationError_)(nil),
+	}
+	file_api_proto_msgTypes[36].OneofWrappers = []interface{}{
+		(*ReviewRoleRequestResponseV1_Ok)(nil),
+		(*ReviewRoleRequestResponseV1_ValidationError_)(nil),
+	}
+	file_api_proto_msgTypes[37].OneofWrappers = []interface{}{
+		(*CancelRoleRequestResponseV1_Ok)(nil),
+		(*CancelRoleRequestResponseV1_ValidationError_)(nil),
+	}
+	file_api_proto_msgTypes[41].OneofWrappers = []interface{}{
+		(*CreateOrganizationResponseV1_Ok)(nil),
+		(*CreateOrganizationResponseV1_ValidationError_)(nil),
+		(*CreateOrganizationResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[43].OneofWrappers = []interface{}{
+		(*CreateOrganizationMemberResponseV1_Ok)(nil),
+		(*CreateOrganizationMemberResponseV1_ValidationError_)(nil),
+		(*CreateOrganizationMemberResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[46].OneofWrappers = []interface{}{
+		(*CreateGroupResponseV1_Ok)(nil),
+		(*CreateGroupResponseV1_ValidationError_)(nil),
+		(*CreateGroupResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[47].OneofWrappers = []interface{}{
+		(*UpdateGroupResponseV1_Ok)(nil),
+		(*UpdateGroupResponseV1_ValidationError_)(nil),
+		(*UpdateGroupResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[49].OneofWrappers = []interface{}{
+		(*CreateGroupMemberResponseV1_Ok)(nil),
+		(*CreateGroupMemberResponseV1_ValidationError_)(nil),
+		(*CreateGroupMemberResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[51].OneofWrappers = []interface{}{
+		(*CreateGroupRoleResponseV1_Ok)(nil),
+		(*CreateGroupRoleResponseV1_ValidationError_)(nil),
+		(*CreateGroupRoleResponseV1_Error)(nil),
+	}
+	file_api_proto_msgTypes[53].OneofWrappers = []interface{}{
 		(*CreateEmailResponseV1_Ok)(nil),
 		(*CreateEmailResponseV1_ValidationError_)(nil),
 		(*CreateEmailResponseV1_Error)(nil),
+		(*CreateEmailResponseV1_Transfer)(nil),
+	}
+	file_api_proto_msgTypes[55].OneofWrappers = []interface{}{
+		(*DeleteEmailResponseV1_Ok)(nil),
+		(*DeleteEmailResponseV1_ValidationError_)(nil),
 	}
-	file_api_proto_msgTypes[20].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[57].OneofWrappers = []interface{}{
 		(*CreateEmailConfirmationResponseV1_Ok)(nil),
 		(*CreateEmailConfirmationResponseV1_ValidationError_)(nil),
 	}
-	file_api_proto_msgTypes[21].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[58].OneofWrappers = []interface{}{
 		(*CreatePhoneResponseV1_Ok)(nil),
 		(*CreatePhoneResponseV1_ValidationError_)(nil),
 		(*CreatePhoneResponseV1_Error)(nil),
+		(*CreatePhoneResponseV1_Transfer)(nil),
 	}
-	file_api_proto_msgTypes[22].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[60].OneofWrappers = []interface{}{
+		(*DeletePhoneResponseV1_Ok)(nil),
+		(*DeletePhoneResponseV1_ValidationError_)(nil),
+	}
+	file_api_proto_msgTypes[62].OneofWrappers = []interface{}{
 		(*CreatePhoneConfirmationResponseV1_Ok)(nil),
 		(*CreatePhoneConfirmationResponseV1_ValidationError_)(nil),
 	}
-	file_api_proto_msgTypes[23].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[65].OneofWrappers = []interface{}{
+		(*ReviewContactTransferResponseV1_Ok)(nil),
+		(*ReviewContactTransferResponseV1_ValidationError_)(nil),
+	}
+	file_api_proto_msgTypes[66].OneofWrappers = []interface{}{
 		(*CreatePasswordResponseV1_Ok)(nil),
 		(*CreatePasswordResponseV1_ValidationError_)(nil),
 		(*CreatePasswordResponseV1_Error)(nil),
 	}
-	file_api_proto_msgTypes[24].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[67].OneofWrappers = []interface{}{
 		(*CreateUserResponseV1_Ok)(nil),
 		(*CreateUserResponseV1_ValidationError_)(nil),
 	}
-	file_api_proto_msgTypes[27].OneofWrappers = []interface{}{
+	file_api_proto_msgTypes[70].OneofWrappers = []interface{}{
+		(*UpdateUserStatusResponseV1_Ok)(nil),
+		(*UpdateUserStatusResponseV1_ValidationError_)(nil),
+	}
+	file_api_proto_msgTypes[80].OneofWrappers = []interface{}{
+		(*UpdateUserProfileResponseV1_Ok)(nil),
+		(*UpdateUserProfileResponseV1_ValidationError_)(nil),
+	}
+	file_api_proto_msgTypes[81].OneofWrappers = []interface{}{
 		(*CreateSessionResponseV1_Ok)(nil),
 		(*CreateSessionResponseV1_ValidationError_)(nil),
 	}
+	file_api_proto_msgTypes[90].OneofWrappers = []interface{}{
+		(*AuthorizeResponseV1_Ok)(nil),
+		(*AuthorizeResponseV1_ValidationError_)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   49,
+			NumMessages:   144,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
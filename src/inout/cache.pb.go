@@ -30,9 +30,13 @@ type SecretCache struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Value   []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Id             []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created        int64  `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Value          []byte `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Expires        int64  `protobuf:"varint,4,opt,name=expires,proto3" json:"expires,omitempty"`
+	Revoked        int64  `protobuf:"varint,5,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	EncryptedValue []byte `protobuf:"bytes,6,opt,name=encryptedValue,proto3" json:"encryptedValue,omitempty"`
+	MasterKeyID    string `protobuf:"bytes,7,opt,name=masterKeyID,proto3" json:"masterKeyID,omitempty"`
 }
 
 func (x *SecretCache) Reset() {
@@ -88,17 +92,55 @@ func (x *SecretCache) GetValue() []byte {
 	return nil
 }
 
+func (x *SecretCache) GetExpires() int64 {
+	if x != nil {
+		return x.Expires
+	}
+	return 0
+}
+
+func (x *SecretCache) GetRevoked() int64 {
+	if x != nil {
+		return x.Revoked
+	}
+	return 0
+}
+
+func (x *SecretCache) GetEncryptedValue() []byte {
+	if x != nil {
+		return x.EncryptedValue
+	}
+	return nil
+}
+
+func (x *SecretCache) GetMasterKeyID() string {
+	if x != nil {
+		return x.MasterKeyID
+	}
+	return ""
+}
+
 type UserViewCache struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id      []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Created int64    `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
-	Roles   []string `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
-	Phones  []string `protobuf:"bytes,4,rep,name=phones,proto3" json:"phones,omitempty"`
-	Emails  []string `protobuf:"bytes,5,rep,name=emails,proto3" json:"emails,omitempty"`
-	RolesID [][]byte `protobuf:"bytes,6,rep,name=rolesID,proto3" json:"rolesID,omitempty"`
+	Id                []byte                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Created           int64                        `protobuf:"varint,2,opt,name=created,proto3" json:"created,omitempty"`
+	Roles             []string                     `protobuf:"bytes,3,rep,name=roles,proto3" json:"roles,omitempty"`
+	Phones            []string                     `protobuf:"bytes,4,rep,name=phones,proto3" json:"phones,omitempty"`
+	Emails            []string                     `protobuf:"bytes,5,rep,name=emails,proto3" json:"emails,omitempty"`
+	RolesID           [][]byte                     `protobuf:"bytes,6,rep,name=rolesID,proto3" json:"rolesID,omitempty"`
+	Permissions       []string                     `protobuf:"bytes,7,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Organizations     []*UserViewOrganizationCache `protobuf:"bytes,8,rep,name=organizations,proto3" json:"organizations,omitempty"`
+	Version           int64                        `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	RolesExpires      int64                        `protobuf:"varint,10,opt,name=rolesExpires,proto3" json:"rolesExpires,omitempty"`
+	Profile           []byte                       `protobuf:"bytes,11,opt,name=profile,proto3" json:"profile,omitempty"`
+	Status            string                       `protobuf:"bytes,12,opt,name=status,proto3" json:"status,omitempty"`
+	SuspendedUntil    int64                        `protobuf:"varint,13,opt,name=suspendedUntil,proto3" json:"suspendedUntil,omitempty"`
+	DeletionScheduled int64                        `protobuf:"varint,14,opt,name=deletionScheduled,proto3" json:"deletionScheduled,omitempty"`
+	PrimaryEmail      string                       `protobuf:"bytes,15,opt,name=primaryEmail,proto3" json:"primaryEmail,omitempty"`
+	PrimaryPhone      string                       `protobuf:"bytes,16,opt,name=primaryPhone,proto3" json:"primaryPhone,omitempty"`
 }
 
 func (x *UserViewCache) Reset() {
@@ -175,27 +217,257 @@ func (x *UserViewCache) GetRolesID() [][]byte {
 	return nil
 }
 
+func (x *UserViewCache) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *UserViewCache) GetOrganizations() []*UserViewOrganizationCache {
+	if x != nil {
+		return x.Organizations
+	}
+	return nil
+}
+
+func (x *UserViewCache) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *UserViewCache) GetRolesExpires() int64 {
+	if x != nil {
+		return x.RolesExpires
+	}
+	return 0
+}
+
+func (x *UserViewCache) GetProfile() []byte {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *UserViewCache) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UserViewCache) GetSuspendedUntil() int64 {
+	if x != nil {
+		return x.SuspendedUntil
+	}
+	return 0
+}
+
+func (x *UserViewCache) GetDeletionScheduled() int64 {
+	if x != nil {
+		return x.DeletionScheduled
+	}
+	return 0
+}
+
+func (x *UserViewCache) GetPrimaryEmail() string {
+	if x != nil {
+		return x.PrimaryEmail
+	}
+	return ""
+}
+
+func (x *UserViewCache) GetPrimaryPhone() string {
+	if x != nil {
+		return x.PrimaryPhone
+	}
+	return ""
+}
+
+type UserViewOrganizationCache struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          []byte   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Roles       []string `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
+	Permissions []string `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+}
+
+func (x *UserViewOrganizationCache) Reset() {
+	*x = UserViewOrganizationCache{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cache_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserViewOrganizationCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserViewOrganizationCache) ProtoMessage() {}
+
+func (x *UserViewOrganizationCache) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserViewOrganizationCache.ProtoReflect.Descriptor instead.
+func (*UserViewOrganizationCache) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UserViewOrganizationCache) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *UserViewOrganizationCache) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *UserViewOrganizationCache) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type AuthorizationDecisionCache struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Allowed bool   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AuthorizationDecisionCache) Reset() {
+	*x = AuthorizationDecisionCache{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cache_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AuthorizationDecisionCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthorizationDecisionCache) ProtoMessage() {}
+
+func (x *AuthorizationDecisionCache) ProtoReflect() protoreflect.Message {
+	mi := &file_cache_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthorizationDecisionCache.ProtoReflect.Descriptor instead.
+func (*AuthorizationDecisionCache) Descriptor() ([]byte, []int) {
+	return file_cache_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuthorizationDecisionCache) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *AuthorizationDecisionCache) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
 var File_cache_proto protoreflect.FileDescriptor
 
 var file_cache_proto_rawDesc = []byte{
 	0x0a, 0x0b, 0x63, 0x61, 0x63, 0x68, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x69,
-	0x6e, 0x6f, 0x75, 0x74, 0x22, 0x4d, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43, 0x61,
+	0x6e, 0x6f, 0x75, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0e, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x49, 0x44, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x49, 0x44, 0x22, 0x91, 0x04, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x43,
+	0x61, 0x63, 0x68, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x72,
+	0x6f, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x44, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x44, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x46, 0x0a, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x2e,
+	0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x0d, 0x6f, 0x72, 0x67, 0x61, 0x6e,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x45,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x75, 0x73, 0x70,
+	0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x73, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c,
+	0x12, 0x2c, 0x0a, 0x11, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65,
+	0x64, 0x75, 0x6c, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x68, 0x6f,
+	0x6e, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x22, 0x63, 0x0a, 0x19, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69,
+	0x65, 0x77, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61,
 	0x63, 0x68, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x99, 0x01, 0x0a, 0x0d, 0x55, 0x73, 0x65, 0x72, 0x56, 0x69, 0x65, 0x77,
-	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05,
-	0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x65,
-	0x6d, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x44,
-	0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x07, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x49, 0x44, 0x42,
-	0x09, 0x5a, 0x07, 0x2e, 0x3b, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x72, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65, 0x72,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4e, 0x0a, 0x1a, 0x41,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x65, 0x63, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x6c, 0x6c, 0x6f,
+	0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0x09, 0x5a, 0x07, 0x2e,
+	0x3b, 0x69, 0x6e, 0x6f, 0x75, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -210,17 +482,20 @@ func file_cache_proto_rawDescGZIP() []byte {
 	return file_cache_proto_rawDescData
 }
 
-var file_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_cache_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_cache_proto_goTypes = []interface{}{
-	(*SecretCache)(nil),   // 0: inout.SecretCache
-	(*UserViewCache)(nil), // 1: inout.UserViewCache
+	(*SecretCache)(nil),                // 0: inout.SecretCache
+	(*UserViewCache)(nil),              // 1: inout.UserViewCache
+	(*UserViewOrganizationCache)(nil),  // 2: inout.UserViewOrganizationCache
+	(*AuthorizationDecisionCache)(nil), // 3: inout.AuthorizationDecisionCache
 }
 var file_cache_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: inout.UserViewCache.organizations:type_name -> inout.UserViewOrganizationCache
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_cache_proto_init() }
@@ -253,6 +528,30 @@ func file_cache_proto_init() {
 				return nil
 			}
 		}
+		file_cache_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UserViewOrganizationCache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cache_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AuthorizationDecisionCache); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -260,7 +559,7 @@ func file_cache_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cache_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -1,6 +1,8 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	sentryHttp "github.com/getsentry/sentry-go/http"
 	"github.com/gorilla/mux"
@@ -8,19 +10,27 @@ import (
 	"github.com/jackc/pgx/v4/stdlib"
 	"github.com/pressly/goose"
 	"github.com/rs/zerolog/log"
+	uuid "github.com/satori/go.uuid"
 	"github.com/urfave/cli/v2"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
 	api2 "hive/api"
 	"hive/auth"
 	"hive/auth/backends"
 	"hive/config"
 	"hive/controllers"
+	"hive/enums"
 	"hive/eventDispatchers"
 	"hive/middlewares"
 	"hive/passwordProcessors"
+	"hive/policies"
+	"hive/repositories"
 	"hive/repositories/inMemoryRepository"
 	"hive/repositories/postgresRepository"
 	"hive/repositories/redisRepository"
+	"hive/secretEncryptors"
 	"hive/stores"
+	"io/ioutil"
 	"net/http"
 	"os"
 )
@@ -29,6 +39,7 @@ func server() error {
 
 	// Initialization
 	environment := config.InitEnvironment()
+	realms := config.InitRealms(environment)
 	tracer, tracerCloser := config.InitTracing(environment)
 	config.InitSentry(environment)
 	pool := config.InitPool(tracer, environment)
@@ -36,22 +47,36 @@ func server() error {
 	inMemoryCache := config.InitInMemoryCache()
 	producer := config.InitNSQProducer(environment)
 	passwordProcessor := passwordProcessors.InitPasswordProcessor()
-	postgresRepo := postgresRepository.InitPostgresRepository(pool, environment)
-	redisRepo := redisRepository.InitRedisRepository(redis)
+	secretEncryptor := secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(environment))
+	postgresRepo := postgresRepository.InitPostgresRepository(pool, environment, secretEncryptor)
+	redisRepo := redisRepository.InitRedisRepository(redis, secretEncryptor)
 	inMemoryRepo := inMemoryRepository.InitInMemoryRepository(inMemoryCache)
 	store := stores.InitStore(pool, redis, inMemoryCache, environment, postgresRepo, redisRepo, inMemoryRepo)
-	jwtAuthenticationBackend := backends.InitJWTAuthenticationBackend(store, environment)
+	for _, realm := range realms.List() {
+		go store.ListenSecretsInvalidation(repositories.SetRealmToContext(context.Background(), realm))
+	}
+	jwtAuthenticationBackend := backends.InitJWTAuthenticationBackend(store, environment, config.InitClaimsMapping(environment))
 	basicAuthenticationBackend := backends.InitBasicAuthenticationBackend(store, passwordProcessor, environment)
 	authenticationController := auth.InitAuthController(map[string]backends.IAuthenticationBackend{
 		"Basic":  basicAuthenticationBackend,
 		"Bearer": jwtAuthenticationBackend,
-	}, environment)
+		"DPoP":   jwtAuthenticationBackend,
+	}, store, environment)
 	dispatcher := eventDispatchers.InitNSQEventDispatcher(producer, environment)
-	controller := controllers.InitController(store, passwordProcessor, dispatcher, environment, jwtAuthenticationBackend.EncodeAccessToken)
-	API := api2.InitAPI(controller, authenticationController, environment)
+	controller := controllers.InitController(store, passwordProcessor, dispatcher, environment, jwtAuthenticationBackend.EncodeAccessToken, config.InitProfileSchema(environment))
+	for _, realm := range realms.List() {
+		go controller.WatchUserRolesPeriods(repositories.SetRealmToContext(context.Background(), realm))
+		go controller.WatchUserExports(repositories.SetRealmToContext(context.Background(), realm))
+		go controller.WatchUserDeletions(repositories.SetRealmToContext(context.Background(), realm))
+	}
+
+	policyEngine := policies.InitPolicyEngine(environment)
+	go policyEngine.Watch(context.Background())
+	API := api2.InitAPI(controller, authenticationController, policyEngine, environment)
 
 	authentication := middlewares.AuthenticationMiddleware(authenticationController)
 	isLocalRequest := middlewares.IsLocalRequestMiddleware(environment.LocalNetworkNamespace)
+	stepUpAuthentication := middlewares.StepUpAuthenticationMiddleware(environment)
 
 	// Init Routing
 
@@ -60,60 +85,177 @@ func server() error {
 	CreateUserV1 := http.HandlerFunc(API.CreateUserV1)
 	GetUsersV1 := authentication(http.HandlerFunc(API.GetUsersV1), true)
 	GetUserV1 := authentication(http.HandlerFunc(API.GetUserV1), true)
-	DeleteUserV1 := authentication(http.HandlerFunc(API.DeleteUserV1), true)
-
-	CreatePasswordV1 := authentication(http.HandlerFunc(API.CreatePasswordV1), true)
-
-	CreateEmailV1 := authentication(http.HandlerFunc(API.CreateEmailV1), true)
+	GetUserProfileV1 := authentication(http.HandlerFunc(API.GetUserProfileV1), true)
+	UpdateUserProfileV1 := authentication(http.HandlerFunc(API.UpdateUserProfileV1), true)
+	DeleteUserV1 := authentication(stepUpAuthentication(middlewares.RequirePermission(http.HandlerFunc(API.DeleteUserV1), enums.UsersDelete), false), true)
+	UpdateUserStatusV1 := authentication(stepUpAuthentication(middlewares.RequirePermission(http.HandlerFunc(API.UpdateUserStatusV1), enums.UsersBlock), false), true)
+	RequestUserDeletionV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.RequestUserDeletionV1), false), true)
+	CancelUserDeletionV1 := authentication(http.HandlerFunc(API.CancelUserDeletionV1), true)
+
+	CreateUserExportV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.CreateUserExportV1), false), true)
+	GetUserExportV1 := authentication(http.HandlerFunc(API.GetUserExportV1), true)
+	GetUserExportArchiveV1 := http.HandlerFunc(API.GetUserExportArchiveV1)
+
+	CreatePasswordV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.CreatePasswordV1), false), true)
+
+	CreateEmailV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.CreateEmailV1), false), true)
+	GetUserEmailsV1 := authentication(http.HandlerFunc(API.GetUserEmailsV1), true)
+	DeleteEmailV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.DeleteEmailV1), false), true)
+	SetPrimaryEmailV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.SetPrimaryEmailV1), false), true)
 	CreateEmailConfirmationV1 := http.HandlerFunc(API.CreateEmailConfirmationV1)
 
-	CreateRoleV1 := authentication(http.HandlerFunc(API.CreateRoleV1), true)
+	CreateRoleV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.CreateRoleV1), enums.RolesCreate), true)
 	GetRolesV1 := authentication(http.HandlerFunc(API.GetRolesV1), true)
 	GetRoleV1 := authentication(http.HandlerFunc(API.GetRoleV1), true)
+	UpdateRoleParentsV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.UpdateRoleParentsV1), enums.RolesUpdate), true)
+	UpdateRoleV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.UpdateRoleV1), enums.RolesUpdate), true)
+	DeleteRoleV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.DeleteRoleV1), enums.RolesDelete), true)
+	UpdateRoleManagedRolesV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.UpdateRoleManagedRolesV1), enums.RolesUpdate), true)
+	GetManageableRolesV1 := authentication(http.HandlerFunc(API.GetManageableRolesV1), true)
 
+	// Users without permissions could manage roles delegated to their roles
 	CreateUserRoleV1 := authentication(http.HandlerFunc(API.CreateUserRoleV1), true)
 	GetUserRolesV1 := authentication(http.HandlerFunc(API.GetUserRolesV1), true)
 	DeleteUserRoleV1 := authentication(http.HandlerFunc(API.DeleteUserRoleV1), true)
 
-	CreatePhoneConfirmationV1 := http.HandlerFunc(API.CreatePhoneConfirmationV1)
-	CreatePhoneV1 := authentication(http.HandlerFunc(API.CreatePhoneV1), true)
+	CreateRoleRequestV1 := authentication(http.HandlerFunc(API.CreateRoleRequestV1), true)
+	GetRoleRequestsV1 := authentication(http.HandlerFunc(API.GetRoleRequestsV1), true)
+	GetRoleRequestV1 := authentication(http.HandlerFunc(API.GetRoleRequestV1), true)
+	ReviewRoleRequestV1 := authentication(http.HandlerFunc(API.ReviewRoleRequestV1), true)
+	CancelRoleRequestV1 := authentication(http.HandlerFunc(API.CancelRoleRequestV1), true)
 
-	CreateSessionV1 := authentication(http.HandlerFunc(API.CreateSessionV1), false)
+	CreateOrganizationV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.CreateOrganizationV1), enums.OrganizationsCreate), true)
+	GetOrganizationsV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.GetOrganizationsV1), enums.OrganizationsRead), true)
+	GetOrganizationV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.GetOrganizationV1), enums.OrganizationsRead), true)
 
-	GetSecretV1 := isLocalRequest(http.HandlerFunc(API.GetSecretV1))
+	CreateOrganizationMemberV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.CreateOrganizationMemberV1), enums.OrganizationMembersCreate), true)
+	GetOrganizationMembersV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.GetOrganizationMembersV1), enums.OrganizationMembersRead), true)
+	DeleteOrganizationMemberV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.DeleteOrganizationMemberV1), enums.OrganizationMembersDelete), true)
 
-	GetUserViewV1 := authentication(http.HandlerFunc(API.GetUserViewV1), true)
-	GetUsersViewV1 := authentication(http.HandlerFunc(API.GetUsersViewV1), true)
+	CreateGroupV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.CreateGroupV1), enums.GroupsCreate), true)
+	GetGroupsV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.GetGroupsV1), enums.GroupsRead), true)
+	GetGroupV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.GetGroupV1), enums.GroupsRead), true)
+	UpdateGroupV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.UpdateGroupV1), enums.GroupsUpdate), true)
+	DeleteGroupV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.DeleteGroupV1), enums.GroupsDelete), true)
 
-	uuidRE := "[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}"
+	CreateGroupMemberV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.CreateGroupMemberV1), enums.GroupMembersCreate), true)
+	GetGroupMembersV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.GetGroupMembersV1), enums.GroupMembersRead), true)
+	DeleteGroupMemberV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.DeleteGroupMemberV1), enums.GroupMembersDelete), true)
 
-	router.Handle("/api/v1/users", CreateUserV1).Methods(http.MethodPost)
-	router.Handle("/api/v1/users", GetUsersV1).Methods(http.MethodGet)
-	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}", uuidRE), GetUserV1).Methods(http.MethodGet)
-	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}", uuidRE), DeleteUserV1).Methods(http.MethodDelete)
+	CreateGroupRoleV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.CreateGroupRoleV1), enums.GroupRolesCreate), true)
+	GetGroupRolesV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.GetGroupRolesV1), enums.GroupRolesRead), true)
+	DeleteGroupRoleV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.DeleteGroupRoleV1), enums.GroupRolesDelete), true)
 
-	router.Handle("/api/v1/passwords", CreatePasswordV1).Methods(http.MethodPost)
+	CreatePhoneConfirmationV1 := http.HandlerFunc(API.CreatePhoneConfirmationV1)
+	CreatePhoneV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.CreatePhoneV1), false), true)
+	GetUserPhonesV1 := authentication(http.HandlerFunc(API.GetUserPhonesV1), true)
+	DeletePhoneV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.DeletePhoneV1), false), true)
+	SetPrimaryPhoneV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.SetPrimaryPhoneV1), false), true)
 
-	router.Handle("/api/v1/emails", CreateEmailV1).Methods(http.MethodPost)
-	router.Handle("/api/v1/emailConfirmations", CreateEmailConfirmationV1).Methods(http.MethodPost)
+	GetUserContactTransfersV1 := authentication(http.HandlerFunc(API.GetUserContactTransfersV1), true)
+	GetContactTransferV1 := authentication(http.HandlerFunc(API.GetContactTransferV1), true)
+	ReviewContactTransferV1 := authentication(stepUpAuthentication(http.HandlerFunc(API.ReviewContactTransferV1), false), true)
 
-	router.Handle("/api/v1/roles", CreateRoleV1).Methods(http.MethodPost)
-	router.Handle("/api/v1/roles", GetRolesV1).Methods(http.MethodGet)
-	router.Handle(fmt.Sprintf("/api/v1/roles/{id:%s}", uuidRE), GetRoleV1).Methods(http.MethodGet)
+	CreateSessionV1 := authentication(http.HandlerFunc(API.CreateSessionV1), false)
 
-	router.Handle("/api/v1/userRoles", CreateUserRoleV1).Methods(http.MethodPost)
-	router.Handle("/api/v1/userRoles", GetUserRolesV1).Methods(http.MethodGet)
-	router.Handle(fmt.Sprintf("/api/v1/userRoles/{id:%s}", uuidRE), DeleteUserRoleV1).Methods(http.MethodDelete)
+	GetSecretV1 := isLocalRequest(http.HandlerFunc(API.GetSecretV1))
+	GetSecretsV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.GetSecretsV1), enums.SecretsRead), true)
+	CreateSecretV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.CreateSecretV1), enums.SecretsCreate), true)
+	DeleteSecretV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.DeleteSecretV1), enums.SecretsDelete), true)
 
-	router.Handle("/api/v1/phoneConfirmations", CreatePhoneConfirmationV1).Methods(http.MethodPost)
-	router.Handle("/api/v1/phones", CreatePhoneV1).Methods(http.MethodPost)
+	AuthorizeV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.AuthorizeV1), enums.AuthorizationCheck), true)
 
-	router.Handle("/api/v1/sessions", CreateSessionV1).Methods(http.MethodPost)
+	GetUserViewV1 := authentication(http.HandlerFunc(API.GetUserViewV1), true)
+	GetUsersViewV1 := authentication(http.HandlerFunc(API.GetUsersViewV1), true)
+	SearchUsersViewV1 := authentication(middlewares.RequirePermission(http.HandlerFunc(API.SearchUsersViewV1), enums.UserViewsRead), true)
 
-	router.Handle(fmt.Sprintf("/api/v1/secrets/{id:%s}", uuidRE), GetSecretV1).Methods(http.MethodGet)
+	uuidRE := "[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-4[a-fA-F0-9]{3}-[8|9|aA|bB][a-fA-F0-9]{3}-[a-fA-F0-9]{12}"
 
-	router.Handle("/views/v1/users", GetUserViewV1).Methods(http.MethodGet)
-	router.Handle(fmt.Sprintf("/views/v1/users/{id:%s}", uuidRE), GetUsersViewV1).Methods(http.MethodGet)
+	router.Handle("/api/v1/users", CreateUserV1).Methods(http.MethodPost).Name("CreateUserV1")
+	router.Handle("/api/v1/users", GetUsersV1).Methods(http.MethodGet).Name("GetUsersV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}", uuidRE), GetUserV1).Methods(http.MethodGet).Name("GetUserV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}", uuidRE), DeleteUserV1).Methods(http.MethodDelete).Name("DeleteUserV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/profile", uuidRE), GetUserProfileV1).Methods(http.MethodGet).Name("GetUserProfileV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/profile", uuidRE), UpdateUserProfileV1).Methods(http.MethodPatch).Name("UpdateUserProfileV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/status", uuidRE), UpdateUserStatusV1).Methods(http.MethodPut).Name("UpdateUserStatusV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/deletion", uuidRE), RequestUserDeletionV1).Methods(http.MethodPost).Name("RequestUserDeletionV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/deletion", uuidRE), CancelUserDeletionV1).Methods(http.MethodDelete).Name("CancelUserDeletionV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/exports", uuidRE), CreateUserExportV1).Methods(http.MethodPost).Name("CreateUserExportV1")
+
+	router.Handle(fmt.Sprintf("/api/v1/userExports/{id:%s}", uuidRE), GetUserExportV1).Methods(http.MethodGet).Name("GetUserExportV1")
+	router.Handle(fmt.Sprintf("/api/v1/userExports/{id:%s}/archive", uuidRE), GetUserExportArchiveV1).Methods(http.MethodGet).Name("GetUserExportArchiveV1")
+
+	router.Handle("/api/v1/passwords", CreatePasswordV1).Methods(http.MethodPost).Name("CreatePasswordV1")
+
+	router.Handle("/api/v1/emails", CreateEmailV1).Methods(http.MethodPost).Name("CreateEmailV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/emails", uuidRE), GetUserEmailsV1).Methods(http.MethodGet).Name("GetUserEmailsV1")
+	router.Handle(fmt.Sprintf("/api/v1/emails/{id:%s}", uuidRE), DeleteEmailV1).Methods(http.MethodDelete).Name("DeleteEmailV1")
+	router.Handle(fmt.Sprintf("/api/v1/emails/{id:%s}/primary", uuidRE), SetPrimaryEmailV1).Methods(http.MethodPut).Name("SetPrimaryEmailV1")
+	router.Handle("/api/v1/emailConfirmations", CreateEmailConfirmationV1).Methods(http.MethodPost).Name("CreateEmailConfirmationV1")
+
+	router.Handle("/api/v1/roles", CreateRoleV1).Methods(http.MethodPost).Name("CreateRoleV1")
+	router.Handle("/api/v1/roles", GetRolesV1).Methods(http.MethodGet).Name("GetRolesV1")
+	router.Handle(fmt.Sprintf("/api/v1/roles/{id:%s}", uuidRE), GetRoleV1).Methods(http.MethodGet).Name("GetRoleV1")
+	router.Handle(fmt.Sprintf("/api/v1/roles/{id:%s}", uuidRE), UpdateRoleV1).Methods(http.MethodPut).Name("UpdateRoleV1")
+	router.Handle(fmt.Sprintf("/api/v1/roles/{id:%s}", uuidRE), DeleteRoleV1).Methods(http.MethodDelete).Name("DeleteRoleV1")
+	router.Handle(fmt.Sprintf("/api/v1/roles/{id:%s}/parents", uuidRE), UpdateRoleParentsV1).Methods(http.MethodPut).Name("UpdateRoleParentsV1")
+	router.Handle(fmt.Sprintf("/api/v1/roles/{id:%s}/managedRoles", uuidRE), UpdateRoleManagedRolesV1).Methods(http.MethodPut).Name("UpdateRoleManagedRolesV1")
+	router.Handle("/api/v1/roles/manageable", GetManageableRolesV1).Methods(http.MethodGet).Name("GetManageableRolesV1")
+
+	router.Handle("/api/v1/userRoles", CreateUserRoleV1).Methods(http.MethodPost).Name("CreateUserRoleV1")
+	router.Handle("/api/v1/userRoles", GetUserRolesV1).Methods(http.MethodGet).Name("GetUserRolesV1")
+	router.Handle(fmt.Sprintf("/api/v1/userRoles/{id:%s}", uuidRE), DeleteUserRoleV1).Methods(http.MethodDelete).Name("DeleteUserRoleV1")
+
+	router.Handle("/api/v1/roleRequests", CreateRoleRequestV1).Methods(http.MethodPost).Name("CreateRoleRequestV1")
+	router.Handle("/api/v1/roleRequests", GetRoleRequestsV1).Methods(http.MethodGet).Name("GetRoleRequestsV1")
+	router.Handle(fmt.Sprintf("/api/v1/roleRequests/{id:%s}", uuidRE), GetRoleRequestV1).Methods(http.MethodGet).Name("GetRoleRequestV1")
+	router.Handle(fmt.Sprintf("/api/v1/roleRequests/{id:%s}", uuidRE), ReviewRoleRequestV1).Methods(http.MethodPut).Name("ReviewRoleRequestV1")
+	router.Handle(fmt.Sprintf("/api/v1/roleRequests/{id:%s}", uuidRE), CancelRoleRequestV1).Methods(http.MethodDelete).Name("CancelRoleRequestV1")
+
+	router.Handle("/api/v1/organizations", CreateOrganizationV1).Methods(http.MethodPost).Name("CreateOrganizationV1")
+	router.Handle("/api/v1/organizations", GetOrganizationsV1).Methods(http.MethodGet).Name("GetOrganizationsV1")
+	router.Handle(fmt.Sprintf("/api/v1/organizations/{id:%s}", uuidRE), GetOrganizationV1).Methods(http.MethodGet).Name("GetOrganizationV1")
+
+	router.Handle("/api/v1/organizationMembers", CreateOrganizationMemberV1).Methods(http.MethodPost).Name("CreateOrganizationMemberV1")
+	router.Handle("/api/v1/organizationMembers", GetOrganizationMembersV1).Methods(http.MethodGet).Name("GetOrganizationMembersV1")
+	router.Handle(fmt.Sprintf("/api/v1/organizationMembers/{id:%s}", uuidRE), DeleteOrganizationMemberV1).Methods(http.MethodDelete).Name("DeleteOrganizationMemberV1")
+
+	router.Handle("/api/v1/groups", CreateGroupV1).Methods(http.MethodPost).Name("CreateGroupV1")
+	router.Handle("/api/v1/groups", GetGroupsV1).Methods(http.MethodGet).Name("GetGroupsV1")
+	router.Handle(fmt.Sprintf("/api/v1/groups/{id:%s}", uuidRE), GetGroupV1).Methods(http.MethodGet).Name("GetGroupV1")
+	router.Handle(fmt.Sprintf("/api/v1/groups/{id:%s}", uuidRE), UpdateGroupV1).Methods(http.MethodPut).Name("UpdateGroupV1")
+	router.Handle(fmt.Sprintf("/api/v1/groups/{id:%s}", uuidRE), DeleteGroupV1).Methods(http.MethodDelete).Name("DeleteGroupV1")
+
+	router.Handle("/api/v1/groupMembers", CreateGroupMemberV1).Methods(http.MethodPost).Name("CreateGroupMemberV1")
+	router.Handle("/api/v1/groupMembers", GetGroupMembersV1).Methods(http.MethodGet).Name("GetGroupMembersV1")
+	router.Handle(fmt.Sprintf("/api/v1/groupMembers/{id:%s}", uuidRE), DeleteGroupMemberV1).Methods(http.MethodDelete).Name("DeleteGroupMemberV1")
+
+	router.Handle("/api/v1/groupRoles", CreateGroupRoleV1).Methods(http.MethodPost).Name("CreateGroupRoleV1")
+	router.Handle("/api/v1/groupRoles", GetGroupRolesV1).Methods(http.MethodGet).Name("GetGroupRolesV1")
+	router.Handle(fmt.Sprintf("/api/v1/groupRoles/{id:%s}", uuidRE), DeleteGroupRoleV1).Methods(http.MethodDelete).Name("DeleteGroupRoleV1")
+
+	router.Handle("/api/v1/phoneConfirmations", CreatePhoneConfirmationV1).Methods(http.MethodPost).Name("CreatePhoneConfirmationV1")
+	router.Handle("/api/v1/phones", CreatePhoneV1).Methods(http.MethodPost).Name("CreatePhoneV1")
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/phones", uuidRE), GetUserPhonesV1).Methods(http.MethodGet).Name("GetUserPhonesV1")
+	router.Handle(fmt.Sprintf("/api/v1/phones/{id:%s}", uuidRE), DeletePhoneV1).Methods(http.MethodDelete).Name("DeletePhoneV1")
+	router.Handle(fmt.Sprintf("/api/v1/phones/{id:%s}/primary", uuidRE), SetPrimaryPhoneV1).Methods(http.MethodPut).Name("SetPrimaryPhoneV1")
+
+	router.Handle(fmt.Sprintf("/api/v1/users/{id:%s}/contactTransfers", uuidRE), GetUserContactTransfersV1).Methods(http.MethodGet).Name("GetUserContactTransfersV1")
+	router.Handle(fmt.Sprintf("/api/v1/contactTransfers/{id:%s}", uuidRE), GetContactTransferV1).Methods(http.MethodGet).Name("GetContactTransferV1")
+	router.Handle(fmt.Sprintf("/api/v1/contactTransfers/{id:%s}", uuidRE), ReviewContactTransferV1).Methods(http.MethodPut).Name("ReviewContactTransferV1")
+
+	router.Handle("/api/v1/sessions", CreateSessionV1).Methods(http.MethodPost).Name("CreateSessionV1")
+
+	router.Handle("/api/v1/secrets", GetSecretsV1).Methods(http.MethodGet).Name("GetSecretsV1")
+	router.Handle("/api/v1/secrets", CreateSecretV1).Methods(http.MethodPost).Name("CreateSecretV1")
+	router.Handle(fmt.Sprintf("/api/v1/secrets/{id:%s}", uuidRE), GetSecretV1).Methods(http.MethodGet).Name("GetSecretV1")
+	router.Handle(fmt.Sprintf("/api/v1/secrets/{id:%s}", uuidRE), DeleteSecretV1).Methods(http.MethodDelete).Name("DeleteSecretV1")
+
+	router.Handle("/api/v1/authorize", AuthorizeV1).Methods(http.MethodPost).Name("AuthorizeV1")
+
+	router.Handle("/views/v1/users", GetUsersViewV1).Methods(http.MethodGet).Name("GetUsersViewV1")
+	router.Handle("/views/v1/users/search", SearchUsersViewV1).Methods(http.MethodGet).Name("SearchUsersViewV1")
+	router.Handle(fmt.Sprintf("/views/v1/users/{id:%s}", uuidRE), GetUserViewV1).Methods(http.MethodGet).Name("GetUserViewV1")
 
 	// Middleware
 
@@ -124,7 +266,7 @@ func server() error {
 
 	// Finish
 
-	http.Handle("/", router)
+	http.Handle("/", middlewares.RealmMiddleware(realms)(router))
 
 	log.Log().Msg(fmt.Sprintf("Server starting at address %s", environment.ServerAddress))
 	err := http.ListenAndServe(environment.ServerAddress, nil)
@@ -150,6 +292,82 @@ func migrate() error {
 	return db.Close()
 }
 
+func reencryptSecrets() error {
+	environment := config.InitEnvironment()
+	realms := config.InitRealms(environment)
+	pool := config.InitPool(nil, environment)
+	redis := config.InitRedis(environment)
+	inMemoryCache := config.InitInMemoryCache()
+	secretEncryptor := secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(environment))
+	postgresRepo := postgresRepository.InitPostgresRepository(pool, environment, secretEncryptor)
+	redisRepo := redisRepository.InitRedisRepository(redis, secretEncryptor)
+	inMemoryRepo := inMemoryRepository.InitInMemoryRepository(inMemoryCache)
+	store := stores.InitStore(pool, redis, inMemoryCache, environment, postgresRepo, redisRepo, inMemoryRepo)
+
+	for _, realm := range realms.List() {
+		identifiers := store.ReencryptSecrets(repositories.SetRealmToContext(context.Background(), realm))
+		log.Log().Msg(fmt.Sprintf("%d secrets of realm %s encrypted with master key %s", len(identifiers), realm.Name, secretEncryptor.GetKeyID()))
+	}
+
+	pool.Close()
+	return redis.Close()
+}
+
+// exportUser writes everything hive holds for user to output synchronously, unlike export requested with API
+func exportUser(userID string, realmName string, format string, output string) error {
+	environment := config.InitEnvironment()
+	realms := config.InitRealms(environment)
+	realm := realms.GetRealm(realmName)
+	if realm == nil {
+		return errors.New(fmt.Sprintf("realm %s is not declared", realmName))
+	}
+
+	id, err := uuid.FromString(userID)
+	if err != nil {
+		return err
+	}
+
+	pool := config.InitPool(nil, environment)
+	redis := config.InitRedis(environment)
+	inMemoryCache := config.InitInMemoryCache()
+	secretEncryptor := secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(environment))
+	postgresRepo := postgresRepository.InitPostgresRepository(pool, environment, secretEncryptor)
+	redisRepo := redisRepository.InitRedisRepository(redis, secretEncryptor)
+	inMemoryRepo := inMemoryRepository.InitInMemoryRepository(inMemoryCache)
+	store := stores.InitStore(pool, redis, inMemoryCache, environment, postgresRepo, redisRepo, inMemoryRepo)
+	defer pool.Close()
+	defer redis.Close()
+
+	status, data := store.GetUserExportData(repositories.SetRealmToContext(context.Background(), realm), id)
+	switch status {
+	case enums.Ok:
+	case enums.UserNotFound:
+		return errors.New(fmt.Sprintf("user %s is not found in realm %s", userID, realmName))
+	default:
+		return errors.New(fmt.Sprintf("user %s could not be exported, status %d", userID, status))
+	}
+
+	var content []byte
+	switch format {
+	case "json":
+		content, err = protojson.Marshal(controllers.UserExportDataToProto(data))
+	case "protobuf":
+		content, err = proto.Marshal(controllers.UserExportDataToProto(data))
+	default:
+		return errors.New(fmt.Sprintf("unknown format %s", format))
+	}
+	if err != nil {
+		return err
+	}
+
+	if output == "" {
+		_, err = os.Stdout.Write(content)
+		return err
+	}
+
+	return ioutil.WriteFile(output, content, 0600)
+}
+
 func main() {
 	app := &cli.App{
 		Name:  "hive",
@@ -165,6 +383,26 @@ func main() {
 					return migrate()
 				},
 			},
+			{
+				Name:  "reencrypt-secrets",
+				Usage: "Encrypt signing secrets with current master key, run it after master key rotation",
+				Action: func(c *cli.Context) error {
+					return reencryptSecrets()
+				},
+			},
+			{
+				Name:  "export-user",
+				Usage: "Export everything stored for user, answers subject access requests",
+				Flags: []cli.Flag{
+					&cli.StringFlag{Name: "user", Usage: "Identifier of exported user", Required: true},
+					&cli.StringFlag{Name: "realm", Usage: "Realm of exported user", Value: config.DefaultRealm},
+					&cli.StringFlag{Name: "format", Usage: "Format of archive, json or protobuf", Value: "json"},
+					&cli.StringFlag{Name: "output", Usage: "Archive file, standard output by default"},
+				},
+				Action: func(c *cli.Context) error {
+					return exportUser(c.String("user"), c.String("realm"), c.String("format"), c.String("output"))
+				},
+			},
 		},
 	}
 
@@ -17,6 +17,10 @@ func AuthenticationMiddleware(authenticationController auth.IAuthenticationContr
 				ctx = repositories.SetUserToContext(ctx, user)
 				r = r.WithContext(ctx)
 				next.ServeHTTP(w, r)
+			} else if status == enums.DPoPNonceRequired {
+				w.Header().Set(auth.DPoPNonceHeader, authenticationController.CreateDPoPNonce(ctx))
+				w.Header().Set("WWW-Authenticate", `DPoP error="use_dpop_nonce"`)
+				w.WriteHeader(http.StatusUnauthorized)
 			} else {
 				w.WriteHeader(http.StatusUnauthorized)
 			}
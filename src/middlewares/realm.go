@@ -0,0 +1,27 @@
+package middlewares
+
+import (
+	"hive/config"
+	"hive/repositories"
+	"net/http"
+)
+
+// RealmMiddleware selects realm by host or path prefix of request and strips the prefix,
+// so it must wrap router instead of being used as router middleware
+func RealmMiddleware(realms *config.Realms) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			realm, path := realms.Resolve(r.Host, r.URL.Path)
+			r = r.WithContext(repositories.SetRealmToContext(r.Context(), realm))
+
+			if path != r.URL.Path {
+				url := *r.URL
+				url.Path = path
+				url.RawPath = ""
+				r.URL = &url
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
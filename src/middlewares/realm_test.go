@@ -0,0 +1,67 @@
+package middlewares
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	"hive/config"
+	"hive/repositories"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func initRealms(t *testing.T) *config.Realms {
+	file, err := ioutil.TempFile("", "realms*.json")
+	require.Nil(t, err)
+	defer os.Remove(file.Name())
+
+	_, err = file.WriteString(`[
+		{"name": "shop", "hosts": ["shop.local"], "accessTokenLifetime": 5},
+		{"name": "games", "pathPrefix": "/realms/games/"}
+	]`)
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	return config.InitRealms(&config.Environment{RealmsFile: file.Name()})
+}
+
+func TestRealmMiddleware(t *testing.T) {
+	t.Parallel()
+	realms := initRealms(t)
+
+	for _, testCase := range []struct {
+		target string
+		realm  string
+		path   string
+	}{
+		{"http://shop.local:8080/api/v1/users", "shop", "/api/v1/users"},
+		{"http://hive.local/realms/games/api/v1/users", "games", "/api/v1/users"},
+		{"http://hive.local/realms/gamesx/api/v1/users", config.DefaultRealm, "/realms/gamesx/api/v1/users"},
+		{"http://hive.local/api/v1/users", config.DefaultRealm, "/api/v1/users"},
+	} {
+		var realm *config.Realm
+		var path string
+
+		handler := RealmMiddleware(realms)(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			realm = repositories.GetRealmFromContext(r.Context())
+			path = r.URL.Path
+		}))
+
+		request := httptest.NewRequest(http.MethodGet, testCase.target, bytes.NewReader([]byte{}))
+		handler.ServeHTTP(httptest.NewRecorder(), request)
+		require.Equal(t, testCase.realm, realm.Name)
+		require.Equal(t, testCase.path, path)
+	}
+}
+
+func TestRealmLifetimes(t *testing.T) {
+	t.Parallel()
+	realms := initRealms(t)
+	environment := &config.Environment{AccessTokenLifetime: 15}
+
+	require.Equal(t, int64(5), realms.GetRealm("shop").GetAccessTokenLifetime(environment))
+	require.Equal(t, int64(15), realms.GetRealm("games").GetAccessTokenLifetime(environment))
+	require.Equal(t, int64(15), realms.Default.GetAccessTokenLifetime(environment))
+}
@@ -0,0 +1,20 @@
+package middlewares
+
+import (
+	"hive/functools"
+	"hive/repositories"
+	"net/http"
+)
+
+// RequirePermission allows request only for users with permission, it must be wrapped by AuthenticationMiddleware
+func RequirePermission(next http.Handler, permission string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := repositories.GetUserFromContext(r.Context())
+
+		if user != nil && functools.HasPermission(user.GetPermissions(), permission) {
+			next.ServeHTTP(w, r)
+		} else {
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+}
@@ -0,0 +1,54 @@
+package middlewares
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	uuid "github.com/satori/go.uuid"
+	"hive/auth/backends"
+	"hive/enums"
+	"hive/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestRequirePermission(t *testing.T) {
+	t.Parallel()
+	handler := RequirePermission(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), enums.UsersDelete)
+
+	for _, testCase := range []struct {
+		permissions []string
+		status      int
+	}{
+		{[]string{enums.UsersDelete}, http.StatusNoContent},
+		{[]string{"users:*"}, http.StatusNoContent},
+		{[]string{enums.AllPermissions}, http.StatusNoContent},
+		{[]string{enums.UsersRead}, http.StatusForbidden},
+		{nil, http.StatusForbidden},
+	} {
+		request := httptest.NewRequest(http.MethodDelete, "/", bytes.NewReader([]byte{}))
+		request = request.WithContext(repositories.SetUserToContext(request.Context(), &backends.BasicAuthenticationBackendUser{
+			UserID:      uuid.NewV4(),
+			Permissions: testCase.permissions,
+		}))
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, request)
+		require.Equal(t, testCase.status, recorder.Result().StatusCode)
+	}
+}
+
+func TestRequirePermissionWithoutUser(t *testing.T) {
+	t.Parallel()
+	handler := RequirePermission(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), enums.UsersDelete)
+
+	request := httptest.NewRequest(http.MethodDelete, "/", bytes.NewReader([]byte{}))
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusForbidden, recorder.Result().StatusCode)
+}
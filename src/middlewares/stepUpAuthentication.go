@@ -0,0 +1,53 @@
+package middlewares
+
+import (
+	"hive/auth"
+	"hive/config"
+	"hive/enums"
+	"hive/inout"
+	"hive/models"
+	"hive/presenters"
+	"hive/repositories"
+	"fmt"
+	"net/http"
+)
+
+// StepUpAuthenticationMiddleware requires recent authentication for sensitive operations,
+// it must be wrapped by AuthenticationMiddleware, see RFC 9470 for challenge format
+func StepUpAuthenticationMiddleware(environment *config.Environment) func(http.Handler, bool) http.Handler {
+	renderer := presenters.InitRenderer()
+
+	return func(next http.Handler, secondFactorRequired bool) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			user := repositories.GetUserFromContext(r.Context())
+
+			status := auth.CheckAuthenticationContext(user, environment.StepUpMaxAge, secondFactorRequired)
+			if status == enums.Ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			level := enums.SingleFactorAuthenticationLevel
+			if secondFactorRequired {
+				level = enums.MultiFactorAuthenticationLevel
+			}
+
+			response := &inout.ReauthenticationRequiredResponseV1{
+				MaxAge:               environment.StepUpMaxAge,
+				Acr:                  level,
+				SecondFactorRequired: status == enums.SecondFactorRequired,
+			}
+
+			if authenticationContextUser, ok := user.(models.IAuthenticationContextUser); ok {
+				authentication := authenticationContextUser.GetAuthenticationContext()
+				response.AuthTime = authentication.Time
+				response.Amr = authentication.Methods
+			}
+
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(
+				`Bearer error="insufficient_user_authentication", error_description="Reauthentication required", acr_values="%s", max_age=%d`,
+				level, environment.StepUpMaxAge))
+			renderer.Render(w, r, http.StatusUnauthorized, response)
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package middlewares
+
+import (
+	"bytes"
+	"github.com/stretchr/testify/require"
+	uuid "github.com/satori/go.uuid"
+	"hive/auth/backends"
+	"hive/config"
+	"hive/enums"
+	"hive/repositories"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestStepUpAuthenticationMiddleware(t *testing.T) {
+	t.Parallel()
+	middleware := StepUpAuthenticationMiddleware(config.InitEnvironment())
+
+	handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), false)
+
+	for _, testCase := range []struct {
+		authTime int64
+		status   int
+	}{
+		{time.Now().Unix(), http.StatusNoContent},
+		{time.Now().Add(-time.Hour).Unix(), http.StatusUnauthorized},
+		{0, http.StatusUnauthorized},
+	} {
+		request := httptest.NewRequest(http.MethodDelete, "/", bytes.NewReader([]byte{}))
+		request.Header.Set(repositories.ContentType, string(enums.JSONContentType))
+		request = request.WithContext(repositories.SetUserToContext(request.Context(), &backends.BasicAuthenticationBackendUser{
+			UserID:   uuid.NewV4(),
+			Methods:  []string{enums.PasswordAuthenticationMethod},
+			AuthTime: testCase.authTime,
+		}))
+		recorder := httptest.NewRecorder()
+
+		handler.ServeHTTP(recorder, request)
+		require.Equal(t, testCase.status, recorder.Result().StatusCode)
+	}
+}
+
+func TestStepUpAuthenticationMiddlewareWithSecondFactor(t *testing.T) {
+	t.Parallel()
+	middleware := StepUpAuthenticationMiddleware(config.InitEnvironment())
+
+	handler := middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}), true)
+
+	request := httptest.NewRequest(http.MethodDelete, "/", bytes.NewReader([]byte{}))
+	request.Header.Set(repositories.ContentType, string(enums.JSONContentType))
+	request = request.WithContext(repositories.SetUserToContext(request.Context(), &backends.BasicAuthenticationBackendUser{
+		UserID:   uuid.NewV4(),
+		Methods:  []string{enums.PasswordAuthenticationMethod},
+		AuthTime: time.Now().Unix(),
+	}))
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, request)
+	require.Equal(t, http.StatusUnauthorized, recorder.Result().StatusCode)
+	require.Contains(t, recorder.Header().Get("WWW-Authenticate"), "insufficient_user_authentication")
+	require.Contains(t, recorder.Body.String(), "secondFactorRequired")
+}
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE secrets
+    ADD COLUMN expires BIGINT,
+    ADD COLUMN revoked BIGINT;
+
+UPDATE secrets
+SET expires = created + 1440 * 60 * 1000
+WHERE expires IS NULL;
+
+CREATE INDEX secrets_created_idx ON secrets (created);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX secrets_created_idx;
+
+ALTER TABLE secrets
+    DROP COLUMN expires,
+    DROP COLUMN revoked;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- Plain text values are kept until "hive reencrypt-secrets" command encrypts them with master key
+
+ALTER TABLE secrets
+    ADD COLUMN encrypted_value BYTEA,
+    ADD COLUMN master_key_id   VARCHAR(16);
+
+CREATE INDEX secrets_master_key_id_idx ON secrets (master_key_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX secrets_master_key_id_idx;
+
+ALTER TABLE secrets
+    DROP COLUMN encrypted_value,
+    DROP COLUMN master_key_id;
+-- +goose StatementEnd
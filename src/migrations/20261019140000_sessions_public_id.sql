@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Session id is used as refresh token, so access tokens reference session by public id
+ALTER TABLE sessions ADD COLUMN public_id UUID;
+UPDATE sessions SET public_id = md5(random()::text || id::text)::uuid;
+ALTER TABLE sessions ALTER COLUMN public_id SET NOT NULL;
+CREATE UNIQUE INDEX sessions_public_id_idx ON sessions (public_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX sessions_public_id_idx;
+ALTER TABLE sessions DROP COLUMN public_id;
+-- +goose StatementEnd
@@ -0,0 +1,10 @@
+-- +goose Up
+-- +goose StatementBegin
+-- JWK thumbprint of DPoP key which session tokens are bound to
+ALTER TABLE sessions ADD COLUMN key_thumbprint VARCHAR(64) NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions DROP COLUMN key_thumbprint;
+-- +goose StatementEnd
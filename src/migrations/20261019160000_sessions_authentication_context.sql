@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Time and methods of authentication which session was created by, inherited by refreshed sessions
+ALTER TABLE sessions ADD COLUMN auth_time BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE sessions ADD COLUMN auth_methods VARCHAR(16)[] NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions DROP COLUMN auth_methods;
+ALTER TABLE sessions DROP COLUMN auth_time;
+-- +goose StatementEnd
@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE roles ADD COLUMN permissions VARCHAR(128)[] NOT NULL DEFAULT '{}';
+ALTER TABLE users_view ADD COLUMN permissions TEXT[] NOT NULL DEFAULT '{}';
+
+-- Admin role keeps full access
+UPDATE roles SET permissions = '{*}' WHERE title = 'admin';
+
+UPDATE users_view uv
+SET permissions = p.permissions
+FROM (SELECT ur.user_id, array_agg(DISTINCT rp.permission)::text[] AS permissions
+      FROM user_roles ur
+               JOIN roles r ON ur.role_id = r.id
+               JOIN LATERAL unnest(r.permissions) AS rp(permission) ON true
+      GROUP BY ur.user_id) AS p
+WHERE uv.id = p.user_id;
+
+CREATE INDEX user_views_permissions_idx ON users_view USING GIN (permissions);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_views_permissions_idx;
+ALTER TABLE users_view DROP COLUMN permissions;
+ALTER TABLE roles DROP COLUMN permissions;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE role_parents
+(
+    created   BIGINT DEFAULT extract(epoch from now()) * 1000,
+    role_id   UUID NOT NULL,
+    parent_id UUID NOT NULL,
+    PRIMARY KEY (role_id, parent_id),
+    CHECK (role_id <> parent_id),
+    FOREIGN KEY (role_id) REFERENCES roles ON DELETE CASCADE,
+    FOREIGN KEY (parent_id) REFERENCES roles ON DELETE CASCADE
+);
+
+CREATE INDEX role_parents_parent_id_idx ON role_parents (parent_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE role_parents;
+-- +goose StatementEnd
@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE organizations
+(
+    id      UUID PRIMARY KEY,
+    created BIGINT DEFAULT extract(epoch from now()) * 1000,
+    title   VARCHAR(255) UNIQUE NOT NULL
+);
+
+CREATE TABLE organization_members
+(
+    id              UUID PRIMARY KEY,
+    created         BIGINT DEFAULT extract(epoch from now()) * 1000,
+    organization_id UUID NOT NULL,
+    user_id         UUID NOT NULL,
+    UNIQUE (organization_id, user_id),
+    FOREIGN KEY (organization_id) REFERENCES organizations ON DELETE CASCADE,
+    FOREIGN KEY (user_id) REFERENCES users ON DELETE CASCADE
+);
+
+CREATE INDEX ON organization_members (user_id);
+
+-- Roles scoped to organization could be granted only to its members and are revoked with membership
+ALTER TABLE user_roles ADD COLUMN organization_id UUID;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_organization_member_fkey
+    FOREIGN KEY (organization_id, user_id) REFERENCES organization_members (organization_id, user_id) ON DELETE CASCADE;
+
+DROP INDEX user_roles_idx;
+CREATE UNIQUE INDEX user_roles_idx ON user_roles (user_id, role_id) WHERE organization_id IS NULL;
+CREATE UNIQUE INDEX user_roles_organization_idx ON user_roles (user_id, role_id, organization_id) WHERE organization_id IS NOT NULL;
+
+ALTER TABLE users_view ADD COLUMN organizations JSONB NOT NULL DEFAULT '[]';
+
+-- Organization selected at session creation, carried by access tokens
+ALTER TABLE sessions ADD COLUMN organization_id UUID REFERENCES organizations ON DELETE CASCADE;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sessions DROP COLUMN organization_id;
+ALTER TABLE users_view DROP COLUMN organizations;
+DROP INDEX user_roles_organization_idx;
+DROP INDEX user_roles_idx;
+DELETE FROM user_roles WHERE organization_id IS NOT NULL;
+ALTER TABLE user_roles DROP COLUMN organization_id;
+CREATE UNIQUE INDEX user_roles_idx ON user_roles (user_id, role_id);
+DROP TABLE organization_members;
+DROP TABLE organizations;
+-- +goose StatementEnd
@@ -0,0 +1,134 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Realms are isolated user pools, existing data belongs to default realm. Composite foreign keys
+-- guarantee that related rows never belong to different realms
+ALTER TABLE users ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE users ADD CONSTRAINT users_realm_id_key UNIQUE (realm, id);
+
+ALTER TABLE roles ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE roles ADD CONSTRAINT roles_realm_id_key UNIQUE (realm, id);
+ALTER TABLE roles DROP CONSTRAINT roles_title_key;
+ALTER TABLE roles ADD CONSTRAINT roles_title_key UNIQUE (realm, title);
+
+ALTER TABLE organizations ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE organizations ADD CONSTRAINT organizations_realm_id_key UNIQUE (realm, id);
+ALTER TABLE organizations DROP CONSTRAINT organizations_title_key;
+ALTER TABLE organizations ADD CONSTRAINT organizations_title_key UNIQUE (realm, title);
+
+ALTER TABLE secrets ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE secrets ADD CONSTRAINT secrets_realm_id_key UNIQUE (realm, id);
+
+ALTER TABLE emails ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE emails DROP CONSTRAINT emails_value_key;
+ALTER TABLE emails ADD CONSTRAINT emails_value_key UNIQUE (realm, value);
+ALTER TABLE emails DROP CONSTRAINT emails_user_id_fkey;
+ALTER TABLE emails ADD CONSTRAINT emails_user_id_fkey
+    FOREIGN KEY (realm, user_id) REFERENCES users (realm, id) ON DELETE CASCADE;
+
+ALTER TABLE phones ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE phones DROP CONSTRAINT phones_value_key;
+ALTER TABLE phones ADD CONSTRAINT phones_value_key UNIQUE (realm, value);
+ALTER TABLE phones DROP CONSTRAINT phones_user_id_fkey;
+ALTER TABLE phones ADD CONSTRAINT phones_user_id_fkey
+    FOREIGN KEY (realm, user_id) REFERENCES users (realm, id) ON DELETE CASCADE;
+
+ALTER TABLE user_roles ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE user_roles DROP CONSTRAINT user_roles_user_id_fkey;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_user_id_fkey
+    FOREIGN KEY (realm, user_id) REFERENCES users (realm, id) ON DELETE CASCADE;
+ALTER TABLE user_roles DROP CONSTRAINT user_roles_role_id_fkey;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_role_id_fkey
+    FOREIGN KEY (realm, role_id) REFERENCES roles (realm, id) ON DELETE CASCADE;
+
+ALTER TABLE role_parents ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE role_parents DROP CONSTRAINT role_parents_role_id_fkey;
+ALTER TABLE role_parents ADD CONSTRAINT role_parents_role_id_fkey
+    FOREIGN KEY (realm, role_id) REFERENCES roles (realm, id) ON DELETE CASCADE;
+ALTER TABLE role_parents DROP CONSTRAINT role_parents_parent_id_fkey;
+ALTER TABLE role_parents ADD CONSTRAINT role_parents_parent_id_fkey
+    FOREIGN KEY (realm, parent_id) REFERENCES roles (realm, id) ON DELETE CASCADE;
+
+ALTER TABLE organization_members ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE organization_members DROP CONSTRAINT organization_members_organization_id_fkey;
+ALTER TABLE organization_members ADD CONSTRAINT organization_members_organization_id_fkey
+    FOREIGN KEY (realm, organization_id) REFERENCES organizations (realm, id) ON DELETE CASCADE;
+ALTER TABLE organization_members DROP CONSTRAINT organization_members_user_id_fkey;
+ALTER TABLE organization_members ADD CONSTRAINT organization_members_user_id_fkey
+    FOREIGN KEY (realm, user_id) REFERENCES users (realm, id) ON DELETE CASCADE;
+
+ALTER TABLE sessions ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+ALTER TABLE sessions DROP CONSTRAINT sessions_user_id_fkey;
+ALTER TABLE sessions ADD CONSTRAINT sessions_user_id_fkey
+    FOREIGN KEY (realm, user_id) REFERENCES users (realm, id) ON DELETE CASCADE;
+ALTER TABLE sessions DROP CONSTRAINT sessions_secret_id_fkey;
+ALTER TABLE sessions ADD CONSTRAINT sessions_secret_id_fkey
+    FOREIGN KEY (realm, secret_id) REFERENCES secrets (realm, id) ON DELETE CASCADE;
+ALTER TABLE sessions DROP CONSTRAINT sessions_organization_id_fkey;
+ALTER TABLE sessions ADD CONSTRAINT sessions_organization_id_fkey
+    FOREIGN KEY (realm, organization_id) REFERENCES organizations (realm, id) ON DELETE CASCADE;
+
+ALTER TABLE users_view ADD COLUMN realm VARCHAR(64) NOT NULL DEFAULT 'default';
+CREATE INDEX users_view_realm_idx ON users_view (realm);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX users_view_realm_idx;
+ALTER TABLE users_view DROP COLUMN realm;
+
+ALTER TABLE sessions DROP CONSTRAINT sessions_organization_id_fkey;
+ALTER TABLE sessions DROP CONSTRAINT sessions_secret_id_fkey;
+ALTER TABLE sessions DROP CONSTRAINT sessions_user_id_fkey;
+ALTER TABLE sessions DROP COLUMN realm;
+ALTER TABLE sessions ADD CONSTRAINT sessions_user_id_fkey FOREIGN KEY (user_id) REFERENCES users ON DELETE CASCADE;
+ALTER TABLE sessions ADD CONSTRAINT sessions_secret_id_fkey FOREIGN KEY (secret_id) REFERENCES secrets ON DELETE CASCADE;
+ALTER TABLE sessions ADD CONSTRAINT sessions_organization_id_fkey FOREIGN KEY (organization_id) REFERENCES organizations ON DELETE CASCADE;
+
+ALTER TABLE organization_members DROP CONSTRAINT organization_members_user_id_fkey;
+ALTER TABLE organization_members DROP CONSTRAINT organization_members_organization_id_fkey;
+ALTER TABLE organization_members DROP COLUMN realm;
+ALTER TABLE organization_members ADD CONSTRAINT organization_members_organization_id_fkey
+    FOREIGN KEY (organization_id) REFERENCES organizations ON DELETE CASCADE;
+ALTER TABLE organization_members ADD CONSTRAINT organization_members_user_id_fkey
+    FOREIGN KEY (user_id) REFERENCES users ON DELETE CASCADE;
+
+ALTER TABLE role_parents DROP CONSTRAINT role_parents_parent_id_fkey;
+ALTER TABLE role_parents DROP CONSTRAINT role_parents_role_id_fkey;
+ALTER TABLE role_parents DROP COLUMN realm;
+ALTER TABLE role_parents ADD CONSTRAINT role_parents_role_id_fkey FOREIGN KEY (role_id) REFERENCES roles ON DELETE CASCADE;
+ALTER TABLE role_parents ADD CONSTRAINT role_parents_parent_id_fkey FOREIGN KEY (parent_id) REFERENCES roles ON DELETE CASCADE;
+
+ALTER TABLE user_roles DROP CONSTRAINT user_roles_role_id_fkey;
+ALTER TABLE user_roles DROP CONSTRAINT user_roles_user_id_fkey;
+ALTER TABLE user_roles DROP COLUMN realm;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_user_id_fkey FOREIGN KEY (user_id) REFERENCES users ON DELETE CASCADE;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_role_id_fkey FOREIGN KEY (role_id) REFERENCES roles ON DELETE CASCADE;
+
+ALTER TABLE phones DROP CONSTRAINT phones_user_id_fkey;
+ALTER TABLE phones DROP CONSTRAINT phones_value_key;
+ALTER TABLE phones DROP COLUMN realm;
+ALTER TABLE phones ADD CONSTRAINT phones_value_key UNIQUE (value);
+ALTER TABLE phones ADD CONSTRAINT phones_user_id_fkey FOREIGN KEY (user_id) REFERENCES users ON DELETE CASCADE;
+
+ALTER TABLE emails DROP CONSTRAINT emails_user_id_fkey;
+ALTER TABLE emails DROP CONSTRAINT emails_value_key;
+ALTER TABLE emails DROP COLUMN realm;
+ALTER TABLE emails ADD CONSTRAINT emails_value_key UNIQUE (value);
+ALTER TABLE emails ADD CONSTRAINT emails_user_id_fkey FOREIGN KEY (user_id) REFERENCES users ON DELETE CASCADE;
+
+ALTER TABLE secrets DROP CONSTRAINT secrets_realm_id_key;
+ALTER TABLE secrets DROP COLUMN realm;
+
+ALTER TABLE organizations DROP CONSTRAINT organizations_title_key;
+ALTER TABLE organizations DROP CONSTRAINT organizations_realm_id_key;
+ALTER TABLE organizations DROP COLUMN realm;
+ALTER TABLE organizations ADD CONSTRAINT organizations_title_key UNIQUE (title);
+
+ALTER TABLE roles DROP CONSTRAINT roles_title_key;
+ALTER TABLE roles DROP CONSTRAINT roles_realm_id_key;
+ALTER TABLE roles DROP COLUMN realm;
+ALTER TABLE roles ADD CONSTRAINT roles_title_key UNIQUE (title);
+
+ALTER TABLE users DROP CONSTRAINT users_realm_id_key;
+ALTER TABLE users DROP COLUMN realm;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Version is incremented on every change of user view, so data derived from the view
+-- can be cached under its version
+ALTER TABLE users_view ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users_view DROP COLUMN version;
+-- +goose StatementEnd
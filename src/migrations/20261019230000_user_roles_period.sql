@@ -0,0 +1,26 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Assignments are active from starts_at until expires_at, both are milliseconds and null means unbounded.
+-- Started is set when user views were recalculated for assignment which was pending at creation
+ALTER TABLE user_roles ADD COLUMN starts_at BIGINT;
+ALTER TABLE user_roles ADD COLUMN expires_at BIGINT;
+ALTER TABLE user_roles ADD COLUMN started BOOLEAN NOT NULL DEFAULT true;
+ALTER TABLE user_roles ADD CONSTRAINT user_roles_period_check
+    CHECK (starts_at IS NULL OR expires_at IS NULL OR starts_at < expires_at);
+CREATE INDEX user_roles_starts_at_idx ON user_roles (starts_at) WHERE NOT started;
+CREATE INDEX user_roles_expires_at_idx ON user_roles (expires_at) WHERE expires_at IS NOT NULL;
+
+ALTER TABLE users_view ADD COLUMN roles_expires BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users_view DROP COLUMN roles_expires;
+
+DROP INDEX user_roles_expires_at_idx;
+DROP INDEX user_roles_starts_at_idx;
+ALTER TABLE user_roles DROP CONSTRAINT user_roles_period_check;
+ALTER TABLE user_roles DROP COLUMN started;
+ALTER TABLE user_roles DROP COLUMN expires_at;
+ALTER TABLE user_roles DROP COLUMN starts_at;
+-- +goose StatementEnd
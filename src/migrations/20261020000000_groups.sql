@@ -0,0 +1,49 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE groups
+(
+    id          UUID PRIMARY KEY,
+    created     BIGINT DEFAULT extract(epoch from now()) * 1000,
+    title       VARCHAR(255) NOT NULL,
+    description TEXT         NOT NULL DEFAULT '',
+    realm       VARCHAR(64)  NOT NULL DEFAULT 'default',
+    CONSTRAINT groups_title_key UNIQUE (realm, title),
+    CONSTRAINT groups_realm_id_key UNIQUE (realm, id)
+);
+
+CREATE TABLE group_members
+(
+    id       UUID PRIMARY KEY,
+    created  BIGINT DEFAULT extract(epoch from now()) * 1000,
+    group_id UUID        NOT NULL,
+    user_id  UUID        NOT NULL,
+    realm    VARCHAR(64) NOT NULL DEFAULT 'default',
+    CONSTRAINT group_members_group_id_user_id_key UNIQUE (group_id, user_id),
+    CONSTRAINT group_members_group_id_fkey FOREIGN KEY (realm, group_id) REFERENCES groups (realm, id) ON DELETE CASCADE,
+    CONSTRAINT group_members_user_id_fkey FOREIGN KEY (realm, user_id) REFERENCES users (realm, id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON group_members (user_id);
+
+-- Roles granted to group are effective roles of every its member
+CREATE TABLE group_roles
+(
+    id       UUID PRIMARY KEY,
+    created  BIGINT DEFAULT extract(epoch from now()) * 1000,
+    group_id UUID        NOT NULL,
+    role_id  UUID        NOT NULL,
+    realm    VARCHAR(64) NOT NULL DEFAULT 'default',
+    CONSTRAINT group_roles_group_id_role_id_key UNIQUE (group_id, role_id),
+    CONSTRAINT group_roles_group_id_fkey FOREIGN KEY (realm, group_id) REFERENCES groups (realm, id) ON DELETE CASCADE,
+    CONSTRAINT group_roles_role_id_fkey FOREIGN KEY (realm, role_id) REFERENCES roles (realm, id) ON DELETE CASCADE
+);
+
+CREATE INDEX ON group_roles (role_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE group_roles;
+DROP TABLE group_members;
+DROP TABLE groups;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Users having role could grant and revoke its managed roles without permissions to manage every user role
+CREATE TABLE role_managed_roles
+(
+    created         BIGINT DEFAULT extract(epoch from now()) * 1000,
+    role_id         UUID        NOT NULL,
+    managed_role_id UUID        NOT NULL,
+    realm           VARCHAR(64) NOT NULL DEFAULT 'default',
+    PRIMARY KEY (role_id, managed_role_id),
+    CONSTRAINT role_managed_roles_role_id_fkey FOREIGN KEY (realm, role_id) REFERENCES roles (realm, id) ON DELETE CASCADE,
+    CONSTRAINT role_managed_roles_managed_role_id_fkey FOREIGN KEY (realm, managed_role_id) REFERENCES roles (realm, id) ON DELETE CASCADE
+);
+
+CREATE INDEX role_managed_roles_managed_role_id_idx ON role_managed_roles (managed_role_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE role_managed_roles;
+-- +goose StatementEnd
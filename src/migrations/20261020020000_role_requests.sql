@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Requests of users for roles, approved request creates user role. Reviewer is kept even if deleted later
+CREATE TABLE role_requests
+(
+    id           UUID PRIMARY KEY,
+    created      BIGINT               DEFAULT extract(epoch from now()) * 1000,
+    user_id      UUID        NOT NULL,
+    role_id      UUID        NOT NULL,
+    reason       TEXT        NOT NULL,
+    status       VARCHAR(16) NOT NULL DEFAULT 'pending',
+    expires_at   BIGINT,
+    reviewer_id  UUID,
+    reviewed     BIGINT,
+    comment      TEXT        NOT NULL DEFAULT '',
+    user_role_id UUID,
+    realm        VARCHAR(64) NOT NULL DEFAULT 'default',
+    CONSTRAINT role_requests_status_check CHECK (status IN ('pending', 'approved', 'denied', 'cancelled')),
+    CONSTRAINT role_requests_user_id_fkey FOREIGN KEY (realm, user_id) REFERENCES users (realm, id) ON DELETE CASCADE,
+    CONSTRAINT role_requests_role_id_fkey FOREIGN KEY (realm, role_id) REFERENCES roles (realm, id) ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX role_requests_pending_idx ON role_requests (user_id, role_id) WHERE status = 'pending';
+CREATE INDEX role_requests_role_id_idx ON role_requests (role_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE role_requests;
+-- +goose StatementEnd
@@ -0,0 +1,12 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Profile attributes of users are validated against configured JSON Schema by application
+ALTER TABLE users ADD COLUMN profile JSONB NOT NULL DEFAULT '{}';
+ALTER TABLE users_view ADD COLUMN profile JSONB NOT NULL DEFAULT '{}';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users_view DROP COLUMN profile;
+ALTER TABLE users DROP COLUMN profile;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Search text of view joins emails, phones and profile attributes of user, trigram index serves
+-- substring matches and full-text index serves word matches
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+ALTER TABLE users_view ADD COLUMN search TEXT NOT NULL DEFAULT '';
+CREATE INDEX users_view_search_trgm_idx ON users_view USING GIN (search gin_trgm_ops);
+CREATE INDEX users_view_search_fts_idx ON users_view USING GIN (to_tsvector('simple', search));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX users_view_search_fts_idx;
+DROP INDEX users_view_search_trgm_idx;
+ALTER TABLE users_view DROP COLUMN search;
+-- +goose StatementEnd
@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Suspended users can't authenticate until suspended_until (milliseconds), blocked ones until they are activated
+ALTER TABLE users ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'active'
+    CHECK (status IN ('active', 'suspended', 'blocked'));
+ALTER TABLE users ADD COLUMN suspended_until BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN status_reason TEXT NOT NULL DEFAULT '';
+ALTER TABLE users ADD COLUMN status_actor_id UUID;
+ALTER TABLE users ADD COLUMN status_changed BIGINT NOT NULL DEFAULT 0;
+
+ALTER TABLE users_view ADD COLUMN status VARCHAR(16) NOT NULL DEFAULT 'active';
+ALTER TABLE users_view ADD COLUMN suspended_until BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users_view DROP COLUMN suspended_until;
+ALTER TABLE users_view DROP COLUMN status;
+
+ALTER TABLE users DROP COLUMN status_changed;
+ALTER TABLE users DROP COLUMN status_actor_id;
+ALTER TABLE users DROP COLUMN status_reason;
+ALTER TABLE users DROP COLUMN suspended_until;
+ALTER TABLE users DROP COLUMN status;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Exports of everything hive holds for user, archives are built in background and downloaded
+-- with token known only to requester, token_hash is SHA-256 of it
+CREATE TABLE user_exports
+(
+    id           UUID PRIMARY KEY,
+    created      BIGINT               DEFAULT extract(epoch from now()) * 1000,
+    user_id      UUID        NOT NULL,
+    requester_id UUID,
+    status       VARCHAR(16) NOT NULL DEFAULT 'pending',
+    token_hash   VARCHAR(64) NOT NULL,
+    completed    BIGINT      NOT NULL DEFAULT 0,
+    expires      BIGINT      NOT NULL DEFAULT 0,
+    archive      BYTEA,
+    realm        VARCHAR(64) NOT NULL DEFAULT 'default',
+    CONSTRAINT user_exports_status_check CHECK (status IN ('pending', 'processing', 'ready', 'failed')),
+    CONSTRAINT user_exports_user_id_fkey FOREIGN KEY (realm, user_id) REFERENCES users (realm, id) ON DELETE CASCADE
+);
+
+CREATE INDEX user_exports_user_id_idx ON user_exports (user_id);
+CREATE INDEX user_exports_pending_idx ON user_exports (created) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_exports;
+-- +goose StatementEnd
@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Users requested deletion are anonymized at deletion_scheduled (milliseconds) unless they log in before,
+-- anonymized users are kept as tombstones with deleted status for audit and foreign references
+ALTER TABLE users ADD COLUMN deletion_scheduled BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE users DROP CONSTRAINT users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check CHECK (status IN ('active', 'suspended', 'blocked', 'deleted'));
+CREATE INDEX users_deletion_scheduled_idx ON users (realm, deletion_scheduled) WHERE deletion_scheduled > 0;
+
+ALTER TABLE users_view ADD COLUMN deletion_scheduled BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users_view DROP COLUMN deletion_scheduled;
+
+DROP INDEX users_deletion_scheduled_idx;
+ALTER TABLE users DROP CONSTRAINT users_status_check;
+ALTER TABLE users ADD CONSTRAINT users_status_check CHECK (status IN ('active', 'suspended', 'blocked'));
+ALTER TABLE users DROP COLUMN deletion_scheduled;
+-- +goose StatementEnd
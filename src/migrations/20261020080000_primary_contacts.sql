@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every user has at most one primary email and phone, contacts are added only with confirmation code,
+-- so existing ones are verified when created. The earliest contact of user becomes primary
+ALTER TABLE emails ADD COLUMN is_primary BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE emails ADD COLUMN verified_at BIGINT NOT NULL DEFAULT 0;
+UPDATE emails SET verified_at = COALESCE(created, 0);
+UPDATE emails SET is_primary = true
+WHERE id IN (SELECT DISTINCT ON (user_id) id FROM emails ORDER BY user_id, created, id);
+CREATE UNIQUE INDEX emails_primary_idx ON emails (user_id) WHERE is_primary;
+
+ALTER TABLE phones ADD COLUMN is_primary BOOLEAN NOT NULL DEFAULT false;
+ALTER TABLE phones ADD COLUMN verified_at BIGINT NOT NULL DEFAULT 0;
+UPDATE phones SET verified_at = COALESCE(created, 0);
+UPDATE phones SET is_primary = true
+WHERE id IN (SELECT DISTINCT ON (user_id) id FROM phones ORDER BY user_id, created, id);
+CREATE UNIQUE INDEX phones_primary_idx ON phones (user_id) WHERE is_primary;
+
+ALTER TABLE users_view ADD COLUMN primary_email TEXT NOT NULL DEFAULT '';
+ALTER TABLE users_view ADD COLUMN primary_phone TEXT NOT NULL DEFAULT '';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE users_view DROP COLUMN primary_phone;
+ALTER TABLE users_view DROP COLUMN primary_email;
+
+DROP INDEX phones_primary_idx;
+ALTER TABLE phones DROP COLUMN verified_at;
+ALTER TABLE phones DROP COLUMN is_primary;
+
+DROP INDEX emails_primary_idx;
+ALTER TABLE emails DROP COLUMN verified_at;
+ALTER TABLE emails DROP COLUMN is_primary;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- History of email and phone ownership changes. Users are referenced without foreign keys, so history is kept
+-- after previous or new owner is deleted
+CREATE TABLE contact_transfers
+(
+    id           UUID PRIMARY KEY,
+    created      BIGINT               DEFAULT extract(epoch from now()) * 1000,
+    kind         VARCHAR(16) NOT NULL,
+    value        TEXT        NOT NULL,
+    from_user_id UUID        NOT NULL,
+    to_user_id   UUID        NOT NULL,
+    status       VARCHAR(16) NOT NULL DEFAULT 'pending',
+    resolved     BIGINT      NOT NULL DEFAULT 0,
+    realm        VARCHAR(64) NOT NULL DEFAULT 'default',
+    CONSTRAINT contact_transfers_kind_check CHECK (kind IN ('email', 'phone')),
+    CONSTRAINT contact_transfers_status_check CHECK (status IN ('pending', 'completed', 'rejected'))
+);
+
+CREATE UNIQUE INDEX contact_transfers_pending_idx ON contact_transfers (realm, kind, value, to_user_id) WHERE status = 'pending';
+CREATE INDEX contact_transfers_from_user_id_idx ON contact_transfers (from_user_id);
+CREATE INDEX contact_transfers_to_user_id_idx ON contact_transfers (to_user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE contact_transfers;
+-- +goose StatementEnd
@@ -8,7 +8,34 @@ import (
 type IAuthenticationBackendUser interface {
 	GetIsAdmin() bool
 	GetRoles() []string
+	GetPermissions() []string
 	GetUserID() uuid.UUID
 }
 
-type AccessTokenEncoder func(_ context.Context, userID uuid.UUID, roles []string, secret *Secret, expires int64) string
+// ISessionAuthenticationBackendUser is implemented by users authenticated with tokens bound to session
+type ISessionAuthenticationBackendUser interface {
+	IAuthenticationBackendUser
+	GetSessionID() uuid.UUID
+	GetFingerprintHash() string
+}
+
+// IAuthenticationContextUser is implemented by users which know how they were authenticated
+type IAuthenticationContextUser interface {
+	IAuthenticationBackendUser
+	GetAuthenticationContext() *AuthenticationContext
+}
+
+// IProofOfPossessionAuthenticationBackendUser is implemented by users authenticated with tokens which may be bound to DPoP key
+type IProofOfPossessionAuthenticationBackendUser interface {
+	IAuthenticationBackendUser
+	GetKeyThumbprint() string
+}
+
+// IOrganizationAuthenticationBackendUser is implemented by users authenticated with tokens which may carry active organization
+type IOrganizationAuthenticationBackendUser interface {
+	IAuthenticationBackendUser
+	GetOrganizationID() uuid.UUID
+	GetOrganizationPermissions() []string
+}
+
+type AccessTokenEncoder func(_ context.Context, user *UserView, session *Session, clientID string, secret *Secret) string
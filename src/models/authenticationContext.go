@@ -0,0 +1,27 @@
+package models
+
+import "hive/enums"
+
+// AuthenticationContext describes how and when user was authenticated, it is put to acr, amr and auth_time claims
+type AuthenticationContext struct {
+	Level   string
+	Methods []string
+	Time    int64
+}
+
+func InitAuthenticationContext(methods []string, time int64) *AuthenticationContext {
+	if methods == nil {
+		methods = []string{}
+	}
+
+	level := enums.SingleFactorAuthenticationLevel
+	if len(methods) > 1 {
+		level = enums.MultiFactorAuthenticationLevel
+	}
+
+	return &AuthenticationContext{
+		Level:   level,
+		Methods: methods,
+		Time:    time,
+	}
+}
@@ -0,0 +1,27 @@
+package models
+
+import uuid "github.com/satori/go.uuid"
+
+// AuthorizationCheck asks whether user may perform action on resource, that is whether permission
+// "<resource>:<action>" is granted to user globally or within organization if it is set
+type AuthorizationCheck struct {
+	Action         string
+	Resource       string
+	OrganizationID uuid.UUID
+}
+
+func (check *AuthorizationCheck) GetPermission() string {
+	return check.Resource + ":" + check.Action
+}
+
+type AuthorizationDecision struct {
+	Allowed bool
+	Reason  string
+}
+
+// Authorization is result of checks made against given version of user view
+type Authorization struct {
+	UserID    uuid.UUID
+	Version   int64
+	Decisions []*AuthorizationDecision
+}
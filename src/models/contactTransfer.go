@@ -0,0 +1,15 @@
+package models
+
+import uuid "github.com/satori/go.uuid"
+
+// ContactTransfer is a change of email or phone owner, completed transfers make ownership history
+type ContactTransfer struct {
+	Id         uuid.UUID
+	Created    int64
+	Kind       string // Email or phone
+	Value      string
+	FromUserId uuid.UUID // Previous owner
+	ToUserId   uuid.UUID
+	Status     string
+	Resolved   int64 // Zero until transfer is completed or rejected
+}
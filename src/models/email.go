@@ -9,6 +9,9 @@ type Email struct {
 	Created int64
 	UserId  uuid.UUID
 	Value   string
+	Primary bool // Every user has at most one primary email
+	// Milliseconds, moment when ownership of email was confirmed with code
+	VerifiedAt int64
 }
 
 type EmailConfirmation struct {
@@ -0,0 +1,24 @@
+package models
+
+import uuid "github.com/satori/go.uuid"
+
+type Group struct {
+	Id          uuid.UUID
+	Created     int64
+	Title       string
+	Description string
+}
+
+type GroupMember struct {
+	Id      uuid.UUID
+	Created int64
+	GroupId uuid.UUID
+	UserId  uuid.UUID
+}
+
+type GroupRole struct {
+	Id      uuid.UUID
+	Created int64
+	GroupId uuid.UUID
+	RoleId  uuid.UUID
+}
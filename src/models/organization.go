@@ -0,0 +1,16 @@
+package models
+
+import uuid "github.com/satori/go.uuid"
+
+type Organization struct {
+	Id      uuid.UUID
+	Created int64
+	Title   string
+}
+
+type OrganizationMember struct {
+	Id             uuid.UUID
+	Created        int64
+	OrganizationId uuid.UUID
+	UserId         uuid.UUID
+}
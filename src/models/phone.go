@@ -3,10 +3,13 @@ package models
 import uuid "github.com/satori/go.uuid"
 
 type Phone struct {
-	Id          uuid.UUID
-	Created     int64
-	UserId      uuid.UUID
-	Value       string
+	Id      uuid.UUID
+	Created int64
+	UserId  uuid.UUID
+	Value   string
+	Primary bool // Every user has at most one primary phone
+	// Milliseconds, moment when ownership of phone was confirmed with code
+	VerifiedAt int64
 }
 
 type PhoneConfirmation struct {
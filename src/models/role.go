@@ -3,7 +3,12 @@ package models
 import uuid "github.com/satori/go.uuid"
 
 type Role struct {
-	Id      uuid.UUID
-	Created int64
-	Title   string
+	Id          uuid.UUID
+	Created     int64
+	Title       string
+	Description string
+	Permissions []string
+	ParentsID   []uuid.UUID
+
+	ManagedRolesID []uuid.UUID // Roles which users having this role could grant and revoke
 }
@@ -0,0 +1,17 @@
+package models
+
+import uuid "github.com/satori/go.uuid"
+
+type RoleRequest struct {
+	Id         uuid.UUID
+	Created    int64
+	UserId     uuid.UUID
+	RoleId     uuid.UUID
+	Reason     string
+	Status     string
+	ExpiresAt  int64 // Milliseconds, expiry of granted role, zero if role is granted forever
+	ReviewerId uuid.UUID
+	Reviewed   int64 // Zero until request is approved, denied or cancelled
+	Comment    string
+	UserRoleId uuid.UUID // Role granted on approval
+}
@@ -6,4 +6,6 @@ type Secret struct {
 	Id      uuid.UUID
 	Created int64
 	Value   uuid.UUID
+	Expires int64 // Until this moment secret used for signing new access tokens
+	Revoked int64 // Zero if secret is not revoked
 }
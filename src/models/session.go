@@ -4,6 +4,7 @@ import uuid "github.com/satori/go.uuid"
 
 type Session struct {
 	Id           uuid.UUID
+	PublicID     uuid.UUID // Referenced by sid claim of access token, Id is used as refresh token
 	RefreshToken uuid.UUID
 	Fingerprint  string
 	UserID       uuid.UUID
@@ -12,4 +13,10 @@ type Session struct {
 	Expires      int64
 	UserAgent    string
 	AccessToken  string
+	// JWK thumbprint of DPoP key, empty for sessions with bearer tokens
+	KeyThumbprint string
+	AuthTime      int64
+	AuthMethods   []string
+	// Active organization of session, nil if organization is not selected
+	OrganizationID uuid.UUID
 }
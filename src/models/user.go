@@ -1,17 +1,74 @@
 package models
 
-import uuid "github.com/satori/go.uuid"
+import (
+	"hive/enums"
+	uuid "github.com/satori/go.uuid"
+)
 
 type User struct {
 	Id      uuid.UUID
 	Created int64
+	Profile map[string]interface{} // Attributes conforming to profile schema
+	Status  string
+	// Milliseconds, end of suspension, zero unless user is suspended
+	SuspendedUntil int64
+	StatusReason   string
+	StatusActorId  uuid.UUID // User who changed status, zero until status is changed
+	StatusChanged  int64
+	// Milliseconds, moment when user is anonymized, zero unless user requested deletion
+	DeletionScheduled int64
 }
 
 type UserView struct {
-	Id      uuid.UUID
-	Created int64
-	Roles   []string
-	Phones  []string
-	Emails  []string
-	RolesID []uuid.UUID
+	Id            uuid.UUID
+	Created       int64
+	Roles         []string
+	Phones        []string
+	Emails        []string
+	RolesID       []uuid.UUID
+	Permissions   []string
+	Organizations []*UserViewOrganization
+	// Version is incremented on every change of the view
+	Version int64
+	// Milliseconds, moment when the first of temporary roles expires, zero if user has none
+	RolesExpires int64
+	Profile      map[string]interface{}
+	Status       string
+	// Milliseconds, end of suspension, zero unless user is suspended
+	SuspendedUntil int64
+	// Milliseconds, moment when user is anonymized, zero unless user requested deletion
+	DeletionScheduled int64
+	PrimaryEmail      string // Empty if user has no emails
+	PrimaryPhone      string // Empty if user has no phones
+}
+
+// UserViewOrganization describes membership of user in organization with roles granted within it
+type UserViewOrganization struct {
+	Id          uuid.UUID `json:"id"`
+	Roles       []string  `json:"roles"`
+	Permissions []string  `json:"permissions"`
+}
+
+// GetOrganization returns membership of user in organization or nil if user is not a member
+func (user *UserView) GetOrganization(id uuid.UUID) *UserViewOrganization {
+	for _, organization := range user.Organizations {
+		if organization.Id == id {
+			return organization
+		}
+	}
+
+	return nil
+}
+
+// IsActive reports whether user is allowed to authenticate at the moment now in milliseconds.
+// Views cached before statuses were introduced have no status and belong to active users
+func (user *UserView) IsActive(now int64) bool {
+	switch user.Status {
+	case enums.UserBlocked, enums.UserDeleted:
+		return false
+	case enums.UserSuspended:
+		return user.SuspendedUntil <= now
+	default:
+		return true
+	}
 }
@@ -0,0 +1,28 @@
+package models
+
+import uuid "github.com/satori/go.uuid"
+
+type UserExport struct {
+	Id          uuid.UUID
+	Created     int64
+	UserId      uuid.UUID
+	RequesterId uuid.UUID
+	Status      string
+	Completed   int64 // Zero until archive is built or building failed
+	Expires     int64 // Milliseconds, archive is deleted afterwards
+	// Download token, known only right after export is requested
+	Token   string
+	Archive []byte // Protobuf encoded UserExportData, loaded only for download
+}
+
+// UserExportData is everything hive holds for user, password hashes are never exported
+type UserExportData struct {
+	User             *User
+	Emails           []*Email
+	Phones           []*Phone
+	PasswordsChanged []int64
+	Roles            []*UserRole
+	Sessions         []*Session
+	RoleRequests     []*RoleRequest
+	ContactTransfers []*ContactTransfer
+}
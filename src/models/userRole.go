@@ -7,4 +7,9 @@ type UserRole struct {
 	Created int64
 	UserId  uuid.UUID
 	RoleId  uuid.UUID
+	// Nil for roles granted globally
+	OrganizationId uuid.UUID
+	// Milliseconds, zero if assignment is not bounded
+	StartsAt  int64
+	ExpiresAt int64
 }
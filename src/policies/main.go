@@ -0,0 +1,274 @@
+package policies
+
+import (
+	"hive/config"
+	"hive/functools"
+	"hive/models"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/getsentry/sentry-go"
+	"github.com/rs/zerolog/log"
+	uuid "github.com/satori/go.uuid"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	Allow = "allow"
+	Deny  = "deny"
+)
+
+// Rule allows or denies access to routes and resources, empty lists match anything.
+// Rules with permissions or owner condition match only authenticated users
+type Rule struct {
+	Id           string   `json:"id"`
+	Effect       string   `json:"effect"`
+	Routes       []string `json:"routes"`
+	Resources    []string `json:"resources"`
+	Permissions  []string `json:"permissions"`  // User must have any of permissions
+	Owner        bool     `json:"owner"`        // Resource must belong to user
+	DeniedFields []string `json:"deniedFields"` // Fields of resource hidden by allow rule
+}
+
+type Policy struct {
+	Rules []*Rule `json:"rules"`
+}
+
+type Resource struct {
+	Type    string
+	OwnerID uuid.UUID
+}
+
+type Request struct {
+	User     models.IAuthenticationBackendUser
+	Route    string
+	Resource Resource
+}
+
+type Decision struct {
+	Allowed      bool
+	Rule         string
+	DeniedFields []string
+}
+
+type IPolicyEngine interface {
+	Evaluate(ctx context.Context, request *Request) *Decision
+	Reload() error
+	Watch(ctx context.Context)
+}
+
+type PolicyEngine struct {
+	environment *config.Environment
+	mutex       sync.RWMutex
+	policy      *Policy
+	modified    time.Time
+}
+
+// Without policy file every request passed authentication is allowed
+func defaultPolicy() *Policy {
+	return &Policy{Rules: []*Rule{{Id: "default", Effect: Allow}}}
+}
+
+func (decision *Decision) IsFieldAllowed(field string) bool {
+	return !functools.Contains(field, decision.DeniedFields)
+}
+
+func (rule *Rule) matches(request *Request) bool {
+	if len(rule.Routes) > 0 && !functools.Contains(request.Route, rule.Routes) {
+		return false
+	}
+
+	if len(rule.Resources) > 0 && !functools.Contains(request.Resource.Type, rule.Resources) {
+		return false
+	}
+
+	if len(rule.Permissions) == 0 && !rule.Owner {
+		return true
+	}
+
+	if request.User == nil {
+		return false
+	}
+
+	if rule.Owner && !uuid.Equal(request.Resource.OwnerID, request.User.GetUserID()) {
+		return false
+	}
+
+	if len(rule.Permissions) == 0 {
+		return true
+	}
+
+	for _, permission := range rule.Permissions {
+		if functools.HasPermission(request.User.GetPermissions(), permission) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// intersectFields keeps fields denied by both rules, so the most permissive allow rule wins
+func intersectFields(a []string, b []string) []string {
+	fields := make([]string, 0, len(a))
+	for _, field := range a {
+		if functools.Contains(field, b) {
+			fields = append(fields, field)
+		}
+	}
+
+	return fields
+}
+
+func (policy *Policy) evaluate(request *Request) *Decision {
+	var allow *Decision
+
+	for _, rule := range policy.Rules {
+		if !rule.matches(request) {
+			continue
+		}
+
+		if rule.Effect == Deny {
+			return &Decision{Allowed: false, Rule: rule.Id}
+		}
+
+		if allow == nil {
+			allow = &Decision{Allowed: true, Rule: rule.Id, DeniedFields: rule.DeniedFields}
+		} else {
+			allow.DeniedFields = intersectFields(allow.DeniedFields, rule.DeniedFields)
+		}
+	}
+
+	if allow == nil {
+		return &Decision{Allowed: false}
+	}
+
+	return allow
+}
+
+func (policy *Policy) validate() error {
+	for _, rule := range policy.Rules {
+		if rule.Effect != Allow && rule.Effect != Deny {
+			return errors.New(fmt.Sprintf("rule %s has incorrect effect %s", rule.Id, rule.Effect))
+		}
+	}
+
+	return nil
+}
+
+// Evaluate decides whether user could access resource on route, deny rules take precedence over allow ones
+// and requests matching no rule are denied. Every decision is written to decision log
+func (engine *PolicyEngine) Evaluate(ctx context.Context, request *Request) *Decision {
+	engine.mutex.RLock()
+	decision := engine.policy.evaluate(request)
+	engine.mutex.RUnlock()
+
+	userID := ""
+	if request.User != nil {
+		userID = request.User.GetUserID().String()
+	}
+
+	log.Log().
+		Str("event", "policy decision").
+		Str("route", request.Route).
+		Str("resource", request.Resource.Type).
+		Str("owner_id", request.Resource.OwnerID.String()).
+		Str("user_id", userID).
+		Bool("allowed", decision.Allowed).
+		Str("rule", decision.Rule).
+		Strs("denied_fields", decision.DeniedFields).
+		Send()
+
+	return decision
+}
+
+// Reload reads policy file again, current policy is kept if the file is incorrect
+func (engine *PolicyEngine) Reload() error {
+	if engine.environment.PoliciesFile == "" {
+		return nil
+	}
+
+	info, err := os.Stat(engine.environment.PoliciesFile)
+	if err != nil {
+		return err
+	}
+
+	content, err := ioutil.ReadFile(engine.environment.PoliciesFile)
+	if err != nil {
+		return err
+	}
+
+	policy := &Policy{}
+	err = json.Unmarshal(content, policy)
+	if err != nil {
+		return err
+	}
+
+	err = policy.validate()
+	if err != nil {
+		return err
+	}
+
+	engine.mutex.Lock()
+	engine.policy = policy
+	engine.modified = info.ModTime()
+	engine.mutex.Unlock()
+
+	log.Log().Msg(fmt.Sprintf("%d access policy rules successfully loaded", len(policy.Rules)))
+	return nil
+}
+
+func (engine *PolicyEngine) isModified() bool {
+	info, err := os.Stat(engine.environment.PoliciesFile)
+	if err != nil {
+		sentry.CaptureException(err)
+		return false
+	}
+
+	engine.mutex.RLock()
+	defer engine.mutex.RUnlock()
+	return !info.ModTime().Equal(engine.modified)
+}
+
+// Watch reloads policy file when it is modified, blocks until context is done
+func (engine *PolicyEngine) Watch(ctx context.Context) {
+	if engine.environment.PoliciesFile == "" || engine.environment.PoliciesReloadInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Second * time.Duration(engine.environment.PoliciesReloadInterval))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !engine.isModified() {
+				continue
+			}
+
+			err := engine.Reload()
+			if err != nil {
+				sentry.CaptureException(err)
+			}
+		}
+	}
+}
+
+func InitPolicyEngine(environment *config.Environment) *PolicyEngine {
+	engine := &PolicyEngine{
+		environment: environment,
+		policy:      defaultPolicy(),
+	}
+
+	err := engine.Reload()
+	if err != nil {
+		panic(err)
+	}
+
+	return engine
+}
@@ -0,0 +1,84 @@
+package policies
+
+import (
+	"hive/auth/backends"
+	"hive/config"
+	"hive/enums"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func initPolicyEngine(t *testing.T, policy string) *PolicyEngine {
+	file, err := ioutil.TempFile("", "policies*.json")
+	require.Nil(t, err)
+	t.Cleanup(func() { os.Remove(file.Name()) })
+
+	_, err = file.WriteString(policy)
+	require.Nil(t, err)
+	require.Nil(t, file.Close())
+
+	return InitPolicyEngine(&config.Environment{PoliciesFile: file.Name()})
+}
+
+func TestEvaluateWithDefaultPolicy(t *testing.T) {
+	t.Parallel()
+	engine := InitPolicyEngine(&config.Environment{})
+
+	decision := engine.Evaluate(context.Background(), &Request{Route: "GetUserViewV1"})
+	require.True(t, decision.Allowed)
+	require.True(t, decision.IsFieldAllowed("phones"))
+}
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	engine := initPolicyEngine(t, `{"rules": [
+		{"id": "support", "effect": "allow", "resources": ["userView"], "permissions": ["userViews:read"], "deniedFields": ["phones", "emails"]},
+		{"id": "owner", "effect": "allow", "resources": ["userView"], "owner": true, "deniedFields": ["emails"]},
+		{"id": "blocked", "effect": "deny", "routes": ["GetUsersViewV1"], "permissions": ["blocked:views"]}
+	]}`)
+
+	owner := &backends.BasicAuthenticationBackendUser{UserID: uuid.NewV4()}
+	support := &backends.BasicAuthenticationBackendUser{UserID: uuid.NewV4(), Permissions: []string{"userViews:read"}}
+
+	decision := engine.Evaluate(ctx, &Request{User: support, Route: "GetUserViewV1", Resource: Resource{Type: enums.UserViewResource, OwnerID: owner.UserID}})
+	require.True(t, decision.Allowed)
+	require.Equal(t, "support", decision.Rule)
+	require.False(t, decision.IsFieldAllowed("phones"))
+
+	decision = engine.Evaluate(ctx, &Request{User: owner, Route: "GetUserViewV1", Resource: Resource{Type: enums.UserViewResource, OwnerID: owner.UserID}})
+	require.True(t, decision.Allowed)
+	require.True(t, decision.IsFieldAllowed("phones"))
+	require.False(t, decision.IsFieldAllowed("emails"))
+
+	decision = engine.Evaluate(ctx, &Request{User: owner, Route: "GetUserViewV1", Resource: Resource{Type: enums.UserViewResource, OwnerID: support.UserID}})
+	require.False(t, decision.Allowed)
+
+	decision = engine.Evaluate(ctx, &Request{Route: "GetUserViewV1", Resource: Resource{Type: enums.UserViewResource}})
+	require.False(t, decision.Allowed)
+
+	support.Permissions = append(support.Permissions, "blocked:views")
+	decision = engine.Evaluate(ctx, &Request{User: support, Route: "GetUsersViewV1", Resource: Resource{Type: enums.UserViewResource, OwnerID: owner.UserID}})
+	require.False(t, decision.Allowed)
+	require.Equal(t, "blocked", decision.Rule)
+}
+
+func TestReload(t *testing.T) {
+	t.Parallel()
+	engine := initPolicyEngine(t, `{"rules": [{"id": "nobody", "effect": "deny"}]}`)
+	require.False(t, engine.Evaluate(context.Background(), &Request{}).Allowed)
+
+	err := ioutil.WriteFile(engine.environment.PoliciesFile, []byte(`{"rules": [{"id": "everybody", "effect": "permit"}]}`), 0600)
+	require.Nil(t, err)
+	require.NotNil(t, engine.Reload())
+	require.False(t, engine.Evaluate(context.Background(), &Request{}).Allowed)
+
+	err = ioutil.WriteFile(engine.environment.PoliciesFile, []byte(`{"rules": [{"id": "everybody", "effect": "allow"}]}`), 0600)
+	require.Nil(t, err)
+	require.Nil(t, engine.Reload())
+	require.True(t, engine.Evaluate(context.Background(), &Request{}).Allowed)
+}
@@ -0,0 +1,74 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: main.go
+
+// Package policies is a generated GoMock package.
+package policies
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockIPolicyEngine is a mock of IPolicyEngine interface
+type MockIPolicyEngine struct {
+	ctrl     *gomock.Controller
+	recorder *MockIPolicyEngineMockRecorder
+}
+
+// MockIPolicyEngineMockRecorder is the mock recorder for MockIPolicyEngine
+type MockIPolicyEngineMockRecorder struct {
+	mock *MockIPolicyEngine
+}
+
+// NewMockIPolicyEngine creates a new mock instance
+func NewMockIPolicyEngine(ctrl *gomock.Controller) *MockIPolicyEngine {
+	mock := &MockIPolicyEngine{ctrl: ctrl}
+	mock.recorder = &MockIPolicyEngineMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockIPolicyEngine) EXPECT() *MockIPolicyEngineMockRecorder {
+	return m.recorder
+}
+
+// Evaluate mocks base method
+func (m *MockIPolicyEngine) Evaluate(ctx context.Context, request *Request) *Decision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Evaluate", ctx, request)
+	ret0, _ := ret[0].(*Decision)
+	return ret0
+}
+
+// Evaluate indicates an expected call of Evaluate
+func (mr *MockIPolicyEngineMockRecorder) Evaluate(ctx, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Evaluate", reflect.TypeOf((*MockIPolicyEngine)(nil).Evaluate), ctx, request)
+}
+
+// Reload mocks base method
+func (m *MockIPolicyEngine) Reload() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reload indicates an expected call of Reload
+func (mr *MockIPolicyEngineMockRecorder) Reload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockIPolicyEngine)(nil).Reload))
+}
+
+// Watch mocks base method
+func (m *MockIPolicyEngine) Watch(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Watch", ctx)
+}
+
+// Watch indicates an expected call of Watch
+func (mr *MockIPolicyEngineMockRecorder) Watch(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockIPolicyEngine)(nil).Watch), ctx)
+}
@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/inout"
+	"hive/models"
+	"context"
+	"fmt"
+	"github.com/getsentry/sentry-go"
+	"github.com/go-redis/redis/v7"
+	"google.golang.org/protobuf/proto"
+	"time"
+)
+
+// Decisions are keyed by version of user view, so they become unreachable as soon as roles of user change
+func getAuthorizationDecisionKey(ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck) string {
+	return GetRealmKey(ctx, fmt.Sprintf("%s:%s:%d:%s:%s", enums.AuthorizationDecision, userView.Id.String(),
+		userView.Version, check.OrganizationID.String(), check.GetPermission()))
+}
+
+func GetAuthorizationDecisionFromCache(cache *redis.Client, ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck) *models.AuthorizationDecision {
+
+	value, err := cache.WithContext(ctx).Get(getAuthorizationDecisionKey(ctx, userView, check)).Bytes()
+	if err != nil {
+		return nil
+	}
+
+	var decision inout.AuthorizationDecisionCache
+
+	err = proto.Unmarshal(value, &decision)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	return &models.AuthorizationDecision{
+		Allowed: decision.Allowed,
+		Reason:  decision.Reason,
+	}
+}
+
+func CacheAuthorizationDecision(cache *redis.Client, ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck, decision *models.AuthorizationDecision, duration time.Duration) {
+
+	data, err := proto.Marshal(&inout.AuthorizationDecisionCache{
+		Allowed: decision.Allowed,
+		Reason:  decision.Reason,
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		return
+	}
+
+	err = cache.WithContext(ctx).Set(getAuthorizationDecisionKey(ctx, userView, check), data, duration).Err()
+	if err != nil {
+		sentry.CaptureException(err)
+	}
+}
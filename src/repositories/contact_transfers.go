@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+)
+
+// Completed transfers are resolved on creation, repeated pending request only refreshes creation time
+func createContactTransferSQL() string {
+	return `
+		INSERT INTO contact_transfers(id, kind, value, from_user_id, to_user_id, status, resolved, realm)
+		VALUES ($1, $2, $3, $4, $5, $6, CASE WHEN $6 = 'pending' THEN 0 ELSE extract(epoch from now()) * 1000 END, $7)
+		ON CONFLICT (realm, kind, value, to_user_id) WHERE status = 'pending'
+		    DO UPDATE SET created = DEFAULT, from_user_id = excluded.from_user_id
+		RETURNING id, created, kind, value, from_user_id, to_user_id, status, resolved;
+		`
+}
+
+func getContactTransferSQL() string {
+	return `
+		SELECT id, created, kind, value, from_user_id, to_user_id, status, resolved
+		FROM contact_transfers
+		WHERE id = $1 AND realm = $2;
+		`
+}
+
+func getUserContactTransfersSQL() string {
+	return `
+		SELECT id, created, kind, value, from_user_id, to_user_id, status, resolved
+		FROM contact_transfers
+		WHERE (from_user_id = $1 OR to_user_id = $1) AND realm = $2
+		ORDER BY created DESC, id;
+		`
+}
+
+// resolveContactTransferSQL changes status of pending transfer only, so transfer is never resolved twice
+func resolveContactTransferSQL() string {
+	return `
+		UPDATE contact_transfers
+		SET status   = $2,
+		    resolved = extract(epoch from now()) * 1000
+		WHERE id = $1 AND status = 'pending' AND realm = $3
+		RETURNING id, created, kind, value, from_user_id, to_user_id, status, resolved;
+		`
+}
+
+func unwrapContactTransferScanError(err error) int {
+	var e *pgconn.PgError
+
+	if errors.As(err, &e) {
+		switch e.ConstraintName {
+		case "contact_transfers_kind_check", "contact_transfers_status_check":
+			return enums.IncorrectContactTransferStatus
+		}
+	} else if strings.Contains(err.Error(), "no rows in result") {
+		return enums.ContactTransferNotFound
+	}
+
+	sentry.CaptureException(err)
+	return enums.NotOk
+}
+
+func scanContactTransfer(row pgx.Row) (int, *models.ContactTransfer) {
+	transfer := &models.ContactTransfer{}
+
+	err := row.Scan(&transfer.Id, &transfer.Created, &transfer.Kind, &transfer.Value, &transfer.FromUserId,
+		&transfer.ToUserId, &transfer.Status, &transfer.Resolved)
+	if err != nil {
+		return unwrapContactTransferScanError(err), nil
+	}
+
+	return enums.Ok, transfer
+}
+
+func CreateContactTransfer(db DB, ctx context.Context, kind string, value string, fromUserID uuid.UUID, toUserID uuid.UUID, status string) (int, *models.ContactTransfer) {
+	row := db.QueryRow(ctx, createContactTransferSQL(), uuid.NewV4(), kind, value, fromUserID, toUserID, status, GetRealmFromContext(ctx).Name)
+	return scanContactTransfer(row)
+}
+
+func GetContactTransfer(db DB, ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer) {
+	return scanContactTransfer(db.QueryRow(ctx, getContactTransferSQL(), id, GetRealmFromContext(ctx).Name))
+}
+
+// GetUserContactTransfers returns transfers of contacts from or to user, the newest go first
+func GetUserContactTransfers(db DB, ctx context.Context, userID uuid.UUID) []*models.ContactTransfer {
+	rows, err := db.Query(ctx, getUserContactTransfersSQL(), userID, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	transfers := make([]*models.ContactTransfer, 0)
+	for rows.Next() {
+		if status, transfer := scanContactTransfer(rows); status == enums.Ok {
+			transfers = append(transfers, transfer)
+		}
+	}
+
+	return transfers
+}
+
+// ResolveContactTransfer completes or rejects pending transfer
+func ResolveContactTransfer(db DB, ctx context.Context, id uuid.UUID, status string) (int, *models.ContactTransfer) {
+	return scanContactTransfer(db.QueryRow(ctx, resolveContactTransferSQL(), id, status, GetRealmFromContext(ctx).Name))
+}
@@ -0,0 +1,41 @@
+package repositories
+
+import (
+	"hive/config"
+	"hive/enums"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestContactTransferLifecycle(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUsers(pool, ctx)
+	PurgeContactTransfers(pool, ctx)
+	from := CreateUser(pool, ctx)
+	to := CreateUser(pool, ctx)
+
+	status, transfer := CreateContactTransfer(pool, ctx, enums.EmailContact, "test@test.com", from.Id, to.Id, enums.ContactTransferPending)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, int64(0), transfer.Resolved)
+
+	status, repeated := CreateContactTransfer(pool, ctx, enums.EmailContact, "test@test.com", from.Id, to.Id, enums.ContactTransferPending)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, transfer.Id, repeated.Id)
+
+	status, transfer = ResolveContactTransfer(pool, ctx, transfer.Id, enums.ContactTransferRejected)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, enums.ContactTransferRejected, transfer.Status)
+	require.NotEqual(t, int64(0), transfer.Resolved)
+
+	status, _ = ResolveContactTransfer(pool, ctx, transfer.Id, enums.ContactTransferCompleted)
+	require.Equal(t, enums.ContactTransferNotFound, status)
+
+	status, _ = CreateContactTransfer(pool, ctx, enums.PhoneContact, "79999999991", from.Id, to.Id, enums.ContactTransferCompleted)
+	require.Equal(t, enums.Ok, status)
+
+	PurgeUsers(pool, ctx)
+	transfers := GetUserContactTransfers(pool, ctx, from.Id)
+	require.Len(t, transfers, 2)
+}
@@ -1,14 +1,19 @@
 package repositories
 
 import (
+	"hive/config"
 	"hive/models"
 	"context"
+	"fmt"
 )
 
 const (
 	AuthenticatedUser string = "authenticatedUser"
+	Realm             string = "realm"
 )
 
+var defaultRealm = &config.Realm{Name: config.DefaultRealm}
+
 func GetUserFromContext(ctx context.Context) models.IAuthenticationBackendUser {
 	user, _ := ctx.Value(AuthenticatedUser).(models.IAuthenticationBackendUser)
 	return user
@@ -17,3 +22,28 @@ func GetUserFromContext(ctx context.Context) models.IAuthenticationBackendUser {
 func SetUserToContext(ctx context.Context, user models.IAuthenticationBackendUser) context.Context {
 	return context.WithValue(ctx, AuthenticatedUser, user)
 }
+
+// GetRealmFromContext returns realm of request, context without realm belongs to default realm
+func GetRealmFromContext(ctx context.Context) *config.Realm {
+	realm, _ := ctx.Value(Realm).(*config.Realm)
+	if realm == nil {
+		return defaultRealm
+	}
+
+	return realm
+}
+
+func SetRealmToContext(ctx context.Context, realm *config.Realm) context.Context {
+	return context.WithValue(ctx, Realm, realm)
+}
+
+// GetRealmKey prefixes cache key with realm of context, keys of default realm stay unprefixed
+// to keep data cached before realms were introduced
+func GetRealmKey(ctx context.Context, key string) string {
+	realm := GetRealmFromContext(ctx)
+	if realm.IsDefault() {
+		return key
+	}
+
+	return fmt.Sprintf("%s:%s", realm.Name, key)
+}
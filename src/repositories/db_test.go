@@ -37,6 +37,22 @@ func PurgeUserRoles(pool *pgxpool.Pool, ctx context.Context) {
 	PurgeTable(pool, ctx, "user_roles")
 }
 
+func PurgeOrganizations(pool *pgxpool.Pool, ctx context.Context) {
+	PurgeTable(pool, ctx, "organizations")
+}
+
+func PurgeGroups(pool *pgxpool.Pool, ctx context.Context) {
+	PurgeTable(pool, ctx, "groups")
+}
+
+func PurgeRoleRequests(pool *pgxpool.Pool, ctx context.Context) {
+	PurgeTable(pool, ctx, "role_requests")
+}
+
+func PurgeContactTransfers(pool *pgxpool.Pool, ctx context.Context) {
+	PurgeTable(pool, ctx, "contact_transfers")
+}
+
 func PurgePasswords(pool *pgxpool.Pool, ctx context.Context) {
 	PurgeTable(pool, ctx, "passwords")
 }
@@ -73,3 +89,7 @@ func Explain(pool *pgxpool.Pool, ctx context.Context, sql string, args ...interf
 
 	return strings
 }
+
+func PurgeUserExports(pool *pgxpool.Pool, ctx context.Context) {
+	PurgeTable(pool, ctx, "user_exports")
+}
@@ -9,12 +9,12 @@ import (
 	"time"
 )
 
-func getEmailConfirmationCodeKey(email string) string {
-	return fmt.Sprintf("%s:%s", enums.EmailConfirmationCode, email)
+func getEmailConfirmationCodeKey(ctx context.Context, email string) string {
+	return GetRealmKey(ctx, fmt.Sprintf("%s:%s", enums.EmailConfirmationCode, email))
 }
 
 func CreateEmailConfirmationCode(ctx context.Context, cache *redis.Client, email string, code string, duration time.Duration) *models.EmailConfirmation {
-	key := getEmailConfirmationCodeKey(email)
+	key := getEmailConfirmationCodeKey(ctx, email)
 	cmd := cache.WithContext(ctx).Set(key, code, duration)
 	if err := cmd.Err(); err != nil {
 		return nil
@@ -30,7 +30,7 @@ func CreateEmailConfirmationCode(ctx context.Context, cache *redis.Client, email
 }
 
 func GetEmailConfirmationCode(ctx context.Context, cache *redis.Client, email string) string {
-	key := getEmailConfirmationCodeKey(email)
+	key := getEmailConfirmationCodeKey(ctx, email)
 	code, err := cache.WithContext(ctx).Get(key).Result()
 
 	if err != nil {
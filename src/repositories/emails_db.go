@@ -10,21 +10,61 @@ import (
 	"github.com/jackc/pgx/v4"
 	uuid "github.com/satori/go.uuid"
 	"strings"
+	"time"
 )
 
 func createEmailSQL() string {
-	return `INSERT INTO emails (id, user_id, value) 
-			VALUES ($1, $2, $3)
-			ON CONFLICT (value)
+	return `INSERT INTO emails (id, user_id, value, realm, verified_at, is_primary)
+			VALUES ($1, $2, $3, $4, $5, NOT EXISTS (SELECT 1 FROM emails WHERE user_id = $2 AND is_primary))
+			ON CONFLICT (realm, value)
 			    DO UPDATE SET created=DEFAULT,
-			                  user_id=excluded.user_id
-			RETURNING id, created, user_id, value;`
+			                  user_id=excluded.user_id,
+			                  verified_at=excluded.verified_at,
+			                  is_primary=CASE WHEN emails.user_id = excluded.user_id THEN emails.is_primary ELSE excluded.is_primary END
+			RETURNING id, created, user_id, value, is_primary, verified_at;`
 }
 
 func getEmailSQL() string {
-	return `SELECT id, created, user_id, value 
+	return `SELECT id, created, user_id, value, is_primary, verified_at
 			FROM emails 
-			WHERE value = $1;`
+			WHERE value = $1 AND realm = $2;`
+}
+
+func getUserEmailsSQL() string {
+	return `
+		SELECT id, created, user_id, value, is_primary, verified_at
+		FROM emails
+		WHERE user_id = $1 AND realm = $2
+		ORDER BY is_primary DESC, created;
+		`
+}
+
+func getEmailByIDSQL() string {
+	return `SELECT id, created, user_id, value, is_primary, verified_at FROM emails WHERE id = $1 AND realm = $2;`
+}
+
+func deleteEmailSQL() string {
+	return `DELETE FROM emails WHERE id = $1 AND realm = $2 RETURNING id, created, user_id, value, is_primary, verified_at;`
+}
+
+// Primary email is unset before another one is set, since unique index of primary emails is checked for every row
+func unsetPrimaryEmailSQL() string {
+	return `UPDATE emails SET is_primary = false WHERE user_id = $1 AND realm = $2 AND is_primary;`
+}
+
+func setPrimaryEmailSQL() string {
+	return `UPDATE emails SET is_primary = true WHERE id = $1 AND realm = $2
+			RETURNING id, created, user_id, value, is_primary, verified_at;`
+}
+
+// The earliest email of user without primary one becomes primary
+func ensurePrimaryEmailSQL() string {
+	return `
+		UPDATE emails
+		SET is_primary = true
+		WHERE id = (SELECT id FROM emails WHERE user_id = $1 AND realm = $2 ORDER BY created, id LIMIT 1) AND
+		      NOT EXISTS (SELECT 1 FROM emails WHERE user_id = $1 AND realm = $2 AND is_primary);
+		`
 }
 
 func unwrapEmailScanError(err error) int {
@@ -42,7 +82,7 @@ func unwrapEmailScanError(err error) int {
 func scanEmail(row pgx.Row) (int, *models.Email) {
 	email := &models.Email{}
 
-	err := row.Scan(&email.Id, &email.Created, &email.UserId, &email.Value)
+	err := row.Scan(&email.Id, &email.Created, &email.UserId, &email.Value, &email.Primary, &email.VerifiedAt)
 	if err != nil {
 		return unwrapEmailScanError(err), nil
 	}
@@ -52,12 +92,76 @@ func scanEmail(row pgx.Row) (int, *models.Email) {
 
 func CreateEmail(db DB, ctx context.Context, userId uuid.UUID, value string) (int, *models.Email) {
 	sql := createEmailSQL()
-	row := db.QueryRow(ctx, sql, uuid.NewV4(), userId, value)
+	row := db.QueryRow(ctx, sql, uuid.NewV4(), userId, value, GetRealmFromContext(ctx).Name, time.Now().UnixNano()/int64(time.Millisecond))
 	return scanEmail(row)
 }
 
 func GetEmail(db DB, ctx context.Context, email string) (int, *models.Email) {
 	sql := getEmailSQL()
-	row := db.QueryRow(ctx, sql, email)
+	row := db.QueryRow(ctx, sql, email, GetRealmFromContext(ctx).Name)
 	return scanEmail(row)
 }
+
+func GetUserEmails(db DB, ctx context.Context, userID uuid.UUID) []*models.Email {
+	rows, err := db.Query(ctx, getUserEmailsSQL(), userID, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	emails := make([]*models.Email, 0)
+	for rows.Next() {
+		if status, email := scanEmail(rows); status == enums.Ok && email != nil {
+			emails = append(emails, email)
+		}
+	}
+
+	return emails
+}
+
+func GetEmailByID(db DB, ctx context.Context, id uuid.UUID) (int, *models.Email) {
+	status, email := scanEmail(db.QueryRow(ctx, getEmailByIDSQL(), id, GetRealmFromContext(ctx).Name))
+	if status == enums.Ok && email == nil {
+		return enums.EmailNotFound, nil
+	}
+
+	return status, email
+}
+
+func DeleteEmail(db DB, ctx context.Context, id uuid.UUID) (int, *models.Email) {
+	status, email := scanEmail(db.QueryRow(ctx, deleteEmailSQL(), id, GetRealmFromContext(ctx).Name))
+	if status == enums.Ok && email == nil {
+		return enums.EmailNotFound, nil
+	}
+
+	return status, email
+}
+
+// SetPrimaryEmail makes email primary one of its owner, db should be transaction
+func SetPrimaryEmail(db DB, ctx context.Context, id uuid.UUID, userID uuid.UUID) (int, *models.Email) {
+	_, err := db.Exec(ctx, unsetPrimaryEmailSQL(), userID, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, email := scanEmail(db.QueryRow(ctx, setPrimaryEmailSQL(), id, GetRealmFromContext(ctx).Name))
+	if status == enums.Ok && email == nil {
+		return enums.EmailNotFound, nil
+	}
+
+	return status, email
+}
+
+// EnsurePrimaryEmail makes the earliest email of user primary if user has emails but none of them is primary
+func EnsurePrimaryEmail(db DB, ctx context.Context, userID uuid.UUID) int {
+	_, err := db.Exec(ctx, ensurePrimaryEmailSQL(), userID, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk
+	}
+
+	return enums.Ok
+}
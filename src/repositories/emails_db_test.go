@@ -52,3 +52,58 @@ func TestGetEmailWithEmptyResult(t *testing.T) {
 	require.Equal(t, enums.Ok, status)
 	require.Nil(t, email)
 }
+
+func TestCreateEmailInDifferentRealms(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	realmCtx := SetRealmToContext(ctx, &config.Realm{Name: "shop"})
+	PurgeUsers(pool, ctx)
+	PurgeEmails(pool, ctx)
+	user := CreateUser(pool, ctx)
+	realmUser := CreateUser(pool, realmCtx)
+	CreateEmail(pool, ctx, user.Id, "mail@mail.com")
+	status, email := CreateEmail(pool, realmCtx, realmUser.Id, "mail@mail.com")
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, realmUser.Id, email.UserId)
+
+	status, email = GetEmail(pool, ctx, "mail@mail.com")
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, user.Id, email.UserId)
+
+	status, email = CreateEmail(pool, realmCtx, user.Id, "other@mail.com")
+	require.Equal(t, enums.UserNotFound, status)
+	require.Nil(t, email)
+}
+
+func TestPrimaryEmail(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUsers(pool, ctx)
+	PurgeEmails(pool, ctx)
+	user := CreateUser(pool, ctx)
+	_, first := CreateEmail(pool, ctx, user.Id, "first@mail.com")
+	_, second := CreateEmail(pool, ctx, user.Id, "second@mail.com")
+	require.True(t, first.Primary)
+	require.False(t, second.Primary)
+	require.NotZero(t, second.VerifiedAt)
+
+	status, second := SetPrimaryEmail(pool, ctx, second.Id, user.Id)
+	require.Equal(t, enums.Ok, status)
+	require.True(t, second.Primary)
+	require.Equal(t, second.Id, GetUserEmails(pool, ctx, user.Id)[0].Id)
+
+	status, _ = DeleteEmail(pool, ctx, second.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, enums.Ok, EnsurePrimaryEmail(pool, ctx, user.Id))
+
+	status, first = GetEmailByID(pool, ctx, first.Id)
+	require.Equal(t, enums.Ok, status)
+	require.True(t, first.Primary)
+
+	status, count := CountUserLoginIdentifiers(pool, ctx, user.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, int64(1), count)
+
+	status, _ = DeleteEmail(pool, ctx, second.Id)
+	require.Equal(t, enums.EmailNotFound, status)
+}
@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/models"
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+)
+
+func createGroupMemberSQL() string {
+	return `
+		INSERT INTO group_members(id, group_id, user_id, realm)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created, group_id, user_id, 0;
+		`
+}
+
+func getGroupMembersSQL() string {
+	return `
+		SELECT id, created, group_id, user_id, count(*) OVER() AS full_count
+		FROM group_members
+		WHERE (array_length($1::uuid[], 1) IS NULL OR group_id = ANY ($1::uuid[])) AND
+		      (array_length($2::uuid[], 1) IS NULL OR user_id = ANY ($2::uuid[])) AND
+		      realm = $5
+		ORDER BY created
+		LIMIT $3
+		OFFSET $4;
+		`
+}
+
+func deleteGroupMemberSQL() string {
+	return `
+		DELETE FROM group_members WHERE id = $1 AND realm = $2 RETURNING id, created, group_id, user_id, 0;
+		`
+}
+
+func unwrapGroupMemberScanError(err error) int {
+	var e *pgconn.PgError
+
+	if errors.As(err, &e) {
+		switch e.ConstraintName {
+		case "group_members_group_id_fkey":
+			return enums.GroupNotFound
+		case "group_members_user_id_fkey":
+			return enums.UserNotFound
+		case "group_members_group_id_user_id_key":
+			return enums.GroupMemberAlreadyExist
+		}
+	} else if strings.Contains(err.Error(), "no rows in result") {
+		return enums.GroupMemberNotFound
+	}
+
+	sentry.CaptureException(err)
+	return enums.NotOk
+}
+
+func scanGroupMember(row pgx.Row) (int, *models.GroupMember, int64) {
+	member := &models.GroupMember{}
+	var count int64
+
+	err := row.Scan(&member.Id, &member.Created, &member.GroupId, &member.UserId, &count)
+	if err != nil {
+		return unwrapGroupMemberScanError(err), nil, 0
+	}
+
+	return enums.Ok, member, count
+}
+
+func scanGroupMembers(rows pgx.Rows, limit int) ([]*models.GroupMember, int64) {
+	members := make([]*models.GroupMember, limit)
+
+	var i int32
+	var count int64
+
+	for rows.Next() {
+		_, member, c := scanGroupMember(rows)
+		count = c
+		members[i] = member
+		i++
+	}
+
+	rows.Close()
+
+	return members[0:i], count
+}
+
+type GetGroupMembersQuery struct {
+	GroupId    []uuid.UUID
+	UserId     []uuid.UUID
+	Pagination *models.PaginationRequest
+}
+
+func CreateGroupMember(db DB, ctx context.Context, groupID uuid.UUID, userID uuid.UUID) (int, *models.GroupMember) {
+	sql := createGroupMemberSQL()
+	row := db.QueryRow(ctx, sql, uuid.NewV4(), groupID, userID, GetRealmFromContext(ctx).Name)
+	status, member, _ := scanGroupMember(row)
+	return status, member
+}
+
+func GetGroupMembers(db DB, ctx context.Context, query GetGroupMembersQuery) ([]*models.GroupMember, *models.PaginationResponse) {
+	sql := getGroupMembersSQL()
+	limit, offset := functools.LimitPageToLimitOffset(query.Pagination.Limit, query.Pagination.Page)
+	rows, err := db.Query(ctx, sql, functools.UUIDListToPGArray(query.GroupId), functools.UUIDListToPGArray(query.UserId), limit, offset, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, nil
+	}
+
+	members, totalCount := scanGroupMembers(rows, limit)
+
+	return members, &models.PaginationResponse{
+		HasNext:     functools.HasNext(totalCount, query.Pagination.Limit, query.Pagination.Page),
+		HasPrevious: functools.HasPrevious(query.Pagination.Page),
+		Count:       totalCount,
+	}
+}
+
+func DeleteGroupMember(db DB, ctx context.Context, id uuid.UUID) (int, *models.GroupMember) {
+	sql := deleteGroupMemberSQL()
+	row := db.QueryRow(ctx, sql, id, GetRealmFromContext(ctx).Name)
+	status, member, _ := scanGroupMember(row)
+	return status, member
+}
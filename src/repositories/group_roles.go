@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/models"
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+)
+
+func createGroupRoleSQL() string {
+	return `
+		INSERT INTO group_roles(id, group_id, role_id, realm)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created, group_id, role_id, 0;
+		`
+}
+
+func getGroupRolesSQL() string {
+	return `
+		SELECT id, created, group_id, role_id, count(*) OVER() AS full_count
+		FROM group_roles
+		WHERE (array_length($1::uuid[], 1) IS NULL OR group_id = ANY ($1::uuid[])) AND
+		      (array_length($2::uuid[], 1) IS NULL OR role_id = ANY ($2::uuid[])) AND
+		      realm = $5
+		ORDER BY created
+		LIMIT $3
+		OFFSET $4;
+		`
+}
+
+func deleteGroupRoleSQL() string {
+	return `
+		DELETE FROM group_roles WHERE id = $1 AND realm = $2 RETURNING id, created, group_id, role_id, 0;
+		`
+}
+
+func unwrapGroupRoleScanError(err error) int {
+	var e *pgconn.PgError
+
+	if errors.As(err, &e) {
+		switch e.ConstraintName {
+		case "group_roles_group_id_fkey":
+			return enums.GroupNotFound
+		case "group_roles_role_id_fkey":
+			return enums.RoleNotFound
+		case "group_roles_group_id_role_id_key":
+			return enums.GroupRoleAlreadyExist
+		}
+	} else if strings.Contains(err.Error(), "no rows in result") {
+		return enums.GroupRoleNotFound
+	}
+
+	sentry.CaptureException(err)
+	return enums.NotOk
+}
+
+func scanGroupRole(row pgx.Row) (int, *models.GroupRole, int64) {
+	role := &models.GroupRole{}
+	var count int64
+
+	err := row.Scan(&role.Id, &role.Created, &role.GroupId, &role.RoleId, &count)
+	if err != nil {
+		return unwrapGroupRoleScanError(err), nil, 0
+	}
+
+	return enums.Ok, role, count
+}
+
+func scanGroupRoles(rows pgx.Rows, limit int) ([]*models.GroupRole, int64) {
+	roles := make([]*models.GroupRole, limit)
+
+	var i int32
+	var count int64
+
+	for rows.Next() {
+		_, role, c := scanGroupRole(rows)
+		count = c
+		roles[i] = role
+		i++
+	}
+
+	rows.Close()
+
+	return roles[0:i], count
+}
+
+type GetGroupRolesQuery struct {
+	GroupId    []uuid.UUID
+	RoleId     []uuid.UUID
+	Pagination *models.PaginationRequest
+}
+
+func CreateGroupRole(db DB, ctx context.Context, groupID uuid.UUID, roleID uuid.UUID) (int, *models.GroupRole) {
+	sql := createGroupRoleSQL()
+	row := db.QueryRow(ctx, sql, uuid.NewV4(), groupID, roleID, GetRealmFromContext(ctx).Name)
+	status, role, _ := scanGroupRole(row)
+	return status, role
+}
+
+func GetGroupRoles(db DB, ctx context.Context, query GetGroupRolesQuery) ([]*models.GroupRole, *models.PaginationResponse) {
+	sql := getGroupRolesSQL()
+	limit, offset := functools.LimitPageToLimitOffset(query.Pagination.Limit, query.Pagination.Page)
+	rows, err := db.Query(ctx, sql, functools.UUIDListToPGArray(query.GroupId), functools.UUIDListToPGArray(query.RoleId), limit, offset, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, nil
+	}
+
+	roles, totalCount := scanGroupRoles(rows, limit)
+
+	return roles, &models.PaginationResponse{
+		HasNext:     functools.HasNext(totalCount, query.Pagination.Limit, query.Pagination.Page),
+		HasPrevious: functools.HasPrevious(query.Pagination.Page),
+		Count:       totalCount,
+	}
+}
+
+func DeleteGroupRole(db DB, ctx context.Context, id uuid.UUID) (int, *models.GroupRole) {
+	sql := deleteGroupRoleSQL()
+	row := db.QueryRow(ctx, sql, id, GetRealmFromContext(ctx).Name)
+	status, role, _ := scanGroupRole(row)
+	return status, role
+}
@@ -0,0 +1,193 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/models"
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	uuid "github.com/satori/go.uuid"
+	"math"
+	"strings"
+)
+
+func getGroupsSQL() string {
+	return `
+		SELECT id, created, title, description, count(*) OVER() AS full_count
+		FROM groups
+		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[])) AND
+		      (array_length($2::text[], 1) IS NULL OR title = ANY ($2::text[])) AND
+		      realm = $5
+		ORDER BY created
+		LIMIT $3
+		OFFSET $4;
+		`
+}
+
+func createGroupSQL() string {
+	return "INSERT INTO groups (id, title, description, realm) VALUES ($1, $2, $3, $4) RETURNING id, created, title, description, 0;"
+}
+
+func updateGroupSQL() string {
+	return `
+		UPDATE groups SET title = $2, description = $3
+		WHERE id = $1 AND realm = $4
+		RETURNING id, created, title, description, 0;
+		`
+}
+
+func deleteGroupSQL() string {
+	return "DELETE FROM groups WHERE id = $1 AND realm = $2 RETURNING id, created, title, description, 0;"
+}
+
+func getGroupUsersSQL() string {
+	return "SELECT user_id FROM group_members WHERE group_id = $1 AND realm = $2;"
+}
+
+func unwrapGroupScanError(err error) int {
+	var e *pgconn.PgError
+	if errors.As(err, &e) && e.ConstraintName == "groups_title_key" {
+		return enums.GroupAlreadyExist
+	} else if strings.Contains(err.Error(), "no rows") {
+		return enums.GroupNotFound
+	}
+
+	sentry.CaptureException(err)
+	return enums.NotOk
+}
+
+func scanGroup(row pgx.Row) (int, *models.Group, int64) {
+	group := &models.Group{}
+	var count int64
+
+	err := row.Scan(&group.Id, &group.Created, &group.Title, &group.Description, &count)
+	if err != nil {
+		return unwrapGroupScanError(err), nil, 0
+	}
+
+	return enums.Ok, group, count
+}
+
+func scanGroups(rows pgx.Rows, limit int) ([]*models.Group, int64) {
+	groups := make([]*models.Group, limit)
+
+	var i int32
+	var count int64
+
+	for rows.Next() {
+		_, group, c := scanGroup(rows)
+		groups[i] = group
+		count = c
+		i++
+	}
+
+	rows.Close()
+
+	return groups[0:i], count
+}
+
+type GetGroupsQuery struct {
+	Pagination  *models.PaginationRequest
+	Identifiers []uuid.UUID
+	Titles      []string
+}
+
+type getGroupsRawQuery struct {
+	Limit       int
+	Offset      int
+	Identifiers string
+	Titles      string
+}
+
+func convertGetGroupsQueryToRaw(query GetGroupsQuery) getGroupsRawQuery {
+
+	limit := query.Pagination.Limit
+	if len(query.Identifiers) > 0 {
+		limit = int(math.Min(
+			float64(query.Pagination.Limit),
+			float64(len(query.Identifiers))))
+	}
+
+	limit, offset := functools.LimitPageToLimitOffset(limit, query.Pagination.Page)
+
+	return getGroupsRawQuery{
+		Limit:       limit,
+		Offset:      offset,
+		Identifiers: functools.UUIDListToPGArray(query.Identifiers),
+		Titles:      functools.StringsToPGArray(query.Titles),
+	}
+}
+
+func CreateGroup(db DB, context context.Context, title string, description string) (int, *models.Group) {
+	sql := createGroupSQL()
+	row := db.QueryRow(context, sql, uuid.NewV4(), title, description, GetRealmFromContext(context).Name)
+	status, group, _ := scanGroup(row)
+	return status, group
+}
+
+func UpdateGroup(db DB, context context.Context, id uuid.UUID, title string, description string) (int, *models.Group) {
+	row := db.QueryRow(context, updateGroupSQL(), id, title, description, GetRealmFromContext(context).Name)
+	status, group, _ := scanGroup(row)
+	return status, group
+}
+
+// DeleteGroup deletes group together with its members and roles granted to it
+func DeleteGroup(db DB, context context.Context, id uuid.UUID) (int, *models.Group) {
+	row := db.QueryRow(context, deleteGroupSQL(), id, GetRealmFromContext(context).Name)
+	status, group, _ := scanGroup(row)
+	return status, group
+}
+
+func GetGroup(db DB, context context.Context, id uuid.UUID) (int, *models.Group) {
+	sql := getGroupsSQL()
+	row := db.QueryRow(context, sql, functools.UUIDListToPGArray([]uuid.UUID{id}), "{}", 1, 0, GetRealmFromContext(context).Name)
+	status, group, _ := scanGroup(row)
+	return status, group
+}
+
+func GetGroups(db DB, context context.Context, query GetGroupsQuery) ([]*models.Group, *models.PaginationResponse) {
+
+	sql := getGroupsSQL()
+	rawQuery := convertGetGroupsQueryToRaw(query)
+
+	rows, err := db.Query(context, sql, rawQuery.Identifiers, rawQuery.Titles, rawQuery.Limit, rawQuery.Offset, GetRealmFromContext(context).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, nil
+	}
+
+	groups, count := scanGroups(rows, rawQuery.Limit)
+
+	return groups, &models.PaginationResponse{
+		HasNext:     functools.HasNext(count, query.Pagination.Limit, query.Pagination.Page),
+		HasPrevious: functools.HasPrevious(query.Pagination.Page),
+		Count:       count,
+	}
+}
+
+func GetGroupUsersID(db DB, context context.Context, id uuid.UUID) []uuid.UUID {
+	rows, err := db.Query(context, getGroupUsersSQL(), id, GetRealmFromContext(context).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	var identifiers []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		err = rows.Scan(&userID)
+		if err != nil {
+			sentry.CaptureException(err)
+			return nil
+		}
+
+		identifiers = append(identifiers, userID)
+	}
+
+	return identifiers
+}
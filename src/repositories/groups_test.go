@@ -0,0 +1,84 @@
+package repositories
+
+import (
+	"hive/config"
+	"hive/enums"
+	"hive/models"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateGroup(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeGroups(pool, ctx)
+	status, group := CreateGroup(pool, ctx, "developers", "")
+	require.Equal(t, enums.Ok, status)
+	require.NotNil(t, group)
+
+	status, group = CreateGroup(pool, ctx, "developers", "")
+	require.Equal(t, enums.GroupAlreadyExist, status)
+	require.Nil(t, group)
+
+	groups, _ := GetGroups(pool, ctx, GetGroupsQuery{
+		Pagination: &models.PaginationRequest{Limit: 10},
+	})
+	require.Len(t, groups, 1)
+}
+
+func TestUpdateAndDeleteGroup(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeGroups(pool, ctx)
+	_, group := CreateGroup(pool, ctx, "developers", "")
+
+	status, updated := UpdateGroup(pool, ctx, group.Id, "engineers", "Backend team")
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, "engineers", updated.Title)
+	require.Equal(t, "Backend team", updated.Description)
+
+	status, _ = DeleteGroup(pool, ctx, group.Id)
+	require.Equal(t, enums.Ok, status)
+
+	status, _ = GetGroup(pool, ctx, group.Id)
+	require.Equal(t, enums.GroupNotFound, status)
+}
+
+func TestGroupRolesInUserView(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeGroups(pool, ctx)
+	PurgeUsers(pool, ctx)
+	PurgeRoles(pool, ctx)
+	PurgeUserViews(pool, ctx)
+	_, group := CreateGroup(pool, ctx, "developers", "")
+	_, role := CreateRole(pool, ctx, "developer", []string{"users:read"}, nil)
+	user := CreateUser(pool, ctx)
+
+	status, member := CreateGroupMember(pool, ctx, group.Id, user.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, user.Id, member.UserId)
+
+	status, _ = CreateGroupMember(pool, ctx, group.Id, user.Id)
+	require.Equal(t, enums.GroupMemberAlreadyExist, status)
+
+	status, _ = CreateGroupRole(pool, ctx, uuid.NewV4(), role.Id)
+	require.Equal(t, enums.GroupNotFound, status)
+
+	status, groupRole := CreateGroupRole(pool, ctx, group.Id, role.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, []uuid.UUID{user.Id}, GetGroupUsersID(pool, ctx, groupRole.GroupId))
+	require.Equal(t, []uuid.UUID{user.Id}, GetRoleUsersID(pool, ctx, role.Id))
+
+	views := CreateOrUpdateUsersView(pool, ctx, CreateOrUpdateUsersViewStoreQuery{Id: []uuid.UUID{user.Id}})
+	require.Len(t, views, 1)
+	require.Equal(t, []string{"developer"}, views[0].Roles)
+	require.Equal(t, []string{"users:read"}, views[0].Permissions)
+
+	DeleteGroupMember(pool, ctx, member.Id)
+	views = CreateOrUpdateUsersView(pool, ctx, CreateOrUpdateUsersViewStoreQuery{Id: []uuid.UUID{user.Id}})
+	require.Len(t, views, 1)
+	require.Len(t, views[0].Roles, 0)
+}
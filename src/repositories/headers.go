@@ -11,6 +11,8 @@ import (
 const (
 	ContentType   = "content-type"
 	Authorization = "authorization"
+	Fingerprint   = "x-fingerprint"
+	ExportToken   = "x-export-token"
 )
 
 func GetContentTypeHeader(r *http.Request) enums.ContentType {
@@ -21,6 +23,16 @@ func GetAuthorizationHeader(r *http.Request) string {
 	return r.Header.Get(Authorization)
 }
 
+func GetFingerprintHeader(r *http.Request) string {
+	return r.Header.Get(Fingerprint)
+}
+
+// GetExportTokenHeader returns download token of user export, token is never accepted in query
+// so it doesn't leak to access logs, proxies and referrers
+func GetExportTokenHeader(r *http.Request) string {
+	return r.Header.Get(ExportToken)
+}
+
 func GetRefreshTokenCookie(r *http.Request, environment *config.Environment) *uuid.UUID {
 	cookie, err := r.Cookie(environment.RefreshTokenCookieName)
 	if err != nil {
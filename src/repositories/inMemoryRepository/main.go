@@ -16,6 +16,8 @@ type IInMemoryRepository interface {
 	CacheSecret(ctx context.Context, secret *models.Secret, timeout time.Duration)
 	GetActualSecret(ctx context.Context) *models.Secret
 	CacheActualSecret(ctx context.Context, secret *models.Secret, timeout time.Duration)
+	DeleteSecret(ctx context.Context, id uuid.UUID)
+	DeleteActualSecret(ctx context.Context)
 }
 
 type InMemoryRepository struct {
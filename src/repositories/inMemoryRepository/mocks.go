@@ -87,3 +87,27 @@ func (mr *MockIInMemoryRepositoryMockRecorder) CacheActualSecret(ctx, secret, ti
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheActualSecret", reflect.TypeOf((*MockIInMemoryRepository)(nil).CacheActualSecret), ctx, secret, timeout)
 }
+
+// DeleteSecret mocks base method
+func (m *MockIInMemoryRepository) DeleteSecret(ctx context.Context, id go_uuid.UUID) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteSecret", ctx, id)
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret
+func (mr *MockIInMemoryRepositoryMockRecorder) DeleteSecret(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockIInMemoryRepository)(nil).DeleteSecret), ctx, id)
+}
+
+// DeleteActualSecret mocks base method
+func (m *MockIInMemoryRepository) DeleteActualSecret(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DeleteActualSecret", ctx)
+}
+
+// DeleteActualSecret indicates an expected call of DeleteActualSecret
+func (mr *MockIInMemoryRepositoryMockRecorder) DeleteActualSecret(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActualSecret", reflect.TypeOf((*MockIInMemoryRepository)(nil).DeleteActualSecret), ctx)
+}
@@ -3,6 +3,7 @@ package inMemoryRepository
 import (
 	"hive/enums"
 	"hive/models"
+	"hive/repositories"
 	"context"
 	"fmt"
 	"github.com/opentracing/opentracing-go"
@@ -26,34 +27,47 @@ func (repository *InMemoryRepository) getSecret(key string) *models.Secret {
 	return secret
 }
 
-func getSecretKey(id uuid.UUID) string {
-	return fmt.Sprintf("%s:%s", enums.Secret, id.String())
+func getSecretKey(ctx context.Context, id uuid.UUID) string {
+	return repositories.GetRealmKey(ctx, fmt.Sprintf("%s:%s", enums.Secret, id.String()))
 }
 
 func (repository *InMemoryRepository) CacheActualSecret(ctx context.Context, secret *models.Secret, timeout time.Duration) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "cache actual secret in memory")
-	repository.cacheSecret(secret, enums.ActualSecret, timeout)
+	repository.cacheSecret(secret, repositories.GetRealmKey(ctx, enums.ActualSecret), timeout)
 	span.LogFields(log.String("secret_id", secret.Id.String()))
 	span.Finish()
 }
 
 func (repository *InMemoryRepository) CacheSecret(ctx context.Context, secret *models.Secret, timeout time.Duration) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "cache secret in memory")
-	repository.cacheSecret(secret, getSecretKey(secret.Id), timeout)
+	repository.cacheSecret(secret, getSecretKey(ctx, secret.Id), timeout)
 	span.LogFields(log.String("secret_id", secret.Id.String()))
 	span.Finish()
 }
 
 func (repository *InMemoryRepository) GetActualSecret(ctx context.Context) *models.Secret {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "Get actual secret")
-	secret := repository.getSecret(enums.ActualSecret)
+	secret := repository.getSecret(repositories.GetRealmKey(ctx, enums.ActualSecret))
 	span.Finish()
 	return secret
 }
 
 func (repository *InMemoryRepository) GetSecret(ctx context.Context, id uuid.UUID) *models.Secret {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "Get secret")
-	secret := repository.getSecret(getSecretKey(id))
+	secret := repository.getSecret(getSecretKey(ctx, id))
 	span.Finish()
 	return secret
 }
+
+func (repository *InMemoryRepository) DeleteSecret(ctx context.Context, id uuid.UUID) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Delete secret from memory")
+	repository.inMemoryCache.Delete(getSecretKey(ctx, id))
+	span.LogFields(log.String("secret_id", id.String()))
+	span.Finish()
+}
+
+func (repository *InMemoryRepository) DeleteActualSecret(ctx context.Context) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Delete actual secret from memory")
+	repository.inMemoryCache.Delete(repositories.GetRealmKey(ctx, enums.ActualSecret))
+	span.Finish()
+}
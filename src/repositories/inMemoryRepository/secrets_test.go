@@ -73,3 +73,19 @@ func TestGetExpiredSecretByIDFromInMemoryCache(t *testing.T) {
 	secretFromCache := repo.GetSecret(ctx, secret.Id)
 	require.Nil(t, secretFromCache)
 }
+
+func TestDeleteSecretFromInMemoryCache(t *testing.T) {
+	cache := config.InitInMemoryCache()
+	repo := InitInMemoryRepository(cache)
+	cache.Flush()
+	ctx := context.Background()
+	secret := &models.Secret{
+		Id:      uuid.NewV4(),
+		Created: 1,
+		Value:   uuid.NewV4(),
+	}
+	repo.CacheSecret(ctx, secret, time.Minute)
+	repo.DeleteSecret(ctx, secret.Id)
+	secretFromCache := repo.GetSecret(ctx, secret.Id)
+	require.Nil(t, secretFromCache)
+}
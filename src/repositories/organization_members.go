@@ -0,0 +1,129 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/models"
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+)
+
+func createOrganizationMemberSQL() string {
+	return `
+		INSERT INTO organization_members(id, organization_id, user_id, realm)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created, organization_id, user_id, 0;
+		`
+}
+
+func getOrganizationMembersSQL() string {
+	return `
+		SELECT id, created, organization_id, user_id, count(*) OVER() AS full_count
+		FROM organization_members
+		WHERE (array_length($1::uuid[], 1) IS NULL OR organization_id = ANY ($1::uuid[])) AND
+		      (array_length($2::uuid[], 1) IS NULL OR user_id = ANY ($2::uuid[])) AND
+		      realm = $5
+		ORDER BY created
+		LIMIT $3
+		OFFSET $4;
+		`
+}
+
+func deleteOrganizationMemberSQL() string {
+	return `
+		DELETE FROM organization_members WHERE id = $1 AND realm = $2 RETURNING id, created, organization_id, user_id, 0;
+		`
+}
+
+func unwrapOrganizationMemberScanError(err error) int {
+	var e *pgconn.PgError
+
+	if errors.As(err, &e) {
+		switch e.ConstraintName {
+		case "organization_members_organization_id_fkey":
+			return enums.OrganizationNotFound
+		case "organization_members_user_id_fkey":
+			return enums.UserNotFound
+		case "organization_members_organization_id_user_id_key":
+			return enums.OrganizationMemberAlreadyExist
+		}
+	} else if strings.Contains(err.Error(), "no rows in result") {
+		return enums.OrganizationMemberNotFound
+	}
+
+	sentry.CaptureException(err)
+	return enums.NotOk
+}
+
+func scanOrganizationMember(row pgx.Row) (int, *models.OrganizationMember, int64) {
+	member := &models.OrganizationMember{}
+	var count int64
+
+	err := row.Scan(&member.Id, &member.Created, &member.OrganizationId, &member.UserId, &count)
+	if err != nil {
+		return unwrapOrganizationMemberScanError(err), nil, 0
+	}
+
+	return enums.Ok, member, count
+}
+
+func scanOrganizationMembers(rows pgx.Rows, limit int) ([]*models.OrganizationMember, int64) {
+	members := make([]*models.OrganizationMember, limit)
+
+	var i int32
+	var count int64
+
+	for rows.Next() {
+		_, member, c := scanOrganizationMember(rows)
+		count = c
+		members[i] = member
+		i++
+	}
+
+	rows.Close()
+
+	return members[0:i], count
+}
+
+type GetOrganizationMembersQuery struct {
+	OrganizationId []uuid.UUID
+	UserId         []uuid.UUID
+	Pagination     *models.PaginationRequest
+}
+
+func CreateOrganizationMember(db DB, ctx context.Context, organizationID uuid.UUID, userID uuid.UUID) (int, *models.OrganizationMember) {
+	sql := createOrganizationMemberSQL()
+	row := db.QueryRow(ctx, sql, uuid.NewV4(), organizationID, userID, GetRealmFromContext(ctx).Name)
+	status, member, _ := scanOrganizationMember(row)
+	return status, member
+}
+
+func GetOrganizationMembers(db DB, ctx context.Context, query GetOrganizationMembersQuery) ([]*models.OrganizationMember, *models.PaginationResponse) {
+	sql := getOrganizationMembersSQL()
+	limit, offset := functools.LimitPageToLimitOffset(query.Pagination.Limit, query.Pagination.Page)
+	rows, err := db.Query(ctx, sql, functools.UUIDListToPGArray(query.OrganizationId), functools.UUIDListToPGArray(query.UserId), limit, offset, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, nil
+	}
+
+	members, totalCount := scanOrganizationMembers(rows, limit)
+
+	return members, &models.PaginationResponse{
+		HasNext:     functools.HasNext(totalCount, query.Pagination.Limit, query.Pagination.Page),
+		HasPrevious: functools.HasPrevious(query.Pagination.Page),
+		Count:       totalCount,
+	}
+}
+
+func DeleteOrganizationMember(db DB, ctx context.Context, id uuid.UUID) (int, *models.OrganizationMember) {
+	sql := deleteOrganizationMemberSQL()
+	row := db.QueryRow(ctx, sql, id, GetRealmFromContext(ctx).Name)
+	status, member, _ := scanOrganizationMember(row)
+	return status, member
+}
@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/models"
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	uuid "github.com/satori/go.uuid"
+	"math"
+	"strings"
+)
+
+func getOrganizationsSQL() string {
+	return `
+		SELECT id, created, title, count(*) OVER() AS full_count
+		FROM organizations
+		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[])) AND
+		      (array_length($2::text[], 1) IS NULL OR title = ANY ($2::text[])) AND
+		      realm = $5
+		ORDER BY created
+		LIMIT $3
+		OFFSET $4;
+		`
+}
+
+func createOrganizationSQL() string {
+	return "INSERT INTO organizations (id, title, realm) VALUES ($1, $2, $3) RETURNING id, created, title, 0;"
+}
+
+func unwrapOrganizationScanError(err error) int {
+	var e *pgconn.PgError
+	if errors.As(err, &e) && e.ConstraintName == "organizations_title_key" {
+		return enums.OrganizationAlreadyExist
+	} else if strings.Contains(err.Error(), "no rows") {
+		return enums.OrganizationNotFound
+	}
+
+	sentry.CaptureException(err)
+	return enums.NotOk
+}
+
+func scanOrganization(row pgx.Row) (int, *models.Organization, int64) {
+	organization := &models.Organization{}
+	var count int64
+
+	err := row.Scan(&organization.Id, &organization.Created, &organization.Title, &count)
+	if err != nil {
+		return unwrapOrganizationScanError(err), nil, 0
+	}
+
+	return enums.Ok, organization, count
+}
+
+func scanOrganizations(rows pgx.Rows, limit int) ([]*models.Organization, int64) {
+	organizations := make([]*models.Organization, limit)
+
+	var i int32
+	var count int64
+
+	for rows.Next() {
+		_, organization, c := scanOrganization(rows)
+		organizations[i] = organization
+		count = c
+		i++
+	}
+
+	rows.Close()
+
+	return organizations[0:i], count
+}
+
+type GetOrganizationsQuery struct {
+	Pagination  *models.PaginationRequest
+	Identifiers []uuid.UUID
+	Titles      []string
+}
+
+type getOrganizationsRawQuery struct {
+	Limit       int
+	Offset      int
+	Identifiers string
+	Titles      string
+}
+
+func convertGetOrganizationsQueryToRaw(query GetOrganizationsQuery) getOrganizationsRawQuery {
+
+	limit := query.Pagination.Limit
+	if len(query.Identifiers) > 0 {
+		limit = int(math.Min(
+			float64(query.Pagination.Limit),
+			float64(len(query.Identifiers))))
+	}
+
+	limit, offset := functools.LimitPageToLimitOffset(limit, query.Pagination.Page)
+
+	return getOrganizationsRawQuery{
+		Limit:       limit,
+		Offset:      offset,
+		Identifiers: functools.UUIDListToPGArray(query.Identifiers),
+		Titles:      functools.StringsToPGArray(query.Titles),
+	}
+}
+
+func CreateOrganization(db DB, context context.Context, title string) (int, *models.Organization) {
+	sql := createOrganizationSQL()
+	row := db.QueryRow(context, sql, uuid.NewV4(), title, GetRealmFromContext(context).Name)
+	status, organization, _ := scanOrganization(row)
+	return status, organization
+}
+
+func GetOrganization(db DB, context context.Context, id uuid.UUID) (int, *models.Organization) {
+	sql := getOrganizationsSQL()
+	row := db.QueryRow(context, sql, functools.UUIDListToPGArray([]uuid.UUID{id}), "{}", 1, 0, GetRealmFromContext(context).Name)
+	status, organization, _ := scanOrganization(row)
+	return status, organization
+}
+
+func GetOrganizations(db DB, context context.Context, query GetOrganizationsQuery) ([]*models.Organization, *models.PaginationResponse) {
+
+	sql := getOrganizationsSQL()
+	rawQuery := convertGetOrganizationsQueryToRaw(query)
+
+	rows, err := db.Query(context, sql, rawQuery.Identifiers, rawQuery.Titles, rawQuery.Limit, rawQuery.Offset, GetRealmFromContext(context).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, nil
+	}
+
+	organizations, count := scanOrganizations(rows, rawQuery.Limit)
+
+	return organizations, &models.PaginationResponse{
+		HasNext:     functools.HasNext(count, query.Pagination.Limit, query.Pagination.Page),
+		HasPrevious: functools.HasPrevious(query.Pagination.Page),
+		Count:       count,
+	}
+}
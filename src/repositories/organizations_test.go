@@ -0,0 +1,76 @@
+package repositories
+
+import (
+	"hive/config"
+	"hive/enums"
+	"hive/models"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateOrganization(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeOrganizations(pool, ctx)
+	status, organization := CreateOrganization(pool, ctx, "hive")
+	require.Equal(t, enums.Ok, status)
+	require.NotNil(t, organization)
+
+	status, organization = CreateOrganization(pool, ctx, "hive")
+	require.Equal(t, enums.OrganizationAlreadyExist, status)
+	require.Nil(t, organization)
+
+	organizations, _ := GetOrganizations(pool, ctx, GetOrganizationsQuery{
+		Pagination: &models.PaginationRequest{Limit: 10},
+	})
+	require.Len(t, organizations, 1)
+}
+
+func TestCreateOrganizationMember(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeOrganizations(pool, ctx)
+	PurgeUsers(pool, ctx)
+	_, organization := CreateOrganization(pool, ctx, "hive")
+	user := CreateUser(pool, ctx)
+
+	status, member := CreateOrganizationMember(pool, ctx, organization.Id, user.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, user.Id, member.UserId)
+
+	status, _ = CreateOrganizationMember(pool, ctx, organization.Id, user.Id)
+	require.Equal(t, enums.OrganizationMemberAlreadyExist, status)
+
+	status, _ = CreateOrganizationMember(pool, ctx, uuid.NewV4(), user.Id)
+	require.Equal(t, enums.OrganizationNotFound, status)
+}
+
+func TestCreateOrganizationUserRole(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeOrganizations(pool, ctx)
+	PurgeUsers(pool, ctx)
+	PurgeRoles(pool, ctx)
+	PurgeUserViews(pool, ctx)
+	_, organization := CreateOrganization(pool, ctx, "hive")
+	_, role := CreateRole(pool, ctx, "manager", []string{"users:read"}, nil)
+	user := CreateUser(pool, ctx)
+
+	status, _ := CreateUserRole(pool, ctx, user.Id, role.Id, organization.Id, 0, 0)
+	require.Equal(t, enums.OrganizationMemberNotFound, status)
+
+	CreateOrganizationMember(pool, ctx, organization.Id, user.Id)
+	status, userRole := CreateUserRole(pool, ctx, user.Id, role.Id, organization.Id, 0, 0)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, organization.Id, userRole.OrganizationId)
+
+	views := CreateOrUpdateUsersView(pool, ctx, CreateOrUpdateUsersViewStoreQuery{Id: []uuid.UUID{user.Id}})
+	require.Len(t, views, 1)
+	require.Len(t, views[0].Roles, 0)
+	require.Len(t, views[0].Organizations, 1)
+	require.Equal(t, organization.Id, views[0].Organizations[0].Id)
+	require.Equal(t, []string{"manager"}, views[0].Organizations[0].Roles)
+	require.Equal(t, []string{"users:read"}, views[0].Organizations[0].Permissions)
+}
@@ -26,6 +26,16 @@ func getPasswordsSQL() string {
 			LIMIT $2;`
 }
 
+// Only moments of password changes are exported, hashes never leave database
+func getPasswordsChangedSQL() string {
+	return `
+		SELECT created
+		FROM passwords
+		WHERE user_id = $1
+		ORDER BY created;
+		`
+}
+
 func unwrapPasswordScanErrors(err error) int {
 	var e *pgconn.PgError
 	if errors.As(err, &e) && strings.Contains(e.Detail, "is not present in table \"users\"") || strings.Contains(e.Detail, "отсутствует в таблице \"users\"") {
@@ -86,3 +96,26 @@ func GetLatestPassword(db DB, ctx context.Context, userId uuid.UUID) (int, *mode
 	row := db.QueryRow(ctx, sql, userId, 1)
 	return scanPassword(row)
 }
+
+func GetPasswordsChanged(db DB, ctx context.Context, userID uuid.UUID) []int64 {
+	rows, err := db.Query(ctx, getPasswordsChangedSQL(), userID)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	changed := make([]int64, 0)
+	for rows.Next() {
+		var created int64
+		if err := rows.Scan(&created); err != nil {
+			sentry.CaptureException(err)
+			return nil
+		}
+
+		changed = append(changed, created)
+	}
+
+	return changed
+}
@@ -9,13 +9,13 @@ import (
 	"time"
 )
 
-func getPhoneConfirmationCodeKey(phone string) string {
-	return fmt.Sprintf("%s:%s", enums.PhoneConfirmationCode, phone)
+func getPhoneConfirmationCodeKey(ctx context.Context, phone string) string {
+	return GetRealmKey(ctx, fmt.Sprintf("%s:%s", enums.PhoneConfirmationCode, phone))
 }
 
 func CreatePhoneConfirmationCode(cache *redis.Client, ctx context.Context, phone string, code string, duration time.Duration) *models.PhoneConfirmation {
 
-	key := getPhoneConfirmationCodeKey(phone)
+	key := getPhoneConfirmationCodeKey(ctx, phone)
 	cmd := cache.WithContext(ctx).Set(key, code, duration)
 	err := cmd.Err()
 	if err != nil {
@@ -35,7 +35,7 @@ func CreatePhoneConfirmationCode(cache *redis.Client, ctx context.Context, phone
 
 func GetPhoneConfirmationCode(cache *redis.Client, ctx context.Context, phone string) string {
 
-	key := getPhoneConfirmationCodeKey(phone)
+	key := getPhoneConfirmationCodeKey(ctx, phone)
 	code, err := cache.WithContext(ctx).Get(key).Result()
 	if err != nil {
 		return ""
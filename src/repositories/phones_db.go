@@ -10,19 +10,59 @@ import (
 	"github.com/jackc/pgx/v4"
 	uuid "github.com/satori/go.uuid"
 	"strings"
+	"time"
 )
 
 func createPhoneSQL() string {
-	return `INSERT INTO phones (id, user_id, value) 
-			VALUES ($1, $2, $3)
-			ON CONFLICT (value) 
+	return `INSERT INTO phones (id, user_id, value, realm, verified_at, is_primary)
+			VALUES ($1, $2, $3, $4, $5, NOT EXISTS (SELECT 1 FROM phones WHERE user_id = $2 AND is_primary))
+			ON CONFLICT (realm, value) 
 			    DO UPDATE SET created=DEFAULT,
-			                  user_id=excluded.user_id
-			RETURNING id, created, user_id, value;`
+			                  user_id=excluded.user_id,
+			                  verified_at=excluded.verified_at,
+			                  is_primary=CASE WHEN phones.user_id = excluded.user_id THEN phones.is_primary ELSE excluded.is_primary END
+			RETURNING id, created, user_id, value, is_primary, verified_at;`
 }
 
 func getPhoneSQL() string {
-	return `SELECT id, created, user_id, value FROM phones WHERE value = $1;`
+	return `SELECT id, created, user_id, value, is_primary, verified_at FROM phones WHERE value = $1 AND realm = $2;`
+}
+
+func getUserPhonesSQL() string {
+	return `
+		SELECT id, created, user_id, value, is_primary, verified_at
+		FROM phones
+		WHERE user_id = $1 AND realm = $2
+		ORDER BY is_primary DESC, created;
+		`
+}
+
+func getPhoneByIDSQL() string {
+	return `SELECT id, created, user_id, value, is_primary, verified_at FROM phones WHERE id = $1 AND realm = $2;`
+}
+
+func deletePhoneSQL() string {
+	return `DELETE FROM phones WHERE id = $1 AND realm = $2 RETURNING id, created, user_id, value, is_primary, verified_at;`
+}
+
+// Primary phone is unset before another one is set, since unique index of primary phones is checked for every row
+func unsetPrimaryPhoneSQL() string {
+	return `UPDATE phones SET is_primary = false WHERE user_id = $1 AND realm = $2 AND is_primary;`
+}
+
+func setPrimaryPhoneSQL() string {
+	return `UPDATE phones SET is_primary = true WHERE id = $1 AND realm = $2
+			RETURNING id, created, user_id, value, is_primary, verified_at;`
+}
+
+// The earliest phone of user without primary one becomes primary
+func ensurePrimaryPhoneSQL() string {
+	return `
+		UPDATE phones
+		SET is_primary = true
+		WHERE id = (SELECT id FROM phones WHERE user_id = $1 AND realm = $2 ORDER BY created, id LIMIT 1) AND
+		      NOT EXISTS (SELECT 1 FROM phones WHERE user_id = $1 AND realm = $2 AND is_primary);
+		`
 }
 
 func unwrapPhoneScanError(err error) int {
@@ -40,7 +80,7 @@ func unwrapPhoneScanError(err error) int {
 func scanPhone(row pgx.Row) (int, *models.Phone) {
 	phone := &models.Phone{}
 
-	err := row.Scan(&phone.Id, &phone.Created, &phone.UserId, &phone.Value)
+	err := row.Scan(&phone.Id, &phone.Created, &phone.UserId, &phone.Value, &phone.Primary, &phone.VerifiedAt)
 	if err != nil {
 		return unwrapPhoneScanError(err), nil
 	}
@@ -50,12 +90,76 @@ func scanPhone(row pgx.Row) (int, *models.Phone) {
 
 func CreatePhone(db DB, ctx context.Context, userId uuid.UUID, value string) (int, *models.Phone) {
 	sql := createPhoneSQL()
-	row := db.QueryRow(ctx, sql, uuid.NewV4(), userId, value)
+	row := db.QueryRow(ctx, sql, uuid.NewV4(), userId, value, GetRealmFromContext(ctx).Name, time.Now().UnixNano()/int64(time.Millisecond))
 	return scanPhone(row)
 }
 
 func GetPhone(db DB, ctx context.Context, phone string) (int, *models.Phone) {
 	sql := getPhoneSQL()
-	row := db.QueryRow(ctx, sql, phone)
+	row := db.QueryRow(ctx, sql, phone, GetRealmFromContext(ctx).Name)
 	return scanPhone(row)
 }
+
+func GetUserPhones(db DB, ctx context.Context, userID uuid.UUID) []*models.Phone {
+	rows, err := db.Query(ctx, getUserPhonesSQL(), userID, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	phones := make([]*models.Phone, 0)
+	for rows.Next() {
+		if status, phone := scanPhone(rows); status == enums.Ok && phone != nil {
+			phones = append(phones, phone)
+		}
+	}
+
+	return phones
+}
+
+func GetPhoneByID(db DB, ctx context.Context, id uuid.UUID) (int, *models.Phone) {
+	status, phone := scanPhone(db.QueryRow(ctx, getPhoneByIDSQL(), id, GetRealmFromContext(ctx).Name))
+	if status == enums.Ok && phone == nil {
+		return enums.PhoneNotFound, nil
+	}
+
+	return status, phone
+}
+
+func DeletePhone(db DB, ctx context.Context, id uuid.UUID) (int, *models.Phone) {
+	status, phone := scanPhone(db.QueryRow(ctx, deletePhoneSQL(), id, GetRealmFromContext(ctx).Name))
+	if status == enums.Ok && phone == nil {
+		return enums.PhoneNotFound, nil
+	}
+
+	return status, phone
+}
+
+// SetPrimaryPhone makes phone primary one of its owner, db should be transaction
+func SetPrimaryPhone(db DB, ctx context.Context, id uuid.UUID, userID uuid.UUID) (int, *models.Phone) {
+	_, err := db.Exec(ctx, unsetPrimaryPhoneSQL(), userID, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, phone := scanPhone(db.QueryRow(ctx, setPrimaryPhoneSQL(), id, GetRealmFromContext(ctx).Name))
+	if status == enums.Ok && phone == nil {
+		return enums.PhoneNotFound, nil
+	}
+
+	return status, phone
+}
+
+// EnsurePrimaryPhone makes the earliest phone of user primary if user has phones but none of them is primary
+func EnsurePrimaryPhone(db DB, ctx context.Context, userID uuid.UUID) int {
+	_, err := db.Exec(ctx, ensurePrimaryPhoneSQL(), userID, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk
+	}
+
+	return enums.Ok
+}
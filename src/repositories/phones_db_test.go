@@ -1,2 +1,29 @@
 package repositories
 
+import (
+	"hive/config"
+	"hive/enums"
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestPrimaryPhone(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUsers(pool, ctx)
+	PurgePhones(pool, ctx)
+	user := CreateUser(pool, ctx)
+	_, first := CreatePhone(pool, ctx, user.Id, "79999999991")
+	_, second := CreatePhone(pool, ctx, user.Id, "79999999992")
+	require.True(t, first.Primary)
+	require.False(t, second.Primary)
+
+	status, second := SetPrimaryPhone(pool, ctx, second.Id, user.Id)
+	require.Equal(t, enums.Ok, status)
+	require.True(t, second.Primary)
+
+	status, first = GetPhoneByID(pool, ctx, first.Id)
+	require.Equal(t, enums.Ok, status)
+	require.False(t, first.Primary)
+}
@@ -3,6 +3,7 @@ package postgresRepository
 import (
 	"hive/config"
 	"hive/models"
+	"hive/secretEncryptors"
 	"context"
 	"github.com/jackc/pgx/v4/pgxpool"
 	uuid "github.com/satori/go.uuid"
@@ -14,21 +15,30 @@ type IPostgresRepository interface {
 
 	CreateSecret(ctx context.Context) *models.Secret
 	GetSecret(ctx context.Context, id uuid.UUID) *models.Secret
+	GetSecrets(ctx context.Context, query GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse)
+	ExpireSecrets(ctx context.Context) []*models.Secret
+	RevokeSecret(ctx context.Context, id uuid.UUID) *models.Secret
+	ReencryptSecrets(ctx context.Context) []uuid.UUID
 
 	// Sessions
 
-	CreateSession(ctx context.Context, userID uuid.UUID, secretID uuid.UUID, fingerprint string, userAgent string) *models.Session
+	CreateSession(ctx context.Context, userID uuid.UUID, secretID uuid.UUID, fingerprint string, userAgent string, keyThumbprint string, organizationID uuid.UUID, authentication *models.AuthenticationContext) *models.Session
 	DeleteSession(ctx context.Context, id uuid.UUID) *models.Session
+	DeleteUserSessions(ctx context.Context, userID uuid.UUID) []*models.Session
+	GetUserSessions(ctx context.Context, userID uuid.UUID) []*models.Session
+	GetSessionByPublicID(ctx context.Context, publicID uuid.UUID) *models.Session
 }
 
 type PostgresRepository struct {
-	pool        *pgxpool.Pool
-	environment *config.Environment
+	pool            *pgxpool.Pool
+	environment     *config.Environment
+	secretEncryptor secretEncryptors.ISecretEncryptor
 }
 
-func InitPostgresRepository(pool *pgxpool.Pool, environment *config.Environment) *PostgresRepository {
+func InitPostgresRepository(pool *pgxpool.Pool, environment *config.Environment, secretEncryptor secretEncryptors.ISecretEncryptor) *PostgresRepository {
 	return &PostgresRepository{
-		pool:        pool,
-		environment: environment,
+		pool:            pool,
+		environment:     environment,
+		secretEncryptor: secretEncryptor,
 	}
 }
@@ -63,18 +63,75 @@ func (mr *MockIPostgresRepositoryMockRecorder) GetSecret(ctx, id interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockIPostgresRepository)(nil).GetSecret), ctx, id)
 }
 
+// GetSecrets mocks base method
+func (m *MockIPostgresRepository) GetSecrets(ctx context.Context, query GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecrets", ctx, query)
+	ret0, _ := ret[0].([]*models.Secret)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetSecrets indicates an expected call of GetSecrets
+func (mr *MockIPostgresRepositoryMockRecorder) GetSecrets(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecrets", reflect.TypeOf((*MockIPostgresRepository)(nil).GetSecrets), ctx, query)
+}
+
+// ExpireSecrets mocks base method
+func (m *MockIPostgresRepository) ExpireSecrets(ctx context.Context) []*models.Secret {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireSecrets", ctx)
+	ret0, _ := ret[0].([]*models.Secret)
+	return ret0
+}
+
+// ExpireSecrets indicates an expected call of ExpireSecrets
+func (mr *MockIPostgresRepositoryMockRecorder) ExpireSecrets(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireSecrets", reflect.TypeOf((*MockIPostgresRepository)(nil).ExpireSecrets), ctx)
+}
+
+// RevokeSecret mocks base method
+func (m *MockIPostgresRepository) RevokeSecret(ctx context.Context, id go_uuid.UUID) *models.Secret {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecret", ctx, id)
+	ret0, _ := ret[0].(*models.Secret)
+	return ret0
+}
+
+// RevokeSecret indicates an expected call of RevokeSecret
+func (mr *MockIPostgresRepositoryMockRecorder) RevokeSecret(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecret", reflect.TypeOf((*MockIPostgresRepository)(nil).RevokeSecret), ctx, id)
+}
+
+// ReencryptSecrets mocks base method
+func (m *MockIPostgresRepository) ReencryptSecrets(ctx context.Context) []go_uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencryptSecrets", ctx)
+	ret0, _ := ret[0].([]go_uuid.UUID)
+	return ret0
+}
+
+// ReencryptSecrets indicates an expected call of ReencryptSecrets
+func (mr *MockIPostgresRepositoryMockRecorder) ReencryptSecrets(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptSecrets", reflect.TypeOf((*MockIPostgresRepository)(nil).ReencryptSecrets), ctx)
+}
+
 // CreateSession mocks base method
-func (m *MockIPostgresRepository) CreateSession(ctx context.Context, userID, secretID go_uuid.UUID, fingerprint, userAgent string) *models.Session {
+func (m *MockIPostgresRepository) CreateSession(ctx context.Context, userID, secretID go_uuid.UUID, fingerprint, userAgent, keyThumbprint string, organizationID go_uuid.UUID, authentication *models.AuthenticationContext) *models.Session {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, secretID, fingerprint, userAgent)
+	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, secretID, fingerprint, userAgent, keyThumbprint, organizationID, authentication)
 	ret0, _ := ret[0].(*models.Session)
 	return ret0
 }
 
 // CreateSession indicates an expected call of CreateSession
-func (mr *MockIPostgresRepositoryMockRecorder) CreateSession(ctx, userID, secretID, fingerprint, userAgent interface{}) *gomock.Call {
+func (mr *MockIPostgresRepositoryMockRecorder) CreateSession(ctx, userID, secretID, fingerprint, userAgent, keyThumbprint, organizationID, authentication interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockIPostgresRepository)(nil).CreateSession), ctx, userID, secretID, fingerprint, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockIPostgresRepository)(nil).CreateSession), ctx, userID, secretID, fingerprint, userAgent, keyThumbprint, organizationID, authentication)
 }
 
 // DeleteSession mocks base method
@@ -90,3 +147,45 @@ func (mr *MockIPostgresRepositoryMockRecorder) DeleteSession(ctx, id interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockIPostgresRepository)(nil).DeleteSession), ctx, id)
 }
+
+// DeleteUserSessions mocks base method
+func (m *MockIPostgresRepository) DeleteUserSessions(ctx context.Context, userID go_uuid.UUID) []*models.Session {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", ctx, userID)
+	ret0, _ := ret[0].([]*models.Session)
+	return ret0
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions
+func (mr *MockIPostgresRepositoryMockRecorder) DeleteUserSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockIPostgresRepository)(nil).DeleteUserSessions), ctx, userID)
+}
+
+// GetUserSessions mocks base method
+func (m *MockIPostgresRepository) GetUserSessions(ctx context.Context, userID go_uuid.UUID) []*models.Session {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserSessions", ctx, userID)
+	ret0, _ := ret[0].([]*models.Session)
+	return ret0
+}
+
+// GetUserSessions indicates an expected call of GetUserSessions
+func (mr *MockIPostgresRepositoryMockRecorder) GetUserSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserSessions", reflect.TypeOf((*MockIPostgresRepository)(nil).GetUserSessions), ctx, userID)
+}
+
+// GetSessionByPublicID mocks base method
+func (m *MockIPostgresRepository) GetSessionByPublicID(ctx context.Context, publicID go_uuid.UUID) *models.Session {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionByPublicID", ctx, publicID)
+	ret0, _ := ret[0].(*models.Session)
+	return ret0
+}
+
+// GetSessionByPublicID indicates an expected call of GetSessionByPublicID
+func (mr *MockIPostgresRepositoryMockRecorder) GetSessionByPublicID(ctx, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionByPublicID", reflect.TypeOf((*MockIPostgresRepository)(nil).GetSessionByPublicID), ctx, publicID)
+}
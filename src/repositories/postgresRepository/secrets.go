@@ -3,45 +3,232 @@ package postgresRepository
 import (
 	"hive/functools"
 	"hive/models"
+	"hive/repositories"
 	"context"
+	"errors"
 	"github.com/getsentry/sentry-go"
 	"github.com/jackc/pgx/v4"
 	uuid "github.com/satori/go.uuid"
 )
 
 func createSecretSQL() string {
-	return "INSERT INTO secrets (id, created, value) VALUES ($1, default, $2) RETURNING id, created, value;"
+	return `
+		INSERT INTO secrets (id, created, encrypted_value, master_key_id, expires, realm)
+		VALUES ($1, default, $2, $3, extract(epoch from now()) * 1000 + $4, $5)
+		RETURNING id, created, value, encrypted_value, master_key_id, expires, revoked, 0;
+		`
 }
 
 func getSecretsSQL() string {
 	return `
-		SELECT id, created, value
+		SELECT id, created, value, encrypted_value, master_key_id, expires, revoked, count(*) OVER() AS full_count
 		FROM secrets
-		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[]))
-		LIMIT $2;
+		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[])) AND
+		      realm = $4
+		ORDER BY created DESC
+		LIMIT $2
+		OFFSET $3;
+		`
+}
+
+func expireSecretsSQL() string {
+	return `
+		UPDATE secrets
+		SET expires = extract(epoch from now()) * 1000
+		WHERE expires > extract(epoch from now()) * 1000 AND
+		      realm = $1
+		RETURNING id, created, value, encrypted_value, master_key_id, expires, revoked, 0;
+		`
+}
+
+func revokeSecretSQL() string {
+	return `
+		UPDATE secrets
+		SET revoked = COALESCE(revoked, extract(epoch from now()) * 1000),
+		    expires = LEAST(expires, extract(epoch from now()) * 1000)
+		WHERE id = $1 AND realm = $2
+		RETURNING id, created, value, encrypted_value, master_key_id, expires, revoked, 0;
+		`
+}
+
+func getSecretsForReencryptionSQL() string {
+	return `
+		SELECT id, created, value, encrypted_value, master_key_id, expires, revoked, 0
+		FROM secrets
+		WHERE master_key_id IS DISTINCT FROM $1 AND
+		      realm = $2;
+		`
+}
+
+func updateSecretEncryptionSQL() string {
+	return `
+		UPDATE secrets
+		SET value = NULL,
+		    encrypted_value = $2,
+		    master_key_id = $3
+		WHERE id = $1;
 		`
 }
 
-func scanSecret(row pgx.Row) *models.Secret {
+func (repository *PostgresRepository) scanSecret(ctx context.Context, row pgx.Row) (*models.Secret, int64) {
 	secret := &models.Secret{}
+	var value *uuid.UUID
+	var encryptedValue []byte
+	var masterKeyID *string
+	var expires, revoked *int64
+	var count int64
 
-	err := row.Scan(&secret.Id, &secret.Created, &secret.Value)
+	err := row.Scan(&secret.Id, &secret.Created, &value, &encryptedValue, &masterKeyID, &expires, &revoked, &count)
 	if err != nil {
 		sentry.CaptureException(err)
-		return nil
+		return nil, 0
 	}
 
-	return secret
+	if encryptedValue != nil && masterKeyID != nil {
+		decryptedValue := repository.secretEncryptor.Decrypt(ctx, *masterKeyID, encryptedValue, secret.Id.Bytes())
+		secret.Value, err = uuid.FromBytes(decryptedValue)
+		if err != nil {
+			sentry.CaptureException(err)
+			return nil, 0
+		}
+	} else if value != nil {
+		secret.Value = *value
+	} else {
+		sentry.CaptureException(errors.New("secret has no value"))
+		return nil, 0
+	}
+
+	if expires != nil {
+		secret.Expires = *expires
+	}
+
+	if revoked != nil {
+		secret.Revoked = *revoked
+	}
+
+	return secret, count
+}
+
+func (repository *PostgresRepository) scanSecrets(ctx context.Context, rows pgx.Rows, limit int) ([]*models.Secret, int64) {
+	secrets := make([]*models.Secret, limit)
+
+	var i int
+	var count int64
+
+	for rows.Next() {
+		secret, c := repository.scanSecret(ctx, rows)
+		count = c
+
+		if len(secrets) <= i {
+			secrets = append(secrets, secret)
+		} else {
+			secrets[i] = secret
+		}
+
+		i++
+	}
+
+	rows.Close()
+
+	return secrets[0:i], count
+}
+
+type GetSecretsQuery struct {
+	Pagination  *models.PaginationRequest
+	Identifiers []uuid.UUID
 }
 
 func (repository *PostgresRepository) CreateSecret(ctx context.Context) *models.Secret {
 	sql := createSecretSQL()
-	row := repository.pool.QueryRow(ctx, sql, uuid.NewV4(), uuid.NewV4())
-	return scanSecret(row)
+	id := uuid.NewV4()
+	masterKeyID, encryptedValue := repository.secretEncryptor.Encrypt(ctx, uuid.NewV4().Bytes(), id.Bytes())
+	if encryptedValue == nil {
+		return nil
+	}
+
+	realm := repositories.GetRealmFromContext(ctx)
+	lifetime := realm.GetActualSecretLifetime(repository.environment) * 60 * 1000
+	row := repository.pool.QueryRow(ctx, sql, id, encryptedValue, masterKeyID, lifetime, realm.Name)
+	secret, _ := repository.scanSecret(ctx, row)
+	return secret
 }
 
 func (repository *PostgresRepository) GetSecret(ctx context.Context, id uuid.UUID) *models.Secret {
 	sql := getSecretsSQL()
-	row := repository.pool.QueryRow(ctx, sql, functools.StringsToPGArray([]string{id.String()}), 1)
-	return scanSecret(row)
+	row := repository.pool.QueryRow(ctx, sql, functools.StringsToPGArray([]string{id.String()}), 1, 0, repositories.GetRealmFromContext(ctx).Name)
+	secret, _ := repository.scanSecret(ctx, row)
+	return secret
+}
+
+func (repository *PostgresRepository) GetSecrets(ctx context.Context, query GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse) {
+	sql := getSecretsSQL()
+	limit, offset := functools.LimitPageToLimitOffset(query.Pagination.Limit, query.Pagination.Page)
+	rows, err := repository.pool.Query(ctx, sql, functools.UUIDListToPGArray(query.Identifiers), limit, offset, repositories.GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, nil
+	}
+
+	secrets, count := repository.scanSecrets(ctx, rows, limit)
+
+	return secrets, &models.PaginationResponse{
+		HasNext:     functools.HasNext(count, query.Pagination.Limit, query.Pagination.Page),
+		HasPrevious: functools.HasPrevious(query.Pagination.Page),
+		Count:       count,
+	}
+}
+
+func (repository *PostgresRepository) ExpireSecrets(ctx context.Context) []*models.Secret {
+	sql := expireSecretsSQL()
+	rows, err := repository.pool.Query(ctx, sql, repositories.GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	secrets, _ := repository.scanSecrets(ctx, rows, 0)
+	return secrets
+}
+
+func (repository *PostgresRepository) RevokeSecret(ctx context.Context, id uuid.UUID) *models.Secret {
+	sql := revokeSecretSQL()
+	row := repository.pool.QueryRow(ctx, sql, id, repositories.GetRealmFromContext(ctx).Name)
+	secret, _ := repository.scanSecret(ctx, row)
+	return secret
+}
+
+// ReencryptSecrets encrypts with current master key all secrets of realm which are stored in plain text or
+// encrypted with previous master key, returns identifiers of updated secrets
+func (repository *PostgresRepository) ReencryptSecrets(ctx context.Context) []uuid.UUID {
+	masterKeyID := repository.secretEncryptor.GetKeyID()
+
+	rows, err := repository.pool.Query(ctx, getSecretsForReencryptionSQL(), masterKeyID, repositories.GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	secrets, _ := repository.scanSecrets(ctx, rows, 0)
+	identifiers := make([]uuid.UUID, 0, len(secrets))
+
+	for _, secret := range secrets {
+		if secret == nil {
+			continue
+		}
+
+		keyID, encryptedValue := repository.secretEncryptor.Encrypt(ctx, secret.Value.Bytes(), secret.Id.Bytes())
+		if encryptedValue == nil {
+			continue
+		}
+
+		_, err = repository.pool.Exec(ctx, updateSecretEncryptionSQL(), secret.Id, encryptedValue, keyID)
+		if err != nil {
+			sentry.CaptureException(err)
+			continue
+		}
+
+		identifiers = append(identifiers, secret.Id)
+	}
+
+	return identifiers
 }
@@ -2,6 +2,7 @@ package postgresRepository
 
 import (
 	"hive/config"
+	"hive/secretEncryptors"
 	"context"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/require"
@@ -11,7 +12,7 @@ import (
 func TestCreateSecret(t *testing.T) {
 	env := config.InitEnvironment()
 	pool := config.InitPool(nil, env)
-	repo := InitPostgresRepository(pool, env)
+	repo := InitPostgresRepository(pool, env, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(env)))
 	ctx := context.Background()
 	PurgeSecrets(pool, ctx)
 	secret := repo.CreateSecret(ctx)
@@ -22,7 +23,7 @@ func TestCreateSecret(t *testing.T) {
 func TestGetSecretFromDB(t *testing.T) {
 	env := config.InitEnvironment()
 	pool := config.InitPool(nil, env)
-	repo := InitPostgresRepository(pool, env)
+	repo := InitPostgresRepository(pool, env, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(env)))
 	ctx := context.Background()
 	PurgeSecrets(pool, ctx)
 	createdSecret := repo.CreateSecret(ctx)
@@ -34,7 +35,7 @@ func TestGetSecretFromDB(t *testing.T) {
 func TestGetSecretFromDBWithoutSecret(t *testing.T) {
 	env := config.InitEnvironment()
 	pool := config.InitPool(nil, env)
-	repo := InitPostgresRepository(pool, env)
+	repo := InitPostgresRepository(pool, env, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(env)))
 	ctx := context.Background()
 	PurgeSecrets(pool, ctx)
 	secret := repo.GetSecret(ctx, uuid.NewV4())
@@ -3,6 +3,7 @@ package postgresRepository
 import (
 	"hive/functools"
 	"hive/models"
+	"hive/repositories"
 	"context"
 	"github.com/getsentry/sentry-go"
 	"github.com/jackc/pgx/v4"
@@ -11,43 +12,76 @@ import (
 )
 
 func createSessionSQL() string {
-	return `INSERT INTO sessions (id, user_id, secret_id, fingerprint, user_agent, created, expires) 
-			VALUES ($1, $2, $3, $4, $5, $6, $7)
-			RETURNING id, user_id, secret_id, fingerprint, user_agent, created, expires;`
+	return `INSERT INTO sessions (id, public_id, user_id, secret_id, fingerprint, user_agent, created, expires, key_thumbprint, auth_time, auth_methods, organization_id, realm) 
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
+			RETURNING id, public_id, user_id, secret_id, fingerprint, user_agent, created, expires, key_thumbprint, auth_time, auth_methods, organization_id;`
 }
 
 func deleteSessionSQL() string {
 	return `
 			DELETE FROM sessions 
-			WHERE id = $1::uuid
-			RETURNING id, user_id, secret_id, fingerprint, user_agent, created, expires;
+			WHERE id = $1::uuid AND realm = $2
+			RETURNING id, public_id, user_id, secret_id, fingerprint, user_agent, created, expires, key_thumbprint, auth_time, auth_methods, organization_id;
+			`
+}
+
+func deleteUserSessionsSQL() string {
+	return `
+			DELETE FROM sessions 
+			WHERE user_id = $1::uuid AND realm = $2
+			RETURNING id, public_id, user_id, secret_id, fingerprint, user_agent, created, expires, key_thumbprint, auth_time, auth_methods, organization_id;
 			`
 }
 
 func getSessionsSQL() string {
 	return `
-			SELECT id, user_id, secret_id, fingerprint, user_agent, created, expires
+			SELECT id, public_id, user_id, secret_id, fingerprint, user_agent, created, expires, key_thumbprint, auth_time, auth_methods, organization_id
 			FROM sessions
-			WHERE id = $1::uuid;
+			WHERE id = $1::uuid AND realm = $2;
+			`
+}
+
+func getUserSessionsSQL() string {
+	return `
+			SELECT id, public_id, user_id, secret_id, fingerprint, user_agent, created, expires, key_thumbprint, auth_time, auth_methods, organization_id
+			FROM sessions
+			WHERE user_id = $1::uuid AND realm = $2
+			ORDER BY created;
+			`
+}
+
+func getSessionByPublicIDSQL() string {
+	return `
+			SELECT id, public_id, user_id, secret_id, fingerprint, user_agent, created, expires, key_thumbprint, auth_time, auth_methods, organization_id
+			FROM sessions
+			WHERE public_id = $1::uuid AND realm = $2;
 			`
 }
 
 func scanSession(row pgx.Row) *models.Session {
 	session := &models.Session{}
+	var organizationID uuid.NullUUID
 
 	err := row.Scan(
 		&session.Id,
+		&session.PublicID,
 		&session.UserID,
 		&session.SecretID,
 		&session.Fingerprint,
 		&session.UserAgent,
 		&session.Created,
-		&session.Expires)
+		&session.Expires,
+		&session.KeyThumbprint,
+		&session.AuthTime,
+		&session.AuthMethods,
+		&organizationID)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil
 	}
 
+	session.RefreshToken = session.Id
+	session.OrganizationID = organizationID.UUID
 	return session
 }
 
@@ -75,11 +109,12 @@ type GetSessionsQuery struct {
 	Now             int64
 }
 
-func (repository *PostgresRepository) CreateSession(ctx context.Context, userID, secretID uuid.UUID, fingerprint, userAgent string) *models.Session {
+func (repository *PostgresRepository) CreateSession(ctx context.Context, userID, secretID uuid.UUID, fingerprint, userAgent, keyThumbprint string, organizationID uuid.UUID, authentication *models.AuthenticationContext) *models.Session {
 	sql := createSessionSQL()
 	created := time.Now()
-	expires := time.Now().Add(time.Minute * time.Duration(repository.environment.AccessTokenLifetime))
-	row := repository.pool.QueryRow(ctx, sql, uuid.NewV4(), userID, secretID, fingerprint, userAgent, created.Unix(), expires.Unix())
+	realm := repositories.GetRealmFromContext(ctx)
+	expires := time.Now().Add(time.Minute * time.Duration(realm.GetAccessTokenLifetime(repository.environment)))
+	row := repository.pool.QueryRow(ctx, sql, uuid.NewV4(), uuid.NewV4(), userID, secretID, fingerprint, userAgent, created.Unix(), expires.Unix(), keyThumbprint, authentication.Time, authentication.Methods, uuid.NullUUID{UUID: organizationID, Valid: organizationID != uuid.Nil}, realm.Name)
 	return scanSession(row)
 }
 
@@ -98,12 +133,61 @@ func (repository *PostgresRepository) GetSessions(ctx context.Context, query *Ge
 
 func (repository *PostgresRepository) GetSession(ctx context.Context, id uuid.UUID) *models.Session {
 	sql := getSessionsSQL()
-	row := repository.pool.QueryRow(ctx, sql, id)
+	row := repository.pool.QueryRow(ctx, sql, id, repositories.GetRealmFromContext(ctx).Name)
 	return scanSession(row)
 }
 
 func (repository *PostgresRepository) DeleteSession(ctx context.Context, id uuid.UUID) *models.Session {
 	sql := deleteSessionSQL()
-	row := repository.pool.QueryRow(ctx, sql, id)
+	row := repository.pool.QueryRow(ctx, sql, id, repositories.GetRealmFromContext(ctx).Name)
+	return scanSession(row)
+}
+
+func (repository *PostgresRepository) GetSessionByPublicID(ctx context.Context, publicID uuid.UUID) *models.Session {
+	sql := getSessionByPublicIDSQL()
+	row := repository.pool.QueryRow(ctx, sql, publicID, repositories.GetRealmFromContext(ctx).Name)
 	return scanSession(row)
 }
+
+func (repository *PostgresRepository) GetUserSessions(ctx context.Context, userID uuid.UUID) []*models.Session {
+	sql := getUserSessionsSQL()
+	rows, err := repository.pool.Query(ctx, sql, userID, repositories.GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	sessions := make([]*models.Session, 0)
+	for rows.Next() {
+		session := scanSession(rows)
+		if session != nil {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions
+}
+
+// DeleteUserSessions deletes every session of user and returns deleted ones
+func (repository *PostgresRepository) DeleteUserSessions(ctx context.Context, userID uuid.UUID) []*models.Session {
+	sql := deleteUserSessionsSQL()
+	rows, err := repository.pool.Query(ctx, sql, userID, repositories.GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	sessions := make([]*models.Session, 0)
+	for rows.Next() {
+		session := scanSession(rows)
+		if session != nil {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions
+}
@@ -2,23 +2,28 @@ package postgresRepository
 
 import (
 	"hive/config"
+	"hive/enums"
+	"hive/models"
+	"hive/secretEncryptors"
 	"hive/repositories"
 	"context"
+	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestCreateSession(t *testing.T) {
 	env := config.InitEnvironment()
 	pool := config.InitPool(nil, env)
-	repo := InitPostgresRepository(pool, env)
+	repo := InitPostgresRepository(pool, env, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(env)))
 	ctx := context.Background()
 	PurgeSessions(pool, ctx)
 	PurgeSecrets(pool, ctx)
 	PurgeUsers(pool, ctx)
 	secret := repo.CreateSecret(ctx)
 	user := repositories.CreateUser(pool, ctx)
-	session := repo.CreateSession(ctx, user.Id, secret.Id, "123", "chrome")
+	session := repo.CreateSession(ctx, user.Id, secret.Id, "123", "chrome", "", uuid.Nil, models.InitAuthenticationContext([]string{enums.PasswordAuthenticationMethod}, time.Now().Unix()))
 	require.NotNil(t, session)
 	require.NotNil(t, session.RefreshToken)
 	require.Equal(t, user.Id, session.UserID)
@@ -30,14 +35,14 @@ func TestCreateSession(t *testing.T) {
 func TestGetSession(t *testing.T) {
 	env := config.InitEnvironment()
 	pool := config.InitPool(nil, env)
-	repo := InitPostgresRepository(pool, env)
+	repo := InitPostgresRepository(pool, env, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(env)))
 	ctx := context.Background()
 	PurgeSessions(pool, ctx)
 	PurgeSecrets(pool, ctx)
 	PurgeUsers(pool, ctx)
 	secret := repo.CreateSecret(ctx)
 	user := repositories.CreateUser(pool, ctx)
-	createdSession := repo.CreateSession(ctx, user.Id, secret.Id, "123", "chrome")
+	createdSession := repo.CreateSession(ctx, user.Id, secret.Id, "123", "chrome", "", uuid.Nil, models.InitAuthenticationContext([]string{enums.PasswordAuthenticationMethod}, time.Now().Unix()))
 	session := repo.GetSession(ctx, createdSession.Id)
 	require.NotNil(t, session)
 	require.Equal(t, createdSession, session)
@@ -46,17 +51,17 @@ func TestGetSession(t *testing.T) {
 func TestPostgresRepository_DeleteSession(t *testing.T) {
 	env := config.InitEnvironment()
 	pool := config.InitPool(nil, env)
-	repo := InitPostgresRepository(pool, env)
+	repo := InitPostgresRepository(pool, env, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(env)))
 	ctx := context.Background()
 	PurgeSessions(pool, ctx)
 	PurgeSecrets(pool, ctx)
 	PurgeUsers(pool, ctx)
 	secret := repo.CreateSecret(ctx)
 	user := repositories.CreateUser(pool, ctx)
-	createdSession := repo.CreateSession(ctx, user.Id, secret.Id, "123", "chrome")
+	createdSession := repo.CreateSession(ctx, user.Id, secret.Id, "123", "chrome", "", uuid.Nil, models.InitAuthenticationContext([]string{enums.PasswordAuthenticationMethod}, time.Now().Unix()))
 	deletedSession := repo.DeleteSession(ctx, createdSession.Id)
 	session := repo.GetSession(ctx, createdSession.Id)
 	require.NotNil(t, deletedSession)
 	require.Equal(t, createdSession, deletedSession)
 	require.Nil(t, session)
-}
\ No newline at end of file
+}
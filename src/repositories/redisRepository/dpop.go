@@ -0,0 +1,32 @@
+package redisRepository
+
+import (
+	"hive/enums"
+	"hive/repositories"
+	"context"
+	"fmt"
+	"time"
+)
+
+func getDPoPNonceKey(ctx context.Context, nonce string) string {
+	return repositories.GetRealmKey(ctx, fmt.Sprintf("%s:%s", enums.DPoPNonce, nonce))
+}
+
+func getDPoPProofKey(ctx context.Context, keyThumbprint, jti string) string {
+	return repositories.GetRealmKey(ctx, fmt.Sprintf("%s:%s:%s", enums.DPoPProof, keyThumbprint, jti))
+}
+
+func (repository *RedisRepository) CreateDPoPNonce(ctx context.Context, nonce string, timeout time.Duration) error {
+	result := repository.redis.WithContext(ctx).Set(getDPoPNonceKey(ctx, nonce), 1, timeout)
+	return result.Err()
+}
+
+func (repository *RedisRepository) GetDPoPNonceExistence(ctx context.Context, nonce string) bool {
+	result, err := repository.redis.WithContext(ctx).Exists(getDPoPNonceKey(ctx, nonce)).Result()
+	return err == nil && result > 0
+}
+
+// RegisterDPoPProof returns false if proof with the same jti was already registered for the key
+func (repository *RedisRepository) RegisterDPoPProof(ctx context.Context, keyThumbprint, jti string, timeout time.Duration) (bool, error) {
+	return repository.redis.WithContext(ctx).SetNX(getDPoPProofKey(ctx, keyThumbprint, jti), 1, timeout).Result()
+}
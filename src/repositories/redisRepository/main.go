@@ -2,6 +2,7 @@ package redisRepository
 
 import (
 	"hive/models"
+	"hive/secretEncryptors"
 	"context"
 	"github.com/go-redis/redis/v7"
 	uuid "github.com/satori/go.uuid"
@@ -16,14 +17,31 @@ type IRedisRepository interface {
 	CacheSecret(ctx context.Context, secret *models.Secret, timeout time.Duration) error
 	GetActualSecret(ctx context.Context) *models.Secret
 	CacheActualSecret(ctx context.Context, secret *models.Secret, timeout time.Duration) error
+	DeleteSecret(ctx context.Context, id uuid.UUID) error
+	DeleteActualSecret(ctx context.Context) error
+	PublishSecretInvalidation(ctx context.Context, id uuid.UUID) error
+	SubscribeSecretInvalidation(ctx context.Context) <-chan uuid.UUID
+
+	// Sessions
+
+	GetSessionExistence(ctx context.Context, publicID uuid.UUID) (bool, bool)
+	CacheSessionExistence(ctx context.Context, publicID uuid.UUID, exists bool, timeout time.Duration) error
+
+	// DPoP
+
+	CreateDPoPNonce(ctx context.Context, nonce string, timeout time.Duration) error
+	GetDPoPNonceExistence(ctx context.Context, nonce string) bool
+	RegisterDPoPProof(ctx context.Context, keyThumbprint, jti string, timeout time.Duration) (bool, error)
 }
 
 type RedisRepository struct {
-	redis *redis.Client
+	redis           *redis.Client
+	secretEncryptor secretEncryptors.ISecretEncryptor
 }
 
-func InitRedisRepository(redis *redis.Client) *RedisRepository {
+func InitRedisRepository(redis *redis.Client, secretEncryptor secretEncryptors.ISecretEncryptor) *RedisRepository {
 	return &RedisRepository{
-		redis: redis,
+		redis:           redis,
+		secretEncryptor: secretEncryptor,
 	}
 }
@@ -91,3 +91,131 @@ func (mr *MockIRedisRepositoryMockRecorder) CacheActualSecret(ctx, secret, timeo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheActualSecret", reflect.TypeOf((*MockIRedisRepository)(nil).CacheActualSecret), ctx, secret, timeout)
 }
+
+// DeleteSecret mocks base method
+func (m *MockIRedisRepository) DeleteSecret(ctx context.Context, id go_uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecret", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSecret indicates an expected call of DeleteSecret
+func (mr *MockIRedisRepositoryMockRecorder) DeleteSecret(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockIRedisRepository)(nil).DeleteSecret), ctx, id)
+}
+
+// DeleteActualSecret mocks base method
+func (m *MockIRedisRepository) DeleteActualSecret(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteActualSecret", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteActualSecret indicates an expected call of DeleteActualSecret
+func (mr *MockIRedisRepositoryMockRecorder) DeleteActualSecret(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteActualSecret", reflect.TypeOf((*MockIRedisRepository)(nil).DeleteActualSecret), ctx)
+}
+
+// PublishSecretInvalidation mocks base method
+func (m *MockIRedisRepository) PublishSecretInvalidation(ctx context.Context, id go_uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishSecretInvalidation", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishSecretInvalidation indicates an expected call of PublishSecretInvalidation
+func (mr *MockIRedisRepositoryMockRecorder) PublishSecretInvalidation(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishSecretInvalidation", reflect.TypeOf((*MockIRedisRepository)(nil).PublishSecretInvalidation), ctx, id)
+}
+
+// SubscribeSecretInvalidation mocks base method
+func (m *MockIRedisRepository) SubscribeSecretInvalidation(ctx context.Context) <-chan go_uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeSecretInvalidation", ctx)
+	ret0, _ := ret[0].(<-chan go_uuid.UUID)
+	return ret0
+}
+
+// SubscribeSecretInvalidation indicates an expected call of SubscribeSecretInvalidation
+func (mr *MockIRedisRepositoryMockRecorder) SubscribeSecretInvalidation(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeSecretInvalidation", reflect.TypeOf((*MockIRedisRepository)(nil).SubscribeSecretInvalidation), ctx)
+}
+
+// GetSessionExistence mocks base method
+func (m *MockIRedisRepository) GetSessionExistence(ctx context.Context, publicID go_uuid.UUID) (bool, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionExistence", ctx, publicID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// GetSessionExistence indicates an expected call of GetSessionExistence
+func (mr *MockIRedisRepositoryMockRecorder) GetSessionExistence(ctx, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionExistence", reflect.TypeOf((*MockIRedisRepository)(nil).GetSessionExistence), ctx, publicID)
+}
+
+// CacheSessionExistence mocks base method
+func (m *MockIRedisRepository) CacheSessionExistence(ctx context.Context, publicID go_uuid.UUID, exists bool, timeout time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheSessionExistence", ctx, publicID, exists, timeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CacheSessionExistence indicates an expected call of CacheSessionExistence
+func (mr *MockIRedisRepositoryMockRecorder) CacheSessionExistence(ctx, publicID, exists, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheSessionExistence", reflect.TypeOf((*MockIRedisRepository)(nil).CacheSessionExistence), ctx, publicID, exists, timeout)
+}
+
+// CreateDPoPNonce mocks base method
+func (m *MockIRedisRepository) CreateDPoPNonce(ctx context.Context, nonce string, timeout time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDPoPNonce", ctx, nonce, timeout)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDPoPNonce indicates an expected call of CreateDPoPNonce
+func (mr *MockIRedisRepositoryMockRecorder) CreateDPoPNonce(ctx, nonce, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDPoPNonce", reflect.TypeOf((*MockIRedisRepository)(nil).CreateDPoPNonce), ctx, nonce, timeout)
+}
+
+// GetDPoPNonceExistence mocks base method
+func (m *MockIRedisRepository) GetDPoPNonceExistence(ctx context.Context, nonce string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDPoPNonceExistence", ctx, nonce)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetDPoPNonceExistence indicates an expected call of GetDPoPNonceExistence
+func (mr *MockIRedisRepositoryMockRecorder) GetDPoPNonceExistence(ctx, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDPoPNonceExistence", reflect.TypeOf((*MockIRedisRepository)(nil).GetDPoPNonceExistence), ctx, nonce)
+}
+
+// RegisterDPoPProof mocks base method
+func (m *MockIRedisRepository) RegisterDPoPProof(ctx context.Context, keyThumbprint, jti string, timeout time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDPoPProof", ctx, keyThumbprint, jti, timeout)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDPoPProof indicates an expected call of RegisterDPoPProof
+func (mr *MockIRedisRepositoryMockRecorder) RegisterDPoPProof(ctx, keyThumbprint, jti, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDPoPProof", reflect.TypeOf((*MockIRedisRepository)(nil).RegisterDPoPProof), ctx, keyThumbprint, jti, timeout)
+}
@@ -4,7 +4,9 @@ import (
 	"hive/enums"
 	"hive/inout"
 	"hive/models"
+	"hive/repositories"
 	"context"
+	"errors"
 	"fmt"
 	"github.com/getsentry/sentry-go"
 	uuid "github.com/satori/go.uuid"
@@ -12,15 +14,23 @@ import (
 	"time"
 )
 
-func getSecretKey(id uuid.UUID) string {
-	return fmt.Sprintf("%s:%s", enums.Secret, id.String())
+func getSecretKey(ctx context.Context, id uuid.UUID) string {
+	return repositories.GetRealmKey(ctx, fmt.Sprintf("%s:%s", enums.Secret, id.String()))
 }
 
 func (repository *RedisRepository) cacheSecret(ctx context.Context, secret *models.Secret, key string, timeout time.Duration) error {
+	masterKeyID, encryptedValue := repository.secretEncryptor.Encrypt(ctx, secret.Value.Bytes(), secret.Id.Bytes())
+	if encryptedValue == nil {
+		return errors.New("secret encryption failed")
+	}
+
 	secretCache := &inout.SecretCache{
-		Id:      secret.Id.Bytes(),
-		Created: secret.Created,
-		Value:   secret.Value.Bytes(),
+		Id:             secret.Id.Bytes(),
+		Created:        secret.Created,
+		Expires:        secret.Expires,
+		Revoked:        secret.Revoked,
+		EncryptedValue: encryptedValue,
+		MasterKeyID:    masterKeyID,
 	}
 
 	data, err := proto.Marshal(secretCache)
@@ -51,25 +61,81 @@ func (repository *RedisRepository) getSecret(ctx context.Context, key string) *m
 		return nil
 	}
 
+	// Values cached before encryption was introduced are stored in plain text
+	secretValue := secretCache.Value
+	if secretCache.EncryptedValue != nil {
+		secretValue = repository.secretEncryptor.Decrypt(ctx, secretCache.MasterKeyID, secretCache.EncryptedValue, secretCache.Id)
+		if secretValue == nil {
+			return nil
+		}
+	}
+
 	return &models.Secret{
 		Id:      uuid.FromBytesOrNil(secretCache.Id),
 		Created: secretCache.Created,
-		Value:   uuid.FromBytesOrNil(secretCache.Value),
+		Value:   uuid.FromBytesOrNil(secretValue),
+		Expires: secretCache.Expires,
+		Revoked: secretCache.Revoked,
 	}
 }
 
 func (repository *RedisRepository) CacheActualSecret(ctx context.Context, secret *models.Secret, timeout time.Duration) error {
-	return repository.cacheSecret(ctx, secret, enums.ActualSecret, timeout)
+	return repository.cacheSecret(ctx, secret, repositories.GetRealmKey(ctx, enums.ActualSecret), timeout)
 }
 
 func (repository *RedisRepository) CacheSecret(ctx context.Context, secret *models.Secret, timeout time.Duration) error {
-	return repository.cacheSecret(ctx, secret, getSecretKey(secret.Id), timeout)
+	return repository.cacheSecret(ctx, secret, getSecretKey(ctx, secret.Id), timeout)
 }
 
 func (repository *RedisRepository) GetActualSecret(ctx context.Context) *models.Secret {
-	return repository.getSecret(ctx, enums.ActualSecret)
+	return repository.getSecret(ctx, repositories.GetRealmKey(ctx, enums.ActualSecret))
 }
 
 func (repository *RedisRepository) GetSecret(ctx context.Context, id uuid.UUID) *models.Secret {
-	return repository.getSecret(ctx, getSecretKey(id))
+	return repository.getSecret(ctx, getSecretKey(ctx, id))
+}
+
+func (repository *RedisRepository) DeleteSecret(ctx context.Context, id uuid.UUID) error {
+	return repository.redis.WithContext(ctx).Del(getSecretKey(ctx, id)).Err()
+}
+
+func (repository *RedisRepository) DeleteActualSecret(ctx context.Context) error {
+	return repository.redis.WithContext(ctx).Del(repositories.GetRealmKey(ctx, enums.ActualSecret)).Err()
+}
+
+func (repository *RedisRepository) PublishSecretInvalidation(ctx context.Context, id uuid.UUID) error {
+	return repository.redis.WithContext(ctx).Publish(repositories.GetRealmKey(ctx, enums.SecretsInvalidation), id.String()).Err()
+}
+
+func (repository *RedisRepository) SubscribeSecretInvalidation(ctx context.Context) <-chan uuid.UUID {
+	pubSub := repository.redis.WithContext(ctx).Subscribe(repositories.GetRealmKey(ctx, enums.SecretsInvalidation))
+	identifiers := make(chan uuid.UUID)
+
+	go func() {
+		defer close(identifiers)
+		defer pubSub.Close()
+
+		messages := pubSub.Channel()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case message, ok := <-messages:
+				if !ok {
+					return
+				}
+
+				id, err := uuid.FromString(message.Payload)
+				if err != nil {
+					sentry.CaptureException(err)
+					continue
+				}
+
+				identifiers <- id
+			}
+		}
+	}()
+
+	return identifiers
 }
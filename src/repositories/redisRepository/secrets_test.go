@@ -2,6 +2,7 @@ package redisRepository
 
 import (
 	"hive/config"
+	"hive/secretEncryptors"
 	"hive/models"
 	"context"
 	uuid "github.com/satori/go.uuid"
@@ -12,7 +13,7 @@ import (
 
 func TestCacheSecret(t *testing.T) {
 	cache := config.InitRedis(config.InitEnvironment())
-	repo := InitRedisRepository(cache)
+	repo := InitRedisRepository(cache, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(config.InitEnvironment())))
 	cache.FlushAll()
 	ctx := context.Background()
 	secret := &models.Secret{
@@ -26,7 +27,7 @@ func TestCacheSecret(t *testing.T) {
 
 func TestGetSecretByIDSecret(t *testing.T) {
 	cache := config.InitRedis(config.InitEnvironment())
-	repo := InitRedisRepository(cache)
+	repo := InitRedisRepository(cache, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(config.InitEnvironment())))
 	cache.FlushAll()
 	ctx := context.Background()
 
@@ -47,7 +48,7 @@ func TestGetSecretByIDSecret(t *testing.T) {
 
 func TestGetExpiredSecretByIDSecret(t *testing.T) {
 	cache := config.InitRedis(config.InitEnvironment())
-	repo := InitRedisRepository(cache)
+	repo := InitRedisRepository(cache, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(config.InitEnvironment())))
 	cache.FlushAll()
 	ctx := context.Background()
 
@@ -67,7 +68,7 @@ func TestGetExpiredSecretByIDSecret(t *testing.T) {
 
 func TestCacheActualSecret(t *testing.T) {
 	cache := config.InitRedis(config.InitEnvironment())
-	repo := InitRedisRepository(cache)
+	repo := InitRedisRepository(cache, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(config.InitEnvironment())))
 	cache.FlushAll()
 	ctx := context.Background()
 
@@ -82,7 +83,7 @@ func TestCacheActualSecret(t *testing.T) {
 
 func TestGetActualSecret(t *testing.T) {
 	cache := config.InitRedis(config.InitEnvironment())
-	repo := InitRedisRepository(cache)
+	repo := InitRedisRepository(cache, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(config.InitEnvironment())))
 	cache.FlushAll()
 	ctx := context.Background()
 	secret := &models.Secret{
@@ -0,0 +1,29 @@
+package redisRepository
+
+import (
+	"hive/enums"
+	"hive/repositories"
+	"context"
+	"fmt"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+func getSessionExistenceKey(ctx context.Context, publicID uuid.UUID) string {
+	return repositories.GetRealmKey(ctx, fmt.Sprintf("%s:%s", enums.SessionExistence, publicID.String()))
+}
+
+// GetSessionExistence returns whether existence of session is cached and whether session exists
+func (repository *RedisRepository) GetSessionExistence(ctx context.Context, publicID uuid.UUID) (bool, bool) {
+	value, err := repository.redis.WithContext(ctx).Get(getSessionExistenceKey(ctx, publicID)).Int()
+	if err != nil {
+		return false, false
+	}
+
+	return true, value == 1
+}
+
+func (repository *RedisRepository) CacheSessionExistence(ctx context.Context, publicID uuid.UUID, exists bool, timeout time.Duration) error {
+	result := repository.redis.WithContext(ctx).Set(getSessionExistenceKey(ctx, publicID), exists, timeout)
+	return result.Err()
+}
@@ -0,0 +1,34 @@
+package redisRepository
+
+import (
+	"hive/config"
+	"hive/secretEncryptors"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+func TestCacheSessionExistence(t *testing.T) {
+	cache := config.InitRedis(config.InitEnvironment())
+	repo := InitRedisRepository(cache, secretEncryptors.InitAESGCMSecretEncryptor(config.InitMasterKeys(config.InitEnvironment())))
+	cache.FlushAll()
+	ctx := context.Background()
+	publicID := uuid.NewV4()
+
+	cached, _ := repo.GetSessionExistence(ctx, publicID)
+	require.False(t, cached)
+
+	err := repo.CacheSessionExistence(ctx, publicID, false, time.Minute)
+	require.Nil(t, err)
+	cached, exists := repo.GetSessionExistence(ctx, publicID)
+	require.True(t, cached)
+	require.False(t, exists)
+
+	err = repo.CacheSessionExistence(ctx, publicID, true, time.Minute)
+	require.Nil(t, err)
+	cached, exists = repo.GetSessionExistence(ctx, publicID)
+	require.True(t, cached)
+	require.True(t, exists)
+}
@@ -0,0 +1,190 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/functools"
+	"hive/models"
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+)
+
+func createRoleRequestSQL() string {
+	return `
+		INSERT INTO role_requests(id, user_id, role_id, reason, expires_at, realm)
+		VALUES ($1, $2, $3, $4, NULLIF($5::bigint, 0), $6)
+		RETURNING id, created, user_id, role_id, reason, status, expires_at, reviewer_id, reviewed, comment, user_role_id, 0;
+		`
+}
+
+// Requests are visible to viewer if they are filed by viewer or request roles managed by roles of viewer,
+// null viewer sees every request
+func getRoleRequestsSQL() string {
+	return `
+		SELECT id, created, user_id, role_id, reason, status, expires_at, reviewer_id, reviewed, comment, user_role_id,
+		       count(*) OVER() AS full_count
+		FROM role_requests
+		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[])) AND
+		      (array_length($2::uuid[], 1) IS NULL OR user_id = ANY ($2::uuid[])) AND
+		      (array_length($3::uuid[], 1) IS NULL OR role_id = ANY ($3::uuid[])) AND
+		      (array_length($4::text[], 1) IS NULL OR status = ANY ($4::text[])) AND
+		      ($5::uuid IS NULL OR user_id = $5::uuid OR role_id IN (SELECT mr.managed_role_id
+		                                                              FROM role_managed_roles mr
+		                                                              WHERE mr.role_id = ANY ($6::uuid[]))) AND
+		      realm = $9
+		ORDER BY created
+		LIMIT $7
+		OFFSET $8;
+		`
+}
+
+// reviewRoleRequestSQL changes status of pending request only, so request is never reviewed twice
+func reviewRoleRequestSQL() string {
+	return `
+		UPDATE role_requests
+		SET status      = $2,
+		    reviewer_id = $3,
+		    comment     = $4,
+		    expires_at  = COALESCE(NULLIF($5::bigint, 0), expires_at),
+		    reviewed    = extract(epoch from now()) * 1000
+		WHERE id = $1 AND status = 'pending' AND realm = $6
+		RETURNING id, created, user_id, role_id, reason, status, expires_at, reviewer_id, reviewed, comment, user_role_id, 0;
+		`
+}
+
+func setRoleRequestUserRoleSQL() string {
+	return `
+		UPDATE role_requests
+		SET user_role_id = $2
+		WHERE id = $1 AND realm = $3
+		RETURNING id, created, user_id, role_id, reason, status, expires_at, reviewer_id, reviewed, comment, user_role_id, 0;
+		`
+}
+
+func unwrapRoleRequestScanError(err error) int {
+	var e *pgconn.PgError
+
+	if errors.As(err, &e) {
+		switch e.ConstraintName {
+		case "role_requests_user_id_fkey":
+			return enums.UserNotFound
+		case "role_requests_role_id_fkey":
+			return enums.RoleNotFound
+		case "role_requests_pending_idx":
+			return enums.RoleRequestAlreadyExist
+		}
+	} else if strings.Contains(err.Error(), "no rows in result") {
+		return enums.RoleRequestNotFound
+	}
+
+	sentry.CaptureException(err)
+	return enums.NotOk
+}
+
+func scanRoleRequest(row pgx.Row) (int, *models.RoleRequest, int64) {
+	request := &models.RoleRequest{}
+	var reviewerID, userRoleID uuid.NullUUID
+	var expiresAt, reviewed *int64
+	var count int64
+
+	err := row.Scan(&request.Id, &request.Created, &request.UserId, &request.RoleId, &request.Reason, &request.Status,
+		&expiresAt, &reviewerID, &reviewed, &request.Comment, &userRoleID, &count)
+	if err != nil {
+		return unwrapRoleRequestScanError(err), nil, 0
+	}
+
+	request.ReviewerId = reviewerID.UUID
+	request.UserRoleId = userRoleID.UUID
+
+	if expiresAt != nil {
+		request.ExpiresAt = *expiresAt
+	}
+
+	if reviewed != nil {
+		request.Reviewed = *reviewed
+	}
+
+	return enums.Ok, request, count
+}
+
+func scanRoleRequests(rows pgx.Rows, limit int) ([]*models.RoleRequest, int64) {
+	requests := make([]*models.RoleRequest, limit)
+
+	var i int32
+	var count int64
+
+	for rows.Next() {
+		_, request, c := scanRoleRequest(rows)
+		count = c
+		requests[i] = request
+		i++
+	}
+
+	rows.Close()
+
+	return requests[0:i], count
+}
+
+type GetRoleRequestsQuery struct {
+	Id         []uuid.UUID
+	UserId     []uuid.UUID
+	RoleId     []uuid.UUID
+	Status     []string
+	ViewerId   uuid.UUID   // Only requests filed by viewer or for roles managed by viewer roles, nil shows every request
+	ManagersID []uuid.UUID // Roles of viewer
+	Pagination *models.PaginationRequest
+}
+
+func CreateRoleRequest(db DB, ctx context.Context, userID uuid.UUID, roleID uuid.UUID, reason string, expiresAt int64) (int, *models.RoleRequest) {
+	row := db.QueryRow(ctx, createRoleRequestSQL(), uuid.NewV4(), userID, roleID, reason, expiresAt, GetRealmFromContext(ctx).Name)
+	status, request, _ := scanRoleRequest(row)
+	return status, request
+}
+
+func GetRoleRequest(db DB, ctx context.Context, id uuid.UUID) (int, *models.RoleRequest) {
+	row := db.QueryRow(ctx, getRoleRequestsSQL(), functools.UUIDListToPGArray([]uuid.UUID{id}), "{}", "{}", "{}",
+		uuid.NullUUID{}, "{}", 1, 0, GetRealmFromContext(ctx).Name)
+	status, request, _ := scanRoleRequest(row)
+	return status, request
+}
+
+func GetRoleRequests(db DB, ctx context.Context, query GetRoleRequestsQuery) ([]*models.RoleRequest, *models.PaginationResponse) {
+	limit, offset := functools.LimitPageToLimitOffset(query.Pagination.Limit, query.Pagination.Page)
+	rows, err := db.Query(ctx, getRoleRequestsSQL(),
+		functools.UUIDListToPGArray(query.Id),
+		functools.UUIDListToPGArray(query.UserId),
+		functools.UUIDListToPGArray(query.RoleId),
+		functools.StringsToPGArray(query.Status),
+		uuid.NullUUID{UUID: query.ViewerId, Valid: query.ViewerId != uuid.Nil},
+		functools.UUIDListToPGArray(query.ManagersID),
+		limit, offset, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, nil
+	}
+
+	requests, totalCount := scanRoleRequests(rows, limit)
+
+	return requests, &models.PaginationResponse{
+		HasNext:     functools.HasNext(totalCount, query.Pagination.Limit, query.Pagination.Page),
+		HasPrevious: functools.HasPrevious(query.Pagination.Page),
+		Count:       totalCount,
+	}
+}
+
+// ReviewRoleRequest moves pending request to another status, zero expiresAt keeps requested expiry
+func ReviewRoleRequest(db DB, ctx context.Context, id uuid.UUID, status string, reviewerID uuid.UUID, comment string, expiresAt int64) (int, *models.RoleRequest) {
+	row := db.QueryRow(ctx, reviewRoleRequestSQL(), id, status, reviewerID, comment, expiresAt, GetRealmFromContext(ctx).Name)
+	s, request, _ := scanRoleRequest(row)
+	return s, request
+}
+
+func SetRoleRequestUserRole(db DB, ctx context.Context, id uuid.UUID, userRoleID uuid.UUID) (int, *models.RoleRequest) {
+	row := db.QueryRow(ctx, setRoleRequestUserRoleSQL(), id, userRoleID, GetRealmFromContext(ctx).Name)
+	status, request, _ := scanRoleRequest(row)
+	return status, request
+}
@@ -0,0 +1,94 @@
+package repositories
+
+import (
+	"hive/config"
+	"hive/enums"
+	"hive/models"
+	"context"
+	uuid "github.com/satori/go.uuid"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestCreateRoleRequest(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeRoleRequests(pool, ctx)
+	PurgeUsers(pool, ctx)
+	PurgeRoles(pool, ctx)
+	_, role := CreateRole(pool, ctx, "developer", nil, nil)
+	user := CreateUser(pool, ctx)
+
+	status, request := CreateRoleRequest(pool, ctx, user.Id, role.Id, "Need access to repositories", 0)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, enums.RoleRequestPending, request.Status)
+	require.Equal(t, uuid.Nil, request.ReviewerId)
+
+	status, _ = CreateRoleRequest(pool, ctx, user.Id, role.Id, "Need access to repositories", 0)
+	require.Equal(t, enums.RoleRequestAlreadyExist, status)
+
+	status, _ = CreateRoleRequest(pool, ctx, user.Id, uuid.NewV4(), "Need access to repositories", 0)
+	require.Equal(t, enums.RoleNotFound, status)
+}
+
+func TestReviewRoleRequest(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeRoleRequests(pool, ctx)
+	PurgeUsers(pool, ctx)
+	PurgeRoles(pool, ctx)
+	_, role := CreateRole(pool, ctx, "developer", nil, nil)
+	user := CreateUser(pool, ctx)
+	reviewer := CreateUser(pool, ctx)
+	_, request := CreateRoleRequest(pool, ctx, user.Id, role.Id, "Need access to repositories", 0)
+
+	status, reviewed := ReviewRoleRequest(pool, ctx, request.Id, enums.RoleRequestDenied, reviewer.Id, "Not now", 0)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, enums.RoleRequestDenied, reviewed.Status)
+	require.Equal(t, reviewer.Id, reviewed.ReviewerId)
+	require.NotZero(t, reviewed.Reviewed)
+
+	status, _ = ReviewRoleRequest(pool, ctx, request.Id, enums.RoleRequestApproved, reviewer.Id, "", 0)
+	require.Equal(t, enums.RoleRequestNotFound, status)
+
+	status, request = CreateRoleRequest(pool, ctx, user.Id, role.Id, "Need access to repositories", 0)
+	require.Equal(t, enums.Ok, status)
+}
+
+func TestGetRoleRequestsVisibility(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeRoleRequests(pool, ctx)
+	PurgeUsers(pool, ctx)
+	PurgeRoles(pool, ctx)
+	_, lead := CreateRole(pool, ctx, "lead", nil, nil)
+	_, developer := CreateRole(pool, ctx, "developer", nil, nil)
+	_, tester := CreateRole(pool, ctx, "tester", nil, nil)
+	UpdateRoleManagedRoles(pool, ctx, lead.Id, []uuid.UUID{developer.Id})
+	user := CreateUser(pool, ctx)
+	viewer := CreateUser(pool, ctx)
+	_, developerRequest := CreateRoleRequest(pool, ctx, user.Id, developer.Id, "Backend tasks", 0)
+	CreateRoleRequest(pool, ctx, user.Id, tester.Id, "Testing tasks", 0)
+
+	requests, _ := GetRoleRequests(pool, ctx, GetRoleRequestsQuery{
+		Pagination: &models.PaginationRequest{Limit: 10, Page: 1},
+	})
+	require.Len(t, requests, 2)
+
+	requests, _ = GetRoleRequests(pool, ctx, GetRoleRequestsQuery{
+		Pagination: &models.PaginationRequest{Limit: 10, Page: 1},
+		ViewerId:   viewer.Id,
+		ManagersID: []uuid.UUID{lead.Id},
+	})
+	require.Len(t, requests, 1)
+	require.Equal(t, developerRequest.Id, requests[0].Id)
+
+	requests, _ = GetRoleRequests(pool, ctx, GetRoleRequestsQuery{
+		Pagination: &models.PaginationRequest{Limit: 10, Page: 1},
+		ViewerId:   user.Id,
+		Status:     []string{enums.RoleRequestPending},
+	})
+	require.Len(t, requests, 2)
+
+	require.Equal(t, []uuid.UUID{lead.Id}, GetRoleManagersID(pool, ctx, developer.Id))
+}
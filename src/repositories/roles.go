@@ -16,22 +16,138 @@ import (
 
 func getRolesSQL() string {
 	return `
-		SELECT id, created, title, count(*) OVER() AS full_count
-		FROM roles
+		SELECT r.id,
+		       r.created,
+		       r.title,
+		       r.description,
+		       r.permissions,
+		       ARRAY(SELECT rp.parent_id FROM role_parents rp WHERE rp.role_id = r.id ORDER BY rp.created),
+		       ARRAY(SELECT mr.managed_role_id FROM role_managed_roles mr WHERE mr.role_id = r.id ORDER BY mr.created),
+		       count(*) OVER() AS full_count
+		FROM roles r
 		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[])) AND
-		      (array_length($2::text[], 1) IS NULL OR title = ANY ($2::text[]))
+		      (array_length($2::text[], 1) IS NULL OR title = ANY ($2::text[])) AND
+		      (array_length($6::uuid[], 1) IS NULL OR id IN (SELECT mr.managed_role_id
+		                                                      FROM role_managed_roles mr
+		                                                      WHERE mr.role_id = ANY ($6::uuid[]))) AND
+		      realm = $5
 		LIMIT $3 
 		OFFSET $4;
 		`
 }
 
 func createRoleSQL() string {
-	return "INSERT INTO roles (id, title) VALUES ($1, $2) RETURNING id, created, title, 0;"
+	return `
+		WITH r AS (INSERT INTO roles (id, title, permissions, realm) VALUES ($1, $2, $3, $5) RETURNING id, created, title, description, permissions),
+		     rp AS (INSERT INTO role_parents (role_id, parent_id, realm) SELECT DISTINCT $1::uuid, unnest($4::uuid[]), $5 RETURNING parent_id)
+		SELECT id, created, title, description, permissions, ARRAY(SELECT parent_id FROM rp), ARRAY[]::uuid[], 0
+		FROM r;
+		`
+}
+
+func updateRoleSQL() string {
+	return `
+		UPDATE roles r
+		SET title = $2, description = $3
+		WHERE r.id = $1 AND r.realm = $4
+		RETURNING r.id, r.created, r.title, r.description, r.permissions,
+		          ARRAY(SELECT rp.parent_id FROM role_parents rp WHERE rp.role_id = r.id ORDER BY rp.created),
+		          ARRAY(SELECT mr.managed_role_id FROM role_managed_roles mr WHERE mr.role_id = r.id ORDER BY mr.created), 0;
+		`
+}
+
+// Parents and managed roles are selected from snapshot taken before cascade deletion
+func deleteRoleSQL() string {
+	return `
+		DELETE FROM roles r
+		WHERE r.id = $1 AND r.realm = $2
+		RETURNING r.id, r.created, r.title, r.description, r.permissions,
+		          ARRAY(SELECT rp.parent_id FROM role_parents rp WHERE rp.role_id = r.id ORDER BY rp.created),
+		          ARRAY(SELECT mr.managed_role_id FROM role_managed_roles mr WHERE mr.role_id = r.id ORDER BY mr.created), 0;
+		`
+}
+
+// getRoleUsersSQL selects users which have role directly, by inheritance, within organization or by group
+func getRoleUsersSQL() string {
+	return `
+		WITH RECURSIVE descendants(id) AS (
+			SELECT $1::uuid
+			UNION
+			SELECT rp.role_id
+			FROM role_parents rp
+					 JOIN descendants d ON rp.parent_id = d.id
+		)
+		SELECT ur.user_id
+		FROM user_roles ur
+				 JOIN descendants d ON ur.role_id = d.id
+		WHERE ur.realm = $2
+		UNION
+		SELECT gm.user_id
+		FROM group_roles gr
+				 JOIN descendants d ON gr.role_id = d.id
+				 JOIN group_members gm ON gm.group_id = gr.group_id
+		WHERE gr.realm = $2;
+		`
+}
+
+func deleteRoleParentsSQL() string {
+	return "DELETE FROM role_parents WHERE role_id = $1 AND realm = $2;"
+}
+
+func createRoleParentsSQL() string {
+	return "INSERT INTO role_parents (role_id, parent_id, realm) SELECT DISTINCT $1::uuid, unnest($2::uuid[]), $3;"
+}
+
+func deleteRoleManagedRolesSQL() string {
+	return "DELETE FROM role_managed_roles WHERE role_id = $1 AND realm = $2;"
+}
+
+func createRoleManagedRolesSQL() string {
+	return "INSERT INTO role_managed_roles (role_id, managed_role_id, realm) SELECT DISTINCT $1::uuid, unnest($2::uuid[]), $3;"
+}
+
+// isRoleManageableSQL checks whether role is managed by any of roles
+func isRoleManageableSQL() string {
+	return `
+		SELECT EXISTS(SELECT 1
+		              FROM role_managed_roles
+		              WHERE role_id = ANY ($1::uuid[]) AND managed_role_id = $2 AND realm = $3);
+		`
+}
+
+func getRoleManagersSQL() string {
+	return "SELECT role_id FROM role_managed_roles WHERE managed_role_id = $1 AND realm = $2;"
+}
+
+func lockRoleHierarchySQL() string {
+	return "SELECT pg_advisory_xact_lock(hashtext('role_parents'));"
+}
+
+// hasRoleHierarchyCycleSQL checks whether role is one of ancestors of proposed parents
+func hasRoleHierarchyCycleSQL() string {
+	return `
+		WITH RECURSIVE ancestors(id) AS (
+			SELECT unnest($2::uuid[])
+			UNION
+			SELECT rp.parent_id
+			FROM role_parents rp
+					 JOIN ancestors a ON rp.role_id = a.id
+		)
+		SELECT EXISTS(SELECT 1 FROM ancestors WHERE id = $1::uuid);
+		`
 }
 
 func unwrapRoleScanError(err error) int {
 	var e *pgconn.PgError
-	if errors.As(err, &e) && (strings.Contains(e.Message, "unique constraint \"roles_title_key\"") || strings.Contains(e.Message, "нарушает ограничение уникальности \"roles_title_key\"")) {
+	if errors.As(err, &e) && e.ConstraintName == "role_parents_parent_id_fkey" {
+		return enums.ParentRoleNotFound
+	} else if errors.As(err, &e) && e.ConstraintName == "role_parents_role_id_fkey" {
+		return enums.RoleNotFound
+	} else if errors.As(err, &e) && e.ConstraintName == "role_managed_roles_managed_role_id_fkey" {
+		return enums.ManagedRoleNotFound
+	} else if errors.As(err, &e) && e.ConstraintName == "role_parents_check" {
+		return enums.RoleHierarchyCycle
+	} else if errors.As(err, &e) && (strings.Contains(e.Message, "unique constraint \"roles_title_key\"") || strings.Contains(e.Message, "нарушает ограничение уникальности \"roles_title_key\"")) {
 		return enums.RoleAlreadyExist
 	} else if strings.Contains(err.Error(), "no rows") {
 		return enums.RoleNotFound
@@ -43,14 +159,19 @@ func unwrapRoleScanError(err error) int {
 
 func scanRole(row pgx.Row) (int, *models.Role, int64) {
 	role := &models.Role{}
+	var parents [][]byte
+	var managedRoles [][]byte
 	var count int64
 
-	err := row.Scan(&role.Id, &role.Created, &role.Title, &count)
+	err := row.Scan(&role.Id, &role.Created, &role.Title, &role.Description, &role.Permissions, &parents, &managedRoles, &count)
 	if err != nil {
 		sentry.CaptureException(err)
 		return unwrapRoleScanError(err), nil, 0
 	}
 
+	role.ParentsID = functools.ByteArraySliceToUUIDSlice(parents)
+	role.ManagedRolesID = functools.ByteArraySliceToUUIDSlice(managedRoles)
+
 	return enums.Ok, role, count
 }
 
@@ -76,6 +197,7 @@ type GetRolesQuery struct {
 	Pagination  *models.PaginationRequest
 	Identifiers []string
 	Titles      []string
+	ManagersID  []uuid.UUID // Only roles managed by any of these roles
 }
 
 type getRolesRawQuery struct {
@@ -83,6 +205,7 @@ type getRolesRawQuery struct {
 	Offset      int
 	Identifiers string
 	Titles      string
+	ManagersID  string
 }
 
 func convertGetRolesQueryToRaw(query GetRolesQuery) getRolesRawQuery {
@@ -101,19 +224,152 @@ func convertGetRolesQueryToRaw(query GetRolesQuery) getRolesRawQuery {
 		Offset:      offset,
 		Identifiers: functools.StringsToPGArray(query.Identifiers),
 		Titles:      functools.StringsToPGArray(query.Titles),
+		ManagersID:  functools.UUIDListToPGArray(query.ManagersID),
 	}
 }
 
-func CreateRole(db DB, context context.Context, title string) (int, *models.Role) {
+func CreateRole(db DB, context context.Context, title string, permissions []string, parentsID []uuid.UUID) (int, *models.Role) {
+	if permissions == nil {
+		permissions = []string{}
+	}
+
 	sql := createRoleSQL()
-	row := db.QueryRow(context, sql, uuid.NewV4(), title)
+	row := db.QueryRow(context, sql, uuid.NewV4(), title, permissions, functools.UUIDListToPGArray(parentsID), GetRealmFromContext(context).Name)
+	status, role, _ := scanRole(row)
+	return status, role
+}
+
+func UpdateRole(db DB, context context.Context, id uuid.UUID, title string, description string) (int, *models.Role) {
+	row := db.QueryRow(context, updateRoleSQL(), id, title, description, GetRealmFromContext(context).Name)
 	status, role, _ := scanRole(row)
 	return status, role
 }
 
+// DeleteRole deletes role together with its assignments to users and links to parents and children
+func DeleteRole(db DB, context context.Context, id uuid.UUID) (int, *models.Role) {
+	row := db.QueryRow(context, deleteRoleSQL(), id, GetRealmFromContext(context).Name)
+	status, role, _ := scanRole(row)
+	return status, role
+}
+
+func GetRoleUsersID(db DB, context context.Context, id uuid.UUID) []uuid.UUID {
+	rows, err := db.Query(context, getRoleUsersSQL(), id, GetRealmFromContext(context).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	var identifiers []uuid.UUID
+	for rows.Next() {
+		var userID uuid.UUID
+		err = rows.Scan(&userID)
+		if err != nil {
+			sentry.CaptureException(err)
+			return nil
+		}
+
+		identifiers = append(identifiers, userID)
+	}
+
+	return identifiers
+}
+
+// LockRoleHierarchy serializes changes of role parents until the end of transaction,
+// so concurrent updates could not create a cycle
+func LockRoleHierarchy(tx DB, context context.Context) int {
+	_, err := tx.Exec(context, lockRoleHierarchySQL())
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk
+	}
+
+	return enums.Ok
+}
+
+func HasRoleHierarchyCycle(db DB, context context.Context, id uuid.UUID, parentsID []uuid.UUID) (int, bool) {
+	var exists bool
+
+	err := db.QueryRow(context, hasRoleHierarchyCycleSQL(), id, functools.UUIDListToPGArray(parentsID)).Scan(&exists)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, false
+	}
+
+	return enums.Ok, exists
+}
+
+func UpdateRoleParents(tx DB, context context.Context, id uuid.UUID, parentsID []uuid.UUID) int {
+	_, err := tx.Exec(context, deleteRoleParentsSQL(), id, GetRealmFromContext(context).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk
+	}
+
+	_, err = tx.Exec(context, createRoleParentsSQL(), id, functools.UUIDListToPGArray(parentsID), GetRealmFromContext(context).Name)
+	if err != nil {
+		return unwrapRoleScanError(err)
+	}
+
+	return enums.Ok
+}
+
+func UpdateRoleManagedRoles(tx DB, context context.Context, id uuid.UUID, managedRolesID []uuid.UUID) int {
+	_, err := tx.Exec(context, deleteRoleManagedRolesSQL(), id, GetRealmFromContext(context).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk
+	}
+
+	_, err = tx.Exec(context, createRoleManagedRolesSQL(), id, functools.UUIDListToPGArray(managedRolesID), GetRealmFromContext(context).Name)
+	if err != nil {
+		return unwrapRoleScanError(err)
+	}
+
+	return enums.Ok
+}
+
+func IsRoleManageable(db DB, context context.Context, managersID []uuid.UUID, id uuid.UUID) (int, bool) {
+	var exists bool
+
+	err := db.QueryRow(context, isRoleManageableSQL(), functools.UUIDListToPGArray(managersID), id, GetRealmFromContext(context).Name).Scan(&exists)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, false
+	}
+
+	return enums.Ok, exists
+}
+
+// GetRoleManagersID returns roles which users could grant and revoke role
+func GetRoleManagersID(db DB, context context.Context, id uuid.UUID) []uuid.UUID {
+	rows, err := db.Query(context, getRoleManagersSQL(), id, GetRealmFromContext(context).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	var identifiers []uuid.UUID
+	for rows.Next() {
+		var roleID uuid.UUID
+		err = rows.Scan(&roleID)
+		if err != nil {
+			sentry.CaptureException(err)
+			return nil
+		}
+
+		identifiers = append(identifiers, roleID)
+	}
+
+	return identifiers
+}
+
 func GetRole(db DB, context context.Context, id uuid.UUID) (int, *models.Role) {
 	sql := getRolesSQL()
-	row := db.QueryRow(context, sql, functools.StringsToPGArray([]string{id.String()}), "{}", 1, 0)
+	row := db.QueryRow(context, sql, functools.StringsToPGArray([]string{id.String()}), "{}", 1, 0, GetRealmFromContext(context).Name, "{}")
 	status, role, _ := scanRole(row)
 	return status, role
 }
@@ -123,7 +379,7 @@ func GetRoles(db DB, context context.Context, query GetRolesQuery) ([]*models.Ro
 	sql := getRolesSQL()
 	rawQuery := convertGetRolesQueryToRaw(query)
 
-	rows, err := db.Query(context, sql, rawQuery.Identifiers, rawQuery.Titles, rawQuery.Limit, rawQuery.Offset)
+	rows, err := db.Query(context, sql, rawQuery.Identifiers, rawQuery.Titles, rawQuery.Limit, rawQuery.Offset, GetRealmFromContext(context).Name, rawQuery.ManagersID)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil, nil
@@ -14,7 +14,7 @@ func TestCreateRole(t *testing.T) {
 	pool := config.InitPool(nil, config.InitEnvironment())
 	ctx := context.Background()
 	PurgeRoles(pool, ctx)
-	status, role := CreateRole(pool, ctx, "admin")
+	status, role := CreateRole(pool, ctx, "admin", nil, nil)
 	require.Equal(t, enums.Ok, status)
 	require.NotNil(t, role)
 	roles, _ := GetRoles(pool, ctx, GetRolesQuery{
@@ -28,8 +28,8 @@ func TestCreateRoleThatAlreadyExist(t *testing.T) {
 	pool := config.InitPool(nil, config.InitEnvironment())
 	ctx := context.Background()
 	PurgeRoles(pool, ctx)
-	_, _ = CreateRole(pool, ctx, "admin")
-	status, role := CreateRole(pool, ctx, "admin")
+	_, _ = CreateRole(pool, ctx, "admin", nil, nil)
+	status, role := CreateRole(pool, ctx, "admin", nil, nil)
 	require.Equal(t, enums.RoleAlreadyExist, status)
 	require.Nil(t, role)
 	roles, _ := GetRoles(pool, ctx, GetRolesQuery{
@@ -54,7 +54,7 @@ func TestGetRole(t *testing.T) {
 	pool := config.InitPool(nil, config.InitEnvironment())
 	ctx := context.Background()
 	PurgeRoles(pool, ctx)
-	_, createdRole := CreateRole(pool, ctx, "admin")
+	_, createdRole := CreateRole(pool, ctx, "admin", nil, nil)
 	status, role := GetRole(pool, ctx, createdRole.Id)
 	require.Equal(t, enums.Ok, status)
 	require.NotNil(t, role)
@@ -70,3 +70,104 @@ func TestGetRoleWithEmptyTable(t *testing.T) {
 	require.Equal(t, enums.RoleNotFound, status)
 	require.Nil(t, role)
 }
+
+func TestCreateRoleWithParents(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeRoles(pool, ctx)
+	_, parent := CreateRole(pool, ctx, "manager", nil, nil)
+	status, role := CreateRole(pool, ctx, "admin", nil, []uuid.UUID{parent.Id})
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, []uuid.UUID{parent.Id}, role.ParentsID)
+
+	status, role = CreateRole(pool, ctx, "owner", nil, []uuid.UUID{uuid.NewV4()})
+	require.Equal(t, enums.ParentRoleNotFound, status)
+	require.Nil(t, role)
+}
+
+func TestHasRoleHierarchyCycle(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeRoles(pool, ctx)
+	_, grandparent := CreateRole(pool, ctx, "user", nil, nil)
+	_, parent := CreateRole(pool, ctx, "manager", nil, []uuid.UUID{grandparent.Id})
+	_, role := CreateRole(pool, ctx, "admin", nil, []uuid.UUID{parent.Id})
+
+	status, cycle := HasRoleHierarchyCycle(pool, ctx, grandparent.Id, []uuid.UUID{role.Id})
+	require.Equal(t, enums.Ok, status)
+	require.True(t, cycle)
+
+	status, cycle = HasRoleHierarchyCycle(pool, ctx, role.Id, []uuid.UUID{grandparent.Id})
+	require.Equal(t, enums.Ok, status)
+	require.False(t, cycle)
+
+	require.Equal(t, enums.Ok, UpdateRoleParents(pool, ctx, role.Id, []uuid.UUID{grandparent.Id}))
+	_, role = GetRole(pool, ctx, role.Id)
+	require.Equal(t, []uuid.UUID{grandparent.Id}, role.ParentsID)
+}
+
+func TestUpdateRole(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeRoles(pool, ctx)
+	_, createdRole := CreateRole(pool, ctx, "admn", nil, nil)
+	status, role := UpdateRole(pool, ctx, createdRole.Id, "manager", "Manages users")
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, "manager", role.Title)
+	require.Equal(t, "Manages users", role.Description)
+	status, role = UpdateRole(pool, ctx, uuid.NewV4(), "manager", "")
+	require.Equal(t, enums.RoleNotFound, status)
+	require.Nil(t, role)
+}
+
+func TestDeleteRole(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUserRoles(pool, ctx)
+	PurgeRoles(pool, ctx)
+	PurgeUsers(pool, ctx)
+	user := CreateUser(pool, ctx)
+	_, parent := CreateRole(pool, ctx, "manager", nil, nil)
+	_, role := CreateRole(pool, ctx, "owner", nil, []uuid.UUID{parent.Id})
+	_, _ = CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
+	require.Equal(t, []uuid.UUID{user.Id}, GetRoleUsersID(pool, ctx, parent.Id))
+
+	status, deletedRole := DeleteRole(pool, ctx, parent.Id)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, parent.Id, deletedRole.Id)
+	_, role = GetRole(pool, ctx, role.Id)
+	require.Empty(t, role.ParentsID)
+
+	status, deletedRole = DeleteRole(pool, ctx, parent.Id)
+	require.Equal(t, enums.RoleNotFound, status)
+	require.Nil(t, deletedRole)
+}
+
+func TestUpdateRoleManagedRoles(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeRoles(pool, ctx)
+	_, lead := CreateRole(pool, ctx, "lead", nil, nil)
+	_, developer := CreateRole(pool, ctx, "developer", nil, nil)
+	_, tester := CreateRole(pool, ctx, "tester", nil, nil)
+
+	require.Equal(t, enums.ManagedRoleNotFound, UpdateRoleManagedRoles(pool, ctx, lead.Id, []uuid.UUID{uuid.NewV4()}))
+	require.Equal(t, enums.Ok, UpdateRoleManagedRoles(pool, ctx, lead.Id, []uuid.UUID{developer.Id}))
+	_, role := GetRole(pool, ctx, lead.Id)
+	require.Equal(t, []uuid.UUID{developer.Id}, role.ManagedRolesID)
+
+	status, manageable := IsRoleManageable(pool, ctx, []uuid.UUID{lead.Id}, developer.Id)
+	require.Equal(t, enums.Ok, status)
+	require.True(t, manageable)
+
+	status, manageable = IsRoleManageable(pool, ctx, []uuid.UUID{lead.Id}, tester.Id)
+	require.Equal(t, enums.Ok, status)
+	require.False(t, manageable)
+
+	roles, _ := GetRoles(pool, ctx, GetRolesQuery{
+		Pagination: &models.PaginationRequest{Limit: 10, Page: 1},
+		ManagersID: []uuid.UUID{lead.Id},
+	})
+	require.Len(t, roles, 1)
+	require.Equal(t, developer.Id, roles[0].Id)
+}
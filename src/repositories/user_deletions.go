@@ -0,0 +1,135 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"github.com/getsentry/sentry-go"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Deletion scheduled earlier is kept, so repeated requests don't postpone anonymization
+func scheduleUserDeletionSQL() string {
+	return `
+		UPDATE users
+		SET deletion_scheduled = CASE WHEN deletion_scheduled = 0 THEN $2 ELSE deletion_scheduled END
+		WHERE id = $1 AND realm = $3 AND status <> 'deleted'
+		RETURNING id, created, profile, status, suspended_until, status_reason, status_actor_id, status_changed, deletion_scheduled;
+		`
+}
+
+func cancelUserDeletionSQL() string {
+	return `
+		UPDATE users
+		SET deletion_scheduled = 0
+		WHERE id = $1 AND realm = $2 AND deletion_scheduled > 0 AND status <> 'deleted'
+		RETURNING id, created, profile, status, suspended_until, status_reason, status_actor_id, status_changed, deletion_scheduled;
+		`
+}
+
+func getDueUserDeletionsSQL() string {
+	return `
+		SELECT id
+		FROM users
+		WHERE realm = $3 AND deletion_scheduled > 0 AND deletion_scheduled <= $1 AND status <> 'deleted'
+		ORDER BY deletion_scheduled
+		LIMIT $2;
+		`
+}
+
+// Tombstone keeps identifier, creation time and status history, the row stays locked until transaction ends,
+// so deletion can't be cancelled while personal data is being erased
+func anonymizeUserSQL() string {
+	return `
+		UPDATE users
+		SET profile = '{}'::jsonb, status = 'deleted', suspended_until = 0, status_changed = $2, deletion_scheduled = 0
+		WHERE id = $1 AND realm = $3 AND deletion_scheduled > 0 AND deletion_scheduled <= $2 AND status <> 'deleted'
+		RETURNING id, created, profile, status, suspended_until, status_reason, status_actor_id, status_changed, deletion_scheduled;
+		`
+}
+
+// Personal data of anonymized user, realm of user is checked by anonymizeUserSQL. Role requests are kept
+// as audit history, pending ones are cancelled so that roles are never granted to tombstone. Contact transfers
+// keep ownership history without contact values
+func eraseUserDataSQL() []string {
+	return []string{
+		"DELETE FROM emails WHERE user_id = $1;",
+		"DELETE FROM phones WHERE user_id = $1;",
+		"DELETE FROM passwords WHERE user_id = $1;",
+		"DELETE FROM user_roles WHERE user_id = $1;",
+		"DELETE FROM organization_members WHERE user_id = $1;",
+		"DELETE FROM group_members WHERE user_id = $1;",
+		"DELETE FROM user_exports WHERE user_id = $1;",
+		"UPDATE role_requests SET status = 'cancelled' WHERE user_id = $1 AND status = 'pending';",
+		`UPDATE contact_transfers
+		 SET status = CASE WHEN status = 'pending' THEN 'rejected' ELSE status END,
+		     resolved = CASE WHEN status = 'pending' THEN extract(epoch from now()) * 1000 ELSE resolved END,
+		     value = ''
+		 WHERE from_user_id = $1 OR to_user_id = $1;`,
+	}
+}
+
+// ScheduleUserDeletion schedules anonymization of user at scheduled moment in milliseconds
+func ScheduleUserDeletion(db DB, ctx context.Context, id uuid.UUID, scheduled int64) (int, *models.User) {
+	row := db.QueryRow(ctx, scheduleUserDeletionSQL(), id, scheduled, GetRealmFromContext(ctx).Name)
+	user := scanUser(row)
+	if user == nil {
+		return enums.UserNotFound, nil
+	}
+
+	return enums.Ok, user
+}
+
+func CancelUserDeletion(db DB, ctx context.Context, id uuid.UUID) (int, *models.User) {
+	row := db.QueryRow(ctx, cancelUserDeletionSQL(), id, GetRealmFromContext(ctx).Name)
+	user := scanUser(row)
+	if user == nil {
+		return enums.UserDeletionNotScheduled, nil
+	}
+
+	return enums.Ok, user
+}
+
+// GetDueUserDeletions returns identifiers of users which deletion is scheduled before now in milliseconds
+func GetDueUserDeletions(db DB, ctx context.Context, now int64, limit int) []uuid.UUID {
+	rows, err := db.Query(ctx, getDueUserDeletionsSQL(), now, limit, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	defer rows.Close()
+
+	var identifiers []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		err = rows.Scan(&id)
+		if err != nil {
+			sentry.CaptureException(err)
+			return nil
+		}
+
+		identifiers = append(identifiers, id)
+	}
+
+	return identifiers
+}
+
+// AnonymizeUser turns user due to be deleted into tombstone and erases personal data, db should be transaction
+func AnonymizeUser(db DB, ctx context.Context, id uuid.UUID, now int64) (int, *models.User) {
+	row := db.QueryRow(ctx, anonymizeUserSQL(), id, now, GetRealmFromContext(ctx).Name)
+	user := scanUser(row)
+	if user == nil {
+		return enums.UserDeletionNotScheduled, nil
+	}
+
+	for _, sql := range eraseUserDataSQL() {
+		_, err := db.Exec(ctx, sql, id)
+		if err != nil {
+			sentry.CaptureException(err)
+			return enums.NotOk, nil
+		}
+	}
+
+	return enums.Ok, user
+}
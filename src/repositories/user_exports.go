@@ -0,0 +1,161 @@
+package repositories
+
+import (
+	"hive/enums"
+	"hive/models"
+	"context"
+	"errors"
+	"github.com/getsentry/sentry-go"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v4"
+	uuid "github.com/satori/go.uuid"
+	"strings"
+)
+
+func createUserExportSQL() string {
+	return `
+		INSERT INTO user_exports(id, user_id, requester_id, token_hash, realm)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created, user_id, requester_id, status, completed, expires;
+		`
+}
+
+func getUserExportSQL() string {
+	return `
+		SELECT id, created, user_id, requester_id, status, completed, expires
+		FROM user_exports
+		WHERE id = $1 AND realm = $2;
+		`
+}
+
+func getUserExportArchiveSQL() string {
+	return `
+		SELECT id, created, user_id, requester_id, status, completed, expires, archive
+		FROM user_exports
+		WHERE id = $1 AND token_hash = $2 AND (expires = 0 OR expires > extract(epoch from now()) * 1000) AND realm = $3;
+		`
+}
+
+// claimUserExportsSQL takes pending exports for processing, exports locked by concurrent instances are skipped,
+// so every archive is built only once
+func claimUserExportsSQL() string {
+	return `
+		UPDATE user_exports
+		SET status = 'processing'
+		WHERE id IN (SELECT id
+		             FROM user_exports
+		             WHERE status = 'pending' AND realm = $2
+		             ORDER BY created
+		             LIMIT $1
+		             FOR UPDATE SKIP LOCKED)
+		RETURNING id, created, user_id, requester_id, status, completed, expires;
+		`
+}
+
+func completeUserExportSQL() string {
+	return `
+		UPDATE user_exports
+		SET status    = $2,
+		    archive   = $3,
+		    expires   = $4,
+		    completed = extract(epoch from now()) * 1000
+		WHERE id = $1 AND status = 'processing' AND realm = $5
+		RETURNING id, created, user_id, requester_id, status, completed, expires;
+		`
+}
+
+func deleteExpiredUserExportsSQL() string {
+	return `
+		DELETE FROM user_exports
+		WHERE status IN ('ready', 'failed') AND expires <= extract(epoch from now()) * 1000 AND realm = $1;
+		`
+}
+
+func unwrapUserExportScanError(err error) int {
+	var e *pgconn.PgError
+
+	if errors.As(err, &e) && e.ConstraintName == "user_exports_user_id_fkey" {
+		return enums.UserNotFound
+	} else if strings.Contains(err.Error(), "no rows in result") {
+		return enums.UserExportNotFound
+	}
+
+	sentry.CaptureException(err)
+	return enums.NotOk
+}
+
+func scanUserExport(row pgx.Row, archive bool) (int, *models.UserExport) {
+	export := &models.UserExport{}
+	var requesterID uuid.NullUUID
+
+	fields := []interface{}{&export.Id, &export.Created, &export.UserId, &requesterID, &export.Status, &export.Completed, &export.Expires}
+	if archive {
+		fields = append(fields, &export.Archive)
+	}
+
+	err := row.Scan(fields...)
+	if err != nil {
+		return unwrapUserExportScanError(err), nil
+	}
+
+	export.RequesterId = requesterID.UUID
+	return enums.Ok, export
+}
+
+func scanUserExports(rows pgx.Rows) []*models.UserExport {
+	exports := make([]*models.UserExport, 0)
+
+	for rows.Next() {
+		status, export := scanUserExport(rows, false)
+		if status == enums.Ok {
+			exports = append(exports, export)
+		}
+	}
+
+	rows.Close()
+	return exports
+}
+
+func CreateUserExport(db DB, ctx context.Context, userID uuid.UUID, requesterID uuid.UUID, tokenHash string) (int, *models.UserExport) {
+	row := db.QueryRow(ctx, createUserExportSQL(), uuid.NewV4(), userID,
+		uuid.NullUUID{UUID: requesterID, Valid: requesterID != uuid.Nil}, tokenHash, GetRealmFromContext(ctx).Name)
+	return scanUserExport(row, false)
+}
+
+func GetUserExport(db DB, ctx context.Context, id uuid.UUID) (int, *models.UserExport) {
+	row := db.QueryRow(ctx, getUserExportSQL(), id, GetRealmFromContext(ctx).Name)
+	return scanUserExport(row, false)
+}
+
+// GetUserExportArchive returns export together with archive if token matches and archive is not expired,
+// exports being built are returned without archive
+func GetUserExportArchive(db DB, ctx context.Context, id uuid.UUID, tokenHash string) (int, *models.UserExport) {
+	row := db.QueryRow(ctx, getUserExportArchiveSQL(), id, tokenHash, GetRealmFromContext(ctx).Name)
+	return scanUserExport(row, true)
+}
+
+func ClaimUserExports(db DB, ctx context.Context, limit int) []*models.UserExport {
+	rows, err := db.Query(ctx, claimUserExportsSQL(), limit, GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	return scanUserExports(rows)
+}
+
+// CompleteUserExport saves archive of processing export, failed exports have no archive
+func CompleteUserExport(db DB, ctx context.Context, id uuid.UUID, status string, archive []byte, expires int64) (int, *models.UserExport) {
+	row := db.QueryRow(ctx, completeUserExportSQL(), id, status, archive, expires, GetRealmFromContext(ctx).Name)
+	return scanUserExport(row, false)
+}
+
+func DeleteExpiredUserExports(db DB, ctx context.Context) int64 {
+	result, err := db.Exec(ctx, deleteExpiredUserExportsSQL(), GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return 0
+	}
+
+	return result.RowsAffected()
+}
@@ -0,0 +1,46 @@
+package repositories
+
+import (
+	"hive/config"
+	"hive/enums"
+	"context"
+	"github.com/stretchr/testify/require"
+	uuid "github.com/satori/go.uuid"
+	"testing"
+	"time"
+)
+
+func TestUserExportLifecycle(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUserExports(pool, ctx)
+	PurgeUsers(pool, ctx)
+	user := CreateUser(pool, ctx)
+
+	status, export := CreateUserExport(pool, ctx, user.Id, user.Id, "hash")
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, enums.UserExportPending, export.Status)
+
+	status, _ = CreateUserExport(pool, ctx, uuid.NewV4(), user.Id, "hash")
+	require.Equal(t, enums.UserNotFound, status)
+
+	exports := ClaimUserExports(pool, ctx, 10)
+	require.Len(t, exports, 1)
+	require.Equal(t, enums.UserExportProcessing, exports[0].Status)
+	require.Len(t, ClaimUserExports(pool, ctx, 10), 0)
+
+	expires := time.Now().Add(time.Hour).UnixNano() / int64(time.Millisecond)
+	status, export = CompleteUserExport(pool, ctx, export.Id, enums.UserExportReady, []byte("archive"), expires)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, enums.UserExportReady, export.Status)
+	require.NotZero(t, export.Completed)
+
+	status, _ = GetUserExportArchive(pool, ctx, export.Id, "incorrect")
+	require.Equal(t, enums.UserExportNotFound, status)
+
+	status, export = GetUserExportArchive(pool, ctx, export.Id, "hash")
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, []byte("archive"), export.Archive)
+
+	require.Equal(t, int64(0), DeleteExpiredUserExports(pool, ctx))
+}
@@ -15,26 +15,53 @@ import (
 
 func createUserRoleSQL() string {
 	return `
-		INSERT INTO user_roles(id, user_id, role_id) 
-		VALUES ($1, $2, $3) 
-		RETURNING id, created, user_id, role_id, 0;
+		INSERT INTO user_roles(id, user_id, role_id, organization_id, realm, starts_at, expires_at, started) 
+		VALUES ($1, $2, $3, $4, $5, NULLIF($6::bigint, 0), NULLIF($7::bigint, 0), $6::bigint <= extract(epoch from now()) * 1000) 
+		RETURNING id, created, user_id, role_id, organization_id, starts_at, expires_at, 0;
 		`
 }
 
 func getUserRolesSQL() string {
 	return `
-		SELECT id, created, user_id, role_id, count(*) OVER() AS full_count
+		SELECT id, created, user_id, role_id, organization_id, starts_at, expires_at, count(*) OVER() AS full_count
 		FROM user_roles
 		WHERE (array_length($1::uuid[], 1) IS NULL OR user_id = ANY ($1::uuid[])) AND 
-		      (array_length($2::uuid[], 1) IS NULL OR role_id = ANY ($2::uuid[])) 
-		LIMIT $3
-		OFFSET $4;
+		      (array_length($2::uuid[], 1) IS NULL OR role_id = ANY ($2::uuid[])) AND
+		      (array_length($3::uuid[], 1) IS NULL OR organization_id = ANY ($3::uuid[])) AND
+		      realm = $6
+		LIMIT $4
+		OFFSET $5;
+		`
+}
+
+func getUserRoleSQL() string {
+	return `
+		SELECT id, created, user_id, role_id, organization_id, starts_at, expires_at, 0
+		FROM user_roles
+		WHERE id = $1 AND realm = $2;
 		`
 }
 
 func deleteUserRoleSQL() string {
 	return `
-		DELETE FROM user_roles WHERE id = $1 RETURNING id, created, user_id, role_id, 0;
+		DELETE FROM user_roles WHERE id = $1 AND realm = $2 RETURNING id, created, user_id, role_id, organization_id, starts_at, expires_at, 0;
+		`
+}
+
+func expireUserRolesSQL() string {
+	return `
+		DELETE FROM user_roles
+		WHERE expires_at <= extract(epoch from now()) * 1000 AND realm = $1
+		RETURNING id, created, user_id, role_id, organization_id, starts_at, expires_at, 0;
+		`
+}
+
+func startUserRolesSQL() string {
+	return `
+		UPDATE user_roles
+		SET started = true
+		WHERE NOT started AND starts_at <= extract(epoch from now()) * 1000 AND realm = $1
+		RETURNING id, created, user_id, role_id, organization_id, starts_at, expires_at, 0;
 		`
 }
 
@@ -42,7 +69,11 @@ func unwrapUserRoleScanError(err error) int {
 	var e *pgconn.PgError
 
 	if errors.As(err, &e) {
-		if strings.Contains(e.Detail, "not present in table \"users\".") || strings.Contains(e.Detail, "отсутствует в таблице \"users\"") {
+		if e.ConstraintName == "user_roles_organization_member_fkey" {
+			return enums.OrganizationMemberNotFound
+		} else if e.ConstraintName == "user_roles_organization_idx" {
+			return enums.UserRoleAlreadyExist
+		} else if strings.Contains(e.Detail, "not present in table \"users\".") || strings.Contains(e.Detail, "отсутствует в таблице \"users\"") {
 			return enums.UserNotFound
 		} else if strings.Contains(e.Detail, "not present in table \"roles\".") || strings.Contains(e.Detail, "отсутствует в таблице \"roles\"") {
 			return enums.RoleNotFound
@@ -61,14 +92,26 @@ func unwrapUserRoleScanError(err error) int {
 
 func scanUserRole(row pgx.Row) (int, *models.UserRole, int64) {
 	ur := &models.UserRole{}
+	var organizationID uuid.NullUUID
+	var startsAt, expiresAt *int64
 	var count int64
 
-	err := row.Scan(&ur.Id, &ur.Created, &ur.UserId, &ur.RoleId, &count)
+	err := row.Scan(&ur.Id, &ur.Created, &ur.UserId, &ur.RoleId, &organizationID, &startsAt, &expiresAt, &count)
 	if err != nil {
 		sentry.CaptureException(err)
 		return unwrapUserRoleScanError(err), nil, 0
 	}
 
+	ur.OrganizationId = organizationID.UUID
+
+	if startsAt != nil {
+		ur.StartsAt = *startsAt
+	}
+
+	if expiresAt != nil {
+		ur.ExpiresAt = *expiresAt
+	}
+
 	return enums.Ok, ur, count
 }
 
@@ -81,7 +124,13 @@ func scanUserRoles(rows pgx.Rows, limit int) ([]*models.UserRole, int64) {
 	for rows.Next() {
 		_, ur, c := scanUserRole(rows)
 		count = c
-		userRoles[i] = ur
+
+		if len(userRoles) <= int(i) {
+			userRoles = append(userRoles, ur)
+		} else {
+			userRoles[i] = ur
+		}
+
 		i++
 	}
 
@@ -91,31 +140,36 @@ func scanUserRoles(rows pgx.Rows, limit int) ([]*models.UserRole, int64) {
 }
 
 type GetUserRoleQuery struct {
-	UserId     []uuid.UUID
-	RoleId     []uuid.UUID
-	Pagination *models.PaginationRequest
+	UserId         []uuid.UUID
+	RoleId         []uuid.UUID
+	OrganizationId []uuid.UUID
+	Pagination     *models.PaginationRequest
 }
 
 type getUserRoleRawQuery struct {
-	Offset int
-	Limit  int
-	UserId string
-	RoleId string
+	Offset         int
+	Limit          int
+	UserId         string
+	RoleId         string
+	OrganizationId string
 }
 
 func convertGetUserRoleQueryToRaw(query GetUserRoleQuery) getUserRoleRawQuery {
 	limit, offset := functools.LimitPageToLimitOffset(query.Pagination.Limit, query.Pagination.Page)
 	return getUserRoleRawQuery{
-		Limit:  limit,
-		Offset: offset,
-		UserId: functools.UUIDListToPGArray(query.UserId),
-		RoleId: functools.UUIDListToPGArray(query.RoleId),
+		Limit:          limit,
+		Offset:         offset,
+		UserId:         functools.UUIDListToPGArray(query.UserId),
+		RoleId:         functools.UUIDListToPGArray(query.RoleId),
+		OrganizationId: functools.UUIDListToPGArray(query.OrganizationId),
 	}
 }
 
-func CreateUserRole(db DB, ctx context.Context, userID uuid.UUID, roleID uuid.UUID) (int, *models.UserRole) {
+// CreateUserRole grants role to user globally or within organization if organizationID is not nil,
+// zero startsAt and expiresAt make assignment active right away and forever
+func CreateUserRole(db DB, ctx context.Context, userID uuid.UUID, roleID uuid.UUID, organizationID uuid.UUID, startsAt int64, expiresAt int64) (int, *models.UserRole) {
 	sql := createUserRoleSQL()
-	row := db.QueryRow(ctx, sql, uuid.NewV4(), userID, roleID)
+	row := db.QueryRow(ctx, sql, uuid.NewV4(), userID, roleID, uuid.NullUUID{UUID: organizationID, Valid: organizationID != uuid.Nil}, GetRealmFromContext(ctx).Name, startsAt, expiresAt)
 	status, userRole, _ := scanUserRole(row)
 	return status, userRole
 }
@@ -123,7 +177,7 @@ func CreateUserRole(db DB, ctx context.Context, userID uuid.UUID, roleID uuid.UU
 func GetUserRoles(db DB, ctx context.Context, query GetUserRoleQuery) ([]*models.UserRole, *models.PaginationResponse) {
 	sql := getUserRolesSQL()
 	rawQuery := convertGetUserRoleQueryToRaw(query)
-	rows, err := db.Query(ctx, sql, rawQuery.UserId, rawQuery.RoleId, rawQuery.Limit, rawQuery.Offset)
+	rows, err := db.Query(ctx, sql, rawQuery.UserId, rawQuery.RoleId, rawQuery.OrganizationId, rawQuery.Limit, rawQuery.Offset, GetRealmFromContext(ctx).Name)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil, nil
@@ -138,9 +192,39 @@ func GetUserRoles(db DB, ctx context.Context, query GetUserRoleQuery) ([]*models
 	}
 }
 
+func GetUserRole(db DB, ctx context.Context, id uuid.UUID) (int, *models.UserRole) {
+	row := db.QueryRow(ctx, getUserRoleSQL(), id, GetRealmFromContext(ctx).Name)
+	status, userRole, _ := scanUserRole(row)
+	return status, userRole
+}
+
 func DeleteUserRole(db DB, ctx context.Context, id uuid.UUID) (int, *models.UserRole) {
 	sql := deleteUserRoleSQL()
-	row := db.QueryRow(ctx, sql, id)
+	row := db.QueryRow(ctx, sql, id, GetRealmFromContext(ctx).Name)
 	status, userRole, _ := scanUserRole(row)
 	return status, userRole
 }
+
+// ExpireUserRoles deletes assignments which expired
+func ExpireUserRoles(db DB, ctx context.Context) []*models.UserRole {
+	rows, err := db.Query(ctx, expireUserRolesSQL(), GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	userRoles, _ := scanUserRoles(rows, 0)
+	return userRoles
+}
+
+// StartUserRoles marks assignments which became active since the previous call
+func StartUserRoles(db DB, ctx context.Context) []*models.UserRole {
+	rows, err := db.Query(ctx, startUserRolesSQL(), GetRealmFromContext(ctx).Name)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	userRoles, _ := scanUserRoles(rows, 0)
+	return userRoles
+}
@@ -18,8 +18,8 @@ func TestCreateUserRole(t *testing.T) {
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
 	user := CreateUser(pool, ctx)
-	_, role := CreateRole(pool, ctx, "role")
-	status, userRole := CreateUserRole(pool, ctx, user.Id, role.Id)
+	_, role := CreateRole(pool, ctx, "role", nil, nil)
+	status, userRole := CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
 	require.Equal(t, enums.Ok, status)
 	require.NotNil(t, userRole)
 }
@@ -31,7 +31,7 @@ func TestCreateUserRoleWithoutRole(t *testing.T) {
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
 	user := CreateUser(pool, ctx)
-	status, userRole := CreateUserRole(pool, ctx, user.Id, uuid.NewV4())
+	status, userRole := CreateUserRole(pool, ctx, user.Id, uuid.NewV4(), uuid.Nil, 0, 0)
 	require.Equal(t, enums.RoleNotFound, status)
 	require.Nil(t, userRole)
 }
@@ -42,8 +42,8 @@ func TestCreateUserRoleWithoutUser(t *testing.T) {
 	PurgeUserRoles(pool, ctx)
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
-	_, role := CreateRole(pool, ctx, "role")
-	status, userRole := CreateUserRole(pool, ctx, uuid.NewV4(), role.Id)
+	_, role := CreateRole(pool, ctx, "role", nil, nil)
+	status, userRole := CreateUserRole(pool, ctx, uuid.NewV4(), role.Id, uuid.Nil, 0, 0)
 	require.Equal(t, enums.UserNotFound, status)
 	require.Nil(t, userRole)
 }
@@ -54,7 +54,7 @@ func TestCreateUserRoleWithoutUserAndRole(t *testing.T) {
 	PurgeUserRoles(pool, ctx)
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
-	status, userRole := CreateUserRole(pool, ctx, uuid.NewV4(), uuid.NewV4())
+	status, userRole := CreateUserRole(pool, ctx, uuid.NewV4(), uuid.NewV4(), uuid.Nil, 0, 0)
 	require.True(t, functools.In([]int{enums.RoleNotFound, enums.UserNotFound}, status))
 	require.Nil(t, userRole)
 }
@@ -66,9 +66,9 @@ func TestCreateUserRoleThatAlreadyExist(t *testing.T) {
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
 	user := CreateUser(pool, ctx)
-	_, role := CreateRole(pool, ctx, "role")
-	CreateUserRole(pool, ctx, user.Id, role.Id)
-	status, userRole := CreateUserRole(pool, ctx, user.Id, role.Id)
+	_, role := CreateRole(pool, ctx, "role", nil, nil)
+	CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
+	status, userRole := CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
 	require.Equal(t, enums.UserRoleAlreadyExist, status)
 	require.Nil(t, userRole)
 }
@@ -80,10 +80,10 @@ func TestGetUserRolesWithTwoRoles(t *testing.T) {
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
 	user := CreateUser(pool, ctx)
-	_, role := CreateRole(pool, ctx, "role")
-	_, adminRole := CreateRole(pool, ctx, "admin")
-	CreateUserRole(pool, ctx, user.Id, role.Id)
-	CreateUserRole(pool, ctx, user.Id, adminRole.Id)
+	_, role := CreateRole(pool, ctx, "role", nil, nil)
+	_, adminRole := CreateRole(pool, ctx, "admin", nil, nil)
+	CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
+	CreateUserRole(pool, ctx, user.Id, adminRole.Id, uuid.Nil, 0, 0)
 	userRoles, _ := GetUserRoles(pool, ctx, GetUserRoleQuery{
 		UserId:     []uuid.UUID{user.Id},
 		RoleId:     nil,
@@ -99,8 +99,8 @@ func TestDeleteUserRole(t *testing.T) {
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
 	user := CreateUser(pool, ctx)
-	_, role := CreateRole(pool, ctx, "role")
-	_, userRole := CreateUserRole(pool, ctx, user.Id, role.Id)
+	_, role := CreateRole(pool, ctx, "role", nil, nil)
+	_, userRole := CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
 	DeleteUserRole(pool, ctx, userRole.Id)
 	userRoles, _ := GetUserRoles(pool, ctx, GetUserRoleQuery{
 		UserId:     []uuid.UUID{user.Id},
@@ -118,10 +118,10 @@ func TestGetUserRolesWithLimitedPagination(t *testing.T) {
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
 	user := CreateUser(pool, ctx)
-	_, role := CreateRole(pool, ctx, "role")
-	_, lore := CreateRole(pool, ctx, "lore")
-	_, userRole := CreateUserRole(pool, ctx, user.Id, role.Id)
-	CreateUserRole(pool, ctx, user.Id, lore.Id)
+	_, role := CreateRole(pool, ctx, "role", nil, nil)
+	_, lore := CreateRole(pool, ctx, "lore", nil, nil)
+	_, userRole := CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
+	CreateUserRole(pool, ctx, user.Id, lore.Id, uuid.Nil, 0, 0)
 	userRoles, _ := GetUserRoles(pool, ctx, GetUserRoleQuery{
 		Pagination: &models.PaginationRequest{Limit: 1},
 	})
@@ -137,10 +137,10 @@ func TestGetUserRolesWithPagination(t *testing.T) {
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
 	user := CreateUser(pool, ctx)
-	_, role := CreateRole(pool, ctx, "role")
-	_, lore := CreateRole(pool, ctx, "lore")
-	CreateUserRole(pool, ctx, user.Id, role.Id)
-	CreateUserRole(pool, ctx, user.Id, lore.Id)
+	_, role := CreateRole(pool, ctx, "role", nil, nil)
+	_, lore := CreateRole(pool, ctx, "lore", nil, nil)
+	CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
+	CreateUserRole(pool, ctx, user.Id, lore.Id, uuid.Nil, 0, 0)
 	userRoles, _ := GetUserRoles(pool, ctx, GetUserRoleQuery{
 		Pagination: &models.PaginationRequest{Limit: 10},
 	})
@@ -155,10 +155,10 @@ func TestGetUserRolesWithLimitedPaginationAndSecondPage(t *testing.T) {
 	PurgeRoles(pool, ctx)
 	PurgeUsers(pool, ctx)
 	user := CreateUser(pool, ctx)
-	_, role := CreateRole(pool, ctx, "role")
-	_, lore := CreateRole(pool, ctx, "lore")
-	CreateUserRole(pool, ctx, user.Id, role.Id)
-	_, userRole := CreateUserRole(pool, ctx, user.Id, lore.Id)
+	_, role := CreateRole(pool, ctx, "role", nil, nil)
+	_, lore := CreateRole(pool, ctx, "lore", nil, nil)
+	CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
+	_, userRole := CreateUserRole(pool, ctx, user.Id, lore.Id, uuid.Nil, 0, 0)
 	userRoles, _ := GetUserRoles(pool, ctx, GetUserRoleQuery{
 		Pagination: &models.PaginationRequest{Limit: 1, Page: 2},
 	})
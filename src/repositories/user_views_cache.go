@@ -6,6 +6,7 @@ import (
 	"hive/inout"
 	"hive/models"
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/getsentry/sentry-go"
 	"github.com/go-redis/redis/v7"
@@ -14,13 +15,13 @@ import (
 	"time"
 )
 
-func getUserKey(id uuid.UUID) string {
-	return fmt.Sprintf("%s:%s", enums.UserView, id.String())
+func getUserKey(ctx context.Context, id uuid.UUID) string {
+	return GetRealmKey(ctx, fmt.Sprintf("%s:%s", enums.UserView, id.String()))
 }
 
 func GetUserViewFromCache(cache *redis.Client, ctx context.Context, id uuid.UUID) *models.UserView {
 
-	key := getUserKey(id)
+	key := getUserKey(ctx, id)
 
 	value, err := cache.WithContext(ctx).Get(key).Bytes()
 	if err != nil {
@@ -41,13 +42,41 @@ func GetUserViewFromCache(cache *redis.Client, ctx context.Context, id uuid.UUID
 		rolesID[i] = uuid.FromBytesOrNil(id)
 	}
 
+	organizations := make([]*models.UserViewOrganization, len(userView.Organizations))
+	for i, organization := range userView.Organizations {
+		organizations[i] = &models.UserViewOrganization{
+			Id:          uuid.FromBytesOrNil(organization.Id),
+			Roles:       organization.Roles,
+			Permissions: organization.Permissions,
+		}
+	}
+
+	var profile map[string]interface{}
+	if len(userView.Profile) > 0 {
+		err = json.Unmarshal(userView.Profile, &profile)
+		if err != nil {
+			sentry.CaptureException(err)
+			return nil
+		}
+	}
+
 	return &models.UserView{
-		Id:      uuid.FromBytesOrNil(userView.Id),
-		Created: userView.Created,
-		Roles:   userView.Roles,
-		Phones:  userView.Phones,
-		Emails:  userView.Emails,
-		RolesID: rolesID,
+		Id:                uuid.FromBytesOrNil(userView.Id),
+		Created:           userView.Created,
+		Roles:             userView.Roles,
+		Phones:            userView.Phones,
+		Emails:            userView.Emails,
+		RolesID:           rolesID,
+		Permissions:       userView.Permissions,
+		Organizations:     organizations,
+		Version:           userView.Version,
+		RolesExpires:      userView.RolesExpires,
+		Profile:           profile,
+		Status:            userView.Status,
+		SuspendedUntil:    userView.SuspendedUntil,
+		DeletionScheduled: userView.DeletionScheduled,
+		PrimaryEmail:      userView.PrimaryEmail,
+		PrimaryPhone:      userView.PrimaryPhone,
 	}
 }
 
@@ -60,13 +89,38 @@ func CacheUserView(cache *redis.Client, ctx context.Context, userViews []*models
 	userViewsCache := make([]*inout.UserViewCache, len(userViews))
 
 	for i, uv := range userViews {
+		organizations := make([]*inout.UserViewOrganizationCache, len(uv.Organizations))
+		for j, organization := range uv.Organizations {
+			organizations[j] = &inout.UserViewOrganizationCache{
+				Id:          organization.Id.Bytes(),
+				Roles:       organization.Roles,
+				Permissions: organization.Permissions,
+			}
+		}
+
+		profile, err := json.Marshal(uv.Profile)
+		if err != nil {
+			sentry.CaptureException(err)
+			return
+		}
+
 		userViewsCache[i] = &inout.UserViewCache{
-			Id:      uv.Id.Bytes(),
-			Created: uv.Created,
-			Roles:   uv.Roles,
-			Phones:  uv.Phones,
-			Emails:  uv.Emails,
-			RolesID: functools.UUIDSliceToByteArraySlice(uv.RolesID),
+			Id:                uv.Id.Bytes(),
+			Created:           uv.Created,
+			Roles:             uv.Roles,
+			Phones:            uv.Phones,
+			Emails:            uv.Emails,
+			RolesID:           functools.UUIDSliceToByteArraySlice(uv.RolesID),
+			Permissions:       uv.Permissions,
+			Organizations:     organizations,
+			Version:           uv.Version,
+			RolesExpires:      uv.RolesExpires,
+			Profile:           profile,
+			Status:            uv.Status,
+			SuspendedUntil:    uv.SuspendedUntil,
+			DeletionScheduled: uv.DeletionScheduled,
+			PrimaryEmail:      uv.PrimaryEmail,
+			PrimaryPhone:      uv.PrimaryPhone,
 		}
 	}
 
@@ -82,7 +136,7 @@ func CacheUserView(cache *redis.Client, ctx context.Context, userViews []*models
 		userID := uuid.FromBytesOrNil(uv.Id)
 
 		identifiers[i] = userID
-		pipeline.Set(getUserKey(userID), data, time.Hour*48)
+		pipeline.Set(getUserKey(ctx, userID), data, time.Hour*48)
 	}
 
 	_, err := pipeline.Exec()
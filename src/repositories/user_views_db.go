@@ -4,10 +4,12 @@ import (
 	"hive/functools"
 	"hive/models"
 	"context"
+	"encoding/json"
 	"github.com/getsentry/sentry-go"
 	"github.com/jackc/pgx/v4"
 	uuid "github.com/satori/go.uuid"
 	"math"
+	"strings"
 )
 
 type GetUsersViewStoreQuery struct {
@@ -19,6 +21,13 @@ type GetUsersViewStoreQuery struct {
 	PhoneCodes []string
 	Emails     []string
 	EmailCodes []string
+	Profile    map[string]string // Attributes of profile equal to values
+}
+
+type SearchUsersViewQuery struct {
+	Query      string
+	Id         []uuid.UUID // Only views of these users, empty list means any user
+	Pagination *models.PaginationRequest
 }
 
 type CreateOrUpdateUsersViewStoreQuery struct {
@@ -28,12 +37,13 @@ type CreateOrUpdateUsersViewStoreQuery struct {
 }
 
 type getUsersViewRepositoryQuery struct {
-	Limit  int
-	Offset int
-	Id     string
-	Roles  string
-	Emails string
-	Phones string
+	Limit   int
+	Offset  int
+	Id      string
+	Roles   string
+	Emails  string
+	Phones  string
+	Profile string
 }
 
 type createOrUpdateUsersViewRepositoryQuery struct {
@@ -46,64 +56,196 @@ type createOrUpdateUsersViewRepositoryQuery struct {
 
 func getUsersViewSQL() string {
 	return `
-		SELECT id, created, roles, phones, emails, role_id, count(*) OVER() AS full_count
+		SELECT id, created, roles, phones, emails, role_id, permissions, organizations, version, roles_expires, profile, status, suspended_until, deletion_scheduled, primary_email, primary_phone,
+		       count(*) OVER() AS full_count
 		FROM users_view u
 		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[])) AND 
 		      (array_length($2::uuid[], 1) IS NULL OR ($2::uuid[]) && role_id) AND
 		      (array_length($3::text[], 1) IS NULL OR ($3::text[]) && phones) AND
-		      (array_length($4::text[], 1) IS NULL OR ($4::text[]) && emails)
+		      (array_length($4::text[], 1) IS NULL OR ($4::text[]) && emails) AND
+		      NOT EXISTS (SELECT 1
+		                  FROM jsonb_each_text($8::jsonb) f
+		                  WHERE u.profile ->> f.key IS DISTINCT FROM f.value) AND
+		      realm = $7
 		ORDER BY created
 		LIMIT $5
 		OFFSET $6;
 		`
 }
 
+// Views containing query as substring or as words are found, views with email or phone starting with query
+// go first and the rest are ordered by relevance. $1 is query escaped for LIKE patterns
+func searchUsersViewSQL() string {
+	return `
+		SELECT id, created, roles, phones, emails, role_id, permissions, organizations, version, roles_expires, profile, status, suspended_until, deletion_scheduled, primary_email, primary_phone,
+		       count(*) OVER() AS full_count
+		FROM users_view u
+		WHERE (search ILIKE '%' || $1 || '%' OR to_tsvector('simple', search) @@ plainto_tsquery('simple', $2)) AND
+		      (array_length($6::uuid[], 1) IS NULL OR id = ANY ($6::uuid[])) AND
+		      realm = $5
+		ORDER BY EXISTS (SELECT 1 FROM unnest(emails || phones) v WHERE v ILIKE $1 || '%') DESC,
+		         ts_rank(to_tsvector('simple', search), plainto_tsquery('simple', $2)) DESC,
+		         similarity(search, $2) DESC,
+		         created
+		LIMIT $3
+		OFFSET $4;
+		`
+}
+
 func updateUsersViewSQL() string {
 
 	// TODO https://habr.com/ru/post/481610/ оптимизировать в соответствии со статье по ссылке
 
+	// Effective roles of user are assigned roles together with all their ancestors in role hierarchy,
+	// UNION guarantees termination even if hierarchy contains a cycle. Roles granted within organization
+	// are kept apart from global ones and collected into organizations memberships. Roles granted to groups
+	// are global roles of their members. Only assignments active at the moment are taken into account,
+	// roles_expires is the moment when the first of them expires. Search text joins contacts and profile attributes.
+	// Hierarchy is expanded only for updated users of realm, users updated by roles are those having any
+	// of the roles or their descendants
+
 	return `
+		WITH RECURSIVE descendant_roles(role_id) AS (
+			SELECT unnest($2::uuid[])
+			UNION
+			SELECT rp.role_id
+			FROM descendant_roles dr
+					 JOIN role_parents rp ON rp.parent_id = dr.role_id
+		),
+		role_users(user_id) AS (
+			SELECT ur.user_id
+			FROM user_roles ur
+			WHERE ur.realm = $3 AND ur.role_id IN (SELECT role_id FROM descendant_roles)
+			UNION
+			SELECT gm.user_id
+			FROM group_members gm
+					 JOIN group_roles gr ON gr.group_id = gm.group_id
+			WHERE gm.realm = $3 AND gr.role_id IN (SELECT role_id FROM descendant_roles)
+		),
+		effective_roles(user_id, role_id, organization_id) AS (
+			SELECT ur.user_id, ur.role_id, ur.organization_id
+			FROM user_roles ur
+			WHERE ur.realm = $3
+			  AND (array_length($1::uuid[], 1) IS NULL OR ur.user_id = ANY ($1::uuid[]))
+			  AND (array_length($2::uuid[], 1) IS NULL OR ur.user_id IN (SELECT user_id FROM role_users))
+			  AND (ur.starts_at IS NULL OR ur.starts_at <= extract(epoch from now()) * 1000)
+			  AND (ur.expires_at IS NULL OR ur.expires_at > extract(epoch from now()) * 1000)
+			UNION
+			SELECT gm.user_id, gr.role_id, NULL::uuid
+			FROM group_members gm
+					 JOIN group_roles gr ON gr.group_id = gm.group_id
+			WHERE gm.realm = $3
+			  AND (array_length($1::uuid[], 1) IS NULL OR gm.user_id = ANY ($1::uuid[]))
+			  AND (array_length($2::uuid[], 1) IS NULL OR gm.user_id IN (SELECT user_id FROM role_users))
+			UNION
+			SELECT er.user_id, rp.parent_id, er.organization_id
+			FROM effective_roles er
+					 JOIN role_parents rp ON rp.role_id = er.role_id
+		)
 		INSERT
-		INTO users_view(id, created, roles, phones, emails, role_id)
-		SELECT nuv.id, nuv.created, nuv.roles, nuv.phones, nuv.emails, nuv.role_id
+		INTO users_view(id, created, roles, phones, emails, role_id, permissions, organizations, roles_expires, profile, search, status, suspended_until, deletion_scheduled, primary_email, primary_phone, realm)
+		SELECT nuv.id, nuv.created, nuv.roles, nuv.phones, nuv.emails, nuv.role_id, nuv.permissions, nuv.organizations, nuv.roles_expires, nuv.profile, nuv.search, nuv.status, nuv.suspended_until, nuv.deletion_scheduled, nuv.primary_email, nuv.primary_phone, nuv.realm
 		FROM users_view as cuv
 				 FULL OUTER JOIN (SELECT u.id,
 										 u.created,
+										 u.realm,
+										 u.profile,
+										 u.status,
+										 u.suspended_until,
+										 u.deletion_scheduled,
 										 array_remove(array_agg(DISTINCT r.title), NULL)::text[]          as roles,
-										 array_remove(array_agg(p.value), NULL)::text[]                   as phones,
+										 array_remove(array_agg(DISTINCT p.value), NULL)::text[]          as phones,
 										 array_remove(array_agg(DISTINCT e.value), NULL)::text[]          as emails,
-										 array_remove(array_agg(DISTINCT r.id), NULL)                     as role_id
+										 COALESCE((SELECT pe.value FROM emails pe WHERE pe.user_id = u.id AND pe.is_primary), '') as primary_email,
+										 COALESCE((SELECT pp.value FROM phones pp WHERE pp.user_id = u.id AND pp.is_primary), '') as primary_phone,
+										 array_remove(array_agg(DISTINCT r.id), NULL)                     as role_id,
+										 array_remove(array_agg(DISTINCT rp.permission), NULL)::text[]    as permissions,
+										 concat_ws(' ',
+												   array_to_string(array_remove(array_agg(DISTINCT e.value), NULL), ' '),
+												   array_to_string(array_remove(array_agg(DISTINCT p.value), NULL), ' '),
+												   (SELECT string_agg(pa.value, ' ' ORDER BY pa.key)
+													FROM jsonb_each_text(u.profile) pa))  as search,
+										 COALESCE((SELECT jsonb_agg(jsonb_build_object(
+																 'id', om.organization_id,
+																 'roles', ARRAY(SELECT DISTINCT orl.title
+																				FROM effective_roles oer
+																						 JOIN roles orl ON oer.role_id = orl.id
+																				WHERE oer.user_id = om.user_id
+																				  AND oer.organization_id = om.organization_id
+																				ORDER BY orl.title),
+																 'permissions', ARRAY(SELECT DISTINCT orp.permission
+																					  FROM effective_roles oer
+																							   JOIN roles orl ON oer.role_id = orl.id
+																							   JOIN LATERAL unnest(orl.permissions) AS orp(permission) ON true
+																					  WHERE oer.user_id = om.user_id
+																						AND oer.organization_id = om.organization_id
+																					  ORDER BY orp.permission)
+															 ) ORDER BY om.created)
+												   FROM organization_members om
+												   WHERE om.user_id = u.id), '[]'::jsonb)          as organizations,
+										 COALESCE((SELECT min(ur.expires_at)
+												   FROM user_roles ur
+												   WHERE ur.user_id = u.id
+													 AND ur.expires_at > extract(epoch from now()) * 1000
+													 AND (ur.starts_at IS NULL OR ur.starts_at <= extract(epoch from now()) * 1000)), 0) as roles_expires
 								  FROM users u
 										   LEFT JOIN emails e on u.id = e.user_id
 										   LEFT JOIN phones p on u.id = p.user_id
-										   LEFT JOIN user_roles ur on u.id = ur.user_id
-										   LEFT JOIN roles r on ur.role_id = r.id
-								  WHERE (array_length($1::uuid[], 1) IS NULL OR u.id = ANY ($1::uuid[]))
-									AND (array_length($2::uuid[], 1) IS NULL OR r.id = ANY ($2::uuid[]))
-								  GROUP BY u.id, ur.created, r.created, p.created, e.created) as nuv
+										   LEFT JOIN effective_roles er on u.id = er.user_id AND er.organization_id IS NULL
+										   LEFT JOIN roles r on er.role_id = r.id
+										   LEFT JOIN LATERAL unnest(r.permissions) AS rp(permission) ON true
+								  WHERE u.realm = $3
+									AND (array_length($1::uuid[], 1) IS NULL OR u.id = ANY ($1::uuid[]))
+									AND (array_length($2::uuid[], 1) IS NULL OR u.id IN (SELECT user_id
+																						 FROM effective_roles
+																						 WHERE role_id = ANY ($2::uuid[])))
+								  GROUP BY u.id, u.created, u.realm, u.profile, u.status, u.suspended_until, u.deletion_scheduled) as nuv
 								 ON nuv.id = cuv.id AND
 									nuv.created = cuv.created AND
 									nuv.phones = cuv.phones AND
 									nuv.roles = cuv.roles AND
 									nuv.emails = cuv.emails AND
-									nuv.role_id = cuv.role_id
+									nuv.role_id = cuv.role_id AND
+									nuv.permissions = cuv.permissions AND
+									nuv.organizations = cuv.organizations AND
+									nuv.roles_expires = cuv.roles_expires AND
+									nuv.profile = cuv.profile AND
+									nuv.search = cuv.search AND
+									nuv.status = cuv.status AND
+									nuv.suspended_until = cuv.suspended_until AND
+									nuv.deletion_scheduled = cuv.deletion_scheduled AND
+									nuv.primary_email = cuv.primary_email AND
+									nuv.primary_phone = cuv.primary_phone
 		WHERE cuv.id IS NULL
 		ORDER BY created
 		ON CONFLICT (id) DO UPDATE SET created=excluded.created,
 									   phones=excluded.phones,
 									   roles=excluded.roles,
 									   emails=excluded.emails,
-									   role_id=excluded.role_id
-		RETURNING id, created, roles, phones, emails, role_id, 0;
+									   role_id=excluded.role_id,
+									   permissions=excluded.permissions,
+									   organizations=excluded.organizations,
+									   roles_expires=excluded.roles_expires,
+									   profile=excluded.profile,
+									   search=excluded.search,
+									   status=excluded.status,
+									   suspended_until=excluded.suspended_until,
+									   deletion_scheduled=excluded.deletion_scheduled,
+									   primary_email=excluded.primary_email,
+									   primary_phone=excluded.primary_phone,
+									   version=users_view.version + 1
+		RETURNING id, created, roles, phones, emails, role_id, permissions, organizations, version, roles_expires, profile, status, suspended_until, deletion_scheduled, primary_email, primary_phone, 0;
     `
 }
 
 func scanUserView(row pgx.Row) (*models.UserView, int64) {
 	userView := &models.UserView{}
 	var bytes [][]byte
+	var organizations []byte
+	var profile []byte
 	var count int64
 
-	err := row.Scan(&userView.Id, &userView.Created, &userView.Roles, &userView.Phones, &userView.Emails, &bytes, &count)
+	err := row.Scan(&userView.Id, &userView.Created, &userView.Roles, &userView.Phones, &userView.Emails, &bytes, &userView.Permissions, &organizations, &userView.Version, &userView.RolesExpires, &profile, &userView.Status, &userView.SuspendedUntil, &userView.DeletionScheduled, &userView.PrimaryEmail, &userView.PrimaryPhone, &count)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil, count
@@ -111,6 +253,18 @@ func scanUserView(row pgx.Row) (*models.UserView, int64) {
 
 	userView.RolesID = functools.ByteArraySliceToUUIDSlice(bytes)
 
+	err = json.Unmarshal(organizations, &userView.Organizations)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, count
+	}
+
+	err = json.Unmarshal(profile, &userView.Profile)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, count
+	}
+
 	return userView, count
 }
 
@@ -153,15 +307,30 @@ func convertGetUsersViewQueryToRaw(query GetUsersViewStoreQuery) getUsersViewRep
 	limit, offset := functools.LimitPageToLimitOffset(limit, query.Page)
 
 	return getUsersViewRepositoryQuery{
-		Limit:  limit,
-		Offset: offset,
-		Id:     functools.UUIDListToPGArray(query.Id),
-		Roles:  functools.UUIDListToPGArray(query.Roles),
-		Phones: functools.StringsToPGArray(query.Phones),
-		Emails: functools.StringsToPGArray(query.Emails),
+		Limit:   limit,
+		Offset:  offset,
+		Id:      functools.UUIDListToPGArray(query.Id),
+		Roles:   functools.UUIDListToPGArray(query.Roles),
+		Phones:  functools.StringsToPGArray(query.Phones),
+		Emails:  functools.StringsToPGArray(query.Emails),
+		Profile: profileFilterToJSON(query.Profile),
 	}
 }
 
+func profileFilterToJSON(filter map[string]string) string {
+	if len(filter) == 0 {
+		return "{}"
+	}
+
+	value, err := json.Marshal(filter)
+	if err != nil {
+		sentry.CaptureException(err)
+		return "{}"
+	}
+
+	return string(value)
+}
+
 func convertCreateOrUpdateUsersViewQueryToRaw(query CreateOrUpdateUsersViewStoreQuery) createOrUpdateUsersViewRepositoryQuery {
 
 	limit := query.Limit
@@ -182,7 +351,7 @@ func GetUsersView(db DB, context context.Context, query GetUsersViewStoreQuery)
 	sql := getUsersViewSQL()
 	rawQuery := convertGetUsersViewQueryToRaw(query)
 
-	rows, err := db.Query(context, sql, rawQuery.Id, rawQuery.Roles, rawQuery.Phones, rawQuery.Emails, rawQuery.Limit, rawQuery.Offset)
+	rows, err := db.Query(context, sql, rawQuery.Id, rawQuery.Roles, rawQuery.Phones, rawQuery.Emails, rawQuery.Limit, rawQuery.Offset, GetRealmFromContext(context).Name, rawQuery.Profile)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil, nil
@@ -197,9 +366,33 @@ func GetUsersView(db DB, context context.Context, query GetUsersViewStoreQuery)
 	}
 }
 
+// escapeLikePattern escapes wildcards of LIKE patterns, so they are matched literally
+func escapeLikePattern(value string) string {
+	return strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`).Replace(value)
+}
+
+// SearchUsersView returns views matching query ordered by relevance
+func SearchUsersView(db DB, ctx context.Context, query SearchUsersViewQuery) ([]*models.UserView, *models.PaginationResponse) {
+	limit, offset := functools.LimitPageToLimitOffset(query.Pagination.Limit, query.Pagination.Page)
+	rows, err := db.Query(ctx, searchUsersViewSQL(), escapeLikePattern(query.Query), query.Query, limit, offset, GetRealmFromContext(ctx).Name,
+		functools.UUIDListToPGArray(query.Id))
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil, nil
+	}
+
+	userViews, totalCount := scanUsersView(rows, limit)
+
+	return userViews, &models.PaginationResponse{
+		HasNext:     functools.HasNext(totalCount, query.Pagination.Limit, query.Pagination.Page),
+		HasPrevious: functools.HasPrevious(query.Pagination.Page),
+		Count:       totalCount,
+	}
+}
+
 func GetUserView(db DB, context context.Context, id uuid.UUID) *models.UserView {
 	sql := getUsersViewSQL()
-	row := db.QueryRow(context, sql, functools.UUIDListToPGArray([]uuid.UUID{id}), "{}", "{}", "{}", 1)
+	row := db.QueryRow(context, sql, functools.UUIDListToPGArray([]uuid.UUID{id}), "{}", "{}", "{}", 1, 0, GetRealmFromContext(context).Name, "{}")
 	userView, _ := scanUserView(row)
 	return userView
 }
@@ -207,7 +400,7 @@ func GetUserView(db DB, context context.Context, id uuid.UUID) *models.UserView
 func CreateOrUpdateUsersView(db DB, context context.Context, query CreateOrUpdateUsersViewStoreQuery) []*models.UserView {
 	sql := updateUsersViewSQL()
 	rawQuery := convertCreateOrUpdateUsersViewQueryToRaw(query)
-	rows, err := db.Query(context, sql, rawQuery.Id, rawQuery.Roles)
+	rows, err := db.Query(context, sql, rawQuery.Id, rawQuery.Roles, GetRealmFromContext(context).Name)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil
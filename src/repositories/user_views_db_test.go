@@ -4,6 +4,7 @@ import (
 	"hive/config"
 	"hive/enums"
 	"hive/functools"
+	"hive/models"
 	"context"
 	uuid "github.com/satori/go.uuid"
 	"github.com/stretchr/testify/require"
@@ -73,6 +74,25 @@ func TestCreateOrUpdateUsersViewWithTheSamePhone(t *testing.T) {
 	require.Len(t, views[1].Phones, 1)
 }
 
+func TestCreateOrUpdateUsersViewWithInheritedRoles(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUserViews(pool, ctx)
+	PurgeUsers(pool, ctx)
+	PurgeRoles(pool, ctx)
+	_, parent := CreateRole(pool, ctx, "manager", []string{"users:read"}, nil)
+	_, role := CreateRole(pool, ctx, "admin", []string{"users:delete"}, []uuid.UUID{parent.Id})
+	user := CreateUser(pool, ctx)
+	status, _ := CreateUserRole(pool, ctx, user.Id, role.Id, uuid.Nil, 0, 0)
+	require.Equal(t, enums.Ok, status)
+
+	views := CreateOrUpdateUsersView(pool, ctx, CreateOrUpdateUsersViewStoreQuery{Roles: []uuid.UUID{parent.Id}})
+	require.Len(t, views, 1)
+	require.ElementsMatch(t, []string{"admin", "manager"}, views[0].Roles)
+	require.ElementsMatch(t, []uuid.UUID{role.Id, parent.Id}, views[0].RolesID)
+	require.ElementsMatch(t, []string{"users:read", "users:delete"}, views[0].Permissions)
+}
+
 func TestDatabaseStore_GetUsersViewPagination(t *testing.T) {
 	pool := config.InitPool(nil, config.InitEnvironment())
 	ctx := context.Background()
@@ -187,3 +207,49 @@ func TestGetUsersViewIndexUsageFilteringBy(t *testing.T) {
 		require.NotContains(t, v, "Seq Scan")
 	}
 }
+
+func TestGetUsersViewFilteringByProfile(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUsers(pool, ctx)
+	PurgeUserViews(pool, ctx)
+	user := CreateUser(pool, ctx)
+	CreateUser(pool, ctx)
+	status, _ := UpdateUserProfile(pool, ctx, user.Id, map[string]interface{}{"locale": "ru", "age": 30})
+	require.Equal(t, enums.Ok, status)
+	CreateOrUpdateUsersView(pool, ctx, CreateOrUpdateUsersViewStoreQuery{})
+
+	views, _ := GetUsersView(pool, ctx, GetUsersViewStoreQuery{Limit: 10, Page: 1, Profile: map[string]string{"locale": "ru", "age": "30"}})
+	require.Len(t, views, 1)
+	require.Equal(t, user.Id, views[0].Id)
+	require.Equal(t, "ru", views[0].Profile["locale"])
+
+	views, _ = GetUsersView(pool, ctx, GetUsersViewStoreQuery{Limit: 10, Page: 1, Profile: map[string]string{"locale": "en"}})
+	require.Len(t, views, 0)
+}
+
+func TestEscapeLikePattern(t *testing.T) {
+	t.Parallel()
+	require.Equal(t, `100\%\_off\\`, escapeLikePattern(`100%_off\`))
+}
+
+func TestSearchUsersView(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUsers(pool, ctx)
+	PurgeUserViews(pool, ctx)
+	ivan := CreateUser(pool, ctx)
+	petr := CreateUser(pool, ctx)
+	CreateEmail(pool, ctx, ivan.Id, "ivan@mail.com")
+	CreateEmail(pool, ctx, petr.Id, "petr@mail.com")
+	UpdateUserProfile(pool, ctx, petr.Id, map[string]interface{}{"name": "Petr Ivanov"})
+	CreateOrUpdateUsersView(pool, ctx, CreateOrUpdateUsersViewStoreQuery{})
+
+	views, pagination := SearchUsersView(pool, ctx, SearchUsersViewQuery{Query: "ivan", Pagination: &models.PaginationRequest{Limit: 10, Page: 1}})
+	require.Len(t, views, 2)
+	require.Equal(t, int64(2), pagination.Count)
+	require.Equal(t, ivan.Id, views[0].Id)
+
+	views, _ = SearchUsersView(pool, ctx, SearchUsersViewQuery{Query: "%", Pagination: &models.PaginationRequest{Limit: 10, Page: 1}})
+	require.Len(t, views, 0)
+}
@@ -5,38 +5,77 @@ import (
 	"hive/functools"
 	"hive/models"
 	"context"
+	"encoding/json"
 	"github.com/getsentry/sentry-go"
 	"github.com/jackc/pgx/v4"
 	uuid "github.com/satori/go.uuid"
 	"math"
+	"strings"
+	"time"
 )
 
 func deleteUserSQL() string {
-	return "DELETE FROM users WHERE id = $1 RETURNING id, created"
+	return "DELETE FROM users WHERE id = $1 AND realm = $2 RETURNING id, created, profile, status, suspended_until, status_reason, status_actor_id, status_changed, deletion_scheduled"
 }
 
 func createUserSQL() string {
-	return "INSERT INTO users (id, created) VALUES ($1, default) RETURNING id, created;"
+	return "INSERT INTO users (id, created, realm) VALUES ($1, default, $2) RETURNING id, created, profile, status, suspended_until, status_reason, status_actor_id, status_changed, deletion_scheduled;"
+}
+
+func updateUserProfileSQL() string {
+	return "UPDATE users SET profile = $2::jsonb WHERE id = $1 AND realm = $3 AND status <> 'deleted' RETURNING id, created, profile, status, suspended_until, status_reason, status_actor_id, status_changed, deletion_scheduled;"
+}
+
+func updateUserStatusSQL() string {
+	return `
+		UPDATE users
+		SET status = $2, suspended_until = $3, status_reason = $4, status_actor_id = $5, status_changed = $6
+		WHERE id = $1 AND realm = $7 AND status <> 'deleted'
+		RETURNING id, created, profile, status, suspended_until, status_reason, status_actor_id, status_changed, deletion_scheduled;
+		`
 }
 
 func getUsersSQL() string {
 	return `
-		SELECT id, created
+		SELECT id, created, profile, status, suspended_until, status_reason, status_actor_id, status_changed, deletion_scheduled
 		FROM users
-		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[]))
+		WHERE (array_length($1::uuid[], 1) IS NULL OR id = ANY ($1::uuid[])) AND
+		      realm = $3
 		LIMIT $2;
 		`
 }
 
+// Contact changes of user lock the user row, so that concurrent removals can't leave user without contacts
+func lockUserSQL() string {
+	return "SELECT id FROM users WHERE id = $1 AND realm = $2 FOR UPDATE;"
+}
+
+// Emails and phones are login identifiers of user
+func countUserLoginIdentifiersSQL() string {
+	return `
+		SELECT (SELECT count(*) FROM emails WHERE user_id = $1 AND realm = $2) +
+		       (SELECT count(*) FROM phones WHERE user_id = $1 AND realm = $2);
+		`
+}
+
 func scanUser(row pgx.Row) *models.User {
 	user := &models.User{}
+	var profile []byte
+	var statusActorID uuid.NullUUID
+
+	err := row.Scan(&user.Id, &user.Created, &profile, &user.Status, &user.SuspendedUntil, &user.StatusReason, &statusActorID, &user.StatusChanged, &user.DeletionScheduled)
+	if err != nil {
+		sentry.CaptureException(err)
+		return nil
+	}
 
-	err := row.Scan(&user.Id, &user.Created)
+	err = json.Unmarshal(profile, &user.Profile)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil
 	}
 
+	user.StatusActorId = statusActorID.UUID
 	return user
 }
 
@@ -83,13 +122,13 @@ func convertGetUsersQueryToRaw(query GetUsersQuery) getUsersRawQuery {
 
 func CreateUser(db DB, ctx context.Context) *models.User {
 	sql := createUserSQL()
-	row := db.QueryRow(ctx, sql, uuid.NewV4())
+	row := db.QueryRow(ctx, sql, uuid.NewV4(), GetRealmFromContext(ctx).Name)
 	return scanUser(row)
 }
 
 func GetUser(db DB, context context.Context, id uuid.UUID) *models.User {
 	sql := getUsersSQL()
-	row := db.QueryRow(context, sql, functools.UUIDListToPGArray([]uuid.UUID{id}), 1)
+	row := db.QueryRow(context, sql, functools.UUIDListToPGArray([]uuid.UUID{id}), 1, GetRealmFromContext(context).Name)
 	return scanUser(row)
 }
 
@@ -97,7 +136,7 @@ func GetUsers(db DB, context context.Context, query GetUsersQuery) []*models.Use
 
 	sql := getUsersSQL()
 	rawQuery := convertGetUsersQueryToRaw(query)
-	rows, err := db.Query(context, sql, rawQuery.Id, rawQuery.Limit)
+	rows, err := db.Query(context, sql, rawQuery.Id, rawQuery.Limit, GetRealmFromContext(context).Name)
 	if err != nil {
 		sentry.CaptureException(err)
 		return nil
@@ -108,7 +147,7 @@ func GetUsers(db DB, context context.Context, query GetUsersQuery) []*models.Use
 
 func DeleteUser(db DB, ctx context.Context, id uuid.UUID) (int, *models.User) {
 	sql := deleteUserSQL()
-	row := db.QueryRow(ctx, sql, id)
+	row := db.QueryRow(ctx, sql, id, GetRealmFromContext(ctx).Name)
 	deletedUser := scanUser(row)
 	if deletedUser == nil {
 		return enums.UserNotFound, nil
@@ -116,3 +155,57 @@ func DeleteUser(db DB, ctx context.Context, id uuid.UUID) (int, *models.User) {
 		return enums.Ok, deletedUser
 	}
 }
+
+// UpdateUserProfile replaces profile attributes of user
+func UpdateUserProfile(db DB, ctx context.Context, id uuid.UUID, profile map[string]interface{}) (int, *models.User) {
+	value, err := json.Marshal(profile)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	row := db.QueryRow(ctx, updateUserProfileSQL(), id, string(value), GetRealmFromContext(ctx).Name)
+	user := scanUser(row)
+	if user == nil {
+		return enums.UserNotFound, nil
+	}
+
+	return enums.Ok, user
+}
+
+// UpdateUserStatus sets status of user together with reason and user who changed it
+func UpdateUserStatus(db DB, ctx context.Context, id uuid.UUID, status string, suspendedUntil int64, reason string, actorID uuid.UUID) (int, *models.User) {
+	row := db.QueryRow(ctx, updateUserStatusSQL(), id, status, suspendedUntil, reason,
+		uuid.NullUUID{UUID: actorID, Valid: actorID != uuid.Nil}, time.Now().UnixNano()/int64(time.Millisecond), GetRealmFromContext(ctx).Name)
+	user := scanUser(row)
+	if user == nil {
+		return enums.UserNotFound, nil
+	}
+
+	return enums.Ok, user
+}
+
+// LockUser locks user row until the end of transaction db
+func LockUser(db DB, ctx context.Context, id uuid.UUID) int {
+	var lockedID uuid.UUID
+	err := db.QueryRow(ctx, lockUserSQL(), id, GetRealmFromContext(ctx).Name).Scan(&lockedID)
+	if err != nil && strings.Contains(err.Error(), "no rows") {
+		return enums.UserNotFound
+	} else if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk
+	}
+
+	return enums.Ok
+}
+
+func CountUserLoginIdentifiers(db DB, ctx context.Context, id uuid.UUID) (int, int64) {
+	var count int64
+	err := db.QueryRow(ctx, countUserLoginIdentifiersSQL(), id, GetRealmFromContext(ctx).Name).Scan(&count)
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, 0
+	}
+
+	return enums.Ok, count
+}
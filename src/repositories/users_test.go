@@ -89,7 +89,6 @@ func TestGetUser(t *testing.T) {
 	require.Equal(t, user, userFromDB)
 }
 
-
 func TestGetUserWithoutUser(t *testing.T) {
 	pool := config.InitPool(nil, config.InitEnvironment())
 	ctx := context.Background()
@@ -117,6 +116,29 @@ func TestDeleteUserWithoutUser(t *testing.T) {
 	require.Nil(t, deletedUser)
 }
 
+func TestUpdateUserStatus(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUsers(pool, ctx)
+	PurgeUserViews(pool, ctx)
+	user := CreateUser(pool, ctx)
+	require.Equal(t, enums.UserActive, user.Status)
+
+	actorID := uuid.NewV4()
+	status, suspendedUser := UpdateUserStatus(pool, ctx, user.Id, enums.UserSuspended, 1000, "spam", actorID)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, enums.UserSuspended, suspendedUser.Status)
+	require.Equal(t, int64(1000), suspendedUser.SuspendedUntil)
+	require.Equal(t, actorID, suspendedUser.StatusActorId)
+
+	views := CreateOrUpdateUsersView(pool, ctx, CreateOrUpdateUsersViewStoreQuery{Id: []uuid.UUID{user.Id}})
+	require.Len(t, views, 1)
+	require.Equal(t, enums.UserSuspended, views[0].Status)
+	require.Equal(t, int64(1000), views[0].SuspendedUntil)
+
+	status, _ = UpdateUserStatus(pool, ctx, uuid.NewV4(), enums.UserBlocked, 0, "", actorID)
+	require.Equal(t, enums.UserNotFound, status)
+}
 
 func BenchmarkCreateUser(b *testing.B) {
 	pool := config.InitPool(nil, config.InitEnvironment())
@@ -130,3 +152,35 @@ func BenchmarkCreateUser(b *testing.B) {
 
 	_ = tx.Commit(ctx)
 }
+
+func TestAnonymizeUser(t *testing.T) {
+	pool := config.InitPool(nil, config.InitEnvironment())
+	ctx := context.Background()
+	PurgeUsers(pool, ctx)
+	PurgeEmails(pool, ctx)
+	user := CreateUser(pool, ctx)
+	CreateEmail(pool, ctx, user.Id, "deleted@example.com")
+
+	status, _ := AnonymizeUser(pool, ctx, user.Id, 1000)
+	require.Equal(t, enums.UserDeletionNotScheduled, status)
+
+	status, scheduled := ScheduleUserDeletion(pool, ctx, user.Id, 1000)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, int64(1000), scheduled.DeletionScheduled)
+
+	status, scheduled = ScheduleUserDeletion(pool, ctx, user.Id, 2000)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, int64(1000), scheduled.DeletionScheduled)
+
+	require.Equal(t, []uuid.UUID{user.Id}, GetDueUserDeletions(pool, ctx, 1000, 10))
+	require.Empty(t, GetDueUserDeletions(pool, ctx, 999, 10))
+
+	status, anonymized := AnonymizeUser(pool, ctx, user.Id, 1000)
+	require.Equal(t, enums.Ok, status)
+	require.Equal(t, enums.UserDeleted, anonymized.Status)
+	require.Empty(t, anonymized.Profile)
+	require.Empty(t, GetUserEmails(pool, ctx, user.Id))
+
+	status, _ = CancelUserDeletion(pool, ctx, user.Id)
+	require.Equal(t, enums.UserDeletionNotScheduled, status)
+}
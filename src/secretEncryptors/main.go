@@ -0,0 +1,112 @@
+package secretEncryptors
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/getsentry/sentry-go"
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/log"
+	"io"
+)
+
+type ISecretEncryptor interface {
+	Encrypt(ctx context.Context, value []byte, additionalData []byte) (string, []byte)
+	Decrypt(ctx context.Context, keyID string, value []byte, additionalData []byte) []byte
+	GetKeyID() string
+}
+
+type AESGCMSecretEncryptor struct {
+	keyID string
+	aead  map[string]cipher.AEAD
+}
+
+func getKeyID(key []byte) string {
+	hash := sha256.Sum256(key)
+	return hex.EncodeToString(hash[:8])
+}
+
+func newAEAD(key []byte) cipher.AEAD {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		panic(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+
+	return aead
+}
+
+// InitAESGCMSecretEncryptor creates encryptor which encrypts with current key and decrypts with current or any of previous keys
+func InitAESGCMSecretEncryptor(key []byte, previousKeys [][]byte) *AESGCMSecretEncryptor {
+	encryptor := &AESGCMSecretEncryptor{
+		keyID: getKeyID(key),
+		aead:  map[string]cipher.AEAD{},
+	}
+
+	for _, previousKey := range previousKeys {
+		encryptor.aead[getKeyID(previousKey)] = newAEAD(previousKey)
+	}
+
+	encryptor.aead[encryptor.keyID] = newAEAD(key)
+	return encryptor
+}
+
+func (encryptor *AESGCMSecretEncryptor) GetKeyID() string {
+	return encryptor.keyID
+}
+
+func (encryptor *AESGCMSecretEncryptor) Encrypt(ctx context.Context, value []byte, additionalData []byte) (string, []byte) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Secret encryption")
+	defer span.Finish()
+
+	aead := encryptor.aead[encryptor.keyID]
+	nonce := make([]byte, aead.NonceSize())
+
+	_, err := io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		sentry.CaptureException(err)
+		return "", nil
+	}
+
+	return encryptor.keyID, aead.Seal(nonce, nonce, value, additionalData)
+}
+
+func (encryptor *AESGCMSecretEncryptor) Decrypt(ctx context.Context, keyID string, value []byte, additionalData []byte) []byte {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "Secret decryption")
+	defer span.Finish()
+
+	aead := encryptor.aead[keyID]
+	if aead == nil {
+		err := errors.New(fmt.Sprintf("master key %s not found", keyID))
+		span.LogFields(log.Error(err))
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	if len(value) < aead.NonceSize() {
+		err := errors.New("encrypted secret is too short")
+		span.LogFields(log.Error(err))
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	nonce, ciphertext := value[:aead.NonceSize()], value[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, additionalData)
+	if err != nil {
+		span.LogFields(log.Error(err))
+		sentry.CaptureException(err)
+		return nil
+	}
+
+	return plaintext
+}
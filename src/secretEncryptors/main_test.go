@@ -0,0 +1,72 @@
+package secretEncryptors
+
+import (
+	"context"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+var (
+	currentKey  = []byte("current-master-key-0123456789abc")
+	previousKey = []byte("previous-master-key-0123456789ab")
+)
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	encryptor := InitAESGCMSecretEncryptor(currentKey, nil)
+
+	keyID, encrypted := encryptor.Encrypt(ctx, []byte("secret"), []byte("id"))
+	require.Equal(t, encryptor.GetKeyID(), keyID)
+	require.NotContains(t, string(encrypted), "secret")
+	require.Equal(t, []byte("secret"), encryptor.Decrypt(ctx, keyID, encrypted, []byte("id")))
+
+	_, again := encryptor.Encrypt(ctx, []byte("secret"), []byte("id"))
+	require.NotEqual(t, encrypted, again)
+}
+
+func TestDecryptWithPreviousKey(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	keyID, encrypted := InitAESGCMSecretEncryptor(previousKey, nil).Encrypt(ctx, []byte("secret"), []byte("id"))
+
+	rotated := InitAESGCMSecretEncryptor(currentKey, [][]byte{previousKey})
+	require.NotEqual(t, keyID, rotated.GetKeyID())
+	require.Equal(t, []byte("secret"), rotated.Decrypt(ctx, keyID, encrypted, []byte("id")))
+
+	withoutPrevious := InitAESGCMSecretEncryptor(currentKey, nil)
+	require.Nil(t, withoutPrevious.Decrypt(ctx, keyID, encrypted, []byte("id")))
+}
+
+func TestDecryptRejectsIncorrectInput(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	encryptor := InitAESGCMSecretEncryptor(currentKey, nil)
+	keyID, encrypted := encryptor.Encrypt(ctx, []byte("secret"), []byte("id"))
+
+	tamper := func(i int) []byte {
+		value := append([]byte{}, encrypted...)
+		value[i] ^= 1
+		return value
+	}
+
+	for name, testCase := range map[string]struct {
+		keyID          string
+		value          []byte
+		additionalData []byte
+	}{
+		"unknown key id":          {"unknown", encrypted, []byte("id")},
+		"tampered nonce":          {keyID, tamper(0), []byte("id")},
+		"tampered ciphertext":     {keyID, tamper(len(encrypted) - 1), []byte("id")},
+		"another additional data": {keyID, encrypted, []byte("another")},
+		"shorter than nonce":      {keyID, encrypted[:4], []byte("id")},
+	} {
+		require.Nil(t, encryptor.Decrypt(ctx, testCase.keyID, testCase.value, testCase.additionalData), name)
+	}
+}
+
+func TestInitWithIncorrectKeyLength(t *testing.T) {
+	t.Parallel()
+	require.Panics(t, func() { InitAESGCMSecretEncryptor([]byte("short"), nil) })
+	require.Panics(t, func() { InitAESGCMSecretEncryptor(currentKey, [][]byte{[]byte("short")}) })
+}
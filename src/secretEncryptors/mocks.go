@@ -0,0 +1,77 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: main.go
+
+// Package secretEncryptors is a generated GoMock package.
+package secretEncryptors
+
+import (
+	context "context"
+	gomock "github.com/golang/mock/gomock"
+	reflect "reflect"
+)
+
+// MockISecretEncryptor is a mock of ISecretEncryptor interface
+type MockISecretEncryptor struct {
+	ctrl     *gomock.Controller
+	recorder *MockISecretEncryptorMockRecorder
+}
+
+// MockISecretEncryptorMockRecorder is the mock recorder for MockISecretEncryptor
+type MockISecretEncryptorMockRecorder struct {
+	mock *MockISecretEncryptor
+}
+
+// NewMockISecretEncryptor creates a new mock instance
+func NewMockISecretEncryptor(ctrl *gomock.Controller) *MockISecretEncryptor {
+	mock := &MockISecretEncryptor{ctrl: ctrl}
+	mock.recorder = &MockISecretEncryptorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockISecretEncryptor) EXPECT() *MockISecretEncryptorMockRecorder {
+	return m.recorder
+}
+
+// Encrypt mocks base method
+func (m *MockISecretEncryptor) Encrypt(ctx context.Context, value, additionalData []byte) (string, []byte) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, value, additionalData)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].([]byte)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt
+func (mr *MockISecretEncryptorMockRecorder) Encrypt(ctx, value, additionalData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockISecretEncryptor)(nil).Encrypt), ctx, value, additionalData)
+}
+
+// Decrypt mocks base method
+func (m *MockISecretEncryptor) Decrypt(ctx context.Context, keyID string, value, additionalData []byte) []byte {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, keyID, value, additionalData)
+	ret0, _ := ret[0].([]byte)
+	return ret0
+}
+
+// Decrypt indicates an expected call of Decrypt
+func (mr *MockISecretEncryptorMockRecorder) Decrypt(ctx, keyID, value, additionalData interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockISecretEncryptor)(nil).Decrypt), ctx, keyID, value, additionalData)
+}
+
+// GetKeyID mocks base method
+func (m *MockISecretEncryptor) GetKeyID() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKeyID")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetKeyID indicates an expected call of GetKeyID
+func (mr *MockISecretEncryptorMockRecorder) GetKeyID() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKeyID", reflect.TypeOf((*MockISecretEncryptor)(nil).GetKeyID))
+}
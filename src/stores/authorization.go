@@ -0,0 +1,16 @@
+package stores
+
+import (
+	"hive/models"
+	"hive/repositories"
+	"context"
+	"time"
+)
+
+func (store *DatabaseStore) GetAuthorizationDecisionFromCache(ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck) *models.AuthorizationDecision {
+	return repositories.GetAuthorizationDecisionFromCache(store.cache, ctx, userView, check)
+}
+
+func (store *DatabaseStore) CacheAuthorizationDecision(ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck, decision *models.AuthorizationDecision, duration time.Duration) {
+	repositories.CacheAuthorizationDecision(store.cache, ctx, userView, check, decision, duration)
+}
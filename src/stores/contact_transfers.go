@@ -0,0 +1,200 @@
+package stores
+
+import (
+	"hive/enums"
+	"hive/models"
+	"hive/repositories"
+	"context"
+	"github.com/getsentry/sentry-go"
+	uuid "github.com/satori/go.uuid"
+)
+
+// checkLoginIdentifiers locks user and checks that user keeps a way to log in after losing one email or phone
+func checkLoginIdentifiers(tx repositories.DB, ctx context.Context, userID uuid.UUID) int {
+	status := repositories.LockUser(tx, ctx, userID)
+	if status != enums.Ok {
+		return status
+	}
+
+	status, count := repositories.CountUserLoginIdentifiers(tx, ctx, userID)
+	if status != enums.Ok {
+		return status
+	}
+
+	if count <= 1 {
+		return enums.LastLoginIdentifier
+	}
+
+	return enums.Ok
+}
+
+// transferEmail gives email to user and returns its previous owner, nil if email was free or already belonged to user.
+// Previous owner gets another primary email, the last login identifier is never taken away. db should be transaction
+func transferEmail(tx repositories.DB, ctx context.Context, userID uuid.UUID, value string) (int, *models.Email, uuid.UUID) {
+	status, previous := repositories.GetEmail(tx, ctx, value)
+	if status != enums.Ok {
+		return status, nil, uuid.Nil
+	}
+
+	previousID := uuid.Nil
+	if previous != nil && !uuid.Equal(previous.UserId, userID) {
+		previousID = previous.UserId
+	}
+
+	if previousID != uuid.Nil {
+		status = checkLoginIdentifiers(tx, ctx, previousID)
+		if status != enums.Ok {
+			return status, nil, uuid.Nil
+		}
+	}
+
+	status, email := repositories.CreateEmail(tx, ctx, userID, value)
+	if status != enums.Ok {
+		return status, nil, uuid.Nil
+	}
+
+	if previousID != uuid.Nil {
+		status = repositories.EnsurePrimaryEmail(tx, ctx, previousID)
+		if status != enums.Ok {
+			return status, nil, uuid.Nil
+		}
+	}
+
+	return enums.Ok, email, previousID
+}
+
+// transferPhone gives phone to user and returns its previous owner, nil if phone was free or already belonged to user.
+// Previous owner gets another primary phone, the last login identifier is never taken away. db should be transaction
+func transferPhone(tx repositories.DB, ctx context.Context, userID uuid.UUID, value string) (int, *models.Phone, uuid.UUID) {
+	status, previous := repositories.GetPhone(tx, ctx, value)
+	if status != enums.Ok {
+		return status, nil, uuid.Nil
+	}
+
+	previousID := uuid.Nil
+	if previous != nil && !uuid.Equal(previous.UserId, userID) {
+		previousID = previous.UserId
+	}
+
+	if previousID != uuid.Nil {
+		status = checkLoginIdentifiers(tx, ctx, previousID)
+		if status != enums.Ok {
+			return status, nil, uuid.Nil
+		}
+	}
+
+	status, phone := repositories.CreatePhone(tx, ctx, userID, value)
+	if status != enums.Ok {
+		return status, nil, uuid.Nil
+	}
+
+	if previousID != uuid.Nil {
+		status = repositories.EnsurePrimaryPhone(tx, ctx, previousID)
+		if status != enums.Ok {
+			return status, nil, uuid.Nil
+		}
+	}
+
+	return enums.Ok, phone, previousID
+}
+
+// recordContactTransfer adds completed transfer to ownership history, nothing is recorded for contact without previous owner
+func recordContactTransfer(tx repositories.DB, ctx context.Context, kind string, value string, fromUserID uuid.UUID, toUserID uuid.UUID) (int, *models.ContactTransfer) {
+	if fromUserID == uuid.Nil {
+		return enums.Ok, nil
+	}
+
+	return repositories.CreateContactTransfer(tx, ctx, kind, value, fromUserID, toUserID, enums.ContactTransferCompleted)
+}
+
+// RequestContactTransfer creates pending transfer which previous owner should review, transfer of the last
+// login identifier of previous owner is refused right away
+func (store *DatabaseStore) RequestContactTransfer(ctx context.Context, kind string, value string, fromUserID uuid.UUID, toUserID uuid.UUID) (int, *models.ContactTransfer) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status := checkLoginIdentifiers(tx, ctx, fromUserID)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, transfer := repositories.CreateContactTransfer(tx, ctx, kind, value, fromUserID, toUserID, enums.ContactTransferPending)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, transfer
+}
+
+func (store *DatabaseStore) GetContactTransfer(ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer) {
+	return repositories.GetContactTransfer(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetUserContactTransfers(ctx context.Context, userID uuid.UUID) []*models.ContactTransfer {
+	return repositories.GetUserContactTransfers(store.db, ctx, userID)
+}
+
+// ApproveContactTransfer completes pending transfer and moves contact in one transaction. Transfer is outdated
+// if previous owner lost the contact since transfer was requested
+func (store *DatabaseStore) ApproveContactTransfer(ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, transfer := repositories.ResolveContactTransfer(tx, ctx, id, enums.ContactTransferCompleted)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	previousID := uuid.Nil
+
+	switch transfer.Kind {
+	case enums.EmailContact:
+		status, _, previousID = transferEmail(tx, ctx, transfer.ToUserId, transfer.Value)
+	case enums.PhoneContact:
+		status, _, previousID = transferPhone(tx, ctx, transfer.ToUserId, transfer.Value)
+	}
+
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	if repositories.Rollback(tx, ctx, !uuid.Equal(previousID, transfer.FromUserId)) {
+		return enums.ContactTransferOutdated, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, transfer
+}
+
+func (store *DatabaseStore) RejectContactTransfer(ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer) {
+	return repositories.ResolveContactTransfer(store.db, ctx, id, enums.ContactTransferRejected)
+}
@@ -0,0 +1,34 @@
+package stores
+
+import (
+	"context"
+	"github.com/getsentry/sentry-go"
+	uuid "github.com/satori/go.uuid"
+	"time"
+)
+
+func (store *DatabaseStore) CreateDPoPNonce(ctx context.Context) string {
+	nonce := uuid.NewV4().String()
+	err := store.redisRepository.CreateDPoPNonce(ctx, nonce, time.Second*time.Duration(store.environment.DPoPNonceLifetime))
+	if err != nil {
+		sentry.CaptureException(err)
+		return ""
+	}
+
+	return nonce
+}
+
+func (store *DatabaseStore) GetDPoPNonceExistence(ctx context.Context, nonce string) bool {
+	return store.redisRepository.GetDPoPNonceExistence(ctx, nonce)
+}
+
+// RegisterDPoPProof returns false for replayed proofs, proof is remembered while its iat is acceptable
+func (store *DatabaseStore) RegisterDPoPProof(ctx context.Context, keyThumbprint, jti string) bool {
+	registered, err := store.redisRepository.RegisterDPoPProof(ctx, keyThumbprint, jti, time.Second*time.Duration(store.environment.DPoPProofLifetime*2))
+	if err != nil {
+		sentry.CaptureException(err)
+		return false
+	}
+
+	return registered
+}
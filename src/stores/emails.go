@@ -2,15 +2,139 @@ package stores
 
 import (
 	"context"
+	"github.com/getsentry/sentry-go"
 	uuid "github.com/satori/go.uuid"
+	"hive/enums"
 	"hive/functools"
 	"hive/models"
 	"hive/repositories"
 	"time"
 )
 
-func (store *DatabaseStore) CreateEmail(ctx context.Context, userId uuid.UUID, value string) (int, *models.Email) {
-	return repositories.CreateEmail(store.db, ctx, userId, value)
+// CreateEmail adds email to user, email taken from previous owner is recorded as completed transfer
+func (store *DatabaseStore) CreateEmail(ctx context.Context, userId uuid.UUID, value string) (int, *models.Email, *models.ContactTransfer) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil, nil
+	}
+
+	status, email, previousID := transferEmail(tx, ctx, userId, value)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil, nil
+	}
+
+	status, transfer := recordContactTransfer(tx, ctx, enums.EmailContact, value, previousID, userId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil, nil
+	}
+
+	return enums.Ok, email, transfer
+}
+
+func (store *DatabaseStore) GetEmailByID(ctx context.Context, id uuid.UUID) (int, *models.Email) {
+	return repositories.GetEmailByID(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetUserEmails(ctx context.Context, userID uuid.UUID) []*models.Email {
+	return repositories.GetUserEmails(store.db, ctx, userID)
+}
+
+// DeleteEmail removes email unless it is the last login identifier of its owner, the earliest of remaining
+// emails becomes primary instead of removed one
+func (store *DatabaseStore) DeleteEmail(ctx context.Context, id uuid.UUID) (int, *models.Email) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, email := repositories.GetEmailByID(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status = repositories.LockUser(tx, ctx, email.UserId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, count := repositories.CountUserLoginIdentifiers(tx, ctx, email.UserId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	if repositories.Rollback(tx, ctx, count <= 1) {
+		return enums.LastLoginIdentifier, nil
+	}
+
+	status, email = repositories.DeleteEmail(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status = repositories.EnsurePrimaryEmail(tx, ctx, email.UserId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, email
+}
+
+func (store *DatabaseStore) SetPrimaryEmail(ctx context.Context, id uuid.UUID) (int, *models.Email) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, email := repositories.GetEmailByID(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, email = repositories.SetPrimaryEmail(tx, ctx, id, email.UserId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, email
 }
 
 func (store *DatabaseStore) GetEmail(ctx context.Context, phone string) (int, *models.Email) {
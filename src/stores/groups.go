@@ -0,0 +1,56 @@
+package stores
+
+import (
+	"hive/models"
+	"hive/repositories"
+	"context"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (store *DatabaseStore) CreateGroup(ctx context.Context, title string, description string) (int, *models.Group) {
+	return repositories.CreateGroup(store.db, ctx, title, description)
+}
+
+func (store *DatabaseStore) UpdateGroup(ctx context.Context, id uuid.UUID, title string, description string) (int, *models.Group) {
+	return repositories.UpdateGroup(store.db, ctx, id, title, description)
+}
+
+func (store *DatabaseStore) DeleteGroup(ctx context.Context, id uuid.UUID) (int, *models.Group) {
+	return repositories.DeleteGroup(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetGroup(ctx context.Context, id uuid.UUID) (int, *models.Group) {
+	return repositories.GetGroup(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetGroups(ctx context.Context, query repositories.GetGroupsQuery) ([]*models.Group, *models.PaginationResponse) {
+	return repositories.GetGroups(store.db, ctx, query)
+}
+
+func (store *DatabaseStore) GetGroupUsersID(ctx context.Context, id uuid.UUID) []uuid.UUID {
+	return repositories.GetGroupUsersID(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) CreateGroupMember(ctx context.Context, groupId uuid.UUID, userId uuid.UUID) (int, *models.GroupMember) {
+	return repositories.CreateGroupMember(store.db, ctx, groupId, userId)
+}
+
+func (store *DatabaseStore) GetGroupMembers(ctx context.Context, query repositories.GetGroupMembersQuery) ([]*models.GroupMember, *models.PaginationResponse) {
+	return repositories.GetGroupMembers(store.db, ctx, query)
+}
+
+func (store *DatabaseStore) DeleteGroupMember(ctx context.Context, id uuid.UUID) (int, *models.GroupMember) {
+	return repositories.DeleteGroupMember(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) CreateGroupRole(ctx context.Context, groupId uuid.UUID, roleId uuid.UUID) (int, *models.GroupRole) {
+	return repositories.CreateGroupRole(store.db, ctx, groupId, roleId)
+}
+
+func (store *DatabaseStore) GetGroupRoles(ctx context.Context, query repositories.GetGroupRolesQuery) ([]*models.GroupRole, *models.PaginationResponse) {
+	return repositories.GetGroupRoles(store.db, ctx, query)
+}
+
+func (store *DatabaseStore) DeleteGroupRole(ctx context.Context, id uuid.UUID) (int, *models.GroupRole) {
+	return repositories.DeleteGroupRole(store.db, ctx, id)
+}
@@ -23,14 +23,17 @@ type IStore interface {
 
 	// Users
 
-	CreateUser(ctx context.Context, password, email, phone string) (int, *models.User)
+	CreateUser(ctx context.Context, password, email, phone string) (int, *models.User, []*models.ContactTransfer)
 	GetUser(context context.Context, id uuid.UUID) *models.User
 	GetUsers(context context.Context, query repositories.GetUsersQuery) []*models.User
 	DeleteUser(ctx context.Context, id uuid.UUID) (int, *models.User)
+	UpdateUserProfile(ctx context.Context, id uuid.UUID, profile map[string]interface{}) (int, *models.User)
+	UpdateUserStatus(ctx context.Context, id uuid.UUID, status string, suspendedUntil int64, reason string, actorID uuid.UUID) (int, *models.User)
 
 	// User Views
 
 	GetUsersView(context context.Context, query repositories.GetUsersViewStoreQuery) ([]*models.UserView, *models.PaginationResponse)
+	SearchUsersView(ctx context.Context, query repositories.SearchUsersViewQuery) ([]*models.UserView, *models.PaginationResponse)
 	GetUserView(context context.Context, id uuid.UUID) *models.UserView
 	CreateOrUpdateUsersView(context context.Context, query repositories.CreateOrUpdateUsersViewStoreQuery) []*models.UserView
 	CreateOrUpdateUsersViewByUsersID(context context.Context, id []uuid.UUID) []*models.UserView
@@ -39,10 +42,19 @@ type IStore interface {
 	CreateOrUpdateUsersViewByRoleID(context context.Context, id uuid.UUID) []*models.UserView
 	CacheUserView(ctx context.Context, userViews []*models.UserView)
 
+	// Authorization
+
+	GetAuthorizationDecisionFromCache(ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck) *models.AuthorizationDecision
+	CacheAuthorizationDecision(ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck, decision *models.AuthorizationDecision, duration time.Duration)
+
 	// Emails
 
-	CreateEmail(ctx context.Context, userId uuid.UUID, value string) (int, *models.Email)
+	CreateEmail(ctx context.Context, userId uuid.UUID, value string) (int, *models.Email, *models.ContactTransfer)
 	GetEmail(ctx context.Context, email string) (int, *models.Email)
+	GetEmailByID(ctx context.Context, id uuid.UUID) (int, *models.Email)
+	GetUserEmails(ctx context.Context, userID uuid.UUID) []*models.Email
+	DeleteEmail(ctx context.Context, id uuid.UUID) (int, *models.Email)
+	SetPrimaryEmail(ctx context.Context, id uuid.UUID) (int, *models.Email)
 	CreateEmailConfirmationCode(ctx context.Context, email string, code string, duration time.Duration) *models.EmailConfirmation
 	GetEmailConfirmationCode(ctx context.Context, email string) string
 	GetRandomCodeForEmailConfirmation() string
@@ -55,35 +67,128 @@ type IStore interface {
 
 	// Phones
 
-	CreatePhone(ctx context.Context, userId uuid.UUID, value string) (int, *models.Phone)
+	CreatePhone(ctx context.Context, userId uuid.UUID, value string) (int, *models.Phone, *models.ContactTransfer)
 	GetPhone(ctx context.Context, phone string) (int, *models.Phone)
+	GetPhoneByID(ctx context.Context, id uuid.UUID) (int, *models.Phone)
+	GetUserPhones(ctx context.Context, userID uuid.UUID) []*models.Phone
+	DeletePhone(ctx context.Context, id uuid.UUID) (int, *models.Phone)
+	SetPrimaryPhone(ctx context.Context, id uuid.UUID) (int, *models.Phone)
 	CreatePhoneConfirmationCode(ctx context.Context, phone string, code string, duration time.Duration) *models.PhoneConfirmation
 	GetPhoneConfirmationCode(ctx context.Context, phone string) string
 	GetRandomCodeForPhoneConfirmation() string
 
 	// Roles
 
-	CreateRole(context context.Context, title string) (int, *models.Role)
+	CreateRole(context context.Context, title string, permissions []string, parentsID []uuid.UUID) (int, *models.Role)
+	UpdateRoleParents(ctx context.Context, id uuid.UUID, parentsID []uuid.UUID) (int, *models.Role)
+	UpdateRole(ctx context.Context, id uuid.UUID, title string, description string) (int, *models.Role)
+	DeleteRole(ctx context.Context, id uuid.UUID) (int, *models.Role)
+	GetRoleUsersID(ctx context.Context, id uuid.UUID) []uuid.UUID
+	UpdateRoleManagedRoles(ctx context.Context, id uuid.UUID, managedRolesID []uuid.UUID) (int, *models.Role)
+	IsRoleManageable(ctx context.Context, managersID []uuid.UUID, id uuid.UUID) (int, bool)
 	GetRole(context context.Context, id uuid.UUID) (int, *models.Role)
 	GetRoles(context context.Context, query repositories.GetRolesQuery) ([]*models.Role, *models.PaginationResponse)
 	GetRoleByTitle(ctx context.Context, title string) (int, *models.Role)
 
 	// User Roles
 
-	CreateUserRole(ctx context.Context, userId uuid.UUID, roleId uuid.UUID) (int, *models.UserRole)
+	CreateUserRole(ctx context.Context, userId uuid.UUID, roleId uuid.UUID, organizationId uuid.UUID, startsAt int64, expiresAt int64) (int, *models.UserRole)
 	GetUserRoles(ctx context.Context, query repositories.GetUserRoleQuery) ([]*models.UserRole, *models.PaginationResponse)
+	GetUserRole(ctx context.Context, id uuid.UUID) (int, *models.UserRole)
 	DeleteUserRole(ctx context.Context, id uuid.UUID) (int, *models.UserRole)
+	ExpireUserRoles(ctx context.Context) []*models.UserRole
+	StartUserRoles(ctx context.Context) []*models.UserRole
+
+	// Role Requests
+
+	CreateRoleRequest(ctx context.Context, userId uuid.UUID, roleId uuid.UUID, reason string, expiresAt int64) (int, *models.RoleRequest)
+	GetRoleRequest(ctx context.Context, id uuid.UUID) (int, *models.RoleRequest)
+	GetRoleRequests(ctx context.Context, query repositories.GetRoleRequestsQuery) ([]*models.RoleRequest, *models.PaginationResponse)
+	ReviewRoleRequest(ctx context.Context, id uuid.UUID, status string, reviewerId uuid.UUID, comment string) (int, *models.RoleRequest)
+	ApproveRoleRequest(ctx context.Context, id uuid.UUID, reviewerId uuid.UUID, comment string, expiresAt int64) (int, *models.RoleRequest)
+	GetRoleManagersID(ctx context.Context, roleId uuid.UUID) []uuid.UUID
+
+	// Organizations
+
+	CreateOrganization(ctx context.Context, title string) (int, *models.Organization)
+	GetOrganization(ctx context.Context, id uuid.UUID) (int, *models.Organization)
+	GetOrganizations(ctx context.Context, query repositories.GetOrganizationsQuery) ([]*models.Organization, *models.PaginationResponse)
+
+	// Organization Members
+
+	CreateOrganizationMember(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (int, *models.OrganizationMember)
+	GetOrganizationMembers(ctx context.Context, query repositories.GetOrganizationMembersQuery) ([]*models.OrganizationMember, *models.PaginationResponse)
+	DeleteOrganizationMember(ctx context.Context, id uuid.UUID) (int, *models.OrganizationMember)
+
+	// Groups
+
+	CreateGroup(ctx context.Context, title string, description string) (int, *models.Group)
+	UpdateGroup(ctx context.Context, id uuid.UUID, title string, description string) (int, *models.Group)
+	DeleteGroup(ctx context.Context, id uuid.UUID) (int, *models.Group)
+	GetGroup(ctx context.Context, id uuid.UUID) (int, *models.Group)
+	GetGroups(ctx context.Context, query repositories.GetGroupsQuery) ([]*models.Group, *models.PaginationResponse)
+	GetGroupUsersID(ctx context.Context, id uuid.UUID) []uuid.UUID
+
+	// Group Members
+
+	CreateGroupMember(ctx context.Context, groupId uuid.UUID, userId uuid.UUID) (int, *models.GroupMember)
+	GetGroupMembers(ctx context.Context, query repositories.GetGroupMembersQuery) ([]*models.GroupMember, *models.PaginationResponse)
+	DeleteGroupMember(ctx context.Context, id uuid.UUID) (int, *models.GroupMember)
+
+	// Group Roles
+
+	CreateGroupRole(ctx context.Context, groupId uuid.UUID, roleId uuid.UUID) (int, *models.GroupRole)
+	GetGroupRoles(ctx context.Context, query repositories.GetGroupRolesQuery) ([]*models.GroupRole, *models.PaginationResponse)
+	DeleteGroupRole(ctx context.Context, id uuid.UUID) (int, *models.GroupRole)
 
 	// Secrets
 
 	GetSecret(ctx context.Context, id uuid.UUID) *models.Secret
 	GetActualSecret(ctx context.Context) *models.Secret
 	CreateSecret(ctx context.Context) *models.Secret
+	GetSecrets(ctx context.Context, query postgresRepository.GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse)
+	RotateSecret(ctx context.Context) *models.Secret
+	RevokeSecret(ctx context.Context, id uuid.UUID) (int, *models.Secret)
+	ListenSecretsInvalidation(ctx context.Context)
+	ReencryptSecrets(ctx context.Context) []uuid.UUID
+
+	// User Exports
+
+	CreateUserExport(ctx context.Context, userID uuid.UUID, requesterID uuid.UUID, tokenHash string) (int, *models.UserExport)
+	GetUserExport(ctx context.Context, id uuid.UUID) (int, *models.UserExport)
+	GetUserExportArchive(ctx context.Context, id uuid.UUID, tokenHash string) (int, *models.UserExport)
+	ClaimUserExports(ctx context.Context, limit int) []*models.UserExport
+	CompleteUserExport(ctx context.Context, id uuid.UUID, status string, archive []byte, expires int64) (int, *models.UserExport)
+	DeleteExpiredUserExports(ctx context.Context) int64
+	GetUserExportData(ctx context.Context, userID uuid.UUID) (int, *models.UserExportData)
+
+	// Contact Transfers
+
+	RequestContactTransfer(ctx context.Context, kind string, value string, fromUserID uuid.UUID, toUserID uuid.UUID) (int, *models.ContactTransfer)
+	GetContactTransfer(ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer)
+	GetUserContactTransfers(ctx context.Context, userID uuid.UUID) []*models.ContactTransfer
+	ApproveContactTransfer(ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer)
+	RejectContactTransfer(ctx context.Context, id uuid.UUID) (int, *models.ContactTransfer)
+
+	// User Deletions
+
+	ScheduleUserDeletion(ctx context.Context, id uuid.UUID, scheduled int64) (int, *models.User)
+	CancelUserDeletion(ctx context.Context, id uuid.UUID) (int, *models.User)
+	GetDueUserDeletions(ctx context.Context, now int64, limit int) []uuid.UUID
+	AnonymizeUser(ctx context.Context, id uuid.UUID, now int64) (int, *models.User)
 
 	// Sessions
 
-	CreateSession(ctx context.Context, userID uuid.UUID, secretID uuid.UUID, fingerprint string, userAgent string) *models.Session
+	CreateSession(ctx context.Context, userID uuid.UUID, secretID uuid.UUID, fingerprint string, userAgent string, keyThumbprint string, organizationID uuid.UUID, authentication *models.AuthenticationContext) *models.Session
 	DeleteSession(ctx context.Context, id uuid.UUID) *models.Session
+	DeleteUserSessions(ctx context.Context, userID uuid.UUID) []*models.Session
+	GetSessionExistence(ctx context.Context, publicID uuid.UUID) bool
+
+	// DPoP
+
+	CreateDPoPNonce(ctx context.Context) string
+	GetDPoPNonceExistence(ctx context.Context, nonce string) bool
+	RegisterDPoPProof(ctx context.Context, keyThumbprint, jti string) bool
 }
 
 type DatabaseStore struct {
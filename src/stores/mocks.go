@@ -10,6 +10,7 @@ import (
 	go_uuid "github.com/satori/go.uuid"
 	models "hive/models"
 	repositories "hive/repositories"
+	postgresRepository "hive/repositories/postgresRepository"
 	reflect "reflect"
 	time "time"
 )
@@ -38,12 +39,13 @@ func (m *MockIStore) EXPECT() *MockIStoreMockRecorder {
 }
 
 // CreateUser mocks base method
-func (m *MockIStore) CreateUser(ctx context.Context, password, email, phone string) (int, *models.User) {
+func (m *MockIStore) CreateUser(ctx context.Context, password, email, phone string) (int, *models.User, []*models.ContactTransfer) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateUser", ctx, password, email, phone)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.User)
-	return ret0, ret1
+	ret2, _ := ret[2].([]*models.ContactTransfer)
+	return ret0, ret1, ret2
 }
 
 // CreateUser indicates an expected call of CreateUser
@@ -95,6 +97,36 @@ func (mr *MockIStoreMockRecorder) DeleteUser(ctx, id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockIStore)(nil).DeleteUser), ctx, id)
 }
 
+// UpdateUserProfile mocks base method
+func (m *MockIStore) UpdateUserProfile(ctx context.Context, id go_uuid.UUID, profile map[string]interface{}) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserProfile", ctx, id, profile)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// UpdateUserProfile indicates an expected call of UpdateUserProfile
+func (mr *MockIStoreMockRecorder) UpdateUserProfile(ctx, id, profile interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserProfile", reflect.TypeOf((*MockIStore)(nil).UpdateUserProfile), ctx, id, profile)
+}
+
+// UpdateUserStatus mocks base method
+func (m *MockIStore) UpdateUserStatus(ctx context.Context, id go_uuid.UUID, status string, suspendedUntil int64, reason string, actorID go_uuid.UUID) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserStatus", ctx, id, status, suspendedUntil, reason, actorID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus
+func (mr *MockIStoreMockRecorder) UpdateUserStatus(ctx, id, status, suspendedUntil, reason, actorID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockIStore)(nil).UpdateUserStatus), ctx, id, status, suspendedUntil, reason, actorID)
+}
+
 // GetUsersView mocks base method
 func (m *MockIStore) GetUsersView(context context.Context, query repositories.GetUsersViewStoreQuery) ([]*models.UserView, *models.PaginationResponse) {
 	m.ctrl.T.Helper()
@@ -110,6 +142,21 @@ func (mr *MockIStoreMockRecorder) GetUsersView(context, query interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersView", reflect.TypeOf((*MockIStore)(nil).GetUsersView), context, query)
 }
 
+// SearchUsersView mocks base method
+func (m *MockIStore) SearchUsersView(ctx context.Context, query repositories.SearchUsersViewQuery) ([]*models.UserView, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsersView", ctx, query)
+	ret0, _ := ret[0].([]*models.UserView)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// SearchUsersView indicates an expected call of SearchUsersView
+func (mr *MockIStoreMockRecorder) SearchUsersView(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsersView", reflect.TypeOf((*MockIStore)(nil).SearchUsersView), ctx, query)
+}
+
 // GetUserView mocks base method
 func (m *MockIStore) GetUserView(context context.Context, id go_uuid.UUID) *models.UserView {
 	m.ctrl.T.Helper()
@@ -206,13 +253,40 @@ func (mr *MockIStoreMockRecorder) CacheUserView(ctx, userViews interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheUserView", reflect.TypeOf((*MockIStore)(nil).CacheUserView), ctx, userViews)
 }
 
+// GetAuthorizationDecisionFromCache mocks base method
+func (m *MockIStore) GetAuthorizationDecisionFromCache(ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck) *models.AuthorizationDecision {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAuthorizationDecisionFromCache", ctx, userView, check)
+	ret0, _ := ret[0].(*models.AuthorizationDecision)
+	return ret0
+}
+
+// GetAuthorizationDecisionFromCache indicates an expected call of GetAuthorizationDecisionFromCache
+func (mr *MockIStoreMockRecorder) GetAuthorizationDecisionFromCache(ctx, userView, check interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAuthorizationDecisionFromCache", reflect.TypeOf((*MockIStore)(nil).GetAuthorizationDecisionFromCache), ctx, userView, check)
+}
+
+// CacheAuthorizationDecision mocks base method
+func (m *MockIStore) CacheAuthorizationDecision(ctx context.Context, userView *models.UserView, check *models.AuthorizationCheck, decision *models.AuthorizationDecision, duration time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "CacheAuthorizationDecision", ctx, userView, check, decision, duration)
+}
+
+// CacheAuthorizationDecision indicates an expected call of CacheAuthorizationDecision
+func (mr *MockIStoreMockRecorder) CacheAuthorizationDecision(ctx, userView, check, decision, duration interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheAuthorizationDecision", reflect.TypeOf((*MockIStore)(nil).CacheAuthorizationDecision), ctx, userView, check, decision, duration)
+}
+
 // CreateEmail mocks base method
-func (m *MockIStore) CreateEmail(ctx context.Context, userId go_uuid.UUID, value string) (int, *models.Email) {
+func (m *MockIStore) CreateEmail(ctx context.Context, userId go_uuid.UUID, value string) (int, *models.Email, *models.ContactTransfer) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateEmail", ctx, userId, value)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.Email)
-	return ret0, ret1
+	ret2, _ := ret[2].(*models.ContactTransfer)
+	return ret0, ret1, ret2
 }
 
 // CreateEmail indicates an expected call of CreateEmail
@@ -236,6 +310,65 @@ func (mr *MockIStoreMockRecorder) GetEmail(ctx, email interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmail", reflect.TypeOf((*MockIStore)(nil).GetEmail), ctx, email)
 }
 
+// GetEmailByID mocks base method
+func (m *MockIStore) GetEmailByID(ctx context.Context, id go_uuid.UUID) (int, *models.Email) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailByID", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Email)
+	return ret0, ret1
+}
+
+// GetEmailByID indicates an expected call of GetEmailByID
+func (mr *MockIStoreMockRecorder) GetEmailByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailByID", reflect.TypeOf((*MockIStore)(nil).GetEmailByID), ctx, id)
+}
+
+// GetUserEmails mocks base method
+func (m *MockIStore) GetUserEmails(ctx context.Context, userID go_uuid.UUID) []*models.Email {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserEmails", ctx, userID)
+	ret0, _ := ret[0].([]*models.Email)
+	return ret0
+}
+
+// GetUserEmails indicates an expected call of GetUserEmails
+func (mr *MockIStoreMockRecorder) GetUserEmails(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserEmails", reflect.TypeOf((*MockIStore)(nil).GetUserEmails), ctx, userID)
+}
+
+// DeleteEmail mocks base method
+func (m *MockIStore) DeleteEmail(ctx context.Context, id go_uuid.UUID) (int, *models.Email) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmail", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Email)
+	return ret0, ret1
+}
+
+// DeleteEmail indicates an expected call of DeleteEmail
+func (mr *MockIStoreMockRecorder) DeleteEmail(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmail", reflect.TypeOf((*MockIStore)(nil).DeleteEmail), ctx, id)
+}
+
+// SetPrimaryEmail mocks base method
+func (m *MockIStore) SetPrimaryEmail(ctx context.Context, id go_uuid.UUID) (int, *models.Email) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimaryEmail", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Email)
+	return ret0, ret1
+}
+
+// SetPrimaryEmail indicates an expected call of SetPrimaryEmail
+func (mr *MockIStoreMockRecorder) SetPrimaryEmail(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryEmail", reflect.TypeOf((*MockIStore)(nil).SetPrimaryEmail), ctx, id)
+}
+
 // CreateEmailConfirmationCode mocks base method
 func (m *MockIStore) CreateEmailConfirmationCode(ctx context.Context, email, code string, duration time.Duration) *models.EmailConfirmation {
 	m.ctrl.T.Helper()
@@ -323,12 +456,13 @@ func (mr *MockIStoreMockRecorder) GetLatestPassword(ctx, userId interface{}) *go
 }
 
 // CreatePhone mocks base method
-func (m *MockIStore) CreatePhone(ctx context.Context, userId go_uuid.UUID, value string) (int, *models.Phone) {
+func (m *MockIStore) CreatePhone(ctx context.Context, userId go_uuid.UUID, value string) (int, *models.Phone, *models.ContactTransfer) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePhone", ctx, userId, value)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.Phone)
-	return ret0, ret1
+	ret2, _ := ret[2].(*models.ContactTransfer)
+	return ret0, ret1, ret2
 }
 
 // CreatePhone indicates an expected call of CreatePhone
@@ -352,6 +486,65 @@ func (mr *MockIStoreMockRecorder) GetPhone(ctx, phone interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhone", reflect.TypeOf((*MockIStore)(nil).GetPhone), ctx, phone)
 }
 
+// GetPhoneByID mocks base method
+func (m *MockIStore) GetPhoneByID(ctx context.Context, id go_uuid.UUID) (int, *models.Phone) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPhoneByID", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Phone)
+	return ret0, ret1
+}
+
+// GetPhoneByID indicates an expected call of GetPhoneByID
+func (mr *MockIStoreMockRecorder) GetPhoneByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhoneByID", reflect.TypeOf((*MockIStore)(nil).GetPhoneByID), ctx, id)
+}
+
+// GetUserPhones mocks base method
+func (m *MockIStore) GetUserPhones(ctx context.Context, userID go_uuid.UUID) []*models.Phone {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserPhones", ctx, userID)
+	ret0, _ := ret[0].([]*models.Phone)
+	return ret0
+}
+
+// GetUserPhones indicates an expected call of GetUserPhones
+func (mr *MockIStoreMockRecorder) GetUserPhones(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserPhones", reflect.TypeOf((*MockIStore)(nil).GetUserPhones), ctx, userID)
+}
+
+// DeletePhone mocks base method
+func (m *MockIStore) DeletePhone(ctx context.Context, id go_uuid.UUID) (int, *models.Phone) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhone", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Phone)
+	return ret0, ret1
+}
+
+// DeletePhone indicates an expected call of DeletePhone
+func (mr *MockIStoreMockRecorder) DeletePhone(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhone", reflect.TypeOf((*MockIStore)(nil).DeletePhone), ctx, id)
+}
+
+// SetPrimaryPhone mocks base method
+func (m *MockIStore) SetPrimaryPhone(ctx context.Context, id go_uuid.UUID) (int, *models.Phone) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetPrimaryPhone", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Phone)
+	return ret0, ret1
+}
+
+// SetPrimaryPhone indicates an expected call of SetPrimaryPhone
+func (mr *MockIStoreMockRecorder) SetPrimaryPhone(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPrimaryPhone", reflect.TypeOf((*MockIStore)(nil).SetPrimaryPhone), ctx, id)
+}
+
 // CreatePhoneConfirmationCode mocks base method
 func (m *MockIStore) CreatePhoneConfirmationCode(ctx context.Context, phone, code string, duration time.Duration) *models.PhoneConfirmation {
 	m.ctrl.T.Helper()
@@ -395,18 +588,107 @@ func (mr *MockIStoreMockRecorder) GetRandomCodeForPhoneConfirmation() *gomock.Ca
 }
 
 // CreateRole mocks base method
-func (m *MockIStore) CreateRole(context context.Context, title string) (int, *models.Role) {
+func (m *MockIStore) CreateRole(context context.Context, title string, permissions []string, parentsID []go_uuid.UUID) (int, *models.Role) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateRole", context, title)
+	ret := m.ctrl.Call(m, "CreateRole", context, title, permissions, parentsID)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.Role)
 	return ret0, ret1
 }
 
 // CreateRole indicates an expected call of CreateRole
-func (mr *MockIStoreMockRecorder) CreateRole(context, title interface{}) *gomock.Call {
+func (mr *MockIStoreMockRecorder) CreateRole(context, title, permissions, parentsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockIStore)(nil).CreateRole), context, title, permissions, parentsID)
+}
+
+// UpdateRoleParents mocks base method
+func (m *MockIStore) UpdateRoleParents(ctx context.Context, id go_uuid.UUID, parentsID []go_uuid.UUID) (int, *models.Role) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleParents", ctx, id, parentsID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Role)
+	return ret0, ret1
+}
+
+// UpdateRoleParents indicates an expected call of UpdateRoleParents
+func (mr *MockIStoreMockRecorder) UpdateRoleParents(ctx, id, parentsID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleParents", reflect.TypeOf((*MockIStore)(nil).UpdateRoleParents), ctx, id, parentsID)
+}
+
+// UpdateRole mocks base method
+func (m *MockIStore) UpdateRole(ctx context.Context, id go_uuid.UUID, title, description string) (int, *models.Role) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRole", ctx, id, title, description)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Role)
+	return ret0, ret1
+}
+
+// UpdateRole indicates an expected call of UpdateRole
+func (mr *MockIStoreMockRecorder) UpdateRole(ctx, id, title, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRole", reflect.TypeOf((*MockIStore)(nil).UpdateRole), ctx, id, title, description)
+}
+
+// DeleteRole mocks base method
+func (m *MockIStore) DeleteRole(ctx context.Context, id go_uuid.UUID) (int, *models.Role) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRole", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Role)
+	return ret0, ret1
+}
+
+// DeleteRole indicates an expected call of DeleteRole
+func (mr *MockIStoreMockRecorder) DeleteRole(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRole", reflect.TypeOf((*MockIStore)(nil).DeleteRole), ctx, id)
+}
+
+// GetRoleUsersID mocks base method
+func (m *MockIStore) GetRoleUsersID(ctx context.Context, id go_uuid.UUID) []go_uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleUsersID", ctx, id)
+	ret0, _ := ret[0].([]go_uuid.UUID)
+	return ret0
+}
+
+// GetRoleUsersID indicates an expected call of GetRoleUsersID
+func (mr *MockIStoreMockRecorder) GetRoleUsersID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleUsersID", reflect.TypeOf((*MockIStore)(nil).GetRoleUsersID), ctx, id)
+}
+
+// UpdateRoleManagedRoles mocks base method
+func (m *MockIStore) UpdateRoleManagedRoles(ctx context.Context, id go_uuid.UUID, managedRolesID []go_uuid.UUID) (int, *models.Role) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleManagedRoles", ctx, id, managedRolesID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Role)
+	return ret0, ret1
+}
+
+// UpdateRoleManagedRoles indicates an expected call of UpdateRoleManagedRoles
+func (mr *MockIStoreMockRecorder) UpdateRoleManagedRoles(ctx, id, managedRolesID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleManagedRoles", reflect.TypeOf((*MockIStore)(nil).UpdateRoleManagedRoles), ctx, id, managedRolesID)
+}
+
+// IsRoleManageable mocks base method
+func (m *MockIStore) IsRoleManageable(ctx context.Context, managersID []go_uuid.UUID, id go_uuid.UUID) (int, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRoleManageable", ctx, managersID, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(bool)
+	return ret0, ret1
+}
+
+// IsRoleManageable indicates an expected call of IsRoleManageable
+func (mr *MockIStoreMockRecorder) IsRoleManageable(ctx, managersID, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRole", reflect.TypeOf((*MockIStore)(nil).CreateRole), context, title)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRoleManageable", reflect.TypeOf((*MockIStore)(nil).IsRoleManageable), ctx, managersID, id)
 }
 
 // GetRole mocks base method
@@ -454,34 +736,19 @@ func (mr *MockIStoreMockRecorder) GetRoleByTitle(ctx, title interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByTitle", reflect.TypeOf((*MockIStore)(nil).GetRoleByTitle), ctx, title)
 }
 
-// GetAdminRole mocks base method
-func (m *MockIStore) GetAdminRole(ctx context.Context) (int, *models.Role) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAdminRole", ctx)
-	ret0, _ := ret[0].(int)
-	ret1, _ := ret[1].(*models.Role)
-	return ret0, ret1
-}
-
-// GetAdminRole indicates an expected call of GetAdminRole
-func (mr *MockIStoreMockRecorder) GetAdminRole(ctx interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAdminRole", reflect.TypeOf((*MockIStore)(nil).GetAdminRole), ctx)
-}
-
 // CreateUserRole mocks base method
-func (m *MockIStore) CreateUserRole(ctx context.Context, userId, roleId go_uuid.UUID) (int, *models.UserRole) {
+func (m *MockIStore) CreateUserRole(ctx context.Context, userId, roleId, organizationId go_uuid.UUID, startsAt, expiresAt int64) (int, *models.UserRole) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUserRole", ctx, userId, roleId)
+	ret := m.ctrl.Call(m, "CreateUserRole", ctx, userId, roleId, organizationId, startsAt, expiresAt)
 	ret0, _ := ret[0].(int)
 	ret1, _ := ret[1].(*models.UserRole)
 	return ret0, ret1
 }
 
 // CreateUserRole indicates an expected call of CreateUserRole
-func (mr *MockIStoreMockRecorder) CreateUserRole(ctx, userId, roleId interface{}) *gomock.Call {
+func (mr *MockIStoreMockRecorder) CreateUserRole(ctx, userId, roleId, organizationId, startsAt, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserRole", reflect.TypeOf((*MockIStore)(nil).CreateUserRole), ctx, userId, roleId)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserRole", reflect.TypeOf((*MockIStore)(nil).CreateUserRole), ctx, userId, roleId, organizationId, startsAt, expiresAt)
 }
 
 // GetUserRoles mocks base method
@@ -499,6 +766,21 @@ func (mr *MockIStoreMockRecorder) GetUserRoles(ctx, query interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRoles", reflect.TypeOf((*MockIStore)(nil).GetUserRoles), ctx, query)
 }
 
+// GetUserRole mocks base method
+func (m *MockIStore) GetUserRole(ctx context.Context, id go_uuid.UUID) (int, *models.UserRole) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserRole", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserRole)
+	return ret0, ret1
+}
+
+// GetUserRole indicates an expected call of GetUserRole
+func (mr *MockIStoreMockRecorder) GetUserRole(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserRole", reflect.TypeOf((*MockIStore)(nil).GetUserRole), ctx, id)
+}
+
 // DeleteUserRole mocks base method
 func (m *MockIStore) DeleteUserRole(ctx context.Context, id go_uuid.UUID) (int, *models.UserRole) {
 	m.ctrl.T.Helper()
@@ -514,72 +796,834 @@ func (mr *MockIStoreMockRecorder) DeleteUserRole(ctx, id interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserRole", reflect.TypeOf((*MockIStore)(nil).DeleteUserRole), ctx, id)
 }
 
-// GetSecret mocks base method
-func (m *MockIStore) GetSecret(ctx context.Context, id go_uuid.UUID) *models.Secret {
+// ExpireUserRoles mocks base method
+func (m *MockIStore) ExpireUserRoles(ctx context.Context) []*models.UserRole {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSecret", ctx, id)
-	ret0, _ := ret[0].(*models.Secret)
+	ret := m.ctrl.Call(m, "ExpireUserRoles", ctx)
+	ret0, _ := ret[0].([]*models.UserRole)
 	return ret0
 }
 
-// GetSecret indicates an expected call of GetSecret
-func (mr *MockIStoreMockRecorder) GetSecret(ctx, id interface{}) *gomock.Call {
+// ExpireUserRoles indicates an expected call of ExpireUserRoles
+func (mr *MockIStoreMockRecorder) ExpireUserRoles(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockIStore)(nil).GetSecret), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireUserRoles", reflect.TypeOf((*MockIStore)(nil).ExpireUserRoles), ctx)
 }
 
-// GetActualSecret mocks base method
-func (m *MockIStore) GetActualSecret(ctx context.Context) *models.Secret {
+// StartUserRoles mocks base method
+func (m *MockIStore) StartUserRoles(ctx context.Context) []*models.UserRole {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetActualSecret", ctx)
-	ret0, _ := ret[0].(*models.Secret)
+	ret := m.ctrl.Call(m, "StartUserRoles", ctx)
+	ret0, _ := ret[0].([]*models.UserRole)
 	return ret0
 }
 
-// GetActualSecret indicates an expected call of GetActualSecret
-func (mr *MockIStoreMockRecorder) GetActualSecret(ctx interface{}) *gomock.Call {
+// StartUserRoles indicates an expected call of StartUserRoles
+func (mr *MockIStoreMockRecorder) StartUserRoles(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActualSecret", reflect.TypeOf((*MockIStore)(nil).GetActualSecret), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartUserRoles", reflect.TypeOf((*MockIStore)(nil).StartUserRoles), ctx)
 }
 
-// CreateSecret mocks base method
-func (m *MockIStore) CreateSecret(ctx context.Context) *models.Secret {
+// CreateRoleRequest mocks base method
+func (m *MockIStore) CreateRoleRequest(ctx context.Context, userId, roleId go_uuid.UUID, reason string, expiresAt int64) (int, *models.RoleRequest) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSecret", ctx)
-	ret0, _ := ret[0].(*models.Secret)
-	return ret0
+	ret := m.ctrl.Call(m, "CreateRoleRequest", ctx, userId, roleId, reason, expiresAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.RoleRequest)
+	return ret0, ret1
 }
 
-// CreateSecret indicates an expected call of CreateSecret
-func (mr *MockIStoreMockRecorder) CreateSecret(ctx interface{}) *gomock.Call {
+// CreateRoleRequest indicates an expected call of CreateRoleRequest
+func (mr *MockIStoreMockRecorder) CreateRoleRequest(ctx, userId, roleId, reason, expiresAt interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockIStore)(nil).CreateSecret), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoleRequest", reflect.TypeOf((*MockIStore)(nil).CreateRoleRequest), ctx, userId, roleId, reason, expiresAt)
 }
 
-// CreateSession mocks base method
-func (m *MockIStore) CreateSession(ctx context.Context, userID, secretID go_uuid.UUID, fingerprint, userAgent string) *models.Session {
+// GetRoleRequest mocks base method
+func (m *MockIStore) GetRoleRequest(ctx context.Context, id go_uuid.UUID) (int, *models.RoleRequest) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, secretID, fingerprint, userAgent)
-	ret0, _ := ret[0].(*models.Session)
-	return ret0
+	ret := m.ctrl.Call(m, "GetRoleRequest", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.RoleRequest)
+	return ret0, ret1
 }
 
-// CreateSession indicates an expected call of CreateSession
-func (mr *MockIStoreMockRecorder) CreateSession(ctx, userID, secretID, fingerprint, userAgent interface{}) *gomock.Call {
+// GetRoleRequest indicates an expected call of GetRoleRequest
+func (mr *MockIStoreMockRecorder) GetRoleRequest(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockIStore)(nil).CreateSession), ctx, userID, secretID, fingerprint, userAgent)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleRequest", reflect.TypeOf((*MockIStore)(nil).GetRoleRequest), ctx, id)
 }
 
-// DeleteSession mocks base method
-func (m *MockIStore) DeleteSession(ctx context.Context, id go_uuid.UUID) *models.Session {
+// GetRoleRequests mocks base method
+func (m *MockIStore) GetRoleRequests(ctx context.Context, query repositories.GetRoleRequestsQuery) ([]*models.RoleRequest, *models.PaginationResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteSession", ctx, id)
-	ret0, _ := ret[0].(*models.Session)
+	ret := m.ctrl.Call(m, "GetRoleRequests", ctx, query)
+	ret0, _ := ret[0].([]*models.RoleRequest)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetRoleRequests indicates an expected call of GetRoleRequests
+func (mr *MockIStoreMockRecorder) GetRoleRequests(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleRequests", reflect.TypeOf((*MockIStore)(nil).GetRoleRequests), ctx, query)
+}
+
+// ReviewRoleRequest mocks base method
+func (m *MockIStore) ReviewRoleRequest(ctx context.Context, id go_uuid.UUID, status string, reviewerId go_uuid.UUID, comment string) (int, *models.RoleRequest) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewRoleRequest", ctx, id, status, reviewerId, comment)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.RoleRequest)
+	return ret0, ret1
+}
+
+// ReviewRoleRequest indicates an expected call of ReviewRoleRequest
+func (mr *MockIStoreMockRecorder) ReviewRoleRequest(ctx, id, status, reviewerId, comment interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewRoleRequest", reflect.TypeOf((*MockIStore)(nil).ReviewRoleRequest), ctx, id, status, reviewerId, comment)
+}
+
+// ApproveRoleRequest mocks base method
+func (m *MockIStore) ApproveRoleRequest(ctx context.Context, id, reviewerId go_uuid.UUID, comment string, expiresAt int64) (int, *models.RoleRequest) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveRoleRequest", ctx, id, reviewerId, comment, expiresAt)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.RoleRequest)
+	return ret0, ret1
+}
+
+// ApproveRoleRequest indicates an expected call of ApproveRoleRequest
+func (mr *MockIStoreMockRecorder) ApproveRoleRequest(ctx, id, reviewerId, comment, expiresAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveRoleRequest", reflect.TypeOf((*MockIStore)(nil).ApproveRoleRequest), ctx, id, reviewerId, comment, expiresAt)
+}
+
+// GetRoleManagersID mocks base method
+func (m *MockIStore) GetRoleManagersID(ctx context.Context, roleId go_uuid.UUID) []go_uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRoleManagersID", ctx, roleId)
+	ret0, _ := ret[0].([]go_uuid.UUID)
 	return ret0
 }
 
-// DeleteSession indicates an expected call of DeleteSession
-func (mr *MockIStoreMockRecorder) DeleteSession(ctx, id interface{}) *gomock.Call {
+// GetRoleManagersID indicates an expected call of GetRoleManagersID
+func (mr *MockIStoreMockRecorder) GetRoleManagersID(ctx, roleId interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockIStore)(nil).DeleteSession), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleManagersID", reflect.TypeOf((*MockIStore)(nil).GetRoleManagersID), ctx, roleId)
+}
+
+// CreateOrganization mocks base method
+func (m *MockIStore) CreateOrganization(ctx context.Context, title string) (int, *models.Organization) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganization", ctx, title)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Organization)
+	return ret0, ret1
+}
+
+// CreateOrganization indicates an expected call of CreateOrganization
+func (mr *MockIStoreMockRecorder) CreateOrganization(ctx, title interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganization", reflect.TypeOf((*MockIStore)(nil).CreateOrganization), ctx, title)
+}
+
+// GetOrganization mocks base method
+func (m *MockIStore) GetOrganization(ctx context.Context, id go_uuid.UUID) (int, *models.Organization) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganization", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Organization)
+	return ret0, ret1
+}
+
+// GetOrganization indicates an expected call of GetOrganization
+func (mr *MockIStoreMockRecorder) GetOrganization(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganization", reflect.TypeOf((*MockIStore)(nil).GetOrganization), ctx, id)
+}
+
+// GetOrganizations mocks base method
+func (m *MockIStore) GetOrganizations(ctx context.Context, query repositories.GetOrganizationsQuery) ([]*models.Organization, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizations", ctx, query)
+	ret0, _ := ret[0].([]*models.Organization)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetOrganizations indicates an expected call of GetOrganizations
+func (mr *MockIStoreMockRecorder) GetOrganizations(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizations", reflect.TypeOf((*MockIStore)(nil).GetOrganizations), ctx, query)
+}
+
+// CreateOrganizationMember mocks base method
+func (m *MockIStore) CreateOrganizationMember(ctx context.Context, organizationId, userId go_uuid.UUID) (int, *models.OrganizationMember) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrganizationMember", ctx, organizationId, userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.OrganizationMember)
+	return ret0, ret1
+}
+
+// CreateOrganizationMember indicates an expected call of CreateOrganizationMember
+func (mr *MockIStoreMockRecorder) CreateOrganizationMember(ctx, organizationId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrganizationMember", reflect.TypeOf((*MockIStore)(nil).CreateOrganizationMember), ctx, organizationId, userId)
+}
+
+// GetOrganizationMembers mocks base method
+func (m *MockIStore) GetOrganizationMembers(ctx context.Context, query repositories.GetOrganizationMembersQuery) ([]*models.OrganizationMember, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrganizationMembers", ctx, query)
+	ret0, _ := ret[0].([]*models.OrganizationMember)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetOrganizationMembers indicates an expected call of GetOrganizationMembers
+func (mr *MockIStoreMockRecorder) GetOrganizationMembers(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrganizationMembers", reflect.TypeOf((*MockIStore)(nil).GetOrganizationMembers), ctx, query)
+}
+
+// DeleteOrganizationMember mocks base method
+func (m *MockIStore) DeleteOrganizationMember(ctx context.Context, id go_uuid.UUID) (int, *models.OrganizationMember) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrganizationMember", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.OrganizationMember)
+	return ret0, ret1
+}
+
+// DeleteOrganizationMember indicates an expected call of DeleteOrganizationMember
+func (mr *MockIStoreMockRecorder) DeleteOrganizationMember(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrganizationMember", reflect.TypeOf((*MockIStore)(nil).DeleteOrganizationMember), ctx, id)
+}
+
+// CreateGroup mocks base method
+func (m *MockIStore) CreateGroup(ctx context.Context, title, description string) (int, *models.Group) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroup", ctx, title, description)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Group)
+	return ret0, ret1
+}
+
+// CreateGroup indicates an expected call of CreateGroup
+func (mr *MockIStoreMockRecorder) CreateGroup(ctx, title, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroup", reflect.TypeOf((*MockIStore)(nil).CreateGroup), ctx, title, description)
+}
+
+// UpdateGroup mocks base method
+func (m *MockIStore) UpdateGroup(ctx context.Context, id go_uuid.UUID, title, description string) (int, *models.Group) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGroup", ctx, id, title, description)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Group)
+	return ret0, ret1
+}
+
+// UpdateGroup indicates an expected call of UpdateGroup
+func (mr *MockIStoreMockRecorder) UpdateGroup(ctx, id, title, description interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGroup", reflect.TypeOf((*MockIStore)(nil).UpdateGroup), ctx, id, title, description)
+}
+
+// DeleteGroup mocks base method
+func (m *MockIStore) DeleteGroup(ctx context.Context, id go_uuid.UUID) (int, *models.Group) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroup", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Group)
+	return ret0, ret1
+}
+
+// DeleteGroup indicates an expected call of DeleteGroup
+func (mr *MockIStoreMockRecorder) DeleteGroup(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroup", reflect.TypeOf((*MockIStore)(nil).DeleteGroup), ctx, id)
+}
+
+// GetGroup mocks base method
+func (m *MockIStore) GetGroup(ctx context.Context, id go_uuid.UUID) (int, *models.Group) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroup", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Group)
+	return ret0, ret1
+}
+
+// GetGroup indicates an expected call of GetGroup
+func (mr *MockIStoreMockRecorder) GetGroup(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroup", reflect.TypeOf((*MockIStore)(nil).GetGroup), ctx, id)
+}
+
+// GetGroups mocks base method
+func (m *MockIStore) GetGroups(ctx context.Context, query repositories.GetGroupsQuery) ([]*models.Group, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroups", ctx, query)
+	ret0, _ := ret[0].([]*models.Group)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetGroups indicates an expected call of GetGroups
+func (mr *MockIStoreMockRecorder) GetGroups(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroups", reflect.TypeOf((*MockIStore)(nil).GetGroups), ctx, query)
+}
+
+// GetGroupUsersID mocks base method
+func (m *MockIStore) GetGroupUsersID(ctx context.Context, id go_uuid.UUID) []go_uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupUsersID", ctx, id)
+	ret0, _ := ret[0].([]go_uuid.UUID)
+	return ret0
+}
+
+// GetGroupUsersID indicates an expected call of GetGroupUsersID
+func (mr *MockIStoreMockRecorder) GetGroupUsersID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupUsersID", reflect.TypeOf((*MockIStore)(nil).GetGroupUsersID), ctx, id)
+}
+
+// CreateGroupMember mocks base method
+func (m *MockIStore) CreateGroupMember(ctx context.Context, groupId, userId go_uuid.UUID) (int, *models.GroupMember) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupMember", ctx, groupId, userId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.GroupMember)
+	return ret0, ret1
+}
+
+// CreateGroupMember indicates an expected call of CreateGroupMember
+func (mr *MockIStoreMockRecorder) CreateGroupMember(ctx, groupId, userId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupMember", reflect.TypeOf((*MockIStore)(nil).CreateGroupMember), ctx, groupId, userId)
+}
+
+// GetGroupMembers mocks base method
+func (m *MockIStore) GetGroupMembers(ctx context.Context, query repositories.GetGroupMembersQuery) ([]*models.GroupMember, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupMembers", ctx, query)
+	ret0, _ := ret[0].([]*models.GroupMember)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetGroupMembers indicates an expected call of GetGroupMembers
+func (mr *MockIStoreMockRecorder) GetGroupMembers(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupMembers", reflect.TypeOf((*MockIStore)(nil).GetGroupMembers), ctx, query)
+}
+
+// DeleteGroupMember mocks base method
+func (m *MockIStore) DeleteGroupMember(ctx context.Context, id go_uuid.UUID) (int, *models.GroupMember) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupMember", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.GroupMember)
+	return ret0, ret1
+}
+
+// DeleteGroupMember indicates an expected call of DeleteGroupMember
+func (mr *MockIStoreMockRecorder) DeleteGroupMember(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupMember", reflect.TypeOf((*MockIStore)(nil).DeleteGroupMember), ctx, id)
+}
+
+// CreateGroupRole mocks base method
+func (m *MockIStore) CreateGroupRole(ctx context.Context, groupId, roleId go_uuid.UUID) (int, *models.GroupRole) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGroupRole", ctx, groupId, roleId)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.GroupRole)
+	return ret0, ret1
+}
+
+// CreateGroupRole indicates an expected call of CreateGroupRole
+func (mr *MockIStoreMockRecorder) CreateGroupRole(ctx, groupId, roleId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGroupRole", reflect.TypeOf((*MockIStore)(nil).CreateGroupRole), ctx, groupId, roleId)
+}
+
+// GetGroupRoles mocks base method
+func (m *MockIStore) GetGroupRoles(ctx context.Context, query repositories.GetGroupRolesQuery) ([]*models.GroupRole, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGroupRoles", ctx, query)
+	ret0, _ := ret[0].([]*models.GroupRole)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetGroupRoles indicates an expected call of GetGroupRoles
+func (mr *MockIStoreMockRecorder) GetGroupRoles(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGroupRoles", reflect.TypeOf((*MockIStore)(nil).GetGroupRoles), ctx, query)
+}
+
+// DeleteGroupRole mocks base method
+func (m *MockIStore) DeleteGroupRole(ctx context.Context, id go_uuid.UUID) (int, *models.GroupRole) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGroupRole", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.GroupRole)
+	return ret0, ret1
+}
+
+// DeleteGroupRole indicates an expected call of DeleteGroupRole
+func (mr *MockIStoreMockRecorder) DeleteGroupRole(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGroupRole", reflect.TypeOf((*MockIStore)(nil).DeleteGroupRole), ctx, id)
+}
+
+// GetSecret mocks base method
+func (m *MockIStore) GetSecret(ctx context.Context, id go_uuid.UUID) *models.Secret {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecret", ctx, id)
+	ret0, _ := ret[0].(*models.Secret)
+	return ret0
+}
+
+// GetSecret indicates an expected call of GetSecret
+func (mr *MockIStoreMockRecorder) GetSecret(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockIStore)(nil).GetSecret), ctx, id)
+}
+
+// GetActualSecret mocks base method
+func (m *MockIStore) GetActualSecret(ctx context.Context) *models.Secret {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActualSecret", ctx)
+	ret0, _ := ret[0].(*models.Secret)
+	return ret0
+}
+
+// GetActualSecret indicates an expected call of GetActualSecret
+func (mr *MockIStoreMockRecorder) GetActualSecret(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActualSecret", reflect.TypeOf((*MockIStore)(nil).GetActualSecret), ctx)
+}
+
+// CreateSecret mocks base method
+func (m *MockIStore) CreateSecret(ctx context.Context) *models.Secret {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecret", ctx)
+	ret0, _ := ret[0].(*models.Secret)
+	return ret0
+}
+
+// CreateSecret indicates an expected call of CreateSecret
+func (mr *MockIStoreMockRecorder) CreateSecret(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockIStore)(nil).CreateSecret), ctx)
+}
+
+// GetSecrets mocks base method
+func (m *MockIStore) GetSecrets(ctx context.Context, query postgresRepository.GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecrets", ctx, query)
+	ret0, _ := ret[0].([]*models.Secret)
+	ret1, _ := ret[1].(*models.PaginationResponse)
+	return ret0, ret1
+}
+
+// GetSecrets indicates an expected call of GetSecrets
+func (mr *MockIStoreMockRecorder) GetSecrets(ctx, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecrets", reflect.TypeOf((*MockIStore)(nil).GetSecrets), ctx, query)
+}
+
+// RotateSecret mocks base method
+func (m *MockIStore) RotateSecret(ctx context.Context) *models.Secret {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateSecret", ctx)
+	ret0, _ := ret[0].(*models.Secret)
+	return ret0
+}
+
+// RotateSecret indicates an expected call of RotateSecret
+func (mr *MockIStoreMockRecorder) RotateSecret(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateSecret", reflect.TypeOf((*MockIStore)(nil).RotateSecret), ctx)
+}
+
+// RevokeSecret mocks base method
+func (m *MockIStore) RevokeSecret(ctx context.Context, id go_uuid.UUID) (int, *models.Secret) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecret", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.Secret)
+	return ret0, ret1
+}
+
+// RevokeSecret indicates an expected call of RevokeSecret
+func (mr *MockIStoreMockRecorder) RevokeSecret(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecret", reflect.TypeOf((*MockIStore)(nil).RevokeSecret), ctx, id)
+}
+
+// ListenSecretsInvalidation mocks base method
+func (m *MockIStore) ListenSecretsInvalidation(ctx context.Context) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ListenSecretsInvalidation", ctx)
+}
+
+// ListenSecretsInvalidation indicates an expected call of ListenSecretsInvalidation
+func (mr *MockIStoreMockRecorder) ListenSecretsInvalidation(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListenSecretsInvalidation", reflect.TypeOf((*MockIStore)(nil).ListenSecretsInvalidation), ctx)
+}
+
+// ReencryptSecrets mocks base method
+func (m *MockIStore) ReencryptSecrets(ctx context.Context) []go_uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReencryptSecrets", ctx)
+	ret0, _ := ret[0].([]go_uuid.UUID)
+	return ret0
+}
+
+// ReencryptSecrets indicates an expected call of ReencryptSecrets
+func (mr *MockIStoreMockRecorder) ReencryptSecrets(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReencryptSecrets", reflect.TypeOf((*MockIStore)(nil).ReencryptSecrets), ctx)
+}
+
+// CreateUserExport mocks base method
+func (m *MockIStore) CreateUserExport(ctx context.Context, userID, requesterID go_uuid.UUID, tokenHash string) (int, *models.UserExport) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserExport", ctx, userID, requesterID, tokenHash)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserExport)
+	return ret0, ret1
+}
+
+// CreateUserExport indicates an expected call of CreateUserExport
+func (mr *MockIStoreMockRecorder) CreateUserExport(ctx, userID, requesterID, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserExport", reflect.TypeOf((*MockIStore)(nil).CreateUserExport), ctx, userID, requesterID, tokenHash)
+}
+
+// GetUserExport mocks base method
+func (m *MockIStore) GetUserExport(ctx context.Context, id go_uuid.UUID) (int, *models.UserExport) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserExport", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserExport)
+	return ret0, ret1
+}
+
+// GetUserExport indicates an expected call of GetUserExport
+func (mr *MockIStoreMockRecorder) GetUserExport(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExport", reflect.TypeOf((*MockIStore)(nil).GetUserExport), ctx, id)
+}
+
+// GetUserExportArchive mocks base method
+func (m *MockIStore) GetUserExportArchive(ctx context.Context, id go_uuid.UUID, tokenHash string) (int, *models.UserExport) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserExportArchive", ctx, id, tokenHash)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserExport)
+	return ret0, ret1
+}
+
+// GetUserExportArchive indicates an expected call of GetUserExportArchive
+func (mr *MockIStoreMockRecorder) GetUserExportArchive(ctx, id, tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExportArchive", reflect.TypeOf((*MockIStore)(nil).GetUserExportArchive), ctx, id, tokenHash)
+}
+
+// ClaimUserExports mocks base method
+func (m *MockIStore) ClaimUserExports(ctx context.Context, limit int) []*models.UserExport {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimUserExports", ctx, limit)
+	ret0, _ := ret[0].([]*models.UserExport)
+	return ret0
+}
+
+// ClaimUserExports indicates an expected call of ClaimUserExports
+func (mr *MockIStoreMockRecorder) ClaimUserExports(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimUserExports", reflect.TypeOf((*MockIStore)(nil).ClaimUserExports), ctx, limit)
+}
+
+// CompleteUserExport mocks base method
+func (m *MockIStore) CompleteUserExport(ctx context.Context, id go_uuid.UUID, status string, archive []byte, expires int64) (int, *models.UserExport) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUserExport", ctx, id, status, archive, expires)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserExport)
+	return ret0, ret1
+}
+
+// CompleteUserExport indicates an expected call of CompleteUserExport
+func (mr *MockIStoreMockRecorder) CompleteUserExport(ctx, id, status, archive, expires interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUserExport", reflect.TypeOf((*MockIStore)(nil).CompleteUserExport), ctx, id, status, archive, expires)
+}
+
+// DeleteExpiredUserExports mocks base method
+func (m *MockIStore) DeleteExpiredUserExports(ctx context.Context) int64 {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExpiredUserExports", ctx)
+	ret0, _ := ret[0].(int64)
+	return ret0
+}
+
+// DeleteExpiredUserExports indicates an expected call of DeleteExpiredUserExports
+func (mr *MockIStoreMockRecorder) DeleteExpiredUserExports(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExpiredUserExports", reflect.TypeOf((*MockIStore)(nil).DeleteExpiredUserExports), ctx)
+}
+
+// GetUserExportData mocks base method
+func (m *MockIStore) GetUserExportData(ctx context.Context, userID go_uuid.UUID) (int, *models.UserExportData) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserExportData", ctx, userID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.UserExportData)
+	return ret0, ret1
+}
+
+// GetUserExportData indicates an expected call of GetUserExportData
+func (mr *MockIStoreMockRecorder) GetUserExportData(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserExportData", reflect.TypeOf((*MockIStore)(nil).GetUserExportData), ctx, userID)
+}
+
+// RequestContactTransfer mocks base method
+func (m *MockIStore) RequestContactTransfer(ctx context.Context, kind, value string, fromUserID, toUserID go_uuid.UUID) (int, *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestContactTransfer", ctx, kind, value, fromUserID, toUserID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.ContactTransfer)
+	return ret0, ret1
+}
+
+// RequestContactTransfer indicates an expected call of RequestContactTransfer
+func (mr *MockIStoreMockRecorder) RequestContactTransfer(ctx, kind, value, fromUserID, toUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestContactTransfer", reflect.TypeOf((*MockIStore)(nil).RequestContactTransfer), ctx, kind, value, fromUserID, toUserID)
+}
+
+// GetContactTransfer mocks base method
+func (m *MockIStore) GetContactTransfer(ctx context.Context, id go_uuid.UUID) (int, *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactTransfer", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.ContactTransfer)
+	return ret0, ret1
+}
+
+// GetContactTransfer indicates an expected call of GetContactTransfer
+func (mr *MockIStoreMockRecorder) GetContactTransfer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactTransfer", reflect.TypeOf((*MockIStore)(nil).GetContactTransfer), ctx, id)
+}
+
+// GetUserContactTransfers mocks base method
+func (m *MockIStore) GetUserContactTransfers(ctx context.Context, userID go_uuid.UUID) []*models.ContactTransfer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserContactTransfers", ctx, userID)
+	ret0, _ := ret[0].([]*models.ContactTransfer)
+	return ret0
+}
+
+// GetUserContactTransfers indicates an expected call of GetUserContactTransfers
+func (mr *MockIStoreMockRecorder) GetUserContactTransfers(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserContactTransfers", reflect.TypeOf((*MockIStore)(nil).GetUserContactTransfers), ctx, userID)
+}
+
+// ApproveContactTransfer mocks base method
+func (m *MockIStore) ApproveContactTransfer(ctx context.Context, id go_uuid.UUID) (int, *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveContactTransfer", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.ContactTransfer)
+	return ret0, ret1
+}
+
+// ApproveContactTransfer indicates an expected call of ApproveContactTransfer
+func (mr *MockIStoreMockRecorder) ApproveContactTransfer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveContactTransfer", reflect.TypeOf((*MockIStore)(nil).ApproveContactTransfer), ctx, id)
+}
+
+// RejectContactTransfer mocks base method
+func (m *MockIStore) RejectContactTransfer(ctx context.Context, id go_uuid.UUID) (int, *models.ContactTransfer) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectContactTransfer", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.ContactTransfer)
+	return ret0, ret1
+}
+
+// RejectContactTransfer indicates an expected call of RejectContactTransfer
+func (mr *MockIStoreMockRecorder) RejectContactTransfer(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectContactTransfer", reflect.TypeOf((*MockIStore)(nil).RejectContactTransfer), ctx, id)
+}
+
+// ScheduleUserDeletion mocks base method
+func (m *MockIStore) ScheduleUserDeletion(ctx context.Context, id go_uuid.UUID, scheduled int64) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleUserDeletion", ctx, id, scheduled)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// ScheduleUserDeletion indicates an expected call of ScheduleUserDeletion
+func (mr *MockIStoreMockRecorder) ScheduleUserDeletion(ctx, id, scheduled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleUserDeletion", reflect.TypeOf((*MockIStore)(nil).ScheduleUserDeletion), ctx, id, scheduled)
+}
+
+// CancelUserDeletion mocks base method
+func (m *MockIStore) CancelUserDeletion(ctx context.Context, id go_uuid.UUID) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelUserDeletion", ctx, id)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// CancelUserDeletion indicates an expected call of CancelUserDeletion
+func (mr *MockIStoreMockRecorder) CancelUserDeletion(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelUserDeletion", reflect.TypeOf((*MockIStore)(nil).CancelUserDeletion), ctx, id)
+}
+
+// GetDueUserDeletions mocks base method
+func (m *MockIStore) GetDueUserDeletions(ctx context.Context, now int64, limit int) []go_uuid.UUID {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDueUserDeletions", ctx, now, limit)
+	ret0, _ := ret[0].([]go_uuid.UUID)
+	return ret0
+}
+
+// GetDueUserDeletions indicates an expected call of GetDueUserDeletions
+func (mr *MockIStoreMockRecorder) GetDueUserDeletions(ctx, now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueUserDeletions", reflect.TypeOf((*MockIStore)(nil).GetDueUserDeletions), ctx, now, limit)
+}
+
+// AnonymizeUser mocks base method
+func (m *MockIStore) AnonymizeUser(ctx context.Context, id go_uuid.UUID, now int64) (int, *models.User) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymizeUser", ctx, id, now)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*models.User)
+	return ret0, ret1
+}
+
+// AnonymizeUser indicates an expected call of AnonymizeUser
+func (mr *MockIStoreMockRecorder) AnonymizeUser(ctx, id, now interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymizeUser", reflect.TypeOf((*MockIStore)(nil).AnonymizeUser), ctx, id, now)
+}
+
+// CreateSession mocks base method
+func (m *MockIStore) CreateSession(ctx context.Context, userID, secretID go_uuid.UUID, fingerprint, userAgent, keyThumbprint string, organizationID go_uuid.UUID, authentication *models.AuthenticationContext) *models.Session {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSession", ctx, userID, secretID, fingerprint, userAgent, keyThumbprint, organizationID, authentication)
+	ret0, _ := ret[0].(*models.Session)
+	return ret0
+}
+
+// CreateSession indicates an expected call of CreateSession
+func (mr *MockIStoreMockRecorder) CreateSession(ctx, userID, secretID, fingerprint, userAgent, keyThumbprint, organizationID, authentication interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSession", reflect.TypeOf((*MockIStore)(nil).CreateSession), ctx, userID, secretID, fingerprint, userAgent, keyThumbprint, organizationID, authentication)
+}
+
+// DeleteSession mocks base method
+func (m *MockIStore) DeleteSession(ctx context.Context, id go_uuid.UUID) *models.Session {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSession", ctx, id)
+	ret0, _ := ret[0].(*models.Session)
+	return ret0
+}
+
+// DeleteSession indicates an expected call of DeleteSession
+func (mr *MockIStoreMockRecorder) DeleteSession(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSession", reflect.TypeOf((*MockIStore)(nil).DeleteSession), ctx, id)
+}
+
+// DeleteUserSessions mocks base method
+func (m *MockIStore) DeleteUserSessions(ctx context.Context, userID go_uuid.UUID) []*models.Session {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserSessions", ctx, userID)
+	ret0, _ := ret[0].([]*models.Session)
+	return ret0
+}
+
+// DeleteUserSessions indicates an expected call of DeleteUserSessions
+func (mr *MockIStoreMockRecorder) DeleteUserSessions(ctx, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserSessions", reflect.TypeOf((*MockIStore)(nil).DeleteUserSessions), ctx, userID)
+}
+
+// GetSessionExistence mocks base method
+func (m *MockIStore) GetSessionExistence(ctx context.Context, publicID go_uuid.UUID) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessionExistence", ctx, publicID)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetSessionExistence indicates an expected call of GetSessionExistence
+func (mr *MockIStoreMockRecorder) GetSessionExistence(ctx, publicID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessionExistence", reflect.TypeOf((*MockIStore)(nil).GetSessionExistence), ctx, publicID)
+}
+
+// CreateDPoPNonce mocks base method
+func (m *MockIStore) CreateDPoPNonce(ctx context.Context) string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDPoPNonce", ctx)
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// CreateDPoPNonce indicates an expected call of CreateDPoPNonce
+func (mr *MockIStoreMockRecorder) CreateDPoPNonce(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDPoPNonce", reflect.TypeOf((*MockIStore)(nil).CreateDPoPNonce), ctx)
+}
+
+// GetDPoPNonceExistence mocks base method
+func (m *MockIStore) GetDPoPNonceExistence(ctx context.Context, nonce string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDPoPNonceExistence", ctx, nonce)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetDPoPNonceExistence indicates an expected call of GetDPoPNonceExistence
+func (mr *MockIStoreMockRecorder) GetDPoPNonceExistence(ctx, nonce interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDPoPNonceExistence", reflect.TypeOf((*MockIStore)(nil).GetDPoPNonceExistence), ctx, nonce)
+}
+
+// RegisterDPoPProof mocks base method
+func (m *MockIStore) RegisterDPoPProof(ctx context.Context, keyThumbprint, jti string) bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDPoPProof", ctx, keyThumbprint, jti)
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// RegisterDPoPProof indicates an expected call of RegisterDPoPProof
+func (mr *MockIStoreMockRecorder) RegisterDPoPProof(ctx, keyThumbprint, jti interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDPoPProof", reflect.TypeOf((*MockIStore)(nil).RegisterDPoPProof), ctx, keyThumbprint, jti)
 }
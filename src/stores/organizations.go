@@ -0,0 +1,32 @@
+package stores
+
+import (
+	"hive/models"
+	"hive/repositories"
+	"context"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (store *DatabaseStore) CreateOrganization(ctx context.Context, title string) (int, *models.Organization) {
+	return repositories.CreateOrganization(store.db, ctx, title)
+}
+
+func (store *DatabaseStore) GetOrganization(ctx context.Context, id uuid.UUID) (int, *models.Organization) {
+	return repositories.GetOrganization(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetOrganizations(ctx context.Context, query repositories.GetOrganizationsQuery) ([]*models.Organization, *models.PaginationResponse) {
+	return repositories.GetOrganizations(store.db, ctx, query)
+}
+
+func (store *DatabaseStore) CreateOrganizationMember(ctx context.Context, organizationId uuid.UUID, userId uuid.UUID) (int, *models.OrganizationMember) {
+	return repositories.CreateOrganizationMember(store.db, ctx, organizationId, userId)
+}
+
+func (store *DatabaseStore) GetOrganizationMembers(ctx context.Context, query repositories.GetOrganizationMembersQuery) ([]*models.OrganizationMember, *models.PaginationResponse) {
+	return repositories.GetOrganizationMembers(store.db, ctx, query)
+}
+
+func (store *DatabaseStore) DeleteOrganizationMember(ctx context.Context, id uuid.UUID) (int, *models.OrganizationMember) {
+	return repositories.DeleteOrganizationMember(store.db, ctx, id)
+}
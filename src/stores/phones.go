@@ -2,7 +2,9 @@ package stores
 
 import (
 	"context"
+	"github.com/getsentry/sentry-go"
 	uuid "github.com/satori/go.uuid"
+	"hive/enums"
 	"hive/models"
 	"hive/repositories"
 	"math/rand"
@@ -10,8 +12,130 @@ import (
 	"time"
 )
 
-func (store *DatabaseStore) CreatePhone(ctx context.Context, userId uuid.UUID, value string) (int, *models.Phone) {
-	return repositories.CreatePhone(store.db, ctx, userId, value)
+// CreatePhone adds phone to user, phone taken from previous owner is recorded as completed transfer
+func (store *DatabaseStore) CreatePhone(ctx context.Context, userId uuid.UUID, value string) (int, *models.Phone, *models.ContactTransfer) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil, nil
+	}
+
+	status, phone, previousID := transferPhone(tx, ctx, userId, value)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil, nil
+	}
+
+	status, transfer := recordContactTransfer(tx, ctx, enums.PhoneContact, value, previousID, userId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil, nil
+	}
+
+	return enums.Ok, phone, transfer
+}
+
+func (store *DatabaseStore) GetPhoneByID(ctx context.Context, id uuid.UUID) (int, *models.Phone) {
+	return repositories.GetPhoneByID(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetUserPhones(ctx context.Context, userID uuid.UUID) []*models.Phone {
+	return repositories.GetUserPhones(store.db, ctx, userID)
+}
+
+// DeletePhone removes phone unless it is the last login identifier of its owner, the earliest of remaining
+// phones becomes primary instead of removed one
+func (store *DatabaseStore) DeletePhone(ctx context.Context, id uuid.UUID) (int, *models.Phone) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, phone := repositories.GetPhoneByID(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status = repositories.LockUser(tx, ctx, phone.UserId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, count := repositories.CountUserLoginIdentifiers(tx, ctx, phone.UserId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	if repositories.Rollback(tx, ctx, count <= 1) {
+		return enums.LastLoginIdentifier, nil
+	}
+
+	status, phone = repositories.DeletePhone(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status = repositories.EnsurePrimaryPhone(tx, ctx, phone.UserId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, phone
+}
+
+func (store *DatabaseStore) SetPrimaryPhone(ctx context.Context, id uuid.UUID) (int, *models.Phone) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, phone := repositories.GetPhoneByID(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, phone = repositories.SetPrimaryPhone(tx, ctx, id, phone.UserId)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, phone
 }
 
 func (store *DatabaseStore) GetPhone(ctx context.Context, phone string) (int, *models.Phone) {
@@ -0,0 +1,69 @@
+package stores
+
+import (
+	"hive/enums"
+	"hive/models"
+	"hive/repositories"
+	"context"
+	"github.com/getsentry/sentry-go"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (store *DatabaseStore) CreateRoleRequest(ctx context.Context, userId uuid.UUID, roleId uuid.UUID, reason string, expiresAt int64) (int, *models.RoleRequest) {
+	return repositories.CreateRoleRequest(store.db, ctx, userId, roleId, reason, expiresAt)
+}
+
+func (store *DatabaseStore) GetRoleRequest(ctx context.Context, id uuid.UUID) (int, *models.RoleRequest) {
+	return repositories.GetRoleRequest(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetRoleRequests(ctx context.Context, query repositories.GetRoleRequestsQuery) ([]*models.RoleRequest, *models.PaginationResponse) {
+	return repositories.GetRoleRequests(store.db, ctx, query)
+}
+
+func (store *DatabaseStore) ReviewRoleRequest(ctx context.Context, id uuid.UUID, status string, reviewerId uuid.UUID, comment string) (int, *models.RoleRequest) {
+	return repositories.ReviewRoleRequest(store.db, ctx, id, status, reviewerId, comment, 0)
+}
+
+// ApproveRoleRequest approves pending request and grants requested role to its author in one transaction,
+// so request is never approved without role
+func (store *DatabaseStore) ApproveRoleRequest(ctx context.Context, id uuid.UUID, reviewerId uuid.UUID, comment string, expiresAt int64) (int, *models.RoleRequest) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, request := repositories.ReviewRoleRequest(tx, ctx, id, enums.RoleRequestApproved, reviewerId, comment, expiresAt)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, userRole := createUserRole(tx, ctx, request.UserId, request.RoleId, uuid.Nil, 0, request.ExpiresAt)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, request = repositories.SetRoleRequestUserRole(tx, ctx, id, userRole.Id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, request
+}
+
+func (store *DatabaseStore) GetRoleManagersID(ctx context.Context, roleId uuid.UUID) []uuid.UUID {
+	return repositories.GetRoleManagersID(store.db, ctx, roleId)
+}
@@ -2,14 +2,121 @@ package stores
 
 import (
 	"context"
+	"github.com/getsentry/sentry-go"
 	uuid "github.com/satori/go.uuid"
 	"hive/enums"
 	"hive/models"
 	"hive/repositories"
 )
 
-func (store *DatabaseStore) CreateRole(ctx context.Context, title string) (int, *models.Role) {
-	return repositories.CreateRole(store.db, ctx, title)
+func (store *DatabaseStore) CreateRole(ctx context.Context, title string, permissions []string, parentsID []uuid.UUID) (int, *models.Role) {
+	return repositories.CreateRole(store.db, ctx, title, permissions, parentsID)
+}
+
+// UpdateRoleParents replaces parents of role, returns RoleHierarchyCycle if role would become its own ancestor
+func (store *DatabaseStore) UpdateRoleParents(ctx context.Context, id uuid.UUID, parentsID []uuid.UUID) (int, *models.Role) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status := repositories.LockRoleHierarchy(tx, ctx)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, _ = repositories.GetRole(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, cycle := repositories.HasRoleHierarchyCycle(tx, ctx, id, parentsID)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	if repositories.Rollback(tx, ctx, cycle) {
+		return enums.RoleHierarchyCycle, nil
+	}
+
+	status = repositories.UpdateRoleParents(tx, ctx, id, parentsID)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, role := repositories.GetRole(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, role
+}
+
+func (store *DatabaseStore) UpdateRole(ctx context.Context, id uuid.UUID, title string, description string) (int, *models.Role) {
+	return repositories.UpdateRole(store.db, ctx, id, title, description)
+}
+
+func (store *DatabaseStore) DeleteRole(ctx context.Context, id uuid.UUID) (int, *models.Role) {
+	return repositories.DeleteRole(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetRoleUsersID(ctx context.Context, id uuid.UUID) []uuid.UUID {
+	return repositories.GetRoleUsersID(store.db, ctx, id)
+}
+
+// UpdateRoleManagedRoles replaces roles which users having role could grant and revoke
+func (store *DatabaseStore) UpdateRoleManagedRoles(ctx context.Context, id uuid.UUID, managedRolesID []uuid.UUID) (int, *models.Role) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, _ := repositories.GetRole(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status = repositories.UpdateRoleManagedRoles(tx, ctx, id, managedRolesID)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	status, role := repositories.GetRole(tx, ctx, id)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, role
+}
+
+func (store *DatabaseStore) IsRoleManageable(ctx context.Context, managersID []uuid.UUID, id uuid.UUID) (int, bool) {
+	return repositories.IsRoleManageable(store.db, ctx, managersID, id)
 }
 
 func (store *DatabaseStore) GetRole(ctx context.Context, id uuid.UUID) (int, *models.Role) {
@@ -34,4 +141,4 @@ func (store *DatabaseStore) GetRoleByTitle(ctx context.Context, title string) (i
 	} else {
 		return enums.Ok, nil
 	}
-}
\ No newline at end of file
+}
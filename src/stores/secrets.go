@@ -1,7 +1,10 @@
 package stores
 
 import (
+	"hive/enums"
 	"hive/models"
+	"hive/repositories"
+	"hive/repositories/postgresRepository"
 	"context"
 	"github.com/getsentry/sentry-go"
 	uuid "github.com/satori/go.uuid"
@@ -35,7 +38,7 @@ func (store *DatabaseStore) GetSecret(ctx context.Context, id uuid.UUID) *models
 
 func (store *DatabaseStore) GetActualSecret(ctx context.Context) *models.Secret {
 
-	env := store.environment
+	realm := repositories.GetRealmFromContext(ctx)
 
 	actualSecret := store.inMemoryRepository.GetActualSecret(ctx)
 	if actualSecret != nil {
@@ -44,7 +47,7 @@ func (store *DatabaseStore) GetActualSecret(ctx context.Context) *models.Secret
 
 	actualSecret = store.redisRepository.GetActualSecret(ctx)
 	if actualSecret != nil {
-		store.inMemoryRepository.CacheActualSecret(ctx, actualSecret, time.Minute*time.Duration(env.ActualSecretLifetime))
+		store.inMemoryRepository.CacheActualSecret(ctx, actualSecret, time.Minute*time.Duration(realm.GetActualSecretLifetime(store.environment)))
 		return actualSecret
 	}
 
@@ -53,18 +56,91 @@ func (store *DatabaseStore) GetActualSecret(ctx context.Context) *models.Secret
 
 func (store *DatabaseStore) CreateSecret(ctx context.Context) *models.Secret {
 
-	env := store.environment
+	realm := repositories.GetRealmFromContext(ctx)
+	actualSecretLifetime := time.Minute * time.Duration(realm.GetActualSecretLifetime(store.environment))
+	refreshTokenLifetime := time.Duration(realm.GetRefreshTokenLifetime(store.environment))
 
 	actualSecret := store.postgresRepository.CreateSecret(ctx)
-	err := store.redisRepository.CacheActualSecret(ctx, actualSecret, time.Minute*time.Duration(env.ActualSecretLifetime))
+	err := store.redisRepository.CacheActualSecret(ctx, actualSecret, actualSecretLifetime)
 	if err != nil {
 		sentry.CaptureException(err)
 	}
-	err = store.redisRepository.CacheSecret(ctx, actualSecret, time.Hour*time.Duration(env.RefreshTokenLifetime)*24)
+	err = store.redisRepository.CacheSecret(ctx, actualSecret, time.Hour*refreshTokenLifetime*24)
 	if err != nil {
 		sentry.CaptureException(err)
 	}
-	store.inMemoryRepository.CacheActualSecret(ctx, actualSecret, time.Minute*time.Duration(env.ActualSecretLifetime))
-	store.inMemoryRepository.CacheSecret(ctx, actualSecret, time.Hour*time.Duration(env.RefreshTokenLifetime))
+	store.inMemoryRepository.CacheActualSecret(ctx, actualSecret, actualSecretLifetime)
+	store.inMemoryRepository.CacheSecret(ctx, actualSecret, time.Hour*refreshTokenLifetime)
 	return actualSecret
 }
+
+func (store *DatabaseStore) GetSecrets(ctx context.Context, query postgresRepository.GetSecretsQuery) ([]*models.Secret, *models.PaginationResponse) {
+	return store.postgresRepository.GetSecrets(ctx, query)
+}
+
+func (store *DatabaseStore) publishSecretInvalidation(ctx context.Context, id uuid.UUID) {
+	err := store.redisRepository.PublishSecretInvalidation(ctx, id)
+	if err != nil {
+		sentry.CaptureException(err)
+	}
+}
+
+func (store *DatabaseStore) RotateSecret(ctx context.Context) *models.Secret {
+	store.postgresRepository.ExpireSecrets(ctx)
+	actualSecret := store.CreateSecret(ctx)
+	store.publishSecretInvalidation(ctx, actualSecret.Id)
+	return actualSecret
+}
+
+func (store *DatabaseStore) RevokeSecret(ctx context.Context, id uuid.UUID) (int, *models.Secret) {
+	secret := store.postgresRepository.RevokeSecret(ctx, id)
+	if secret == nil {
+		return enums.SecretNotFound, nil
+	}
+
+	err := store.redisRepository.DeleteSecret(ctx, id)
+	if err != nil {
+		sentry.CaptureException(err)
+	}
+
+	actualSecret := store.redisRepository.GetActualSecret(ctx)
+	if actualSecret != nil && uuid.Equal(actualSecret.Id, id) {
+		err = store.redisRepository.DeleteActualSecret(ctx)
+		if err != nil {
+			sentry.CaptureException(err)
+		}
+	}
+
+	store.inMemoryRepository.DeleteSecret(ctx, id)
+	store.inMemoryRepository.DeleteActualSecret(ctx)
+	store.publishSecretInvalidation(ctx, id)
+	return enums.Ok, secret
+}
+
+// ListenSecretsInvalidation drops secrets of realm from context revoked or rotated by other instances
+// from in memory cache, blocks until context is done
+func (store *DatabaseStore) ListenSecretsInvalidation(ctx context.Context) {
+	for id := range store.redisRepository.SubscribeSecretInvalidation(ctx) {
+		store.inMemoryRepository.DeleteSecret(ctx, id)
+		store.inMemoryRepository.DeleteActualSecret(ctx)
+	}
+}
+
+func (store *DatabaseStore) ReencryptSecrets(ctx context.Context) []uuid.UUID {
+	identifiers := store.postgresRepository.ReencryptSecrets(ctx)
+
+	for _, id := range identifiers {
+		err := store.redisRepository.DeleteSecret(ctx, id)
+		if err != nil {
+			sentry.CaptureException(err)
+		}
+		store.publishSecretInvalidation(ctx, id)
+	}
+
+	err := store.redisRepository.DeleteActualSecret(ctx)
+	if err != nil {
+		sentry.CaptureException(err)
+	}
+
+	return identifiers
+}
@@ -2,14 +2,58 @@ package stores
 
 import (
 	"hive/models"
+	"hive/repositories"
 	"context"
+	"github.com/getsentry/sentry-go"
 	uuid "github.com/satori/go.uuid"
+	"time"
 )
 
-func (store *DatabaseStore) CreateSession(ctx context.Context, userID uuid.UUID, secretID uuid.UUID, fingerprint string, userAgent string) *models.Session {
-	return store.postgresRepository.CreateSession(ctx, userID, secretID, fingerprint, userAgent)
+func (store *DatabaseStore) CreateSession(ctx context.Context, userID uuid.UUID, secretID uuid.UUID, fingerprint string, userAgent string, keyThumbprint string, organizationID uuid.UUID, authentication *models.AuthenticationContext) *models.Session {
+	return store.postgresRepository.CreateSession(ctx, userID, secretID, fingerprint, userAgent, keyThumbprint, organizationID, authentication)
 }
 
 func (store *DatabaseStore) DeleteSession(ctx context.Context, id uuid.UUID) *models.Session {
-	return store.postgresRepository.DeleteSession(ctx, id)
+	session := store.postgresRepository.DeleteSession(ctx, id)
+	if session != nil {
+		err := store.redisRepository.CacheSessionExistence(ctx, session.PublicID, false, store.getSessionExistenceTimeout(ctx))
+		if err != nil {
+			sentry.CaptureException(err)
+		}
+	}
+
+	return session
+}
+
+// DeleteUserSessions revokes every session of user, access tokens bound to them are rejected at once
+func (store *DatabaseStore) DeleteUserSessions(ctx context.Context, userID uuid.UUID) []*models.Session {
+	sessions := store.postgresRepository.DeleteUserSessions(ctx, userID)
+	for _, session := range sessions {
+		err := store.redisRepository.CacheSessionExistence(ctx, session.PublicID, false, store.getSessionExistenceTimeout(ctx))
+		if err != nil {
+			sentry.CaptureException(err)
+		}
+	}
+
+	return sessions
+}
+
+// Access tokens can't outlive their lifetime, so there is no need to cache existence of session longer
+func (store *DatabaseStore) getSessionExistenceTimeout(ctx context.Context) time.Duration {
+	return time.Minute * time.Duration(repositories.GetRealmFromContext(ctx).GetAccessTokenLifetime(store.environment))
+}
+
+func (store *DatabaseStore) GetSessionExistence(ctx context.Context, publicID uuid.UUID) bool {
+	cached, exists := store.redisRepository.GetSessionExistence(ctx, publicID)
+	if cached {
+		return exists
+	}
+
+	exists = store.postgresRepository.GetSessionByPublicID(ctx, publicID) != nil
+	err := store.redisRepository.CacheSessionExistence(ctx, publicID, exists, store.getSessionExistenceTimeout(ctx))
+	if err != nil {
+		sentry.CaptureException(err)
+	}
+
+	return exists
 }
@@ -0,0 +1,52 @@
+package stores
+
+import (
+	"hive/enums"
+	"hive/models"
+	"hive/repositories"
+	"context"
+	"github.com/getsentry/sentry-go"
+	uuid "github.com/satori/go.uuid"
+)
+
+func (store *DatabaseStore) ScheduleUserDeletion(ctx context.Context, id uuid.UUID, scheduled int64) (int, *models.User) {
+	return repositories.ScheduleUserDeletion(store.db, ctx, id, scheduled)
+}
+
+func (store *DatabaseStore) CancelUserDeletion(ctx context.Context, id uuid.UUID) (int, *models.User) {
+	return repositories.CancelUserDeletion(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetDueUserDeletions(ctx context.Context, now int64, limit int) []uuid.UUID {
+	return repositories.GetDueUserDeletions(store.db, ctx, now, limit)
+}
+
+// AnonymizeUser erases personal data of user in one transaction, so user is never left half anonymized.
+// Sessions are revoked afterwards since they are cached
+func (store *DatabaseStore) AnonymizeUser(ctx context.Context, id uuid.UUID, now int64) (int, *models.User) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, user := repositories.AnonymizeUser(tx, ctx, id, now)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	store.DeleteUserSessions(ctx, id)
+	return enums.Ok, user
+}
@@ -0,0 +1,85 @@
+package stores
+
+import (
+	"hive/enums"
+	"hive/models"
+	"hive/repositories"
+	"context"
+	uuid "github.com/satori/go.uuid"
+)
+
+// Role assignments and requests are read by pages of this size, so long histories are exported completely
+const userExportPageLimit = 500
+
+func (store *DatabaseStore) CreateUserExport(ctx context.Context, userID uuid.UUID, requesterID uuid.UUID, tokenHash string) (int, *models.UserExport) {
+	return repositories.CreateUserExport(store.db, ctx, userID, requesterID, tokenHash)
+}
+
+func (store *DatabaseStore) GetUserExport(ctx context.Context, id uuid.UUID) (int, *models.UserExport) {
+	return repositories.GetUserExport(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) GetUserExportArchive(ctx context.Context, id uuid.UUID, tokenHash string) (int, *models.UserExport) {
+	return repositories.GetUserExportArchive(store.db, ctx, id, tokenHash)
+}
+
+func (store *DatabaseStore) ClaimUserExports(ctx context.Context, limit int) []*models.UserExport {
+	return repositories.ClaimUserExports(store.db, ctx, limit)
+}
+
+func (store *DatabaseStore) CompleteUserExport(ctx context.Context, id uuid.UUID, status string, archive []byte, expires int64) (int, *models.UserExport) {
+	return repositories.CompleteUserExport(store.db, ctx, id, status, archive, expires)
+}
+
+func (store *DatabaseStore) DeleteExpiredUserExports(ctx context.Context) int64 {
+	return repositories.DeleteExpiredUserExports(store.db, ctx)
+}
+
+// GetUserExportData collects everything hive holds for user
+func (store *DatabaseStore) GetUserExportData(ctx context.Context, userID uuid.UUID) (int, *models.UserExportData) {
+	user := repositories.GetUser(store.db, ctx, userID)
+	if user == nil {
+		return enums.UserNotFound, nil
+	}
+
+	data := &models.UserExportData{
+		User:             user,
+		Emails:           repositories.GetUserEmails(store.db, ctx, userID),
+		Phones:           repositories.GetUserPhones(store.db, ctx, userID),
+		PasswordsChanged: repositories.GetPasswordsChanged(store.db, ctx, userID),
+		Sessions:         store.postgresRepository.GetUserSessions(ctx, userID),
+		ContactTransfers: repositories.GetUserContactTransfers(store.db, ctx, userID),
+	}
+
+	for page := 1; ; page++ {
+		roles, pagination := repositories.GetUserRoles(store.db, ctx, repositories.GetUserRoleQuery{
+			UserId:     []uuid.UUID{userID},
+			Pagination: &models.PaginationRequest{Limit: userExportPageLimit, Page: page},
+		})
+		if pagination == nil {
+			return enums.NotOk, nil
+		}
+
+		data.Roles = append(data.Roles, roles...)
+		if !pagination.HasNext {
+			break
+		}
+	}
+
+	for page := 1; ; page++ {
+		requests, pagination := repositories.GetRoleRequests(store.db, ctx, repositories.GetRoleRequestsQuery{
+			UserId:     []uuid.UUID{userID},
+			Pagination: &models.PaginationRequest{Limit: userExportPageLimit, Page: page},
+		})
+		if pagination == nil {
+			return enums.NotOk, nil
+		}
+
+		data.RoleRequests = append(data.RoleRequests, requests...)
+		if !pagination.HasNext {
+			break
+		}
+	}
+
+	return enums.Ok, data
+}
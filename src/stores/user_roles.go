@@ -1,20 +1,69 @@
 package stores
 
 import (
+	"hive/enums"
 	"hive/models"
 	"hive/repositories"
 	"context"
+	"github.com/getsentry/sentry-go"
 	uuid "github.com/satori/go.uuid"
 )
 
-func (store *DatabaseStore) CreateUserRole(ctx context.Context, userId uuid.UUID, roleId uuid.UUID) (int, *models.UserRole) {
-	return repositories.CreateUserRole(store.db, ctx, userId, roleId)
+// CreateUserRole grants role in transaction holding role hierarchy lock, so users views are never refreshed
+// from hierarchy which is being changed concurrently
+func (store *DatabaseStore) CreateUserRole(ctx context.Context, userId uuid.UUID, roleId uuid.UUID, organizationId uuid.UUID, startsAt int64, expiresAt int64) (int, *models.UserRole) {
+	tx, err := store.db.Begin(ctx)
+
+	if tx == nil {
+		return enums.NotOk, nil
+	}
+
+	if repositories.Rollback(tx, ctx, err != nil) {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	status, userRole := createUserRole(tx, ctx, userId, roleId, organizationId, startsAt, expiresAt)
+	if repositories.Rollback(tx, ctx, status != enums.Ok) {
+		return status, nil
+	}
+
+	err = tx.Commit(ctx)
+
+	if err != nil {
+		sentry.CaptureException(err)
+		return enums.NotOk, nil
+	}
+
+	return enums.Ok, userRole
+}
+
+// createUserRole is shared by direct grants and approved role requests, transaction must be committed by caller
+func createUserRole(tx repositories.DB, ctx context.Context, userId uuid.UUID, roleId uuid.UUID, organizationId uuid.UUID, startsAt int64, expiresAt int64) (int, *models.UserRole) {
+	status := repositories.LockRoleHierarchy(tx, ctx)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	return repositories.CreateUserRole(tx, ctx, userId, roleId, organizationId, startsAt, expiresAt)
 }
 
 func (store *DatabaseStore) GetUserRoles(ctx context.Context, query repositories.GetUserRoleQuery) ([]*models.UserRole, *models.PaginationResponse) {
 	return repositories.GetUserRoles(store.db, ctx, query)
 }
 
+func (store *DatabaseStore) GetUserRole(ctx context.Context, id uuid.UUID) (int, *models.UserRole) {
+	return repositories.GetUserRole(store.db, ctx, id)
+}
+
+func (store *DatabaseStore) ExpireUserRoles(ctx context.Context) []*models.UserRole {
+	return repositories.ExpireUserRoles(store.db, ctx)
+}
+
+func (store *DatabaseStore) StartUserRoles(ctx context.Context) []*models.UserRole {
+	return repositories.StartUserRoles(store.db, ctx)
+}
+
 func (store *DatabaseStore) DeleteUserRole(ctx context.Context, id uuid.UUID) (int, *models.UserRole) {
 	return repositories.DeleteUserRole(store.db, ctx, id)
 }
@@ -10,14 +10,22 @@ import (
 	uuid "github.com/satori/go.uuid"
 )
 
-func createPhoneForUser(tx repositories.DB, ctx context.Context, phone string, userId uuid.UUID) int {
-	status, _ := repositories.CreatePhone(tx, ctx, userId, phone)
-	return status
+func createPhoneForUser(tx repositories.DB, ctx context.Context, phone string, userId uuid.UUID) (int, *models.ContactTransfer) {
+	status, _, previousID := transferPhone(tx, ctx, userId, phone)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	return recordContactTransfer(tx, ctx, enums.PhoneContact, phone, previousID, userId)
 }
 
-func createEmailForUser(tx repositories.DB, ctx context.Context, email string, userId uuid.UUID) int {
-	status, _ := repositories.CreateEmail(tx, ctx, userId, email)
-	return status
+func createEmailForUser(tx repositories.DB, ctx context.Context, email string, userId uuid.UUID) (int, *models.ContactTransfer) {
+	status, _, previousID := transferEmail(tx, ctx, userId, email)
+	if status != enums.Ok {
+		return status, nil
+	}
+
+	return recordContactTransfer(tx, ctx, enums.EmailContact, email, previousID, userId)
 }
 
 func createPasswordForUser(tx repositories.DB, ctx context.Context, password string, userId uuid.UUID) int {
@@ -25,32 +33,42 @@ func createPasswordForUser(tx repositories.DB, ctx context.Context, password str
 	return status
 }
 
-func (store *DatabaseStore) CreateUser(ctx context.Context, password, email, phone string) (int, *models.User) {
+// CreateUser creates user with password and confirmed contacts, contacts taken from other users are returned as transfers
+func (store *DatabaseStore) CreateUser(ctx context.Context, password, email, phone string) (int, *models.User, []*models.ContactTransfer) {
 	tx, err := store.db.Begin(ctx)
 
 	if tx == nil {
-		return enums.NotOk, nil
+		return enums.NotOk, nil, nil
 	}
 
 	if repositories.Rollback(tx, ctx, err != nil) {
 		sentry.CaptureException(err)
-		return enums.NotOk, nil
+		return enums.NotOk, nil, nil
 	}
 
 	user := repositories.CreateUser(tx, ctx)
 
 	if repositories.Rollback(tx, ctx, user == nil) {
-		return enums.NotOk, nil
+		return enums.NotOk, nil, nil
 	}
 
 	var statuses []int
+	var transfers []*models.ContactTransfer
 
 	if phone != "" {
-		statuses = append(statuses, createPhoneForUser(tx, ctx, phone, user.Id))
+		status, transfer := createPhoneForUser(tx, ctx, phone, user.Id)
+		statuses = append(statuses, status)
+		if transfer != nil {
+			transfers = append(transfers, transfer)
+		}
 	}
 
 	if email != "" {
-		statuses = append(statuses, createEmailForUser(tx, ctx, email, user.Id))
+		status, transfer := createEmailForUser(tx, ctx, email, user.Id)
+		statuses = append(statuses, status)
+		if transfer != nil {
+			transfers = append(transfers, transfer)
+		}
 	}
 
 	if password != "" {
@@ -58,17 +76,17 @@ func (store *DatabaseStore) CreateUser(ctx context.Context, password, email, pho
 	}
 
 	if repositories.Rollback(tx, ctx, !functools.All(enums.Ok, statuses)) {
-		return functools.Max(statuses), nil
+		return functools.Max(statuses), nil, nil
 	}
 
 	err = tx.Commit(ctx)
 
 	if err != nil {
 		sentry.CaptureException(err)
-		return enums.NotOk, nil
+		return enums.NotOk, nil, nil
 	}
 
-	return enums.Ok, user
+	return enums.Ok, user, transfers
 }
 
 func (store *DatabaseStore) GetUser(context context.Context, id uuid.UUID) *models.User {
@@ -82,3 +100,11 @@ func (store *DatabaseStore) GetUsers(context context.Context, query repositories
 func (store *DatabaseStore) DeleteUser(ctx context.Context, id uuid.UUID) (int, *models.User) {
 	return repositories.DeleteUser(store.db, ctx, id)
 }
+
+func (store *DatabaseStore) UpdateUserProfile(ctx context.Context, id uuid.UUID, profile map[string]interface{}) (int, *models.User) {
+	return repositories.UpdateUserProfile(store.db, ctx, id, profile)
+}
+
+func (store *DatabaseStore) UpdateUserStatus(ctx context.Context, id uuid.UUID, status string, suspendedUntil int64, reason string, actorID uuid.UUID) (int, *models.User) {
+	return repositories.UpdateUserStatus(store.db, ctx, id, status, suspendedUntil, reason, actorID)
+}
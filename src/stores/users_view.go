@@ -11,6 +11,10 @@ func (store *DatabaseStore) GetUsersView(ctx context.Context, query repositories
 	return repositories.GetUsersView(store.db, ctx, query)
 }
 
+func (store *DatabaseStore) SearchUsersView(ctx context.Context, query repositories.SearchUsersViewQuery) ([]*models.UserView, *models.PaginationResponse) {
+	return repositories.SearchUsersView(store.db, ctx, query)
+}
+
 func (store *DatabaseStore) GetUserView(ctx context.Context, id uuid.UUID) *models.UserView {
 
 	userView := repositories.GetUserViewFromCache(store.cache, ctx, id)
@@ -33,7 +37,7 @@ func (store *DatabaseStore) CreateOrUpdateUsersView(ctx context.Context, query r
 }
 
 func (store *DatabaseStore) CreateOrUpdateUsersViewByUsersID(context context.Context, id []uuid.UUID) []*models.UserView {
-	return store.CreateOrUpdateUsersView(context, repositories.CreateOrUpdateUsersViewStoreQuery{Id: id,})
+	return store.CreateOrUpdateUsersView(context, repositories.CreateOrUpdateUsersViewStoreQuery{Id: id})
 }
 
 func (store *DatabaseStore) CreateOrUpdateUsersViewByRolesID(context context.Context, id []uuid.UUID) []*models.UserView {